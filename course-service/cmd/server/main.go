@@ -0,0 +1,897 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/config"
+	"github.com/dmehra2102/learning-platform/course-service/internal/download"
+	"github.com/dmehra2102/learning-platform/course-service/internal/grpc"
+	"github.com/dmehra2102/learning-platform/course-service/internal/notification"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/scheduler"
+	"github.com/dmehra2102/learning-platform/course-service/internal/service"
+	"github.com/dmehra2102/learning-platform/course-service/internal/storage"
+	"github.com/dmehra2102/learning-platform/course-service/internal/user"
+	"github.com/dmehra2102/learning-platform/course-service/internal/video"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
+	"github.com/dmehra2102/learning-platform/shared/pkg/jwt"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/dmehra2102/learning-platform/shared/pkg/logger"
+	pb "github.com/dmehra2102/learning-platform/shared/proto/course"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	grpcLib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initializing Logger
+	logger.InitLogger(cfg.App.Environment)
+	log := logger.GetLogger()
+	defer logger.Sync()
+
+	log.Info("starting course service")
+
+	// Initialize Database
+	db, err := database.NewPostgresDB(cfg.Database, log)
+	if err != nil {
+		log.Fatal("failed to connect to database", zap.Error(err))
+	}
+	defer db.Close()
+
+	// Run migrations
+	if err := runDBMigrations(db, log); err != nil {
+		log.Fatal("failed to run migrations", zap.Error(err))
+	}
+
+	// Initialize JWT Manager
+	jwtManager := jwt.NewManager(
+		cfg.JWT.SecretKey,
+		cfg.JWT.AccessTokenExpiry,
+		cfg.JWT.RefreshTokenExpiry,
+	)
+
+	// Initialize Kafka producers
+	courseCreatedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicCourseCreated,
+		log,
+	)
+	defer courseCreatedProducer.Close()
+
+	coursePublishedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicCoursePublished,
+		log,
+	)
+	defer coursePublishedProducer.Close()
+
+	courseReviewedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicCourseReviewed,
+		log,
+	)
+	defer courseReviewedProducer.Close()
+
+	lessonCompletedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicLessonCompleted,
+		log,
+	)
+	defer lessonCompletedProducer.Close()
+
+	assignmentSubmittedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicAssignmentSubmitted,
+		log,
+	)
+	defer assignmentSubmittedProducer.Close()
+
+	assignmentGradedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicAssignmentGraded,
+		log,
+	)
+	defer assignmentGradedProducer.Close()
+
+	collaboratorInvitedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicCollaboratorInvited,
+		log,
+	)
+	defer collaboratorInvitedProducer.Close()
+
+	announcementPublishedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicAnnouncementPublished,
+		log,
+	)
+	defer announcementPublishedProducer.Close()
+
+	// Calls to other services authenticate as course-service
+	serviceCreds := interceptor.NewServiceCredentials(jwtManager, "course-service")
+
+	userConn, err := grpcLib.NewClient(
+		fmt.Sprintf("%s:%d", cfg.Services.UserHost, cfg.Services.UserPort),
+		grpcLib.WithTransportCredentials(insecure.NewCredentials()),
+		grpcLib.WithPerRPCCredentials(serviceCreds),
+	)
+	if err != nil {
+		log.Fatal("failed to create user service client", zap.Error(err))
+	}
+	defer userConn.Close()
+	userClient := user.NewClient(userConn)
+
+	videoConn, err := grpcLib.NewClient(
+		fmt.Sprintf("%s:%d", cfg.Services.VideoHost, cfg.Services.VideoPort),
+		grpcLib.WithTransportCredentials(insecure.NewCredentials()),
+		grpcLib.WithPerRPCCredentials(serviceCreds),
+	)
+	if err != nil {
+		log.Fatal("failed to create video service client", zap.Error(err))
+	}
+	defer videoConn.Close()
+	videoClient := video.NewClient(videoConn)
+
+	notificationConn, err := grpcLib.NewClient(
+		fmt.Sprintf("%s:%d", cfg.Services.NotificationHost, cfg.Services.NotificationPort),
+		grpcLib.WithTransportCredentials(insecure.NewCredentials()),
+		grpcLib.WithPerRPCCredentials(serviceCreds),
+	)
+	if err != nil {
+		log.Fatal("failed to create notification service client", zap.Error(err))
+	}
+	defer notificationConn.Close()
+	notificationClient := notification.NewClient(notificationConn)
+
+	fileStore, err := storage.NewLocalStore(cfg.Storage.Dir)
+	if err != nil {
+		log.Fatal("failed to open file storage", zap.Error(err))
+	}
+
+	// Initialize repositories
+	courseRepo := repository.NewCourseRepository(db)
+	moduleRepo := repository.NewModuleRepository(db)
+	lessonRepo := repository.NewLessonRepository(db)
+	revisionRepo := repository.NewRevisionRepository(db)
+	submissionRepo := repository.NewSubmissionRepository(db)
+	prerequisiteRepo := repository.NewPrerequisiteRepository(db)
+	learningPathRepo := repository.NewLearningPathRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+	saleRepo := repository.NewSaleRepository(db)
+	bundleRepo := repository.NewBundleRepository(db)
+	templateRepo := repository.NewTemplateRepository(db)
+	learnerRepo := repository.NewLearnerRepository(db)
+	quizRepo := repository.NewQuizRepository(db)
+	assignmentRepo := repository.NewAssignmentRepository(db)
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	collaboratorRepo := repository.NewCollaboratorRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	translationRepo := repository.NewTranslationRepository(db)
+	announcementRepo := repository.NewAnnouncementRepository(db)
+	discussionRepo := repository.NewDiscussionRepository(db)
+	revocationRepo := repository.NewRevocationRepository(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Initialize Service
+	courseService := service.NewCourseService(
+		courseRepo,
+		moduleRepo,
+		lessonRepo,
+		revisionRepo,
+		submissionRepo,
+		collaboratorRepo,
+		categoryRepo,
+		userClient,
+		service.Producers{
+			CourseCreated:   courseCreatedProducer,
+			CoursePublished: coursePublishedProducer,
+		},
+		log,
+	)
+
+	reviewService := service.NewReviewService(courseService, submissionRepo, videoClient, quizRepo, assignmentRepo, courseReviewedProducer, log)
+	pathService := service.NewPathService(courseRepo, prerequisiteRepo, learningPathRepo, log)
+	pricingService := service.NewPricingService(courseRepo, couponRepo, saleRepo, log)
+	bundleService := service.NewBundleService(courseRepo, bundleRepo, log)
+	templateService := service.NewTemplateService(courseService, templateRepo, log)
+	transferService := service.NewTransferService(courseService, log)
+	releaseService := service.NewReleaseService(courseService, moduleRepo, lessonRepo, learnerRepo, videoClient, log)
+	quizService := service.NewQuizService(courseService, releaseService, quizRepo, learnerRepo, lessonCompletedProducer, log)
+	assignmentService := service.NewAssignmentService(
+		courseService,
+		releaseService,
+		assignmentRepo,
+		fileStore,
+		service.AssignmentProducers{
+			Submitted: assignmentSubmittedProducer,
+			Graded:    assignmentGradedProducer,
+		},
+		log,
+	)
+	attachmentService := service.NewAttachmentService(
+		courseService,
+		releaseService,
+		attachmentRepo,
+		fileStore,
+		storage.NewURLSigner(cfg.Storage.DownloadBaseURL, cfg.Storage.DownloadSecret, cfg.Storage.DownloadURLTTL),
+		log,
+	)
+	collaboratorService := service.NewCollaboratorService(courseService, collaboratorRepo, collaboratorInvitedProducer, log)
+	statsService := service.NewStatsService(courseRepo, log)
+	categoryService := service.NewCategoryService(categoryRepo, log)
+	translationService := service.NewTranslationService(courseService, courseRepo, translationRepo, log)
+	announcementService := service.NewAnnouncementService(courseService, announcementRepo, learnerRepo, notificationClient, announcementPublishedProducer, log)
+	discussionService := service.NewDiscussionService(
+		courseService,
+		releaseService,
+		discussionRepo,
+		learnerRepo,
+		moduleRepo,
+		lessonRepo,
+		notificationClient,
+		log,
+	)
+
+	// Reject the tokens of suspended users, starting with suspensions whose
+	// tokens may still be live
+	revocations := interceptor.NewRevocationList()
+	revocationService := service.NewRevocationService(revocations, revocationRepo, log)
+	if err := revocationService.Load(ctx, time.Now().Add(-cfg.JWT.RefreshTokenExpiry)); err != nil {
+		log.Fatal("failed to load token revocations", zap.Error(err))
+	}
+
+	// Every replica needs every suspension, so each gets its own group
+	suspensionConsumer := kafka.NewConsumer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserSuspended,
+		fmt.Sprintf("course-service-revocations-%s", uuid.New().String()),
+		revocationService.HandleUserSuspended,
+		log,
+	)
+	go func() {
+		if err := suspensionConsumer.Start(ctx); err != nil {
+			log.Error("suspension consumer stopped", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				revocations.Prune(cfg.JWT.RefreshTokenExpiry)
+			}
+		}
+	}()
+
+	// Keep instructor names current for course search
+	userRegisteredConsumer := kafka.NewConsumer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserRegistered,
+		"course-service-instructor-names",
+		courseService.HandleUserRegistered,
+		log,
+	)
+	go func() {
+		if err := userRegisteredConsumer.Start(ctx); err != nil {
+			log.Error("user registered consumer stopped", zap.Error(err))
+		}
+	}()
+
+	userProfileUpdatedConsumer := kafka.NewConsumer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserProfileUpdated,
+		"course-service-instructor-names",
+		courseService.HandleUserProfileUpdated,
+		log,
+	)
+	go func() {
+		if err := userProfileUpdatedConsumer.Start(ctx); err != nil {
+			log.Error("user profile updated consumer stopped", zap.Error(err))
+		}
+	}()
+
+	// Track enrollments and completed lessons for drip release and access to
+	// course content
+	learnerConsumers := []*kafka.Consumer{
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentSuccess, "course-service-learners", releaseService.HandleEnrollmentSuccess, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentFailed, "course-service-learners", releaseService.HandleEnrollmentFailed, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentCancelled, "course-service-learners", releaseService.HandleEnrollmentCancelled, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicLessonCompleted, "course-service-learners", releaseService.HandleLessonCompleted, log),
+	}
+	for _, consumer := range learnerConsumers {
+		go func(c *kafka.Consumer) {
+			if err := c.Start(ctx); err != nil {
+				log.Error("learner consumer stopped", zap.Error(err))
+			}
+		}(consumer)
+	}
+
+	// Keep enrolled counts in step with enrollment-service
+	enrollmentConsumers := []*kafka.Consumer{
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentSuccess, "course-service-stats", statsService.HandleEnrollmentSuccess, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentFailed, "course-service-stats", statsService.HandleEnrollmentFailed, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentCancelled, "course-service-stats", statsService.HandleEnrollmentCancelled, log),
+	}
+	for _, consumer := range enrollmentConsumers {
+		go func(c *kafka.Consumer) {
+			if err := c.Start(ctx); err != nil {
+				log.Error("enrollment consumer stopped", zap.Error(err))
+			}
+		}(consumer)
+	}
+
+	// Fan announcements out to the students of their course
+	announcementConsumer := kafka.NewConsumer(
+		cfg.Kafka.Brokers,
+		kafka.TopicAnnouncementPublished,
+		"course-service-announcements",
+		announcementService.HandleAnnouncementPublished,
+		log,
+	)
+	go func() {
+		if err := announcementConsumer.Start(ctx); err != nil {
+			log.Error("announcement consumer stopped", zap.Error(err))
+		}
+	}()
+
+	// Apply publish and archive schedules
+	publishingScheduler := scheduler.NewPublishingScheduler(courseService, reviewService, cfg.Scheduler.PublishingInterval, log)
+	go publishingScheduler.Start(ctx)
+
+	// Remove the attachments of deleted lessons
+	cleanupScheduler := scheduler.NewCleanupScheduler(attachmentService, cfg.Scheduler.CleanupInterval, log)
+	go cleanupScheduler.Start(ctx)
+
+	// Send scheduled announcements
+	announcementScheduler := scheduler.NewAnnouncementScheduler(announcementService, cfg.Scheduler.AnnouncementInterval, log)
+	go announcementScheduler.Start(ctx)
+
+	// Fill in instructor names course search is missing
+	instructorNameScheduler := scheduler.NewInstructorNameScheduler(courseService, cfg.Scheduler.InstructorNameInterval, log)
+	go instructorNameScheduler.Start(ctx)
+
+	// Initialize gRPC server
+	authInterceptor := interceptor.NewAuthInterceptor(jwtManager, log)
+	authInterceptor.SetRevocationList(revocations)
+	loggingInterceptor := interceptor.NewLoggingInterceptor(log)
+	recoveryInterceptor := interceptor.NewRecoveryInterceptor(log)
+
+	grpcServer := grpcLib.NewServer(
+		grpcLib.ChainUnaryInterceptor(
+			recoveryInterceptor.Unary(),
+			loggingInterceptor.Unary(),
+			authInterceptor.Unary(),
+		),
+		grpcLib.ChainStreamInterceptor(
+			recoveryInterceptor.Stream(),
+			loggingInterceptor.Stream(),
+			authInterceptor.Stream(),
+		),
+	)
+
+	// Register services
+	courseHandler := grpc.NewCourseHandler(
+		courseService,
+		reviewService,
+		pathService,
+		pricingService,
+		bundleService,
+		templateService,
+		transferService,
+		releaseService,
+		quizService,
+		assignmentService,
+		attachmentService,
+		collaboratorService,
+		statsService,
+		categoryService,
+		translationService,
+		announcementService,
+		discussionService,
+	)
+	pb.RegisterCourseServiceServer(grpcServer, courseHandler)
+
+	// Register health check
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("course-service", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	// Start gRPC server
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
+	if err != nil {
+		log.Fatal("failed to listen", zap.Error(err))
+	}
+
+	go func() {
+		log.Info("course service listening", zap.Int("port", cfg.Server.Port))
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatal("failed to serve", zap.Error(err))
+		}
+	}()
+
+	// Serve attachment downloads over plain HTTP
+	downloadServer := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.Storage.DownloadPort),
+		Handler: download.NewHandler(attachmentService, log),
+	}
+
+	go func() {
+		log.Info("download server listening", zap.Int("port", cfg.Storage.DownloadPort))
+		if err := downloadServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("failed to serve downloads", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("shutting down course service")
+	cancel()
+	grpcServer.GracefulStop()
+	if err := downloadServer.Shutdown(context.Background()); err != nil {
+		log.Error("failed to shut down download server", zap.Error(err))
+	}
+}
+
+func runDBMigrations(db *database.DB, log *zap.Logger) error {
+	migrations := []string{
+		`CREATE TABLE IF NOT EXISTS courses (
+			id UUID PRIMARY KEY,
+			title VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL,
+			instructor_id UUID NOT NULL,
+			thumbnail_url TEXT NOT NULL DEFAULT '',
+			status VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+			level VARCHAR(20) NOT NULL,
+			price NUMERIC(10, 2) NOT NULL DEFAULT 0,
+			category VARCHAR(100) NOT NULL,
+			tags TEXT[] NOT NULL DEFAULT '{}',
+			duration_minutes INTEGER NOT NULL DEFAULT 0,
+			enrolled_count INTEGER NOT NULL DEFAULT 0,
+			average_rating DOUBLE PRECISION NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_courses_instructor_id ON courses(instructor_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_courses_status ON courses(status)`,
+		`CREATE INDEX IF NOT EXISTS idx_courses_category ON courses(category)`,
+		`CREATE TABLE IF NOT EXISTS modules (
+			id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			title VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			order_index INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_modules_course_id ON modules(course_id)`,
+		`CREATE TABLE IF NOT EXISTS lessons (
+			id UUID PRIMARY KEY,
+			module_id UUID NOT NULL REFERENCES modules(id) ON DELETE CASCADE,
+			title VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			video_id VARCHAR(255) NOT NULL DEFAULT '',
+			duration_seconds INTEGER NOT NULL DEFAULT 0,
+			order_index INTEGER NOT NULL,
+			is_preview BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_lessons_module_id ON lessons(module_id)`,
+		`CREATE TABLE IF NOT EXISTS course_revisions (
+			id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			number INTEGER,
+			status VARCHAR(20) NOT NULL,
+			content JSONB NOT NULL,
+			source_revision INTEGER,
+			version INTEGER NOT NULL DEFAULT 0,
+			created_by UUID NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			published_at TIMESTAMP,
+			UNIQUE (course_id, number)
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_course_revisions_one_draft ON course_revisions(course_id) WHERE status = 'DRAFT'`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_course_revisions_one_published ON course_revisions(course_id) WHERE status = 'PUBLISHED'`,
+		`CREATE TABLE IF NOT EXISTS course_submissions (
+			id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			instructor_id UUID NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			reviewer_id VARCHAR(36) NOT NULL DEFAULT '',
+			comment TEXT NOT NULL DEFAULT '',
+			submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			reviewed_at TIMESTAMP
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_course_submissions_one_open ON course_submissions(course_id) WHERE status IN ('SUBMITTED', 'IN_REVIEW')`,
+		`CREATE INDEX IF NOT EXISTS idx_course_submissions_queue ON course_submissions(submitted_at) WHERE status IN ('SUBMITTED', 'IN_REVIEW')`,
+		// Close gaps and break ties before enforcing unique ordering. The
+		// constraints are deferred so renumbering inside a transaction can
+		// pass through duplicates.
+		`DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'modules_course_order_unique') THEN
+				UPDATE modules m SET order_index = r.rn
+				FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY order_index, created_at) - 1 AS rn FROM modules) r
+				WHERE m.id = r.id AND m.order_index <> r.rn;
+				ALTER TABLE modules ADD CONSTRAINT modules_course_order_unique
+					UNIQUE (course_id, order_index) DEFERRABLE INITIALLY DEFERRED;
+			END IF;
+			IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'lessons_module_order_unique') THEN
+				UPDATE lessons l SET order_index = r.rn
+				FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY module_id ORDER BY order_index, created_at) - 1 AS rn FROM lessons) r
+				WHERE l.id = r.id AND l.order_index <> r.rn;
+				ALTER TABLE lessons ADD CONSTRAINT lessons_module_order_unique
+					UNIQUE (module_id, order_index) DEFERRABLE INITIALLY DEFERRED;
+			END IF;
+		END $$`,
+		`CREATE TABLE IF NOT EXISTS course_prerequisites (
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			required_course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (course_id, required_course_id),
+			CHECK (course_id <> required_course_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_course_prerequisites_required ON course_prerequisites(required_course_id)`,
+		`CREATE TABLE IF NOT EXISTS learning_paths (
+			id UUID PRIMARY KEY,
+			title VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			created_by UUID NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS learning_path_courses (
+			path_id UUID NOT NULL REFERENCES learning_paths(id) ON DELETE CASCADE,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			position INTEGER NOT NULL,
+			PRIMARY KEY (path_id, course_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_learning_path_courses_course_id ON learning_path_courses(course_id)`,
+		`CREATE TABLE IF NOT EXISTS coupons (
+			id UUID PRIMARY KEY,
+			code VARCHAR(64) NOT NULL UNIQUE,
+			discount_type VARCHAR(20) NOT NULL,
+			discount_value NUMERIC(10, 2) NOT NULL,
+			course_id UUID REFERENCES courses(id) ON DELETE CASCADE,
+			max_uses INTEGER NOT NULL DEFAULT 0,
+			used_count INTEGER NOT NULL DEFAULT 0,
+			starts_at TIMESTAMP NOT NULL,
+			expires_at TIMESTAMP,
+			created_by UUID NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_coupons_course_id ON coupons(course_id)`,
+		`CREATE TABLE IF NOT EXISTS coupon_redemptions (
+			enrollment_id UUID PRIMARY KEY,
+			coupon_id UUID NOT NULL REFERENCES coupons(id) ON DELETE CASCADE,
+			user_id UUID NOT NULL,
+			course_id UUID NOT NULL,
+			redeemed_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS sales (
+			id UUID PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			course_id UUID REFERENCES courses(id) ON DELETE CASCADE,
+			discount_type VARCHAR(20) NOT NULL,
+			discount_value NUMERIC(10, 2) NOT NULL,
+			starts_at TIMESTAMP NOT NULL,
+			ends_at TIMESTAMP NOT NULL,
+			created_by UUID NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_sales_window ON sales(ends_at, starts_at)`,
+		`CREATE TABLE IF NOT EXISTS bundles (
+			id UUID PRIMARY KEY,
+			title VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			instructor_id UUID NOT NULL,
+			price NUMERIC(10, 2) NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_bundles_instructor_id ON bundles(instructor_id)`,
+		`CREATE TABLE IF NOT EXISTS bundle_courses (
+			bundle_id UUID NOT NULL REFERENCES bundles(id) ON DELETE CASCADE,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			position INTEGER NOT NULL,
+			PRIMARY KEY (bundle_id, course_id)
+		)`,
+		`ALTER TABLE courses ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP`,
+		`ALTER TABLE courses ADD COLUMN IF NOT EXISTS archive_at TIMESTAMP`,
+		`CREATE INDEX IF NOT EXISTS idx_courses_archive_at ON courses(archive_at) WHERE status = 'PUBLISHED'`,
+		// Scheduled approvals hold the course like open submissions do. The
+		// older one-open index is implied by this one but left in place, as
+		// earlier steps recreate it on every run.
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_course_submissions_one_pending ON course_submissions(course_id) WHERE status IN ('SUBMITTED', 'IN_REVIEW', 'SCHEDULED')`,
+		`CREATE TABLE IF NOT EXISTS course_templates (
+			id UUID PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			content JSONB NOT NULL,
+			created_by UUID NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`ALTER TABLE courses ADD COLUMN IF NOT EXISTS instructor_name VARCHAR(255) NOT NULL DEFAULT ''`,
+		`CREATE TABLE IF NOT EXISTS instructor_names (
+			user_id UUID PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE OR REPLACE FUNCTION course_search_vector(title TEXT, description TEXT, tags TEXT[], instructor_name TEXT)
+		RETURNS tsvector LANGUAGE sql IMMUTABLE AS $$
+			SELECT setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('english', coalesce(array_to_string(tags, ' '), '')), 'B') ||
+				setweight(to_tsvector('simple', coalesce(instructor_name, '')), 'B') ||
+				setweight(to_tsvector('english', coalesce(description, '')), 'C')
+		$$`,
+		`ALTER TABLE courses ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (course_search_vector(title, description, tags, instructor_name)) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_courses_search_vector ON courses USING GIN (search_vector)`,
+		`CREATE INDEX IF NOT EXISTS idx_courses_title_trgm ON courses USING GIN (title gin_trgm_ops)`,
+		`ALTER TABLE modules ADD COLUMN IF NOT EXISTS release_rule JSONB`,
+		`ALTER TABLE lessons ADD COLUMN IF NOT EXISTS release_rule JSONB`,
+		`CREATE INDEX IF NOT EXISTS idx_lessons_video_id ON lessons(video_id)`,
+		`CREATE TABLE IF NOT EXISTS course_learners (
+			user_id UUID NOT NULL,
+			course_id UUID NOT NULL,
+			enrolled_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, course_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS learner_lesson_completions (
+			user_id UUID NOT NULL,
+			lesson_id UUID NOT NULL,
+			course_id UUID NOT NULL,
+			completed_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (user_id, lesson_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_learner_lesson_completions_course ON learner_lesson_completions(user_id, course_id)`,
+		`ALTER TABLE lessons ADD COLUMN IF NOT EXISTS type VARCHAR(20) NOT NULL DEFAULT 'VIDEO'`,
+		`CREATE TABLE IF NOT EXISTS question_banks (
+			id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			title VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_question_banks_course_id ON question_banks(course_id)`,
+		`CREATE TABLE IF NOT EXISTS quiz_questions (
+			id UUID PRIMARY KEY,
+			bank_id UUID NOT NULL REFERENCES question_banks(id) ON DELETE CASCADE,
+			type VARCHAR(20) NOT NULL,
+			prompt TEXT NOT NULL,
+			options TEXT[] NOT NULL DEFAULT '{}',
+			correct_options INT[] NOT NULL DEFAULT '{}',
+			accepted_answers TEXT[] NOT NULL DEFAULT '{}',
+			points INT NOT NULL,
+			explanation TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_quiz_questions_bank_id ON quiz_questions(bank_id)`,
+		`CREATE TABLE IF NOT EXISTS lesson_quizzes (
+			lesson_id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			bank_id UUID NOT NULL REFERENCES question_banks(id),
+			question_count INT NOT NULL,
+			time_limit_seconds INT NOT NULL,
+			max_attempts INT NOT NULL,
+			pass_percentage INT NOT NULL,
+			counts_toward_completion BOOLEAN NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS quiz_attempts (
+			id UUID PRIMARY KEY,
+			lesson_id UUID NOT NULL,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			user_id UUID NOT NULL,
+			question_ids UUID[] NOT NULL,
+			answers JSONB NOT NULL DEFAULT '[]',
+			started_at TIMESTAMP NOT NULL,
+			deadline TIMESTAMP,
+			submitted_at TIMESTAMP,
+			score INT NOT NULL DEFAULT 0,
+			max_score INT NOT NULL DEFAULT 0,
+			percentage DOUBLE PRECISION NOT NULL DEFAULT 0,
+			passed BOOLEAN NOT NULL DEFAULT FALSE,
+			expired BOOLEAN NOT NULL DEFAULT FALSE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_quiz_attempts_learner ON quiz_attempts(lesson_id, user_id, started_at)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_quiz_attempts_open ON quiz_attempts(lesson_id, user_id) WHERE submitted_at IS NULL`,
+		`CREATE TABLE IF NOT EXISTS lesson_assignments (
+			lesson_id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			instructions TEXT NOT NULL,
+			due_at TIMESTAMP,
+			rubric JSONB NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS assignment_submissions (
+			id UUID PRIMARY KEY,
+			lesson_id UUID NOT NULL,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			user_id UUID NOT NULL,
+			file_key VARCHAR(255) NOT NULL,
+			file_name VARCHAR(255) NOT NULL,
+			content_type VARCHAR(255) NOT NULL DEFAULT '',
+			size_bytes BIGINT NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			late BOOLEAN NOT NULL DEFAULT FALSE,
+			submitted_at TIMESTAMP NOT NULL,
+			scores JSONB NOT NULL DEFAULT '[]',
+			score INT NOT NULL DEFAULT 0,
+			max_score INT NOT NULL DEFAULT 0,
+			feedback TEXT NOT NULL DEFAULT '',
+			graded_by UUID,
+			graded_at TIMESTAMP,
+			UNIQUE (lesson_id, user_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_assignment_submissions_queue ON assignment_submissions(course_id, status, submitted_at)`,
+		`CREATE TABLE IF NOT EXISTS lesson_attachments (
+			id UUID PRIMARY KEY,
+			lesson_id UUID NOT NULL,
+			course_id UUID NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			mime_type VARCHAR(255) NOT NULL DEFAULT '',
+			size_bytes BIGINT NOT NULL,
+			checksum CHAR(64) NOT NULL,
+			storage_key VARCHAR(255) NOT NULL,
+			created_by UUID NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_lesson_attachments_lesson_id ON lesson_attachments(lesson_id)`,
+		`CREATE TABLE IF NOT EXISTS course_collaborators (
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			user_id UUID NOT NULL,
+			role VARCHAR(30) NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			invited_by UUID NOT NULL,
+			invited_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			accepted_at TIMESTAMP,
+			PRIMARY KEY (course_id, user_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_course_collaborators_user_id ON course_collaborators(user_id)`,
+		`CREATE TABLE IF NOT EXISTS course_enrollments (
+			enrollment_id UUID PRIMARY KEY,
+			course_id UUID NOT NULL,
+			user_id UUID NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_course_enrollments_course_id ON course_enrollments(course_id, status)`,
+		`CREATE TABLE IF NOT EXISTS categories (
+			slug VARCHAR(100) PRIMARY KEY,
+			parent_slug VARCHAR(100) REFERENCES categories(slug),
+			name VARCHAR(255) NOT NULL,
+			sort_order INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_categories_parent_slug ON categories(parent_slug)`,
+		// Courses used to have free-text categories. Give each distinct one a
+		// top-level category and point everything at its slug; spellings that
+		// differ only in case and punctuation end up together, and admins
+		// merge the rest by deleting one category into another.
+		`CREATE OR REPLACE FUNCTION category_slug(name TEXT)
+		RETURNS TEXT LANGUAGE sql IMMUTABLE AS $$
+			SELECT trim(both '-' from left(regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g'), 100))
+		$$`,
+		`INSERT INTO categories (slug, name)
+			SELECT category_slug(name), MIN(trim(name)) FROM (
+				SELECT category AS name FROM courses
+				UNION ALL SELECT content #>> '{details,category}' FROM course_revisions
+				UNION ALL SELECT content #>> '{details,category}' FROM course_templates
+			) used
+			WHERE category_slug(name) <> ''
+			GROUP BY 1
+			ON CONFLICT (slug) DO NOTHING`,
+		`UPDATE courses SET category = category_slug(category)
+			WHERE category <> category_slug(category) AND category_slug(category) <> ''`,
+		`UPDATE course_revisions
+			SET content = jsonb_set(content, '{details,category}', to_jsonb(category_slug(content #>> '{details,category}')))
+			WHERE content #>> '{details,category}' <> category_slug(content #>> '{details,category}')
+				AND category_slug(content #>> '{details,category}') <> ''`,
+		`UPDATE course_templates
+			SET content = jsonb_set(content, '{details,category}', to_jsonb(category_slug(content #>> '{details,category}')))
+			WHERE content #>> '{details,category}' <> category_slug(content #>> '{details,category}')
+				AND category_slug(content #>> '{details,category}') <> ''`,
+		`ALTER TABLE courses ADD COLUMN IF NOT EXISTS default_locale VARCHAR(35) NOT NULL DEFAULT 'en'`,
+		`CREATE TABLE IF NOT EXISTS course_translations (
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			locale VARCHAR(35) NOT NULL,
+			entity_type VARCHAR(10) NOT NULL,
+			entity_id UUID NOT NULL,
+			field VARCHAR(20) NOT NULL,
+			value TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (course_id, locale, entity_id, field)
+		)`,
+		`CREATE TABLE IF NOT EXISTS course_announcements (
+			id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			author_id UUID NOT NULL,
+			title VARCHAR(255) NOT NULL,
+			body TEXT NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			send_at TIMESTAMP NOT NULL,
+			sent_at TIMESTAMP,
+			recipients INT NOT NULL DEFAULT 0,
+			delivered INT NOT NULL DEFAULT 0,
+			failed INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_course_announcements_course_id ON course_announcements(course_id, send_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_course_announcements_due ON course_announcements(send_at) WHERE status = 'SCHEDULED'`,
+		`CREATE TABLE IF NOT EXISTS discussion_threads (
+			id UUID PRIMARY KEY,
+			course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+			lesson_id UUID,
+			author_id UUID NOT NULL,
+			title VARCHAR(255) NOT NULL,
+			body TEXT NOT NULL,
+			pinned BOOLEAN NOT NULL DEFAULT FALSE,
+			locked BOOLEAN NOT NULL DEFAULT FALSE,
+			hidden BOOLEAN NOT NULL DEFAULT FALSE,
+			answer_reply_id UUID,
+			upvotes INT NOT NULL DEFAULT 0,
+			reply_count INT NOT NULL DEFAULT 0,
+			last_activity_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_discussion_threads_course_id ON discussion_threads(course_id, pinned DESC, last_activity_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_discussion_threads_lesson_id ON discussion_threads(lesson_id, pinned DESC, last_activity_at DESC)`,
+		`CREATE TABLE IF NOT EXISTS discussion_replies (
+			id UUID PRIMARY KEY,
+			thread_id UUID NOT NULL REFERENCES discussion_threads(id) ON DELETE CASCADE,
+			parent_id UUID REFERENCES discussion_replies(id) ON DELETE CASCADE,
+			author_id UUID NOT NULL,
+			body TEXT NOT NULL,
+			hidden BOOLEAN NOT NULL DEFAULT FALSE,
+			upvotes INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_discussion_replies_thread_id ON discussion_replies(thread_id, created_at)`,
+		`CREATE TABLE IF NOT EXISTS discussion_votes (
+			target_id UUID NOT NULL,
+			user_id UUID NOT NULL,
+			thread_id UUID NOT NULL REFERENCES discussion_threads(id) ON DELETE CASCADE,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (target_id, user_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS token_revocations (
+			user_id UUID PRIMARY KEY,
+			revoked_at TIMESTAMP NOT NULL
+		)`,
+		`ALTER TABLE course_learners ADD COLUMN IF NOT EXISTS enrollment_id UUID`,
+	}
+
+	for i, migration := range migrations {
+		if _, err := db.Exec(migration); err != nil {
+			return fmt.Errorf("migration %d failed: %w", i+1, err)
+		}
+	}
+
+	log.Info("Database migrations completed successfully")
+	return nil
+}
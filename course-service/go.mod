@@ -5,6 +5,7 @@ go 1.25.1
 require (
 	github.com/dmehra2102/learning-platform/shared v0.0.0-20251201164226-739258ae2fb4
 	github.com/google/uuid v1.6.0
+	github.com/jmoiron/sqlx v1.4.0
 	github.com/lib/pq v1.10.9
 	go.uber.org/zap v1.27.1
 	google.golang.org/grpc v1.77.0
@@ -13,7 +14,6 @@ require (
 
 require (
 	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
-	github.com/jmoiron/sqlx v1.4.0 // indirect
 	github.com/klauspost/compress v1.18.2 // indirect
 	github.com/pierrec/lz4/v4 v4.1.22 // indirect
 	github.com/segmentio/kafka-go v0.4.49 // indirect
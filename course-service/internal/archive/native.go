@@ -0,0 +1,287 @@
+// Package archive reads and writes portable course archives: the platform's
+// own zip format, used to move courses between environments, and SCORM 1.2
+// content packages from other LMSs.
+package archive
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+)
+
+const (
+	// FormatName identifies native archives in their manifest.
+	FormatName = "learning-platform-course"
+	// Version is the archive layout this build writes. Readers accept any
+	// version up to it.
+	Version = 1
+
+	manifestFile = "manifest.json"
+	courseFile   = "course.json"
+	modulesFile  = "modules.jsonl"
+	lessonsFile  = "lessons.jsonl"
+)
+
+// MaxSize bounds how much archive data is read into memory on import,
+// compressed or not.
+const MaxSize = 64 << 20
+
+type Manifest struct {
+	Format         string    `json:"format"`
+	Version        int       `json:"version"`
+	ExportedAt     time.Time `json:"exported_at"`
+	SourceCourseID string    `json:"source_course_id"`
+	ModuleCount    int       `json:"module_count"`
+	LessonCount    int       `json:"lesson_count"`
+}
+
+type moduleRecord struct {
+	ID          string              `json:"id"`
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	OrderIndex  int                 `json:"order_index"`
+	Release     *domain.ReleaseRule `json:"release,omitempty"`
+}
+
+type lessonRecord struct {
+	ID              string              `json:"id"`
+	ModuleID        string              `json:"module_id"`
+	Type            domain.LessonType   `json:"type,omitempty"`
+	Title           string              `json:"title"`
+	Description     string              `json:"description"`
+	VideoID         string              `json:"video_id"`
+	DurationSeconds int                 `json:"duration_seconds"`
+	OrderIndex      int                 `json:"order_index"`
+	IsPreview       bool                `json:"is_preview"`
+	Release         *domain.ReleaseRule `json:"release,omitempty"`
+}
+
+// Write encodes content as a native archive. Module and lesson records are
+// written one JSON object per line so large courses stream without building
+// the whole file in memory.
+func Write(w io.Writer, courseID string, content *domain.CourseContent, exportedAt time.Time) error {
+	zw := zip.NewWriter(w)
+
+	manifest := Manifest{
+		Format:         FormatName,
+		Version:        Version,
+		ExportedAt:     exportedAt,
+		SourceCourseID: courseID,
+		ModuleCount:    len(content.Modules),
+		LessonCount:    content.LessonCount(),
+	}
+	if err := writeJSON(zw, manifestFile, manifest); err != nil {
+		return err
+	}
+	if err := writeJSON(zw, courseFile, content.Details); err != nil {
+		return err
+	}
+
+	var modules, lessons []any
+	for _, m := range content.Modules {
+		modules = append(modules, moduleRecord{
+			ID:          m.ID,
+			Title:       m.Title,
+			Description: m.Description,
+			OrderIndex:  m.OrderIndex,
+			Release:     m.Release,
+		})
+		for _, l := range m.Lessons {
+			lessons = append(lessons, lessonRecord{
+				ID:              l.ID,
+				ModuleID:        m.ID,
+				Type:            l.Type,
+				Title:           l.Title,
+				Description:     l.Description,
+				VideoID:         l.VideoID,
+				DurationSeconds: l.DurationSeconds,
+				OrderIndex:      l.OrderIndex,
+				IsPreview:       l.IsPreview,
+				Release:         l.Release,
+			})
+		}
+	}
+	if err := writeLines(zw, modulesFile, modules); err != nil {
+		return err
+	}
+	if err := writeLines(zw, lessonsFile, lessons); err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+// Read decodes a native archive. The content keeps the archive's module and
+// lesson IDs; callers copy it before storing.
+func Read(data []byte) (*domain.CourseContent, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, domain.ErrInvalidArchive
+	}
+
+	var manifest Manifest
+	if err := readJSON(zr, manifestFile, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Format != FormatName {
+		return nil, domain.ErrInvalidArchive
+	}
+	if manifest.Version < 1 || manifest.Version > Version {
+		return nil, domain.ErrUnsupportedArchive
+	}
+
+	content := &domain.CourseContent{}
+	if err := readJSON(zr, courseFile, &content.Details); err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*domain.ModuleContent)
+	err = readLines(zr, modulesFile, func(line []byte) error {
+		var record moduleRecord
+		if err := json.Unmarshal(line, &record); err != nil || record.ID == "" || byID[record.ID] != nil {
+			return domain.ErrInvalidArchive
+		}
+		module := &domain.ModuleContent{
+			ID:          record.ID,
+			Title:       record.Title,
+			Description: record.Description,
+			OrderIndex:  record.OrderIndex,
+			Release:     record.Release,
+		}
+		byID[module.ID] = module
+		content.Modules = append(content.Modules, module)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = readLines(zr, lessonsFile, func(line []byte) error {
+		var record lessonRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return domain.ErrInvalidArchive
+		}
+		module := byID[record.ModuleID]
+		if module == nil {
+			return domain.ErrInvalidArchive
+		}
+		module.Lessons = append(module.Lessons, &domain.LessonContent{
+			ID:              record.ID,
+			Type:            record.Type,
+			Title:           record.Title,
+			Description:     record.Description,
+			VideoID:         record.VideoID,
+			DurationSeconds: record.DurationSeconds,
+			OrderIndex:      record.OrderIndex,
+			IsPreview:       record.IsPreview,
+			Release:         record.Release,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := normalize(content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// normalize renumbers modules and lessons from zero in archive order, so a
+// hand-edited archive can't break the unique ordering, and checks every
+// record is storable.
+func normalize(content *domain.CourseContent) error {
+	for i, m := range content.Modules {
+		m.OrderIndex = i
+		if err := m.ToModule("").Validate(); err != nil {
+			return domain.ErrInvalidArchive
+		}
+		for j, l := range m.Lessons {
+			l.OrderIndex = j
+			if err := l.ToLesson(m.ID).Validate(); err != nil {
+				return domain.ErrInvalidArchive
+			}
+		}
+	}
+	return nil
+}
+
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func writeLines(zw *zip.Writer, name string, records []any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	enc := json.NewEncoder(w)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func openFile(zr *zip.Reader, name string) (io.ReadCloser, error) {
+	f, err := zr.Open(name)
+	if err != nil {
+		return nil, domain.ErrInvalidArchive
+	}
+	return f, nil
+}
+
+func readJSON(zr *zip.Reader, name string, v any) error {
+	f, err := openFile(zr, name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(io.LimitReader(f, MaxSize)).Decode(v); err != nil {
+		return domain.ErrInvalidArchive
+	}
+	return nil
+}
+
+func readLines(zr *zip.Reader, name string, fn func(line []byte) error) error {
+	f, err := openFile(zr, name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(io.LimitReader(f, MaxSize))
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return domain.ErrInvalidArchive
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+)
+
+// zipOf builds an archive holding files, in the given name/body order.
+func zipOf(t *testing.T, files ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for i := 0; i < len(files); i += 2 {
+		w, err := zw.Create(files[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(files[i+1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	content := &domain.CourseContent{
+		Details: domain.CourseDetails{Title: "Go", Description: "Learn Go", Category: "programming", Tags: []string{"go"}},
+		Modules: []*domain.ModuleContent{
+			{ID: "m1", Title: "Basics", OrderIndex: 0, Lessons: []*domain.LessonContent{
+				{ID: "l1", Title: "Intro", VideoID: "video-1", DurationSeconds: 90, OrderIndex: 0, IsPreview: true},
+				{ID: "l2", Title: "Check", Type: domain.LessonQuiz, OrderIndex: 1},
+			}},
+			{ID: "m2", Title: "Empty", OrderIndex: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "course-1", content, time.Now()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := Read(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	if got.Details.Title != "Go" || got.Details.Category != "programming" || len(got.Details.Tags) != 1 {
+		t.Errorf("Details = %+v, want the exported details", got.Details)
+	}
+	if len(got.Modules) != 2 || got.Modules[0].ID != "m1" || got.Modules[1].ID != "m2" {
+		t.Fatalf("Modules = %+v, want m1, m2", got.Modules)
+	}
+	lessons := got.Modules[0].Lessons
+	if len(lessons) != 2 {
+		t.Fatalf("m1 has %d lessons, want 2", len(lessons))
+	}
+	if *lessons[0] != *content.Modules[0].Lessons[0] || lessons[1].Type != domain.LessonQuiz {
+		t.Errorf("lessons = %+v, %+v, want the exported lessons", lessons[0], lessons[1])
+	}
+}
+
+func TestRead(t *testing.T) {
+	const (
+		manifest = `{"format":"learning-platform-course","version":1}`
+		course   = `{"title":"Go","description":"Learn Go"}`
+		module   = `{"id":"m1","title":"Basics","order_index":0}` + "\n"
+	)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+		want    []string
+	}{
+		{
+			name: "renumbers from archive order",
+			data: zipOf(t,
+				manifestFile, manifest,
+				courseFile, course,
+				modulesFile, `{"id":"m1","title":"Basics","order_index":5}`+"\n\n"+`{"id":"m2","title":"More","order_index":5}`+"\n",
+				lessonsFile, `{"id":"l1","module_id":"m2","title":"A","order_index":3}`+"\n"+`{"id":"l2","module_id":"m2","title":"B","order_index":3}`+"\n",
+			),
+			want: []string{"m1", "m2:l1,l2"},
+		},
+		{name: "not a zip", data: []byte("course"), wantErr: domain.ErrInvalidArchive},
+		{
+			name:    "missing lessons file",
+			data:    zipOf(t, manifestFile, manifest, courseFile, course, modulesFile, module),
+			wantErr: domain.ErrInvalidArchive,
+		},
+		{
+			name:    "another format",
+			data:    zipOf(t, manifestFile, `{"format":"other","version":1}`),
+			wantErr: domain.ErrInvalidArchive,
+		},
+		{
+			name:    "newer version",
+			data:    zipOf(t, manifestFile, `{"format":"learning-platform-course","version":2}`),
+			wantErr: domain.ErrUnsupportedArchive,
+		},
+		{
+			name:    "repeated module",
+			data:    zipOf(t, manifestFile, manifest, courseFile, course, modulesFile, module+module, lessonsFile, ""),
+			wantErr: domain.ErrInvalidArchive,
+		},
+		{
+			name: "lesson of an unknown module",
+			data: zipOf(t, manifestFile, manifest, courseFile, course, modulesFile, module,
+				lessonsFile, `{"id":"l1","module_id":"m9","title":"A"}`),
+			wantErr: domain.ErrInvalidArchive,
+		},
+		{
+			name: "lesson without a title",
+			data: zipOf(t, manifestFile, manifest, courseFile, course, modulesFile, module,
+				lessonsFile, `{"id":"l1","module_id":"m1"}`),
+			wantErr: domain.ErrInvalidArchive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := Read(tt.data)
+			if err != tt.wantErr {
+				t.Fatalf("Read() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := outline(t, content); !slices.Equal(got, tt.want) {
+				t.Errorf("outline = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// outline lists each module as "id" or "id:lesson,lesson", failing the test
+// when an OrderIndex doesn't match its position.
+func outline(t *testing.T, content *domain.CourseContent) []string {
+	t.Helper()
+	var out []string
+	for i, m := range content.Modules {
+		if m.OrderIndex != i {
+			t.Errorf("module %s OrderIndex = %d, want %d", m.ID, m.OrderIndex, i)
+		}
+		entry := m.ID
+		for j, l := range m.Lessons {
+			if l.OrderIndex != j {
+				t.Errorf("lesson %s OrderIndex = %d, want %d", l.ID, l.OrderIndex, j)
+			}
+			if j == 0 {
+				entry += ":" + l.ID
+			} else {
+				entry += "," + l.ID
+			}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
@@ -0,0 +1,152 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+)
+
+const scormManifestFile = "imsmanifest.xml"
+
+type scormManifest struct {
+	Organizations struct {
+		Default       string              `xml:"default,attr"`
+		Organizations []scormOrganization `xml:"organization"`
+	} `xml:"organizations"`
+	Resources struct {
+		Resources []scormResource `xml:"resource"`
+	} `xml:"resources"`
+}
+
+type scormOrganization struct {
+	Identifier string      `xml:"identifier,attr"`
+	Title      string      `xml:"title"`
+	Items      []scormItem `xml:"item"`
+}
+
+type scormItem struct {
+	Identifier    string      `xml:"identifier,attr"`
+	IdentifierRef string      `xml:"identifierref,attr"`
+	IsVisible     string      `xml:"isvisible,attr"`
+	Title         string      `xml:"title"`
+	Items         []scormItem `xml:"item"`
+}
+
+type scormResource struct {
+	Identifier string `xml:"identifier,attr"`
+	Href       string `xml:"href,attr"`
+}
+
+// ReadSCORM maps a SCORM 1.2 package's default organization onto course
+// content. Each top-level item with children becomes a module and the
+// leaves beneath it, at any depth, its lessons in document order. Runs of
+// top-level leaves are gathered into a module named after the
+// organization. Lessons note the launch file of the resource they point to
+// and have no video; the package's assets aren't imported.
+func ReadSCORM(data []byte) (*domain.CourseContent, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, domain.ErrInvalidArchive
+	}
+
+	f, err := openFile(zr, scormManifestFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var manifest scormManifest
+	if err := xml.NewDecoder(io.LimitReader(f, MaxSize)).Decode(&manifest); err != nil {
+		return nil, domain.ErrInvalidArchive
+	}
+
+	org := manifest.defaultOrganization()
+	if org == nil {
+		return nil, domain.ErrInvalidArchive
+	}
+
+	hrefs := make(map[string]string, len(manifest.Resources.Resources))
+	for _, r := range manifest.Resources.Resources {
+		hrefs[r.Identifier] = r.Href
+	}
+
+	orgTitle := strings.TrimSpace(org.Title)
+	content := &domain.CourseContent{
+		Details: domain.CourseDetails{
+			Title:       orgTitle,
+			Description: orgTitle,
+			Level:       domain.LevelBeginner,
+		},
+	}
+
+	var loose *domain.ModuleContent
+	for _, item := range org.Items {
+		if !item.visible() {
+			continue
+		}
+		if len(item.Items) == 0 {
+			if loose == nil {
+				loose = &domain.ModuleContent{ID: item.Identifier, Title: orgTitle}
+				content.Modules = append(content.Modules, loose)
+			}
+			loose.Lessons = append(loose.Lessons, item.lesson(hrefs))
+			continue
+		}
+
+		loose = nil
+		module := &domain.ModuleContent{ID: item.Identifier, Title: strings.TrimSpace(item.Title)}
+		item.collectLessons(hrefs, &module.Lessons)
+		content.Modules = append(content.Modules, module)
+	}
+
+	if len(content.Modules) == 0 {
+		return nil, domain.ErrInvalidArchive
+	}
+
+	if err := normalize(content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (m *scormManifest) defaultOrganization() *scormOrganization {
+	orgs := m.Organizations.Organizations
+	for i := range orgs {
+		if orgs[i].Identifier == m.Organizations.Default {
+			return &orgs[i]
+		}
+	}
+	if len(orgs) > 0 {
+		return &orgs[0]
+	}
+	return nil
+}
+
+func (i scormItem) visible() bool {
+	return !strings.EqualFold(i.IsVisible, "false")
+}
+
+func (i scormItem) collectLessons(hrefs map[string]string, lessons *[]*domain.LessonContent) {
+	for _, child := range i.Items {
+		if !child.visible() {
+			continue
+		}
+		if len(child.Items) == 0 {
+			*lessons = append(*lessons, child.lesson(hrefs))
+			continue
+		}
+		child.collectLessons(hrefs, lessons)
+	}
+}
+
+func (i scormItem) lesson(hrefs map[string]string) *domain.LessonContent {
+	lesson := &domain.LessonContent{ID: i.Identifier, Title: strings.TrimSpace(i.Title)}
+	if href := hrefs[i.IdentifierRef]; href != "" {
+		lesson.Description = "SCORM launch file: " + href
+	}
+	return lesson
+}
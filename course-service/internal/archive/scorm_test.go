@@ -0,0 +1,94 @@
+package archive
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+)
+
+const scormManifestXML = `<?xml version="1.0"?>
+<manifest identifier="pkg">
+  <organizations default="org-main">
+    <organization identifier="org-other">
+      <title>Other</title>
+      <item identifier="other-1"><title>Other lesson</title></item>
+    </organization>
+    <organization identifier="org-main">
+      <title> Safety training </title>
+      <item identifier="welcome" identifierref="res-welcome"><title>Welcome</title></item>
+      <item identifier="unit-1">
+        <title>Unit 1</title>
+        <item identifier="lesson-1" identifierref="res-1"><title>Lesson 1</title></item>
+        <item identifier="section">
+          <title>Section</title>
+          <item identifier="lesson-2"><title>Lesson 2</title></item>
+        </item>
+        <item identifier="hidden" isvisible="false"><title>Hidden</title></item>
+      </item>
+      <item identifier="summary"><title>Summary</title></item>
+      <item identifier="quiz"><title>Quiz</title></item>
+    </organization>
+  </organizations>
+  <resources>
+    <resource identifier="res-welcome" href="welcome/index.html"/>
+    <resource identifier="res-1" href="unit1/lesson1.html"/>
+  </resources>
+</manifest>`
+
+func TestReadSCORM(t *testing.T) {
+	content, err := ReadSCORM(zipOf(t, scormManifestFile, scormManifestXML))
+	if err != nil {
+		t.Fatalf("ReadSCORM() error = %v", err)
+	}
+
+	// Top-level leaves gather into modules named after the organization, and
+	// nested leaves flatten into their top-level item's module
+	want := []string{"welcome:welcome", "unit-1:lesson-1,lesson-2", "summary:summary,quiz"}
+	if got := outline(t, content); !slices.Equal(got, want) {
+		t.Errorf("outline = %v, want %v", got, want)
+	}
+
+	if content.Details.Title != "Safety training" || content.Modules[0].Title != "Safety training" || content.Modules[1].Title != "Unit 1" {
+		t.Errorf("titles = %q, %q, %q, want the organization and item titles",
+			content.Details.Title, content.Modules[0].Title, content.Modules[1].Title)
+	}
+
+	welcome := content.Modules[0].Lessons[0]
+	if welcome.Description != "SCORM launch file: welcome/index.html" || welcome.VideoID != "" {
+		t.Errorf("welcome lesson = %+v, want the launch file and no video", welcome)
+	}
+	if got := content.Modules[1].Lessons[1].Description; got != "" {
+		t.Errorf("lesson without a resource has Description %q, want none", got)
+	}
+}
+
+func TestReadSCORMInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not a zip", data: []byte("scorm")},
+		{name: "no manifest", data: zipOf(t, "index.html", "<html></html>")},
+		{name: "malformed manifest", data: zipOf(t, scormManifestFile, "<manifest>")},
+		{name: "no organization", data: zipOf(t, scormManifestFile, `<manifest><organizations/></manifest>`)},
+		{
+			name: "only hidden items",
+			data: zipOf(t, scormManifestFile, `<manifest><organizations><organization><title>Org</title>
+				<item identifier="a" isvisible="false"><title>A</title></item></organization></organizations></manifest>`),
+		},
+		{
+			name: "item without a title",
+			data: zipOf(t, scormManifestFile, `<manifest><organizations><organization><title>Org</title>
+				<item identifier="a"/></organization></organizations></manifest>`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadSCORM(tt.data); err != domain.ErrInvalidArchive {
+				t.Errorf("ReadSCORM() error = %v, want %v", err, domain.ErrInvalidArchive)
+			}
+		})
+	}
+}
@@ -9,11 +9,14 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database database.Config
-	JWT      JWTConfig
-	Kafka    KafkaConfig
-	App      AppConfig
+	Server    ServerConfig
+	Database  database.Config
+	JWT       JWTConfig
+	Kafka     KafkaConfig
+	App       AppConfig
+	Scheduler SchedulerConfig
+	Storage   StorageConfig
+	Services  ServicesConfig
 }
 
 type ServerConfig struct {
@@ -30,6 +33,32 @@ type KafkaConfig struct {
 	Brokers []string
 }
 
+type SchedulerConfig struct {
+	PublishingInterval     time.Duration
+	CleanupInterval        time.Duration
+	AnnouncementInterval   time.Duration
+	InstructorNameInterval time.Duration
+}
+
+type StorageConfig struct {
+	Dir string
+	// Attachments are downloaded from an HTTP server on DownloadPort through
+	// links under DownloadBaseURL, signed with DownloadSecret.
+	DownloadPort    int
+	DownloadBaseURL string
+	DownloadSecret  string
+	DownloadURLTTL  time.Duration
+}
+
+type ServicesConfig struct {
+	UserHost         string
+	UserPort         int
+	VideoHost        string
+	VideoPort        int
+	NotificationHost string
+	NotificationPort int
+}
+
 type AppConfig struct {
 	Environment string
 	LogLevel    string
@@ -64,6 +93,27 @@ func Load() Config {
 			Environment: getEnv("APP_ENV", "development"),
 			LogLevel:    getEnv("LOG_LEVEL", "info"),
 		},
+		Scheduler: SchedulerConfig{
+			PublishingInterval:     time.Duration(getEnvInt("SCHEDULER_PUBLISHING_INTERVAL_SEC", 60)) * time.Second,
+			CleanupInterval:        time.Duration(getEnvInt("SCHEDULER_CLEANUP_INTERVAL_SEC", 300)) * time.Second,
+			AnnouncementInterval:   time.Duration(getEnvInt("SCHEDULER_ANNOUNCEMENT_INTERVAL_SEC", 30)) * time.Second,
+			InstructorNameInterval: time.Duration(getEnvInt("SCHEDULER_INSTRUCTOR_NAME_INTERVAL_SEC", 3600)) * time.Second,
+		},
+		Storage: StorageConfig{
+			Dir:             getEnv("STORAGE_DIR", "./data/files"),
+			DownloadPort:    getEnvInt("DOWNLOAD_PORT", 8082),
+			DownloadBaseURL: getEnv("DOWNLOAD_BASE_URL", "http://localhost:8082"),
+			DownloadSecret:  getEnv("DOWNLOAD_URL_SECRET", "download_secret"),
+			DownloadURLTTL:  time.Duration(getEnvInt("DOWNLOAD_URL_TTL_MIN", 15)) * time.Minute,
+		},
+		Services: ServicesConfig{
+			UserHost:         getEnv("USER_SERVICE_HOST", "localhost"),
+			UserPort:         getEnvInt("USER_SERVICE_PORT", 50051),
+			VideoHost:        getEnv("VIDEO_SERVICE_HOST", "localhost"),
+			VideoPort:        getEnvInt("VIDEO_SERVICE_PORT", 50054),
+			NotificationHost: getEnv("NOTIFICATION_SERVICE_HOST", "localhost"),
+			NotificationPort: getEnvInt("NOTIFICATION_SERVICE_PORT", 50056),
+		},
 	}
 }
 
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAnnouncementNotFound    = errors.New("announcement not found")
+	ErrAnnouncementRateLimited = errors.New("course has sent too many announcements recently")
+)
+
+// An announcement can't be sent within AnnouncementWindow of
+// AnnouncementLimit others of the same course, so students aren't flooded.
+const (
+	AnnouncementLimit  = 5
+	AnnouncementWindow = 24 * time.Hour
+)
+
+type AnnouncementStatus string
+
+const (
+	AnnouncementScheduled AnnouncementStatus = "SCHEDULED"
+	AnnouncementSending   AnnouncementStatus = "SENDING"
+	AnnouncementSent      AnnouncementStatus = "SENT"
+)
+
+// Announcement is a message from a course's staff to its enrolled students.
+// It's sent at SendAt; Recipients, Delivered and Failed are filled in once
+// it has gone out.
+type Announcement struct {
+	ID         string
+	CourseID   string
+	AuthorID   string
+	Title      string
+	Body       string
+	Status     AnnouncementStatus
+	SendAt     time.Time
+	SentAt     *time.Time
+	Recipients int
+	Delivered  int
+	Failed     int
+	CreatedAt  time.Time
+}
+
+func (a *Announcement) Validate() error {
+	if strings.TrimSpace(a.Title) == "" || len(a.Title) > 255 {
+		return ErrInvalidInput
+	}
+	if strings.TrimSpace(a.Body) == "" || len(a.Body) > 10000 {
+		return ErrInvalidInput
+	}
+	return nil
+}
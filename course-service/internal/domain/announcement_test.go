@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnnouncementValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		announcement Announcement
+		wantErr      error
+	}{
+		{"title and body", Announcement{Title: "Module 3 is out", Body: "Enjoy!"}, nil},
+		{"longest title and body", Announcement{Title: strings.Repeat("a", 255), Body: strings.Repeat("a", 10000)}, nil},
+		{"blank title", Announcement{Title: "  ", Body: "Enjoy!"}, ErrInvalidInput},
+		{"title too long", Announcement{Title: strings.Repeat("a", 256), Body: "Enjoy!"}, ErrInvalidInput},
+		{"blank body", Announcement{Title: "Module 3 is out", Body: "\n"}, ErrInvalidInput},
+		{"body too long", Announcement{Title: "Module 3 is out", Body: strings.Repeat("a", 10001)}, ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.announcement.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
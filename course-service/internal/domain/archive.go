@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidArchive     = errors.New("invalid course archive")
+	ErrUnsupportedArchive = errors.New("unsupported course archive version")
+	ErrArchiveTooLarge    = errors.New("course archive is too large")
+)
+
+type ArchiveFormat string
+
+const (
+	// ArchiveNative is the platform's own export format.
+	ArchiveNative ArchiveFormat = "NATIVE"
+	// ArchiveSCORM12 is a SCORM 1.2 content package.
+	ArchiveSCORM12 ArchiveFormat = "SCORM_1_2"
+)
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAssignmentNotFound           = errors.New("assignment not found")
+	ErrAssignmentSubmissionNotFound = errors.New("assignment submission not found")
+	ErrNotAssignmentLesson          = errors.New("lesson is not an assignment")
+	ErrAssignmentGraded             = errors.New("assignment submission already graded")
+	ErrFileTooLarge                 = errors.New("file is too large")
+)
+
+// MaxAssignmentFileSize caps a single assignment upload.
+const MaxAssignmentFileSize = 100 << 20
+
+type AssignmentStatus string
+
+const (
+	AssignmentSubmitted AssignmentStatus = "SUBMITTED"
+	AssignmentGraded    AssignmentStatus = "GRADED"
+)
+
+// RubricCriterion is one line of an assignment's rubric.
+type RubricCriterion struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	MaxPoints   int    `json:"max_points"`
+}
+
+// Assignment configures an ASSIGNMENT lesson. Work handed in after DueAt is
+// still accepted but marked late.
+type Assignment struct {
+	LessonID     string
+	CourseID     string
+	Instructions string
+	DueAt        *time.Time
+	Rubric       []RubricCriterion
+	UpdatedAt    time.Time
+}
+
+func (a *Assignment) Validate() error {
+	if strings.TrimSpace(a.Instructions) == "" || len(a.Rubric) == 0 {
+		return ErrInvalidInput
+	}
+	for _, criterion := range a.Rubric {
+		if strings.TrimSpace(criterion.Title) == "" || criterion.MaxPoints < 1 {
+			return ErrInvalidInput
+		}
+	}
+	return nil
+}
+
+func (a *Assignment) MaxScore() int {
+	total := 0
+	for _, criterion := range a.Rubric {
+		total += criterion.MaxPoints
+	}
+	return total
+}
+
+// CriterionScore grades one rubric criterion, by its index in the rubric.
+type CriterionScore struct {
+	Criterion int    `json:"criterion"`
+	Points    int    `json:"points"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+// AssignmentSubmission is a learner's handed-in work for an assignment. A
+// learner has one per assignment; uploading again replaces the file until it
+// is graded.
+type AssignmentSubmission struct {
+	ID          string
+	LessonID    string
+	CourseID    string
+	UserID      string
+	FileKey     string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	Status      AssignmentStatus
+	Late        bool
+	SubmittedAt time.Time
+	Scores      []CriterionScore
+	Score       int
+	MaxScore    int
+	Feedback    string
+	GradedBy    string
+	GradedAt    *time.Time
+}
+
+// Grade scores the submission against the assignment's rubric. Every
+// criterion needs exactly one score within its points.
+func (s *AssignmentSubmission) Grade(assignment *Assignment, scores []CriterionScore, feedback, graderID string, now time.Time) error {
+	if s.Status == AssignmentGraded {
+		return ErrAssignmentGraded
+	}
+	if len(scores) != len(assignment.Rubric) {
+		return ErrInvalidInput
+	}
+
+	seen := make(map[int]bool)
+	total := 0
+	for _, score := range scores {
+		if score.Criterion < 0 || score.Criterion >= len(assignment.Rubric) || seen[score.Criterion] {
+			return ErrInvalidInput
+		}
+		if score.Points < 0 || score.Points > assignment.Rubric[score.Criterion].MaxPoints {
+			return ErrInvalidInput
+		}
+		seen[score.Criterion] = true
+		total += score.Points
+	}
+
+	s.Status = AssignmentGraded
+	s.Scores = scores
+	s.Score = total
+	s.MaxScore = assignment.MaxScore()
+	s.Feedback = feedback
+	s.GradedBy = graderID
+	s.GradedAt = &now
+	return nil
+}
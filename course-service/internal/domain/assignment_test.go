@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAssignmentValidate(t *testing.T) {
+	rubric := []RubricCriterion{{Title: "Works", MaxPoints: 5}}
+
+	tests := []struct {
+		name       string
+		assignment Assignment
+		wantErr    error
+	}{
+		{"instructions and rubric", Assignment{Instructions: "Build it", Rubric: rubric}, nil},
+		{"blank instructions", Assignment{Instructions: "  ", Rubric: rubric}, ErrInvalidInput},
+		{"no rubric", Assignment{Instructions: "Build it"}, ErrInvalidInput},
+		{"untitled criterion", Assignment{Instructions: "Build it", Rubric: []RubricCriterion{{Title: " ", MaxPoints: 5}}}, ErrInvalidInput},
+		{"criterion worth nothing", Assignment{Instructions: "Build it", Rubric: []RubricCriterion{{Title: "Works"}}}, ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.assignment.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAssignmentSubmissionGrade(t *testing.T) {
+	assignment := &Assignment{Rubric: []RubricCriterion{{Title: "Works", MaxPoints: 5}, {Title: "Style", MaxPoints: 3}}}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    AssignmentStatus
+		scores    []CriterionScore
+		wantErr   error
+		wantScore int
+	}{
+		{
+			name:      "every criterion scored in any order",
+			scores:    []CriterionScore{{Criterion: 1, Points: 2}, {Criterion: 0, Points: 5}},
+			wantScore: 7,
+		},
+		{
+			name:      "zero points",
+			scores:    []CriterionScore{{Criterion: 0}, {Criterion: 1}},
+			wantScore: 0,
+		},
+		{
+			name:    "criterion missing",
+			scores:  []CriterionScore{{Criterion: 0, Points: 5}},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "criterion scored twice",
+			scores:  []CriterionScore{{Criterion: 0, Points: 5}, {Criterion: 0, Points: 1}},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "unknown criterion",
+			scores:  []CriterionScore{{Criterion: 0, Points: 5}, {Criterion: 2, Points: 1}},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "more than the criterion's points",
+			scores:  []CriterionScore{{Criterion: 0, Points: 6}, {Criterion: 1, Points: 1}},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "negative points",
+			scores:  []CriterionScore{{Criterion: 0, Points: -1}, {Criterion: 1, Points: 1}},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "already graded",
+			status:  AssignmentGraded,
+			scores:  []CriterionScore{{Criterion: 0, Points: 5}, {Criterion: 1, Points: 3}},
+			wantErr: ErrAssignmentGraded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == "" {
+				status = AssignmentSubmitted
+			}
+			submission := &AssignmentSubmission{Status: status}
+
+			err := submission.Grade(assignment, tt.scores, "Nice work", "grader-1", now)
+			if err != tt.wantErr {
+				t.Fatalf("Grade() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if submission.Status != status || submission.GradedAt != nil {
+					t.Errorf("failed Grade() changed the submission: %+v", submission)
+				}
+				return
+			}
+
+			if submission.Status != AssignmentGraded || submission.Score != tt.wantScore || submission.MaxScore != 8 {
+				t.Errorf("Status, Score, MaxScore = %s, %d, %d, want GRADED, %d, 8", submission.Status, submission.Score, submission.MaxScore, tt.wantScore)
+			}
+			if submission.GradedBy != "grader-1" || submission.GradedAt == nil || !submission.GradedAt.Equal(now) {
+				t.Errorf("GradedBy, GradedAt = %q, %v, want grader-1, %v", submission.GradedBy, submission.GradedAt, now)
+			}
+		})
+	}
+}
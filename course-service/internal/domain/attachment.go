@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrInvalidDownloadURL = errors.New("download link is invalid or has expired")
+)
+
+// MaxAttachmentFileSize caps a single lesson attachment.
+const MaxAttachmentFileSize = 500 << 20
+
+// Attachment is a downloadable resource, such as slides or sample code,
+// attached to a lesson. The file itself lives in storage under StorageKey;
+// Checksum is the hex SHA-256 of its contents.
+type Attachment struct {
+	ID         string
+	LessonID   string
+	CourseID   string
+	Name       string
+	MimeType   string
+	SizeBytes  int64
+	Checksum   string
+	StorageKey string
+	CreatedBy  string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrBundleNotFound = errors.New("bundle not found")
+
+// Bundle sells several of an instructor's courses as one purchase.
+type Bundle struct {
+	ID           string
+	Title        string
+	Description  string
+	InstructorID string
+	CourseIDs    []string
+	Price        float64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (b *Bundle) Validate() error {
+	if strings.TrimSpace(b.Title) == "" || b.Price < 0 || len(b.CourseIDs) < 2 {
+		return ErrInvalidInput
+	}
+
+	seen := make(map[string]bool, len(b.CourseIDs))
+	for _, id := range b.CourseIDs {
+		if id == "" || seen[id] {
+			return ErrInvalidInput
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// BundleQuote compares a bundle's price with buying its courses one by one.
+type BundleQuote struct {
+	Bundle    *Bundle
+	Courses   []*Course
+	ListPrice float64
+	Savings   float64
+}
+
+// QuoteBundle prices a bundle for checkout. courses must be the bundle's
+// members in order, and every one of them has to be on sale.
+func QuoteBundle(bundle *Bundle, courses []*Course) (*BundleQuote, error) {
+	quote := &BundleQuote{Bundle: bundle, Courses: courses}
+
+	for _, course := range courses {
+		if course.Status != StatusPublished {
+			return nil, ErrCourseNotForSale
+		}
+		quote.ListPrice += course.Price
+	}
+
+	quote.ListPrice = roundCents(quote.ListPrice)
+	quote.Savings = roundCents(quote.ListPrice - bundle.Price)
+	return quote, nil
+}
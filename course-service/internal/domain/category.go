@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryExists   = errors.New("category already exists")
+	ErrCategoryInUse    = errors.New("category still has subcategories or courses")
+	ErrCategoryCycle    = errors.New("category can't be moved under itself")
+	ErrUnknownCategory  = errors.New("course category does not exist")
+)
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Category is a node of the managed category tree. Courses refer to their
+// category by slug, which never changes once the category is created.
+type Category struct {
+	Slug       string
+	ParentSlug string
+	Name       string
+	SortOrder  int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate checks the category on its own. Whether its parent exists, and
+// whether it would end up under itself, depends on the rest of the tree.
+func (c *Category) Validate() error {
+	// Slugs are lowercase words joined by single hyphens, e.g. "web-development"
+	if len(c.Slug) > 100 || !categorySlugPattern.MatchString(c.Slug) {
+		return ErrInvalidInput
+	}
+	if strings.TrimSpace(c.Name) == "" || len(c.Name) > 255 {
+		return ErrInvalidInput
+	}
+	if c.ParentSlug == c.Slug {
+		return ErrCategoryCycle
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCategoryValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		category Category
+		wantErr  error
+	}{
+		{"top level", Category{Slug: "programming", Name: "Programming"}, nil},
+		{"hyphenated slug with digits", Category{Slug: "web-development-101", ParentSlug: "programming", Name: "Web"}, nil},
+		{"uppercase slug", Category{Slug: "Go", Name: "Go"}, ErrInvalidInput},
+		{"space in slug", Category{Slug: "go lang", Name: "Go"}, ErrInvalidInput},
+		{"double hyphen", Category{Slug: "go--lang", Name: "Go"}, ErrInvalidInput},
+		{"leading hyphen", Category{Slug: "-go", Name: "Go"}, ErrInvalidInput},
+		{"trailing hyphen", Category{Slug: "go-", Name: "Go"}, ErrInvalidInput},
+		{"empty slug", Category{Name: "Go"}, ErrInvalidInput},
+		{"slug too long", Category{Slug: strings.Repeat("a", 101), Name: "Go"}, ErrInvalidInput},
+		{"blank name", Category{Slug: "go", Name: "  "}, ErrInvalidInput},
+		{"its own parent", Category{Slug: "go", ParentSlug: "go", Name: "Go"}, ErrCategoryCycle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.category.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+	ErrAlreadyCollaborator  = errors.New("user is already a collaborator on this course")
+)
+
+// CourseRole is what a user may do on a course. The owner is the course's
+// InstructorID; everyone else holds their role through a collaborator
+// invitation.
+type CourseRole string
+
+const (
+	RoleOwner             CourseRole = "OWNER"
+	RoleEditor            CourseRole = "EDITOR"
+	RoleTeachingAssistant CourseRole = "TEACHING_ASSISTANT"
+)
+
+// Permission is an action on a course that only some roles may take.
+type Permission int
+
+const (
+	// PermViewContent covers reading the draft, the revision history and
+	// unpublished lessons.
+	PermViewContent Permission = iota
+	// PermGrade covers the grading queue and learners' submissions.
+	PermGrade
+	// PermModerate covers marking answers, pinning, locking and hiding in
+	// the course's discussions.
+	PermModerate
+	// PermEditContent covers the course details, modules and lessons, and
+	// lesson settings such as quizzes, assignments and attachments.
+	PermEditContent
+	// PermManageCourse covers publishing, scheduling, archiving and
+	// deleting the course, and managing its collaborators.
+	PermManageCourse
+)
+
+// Can reports whether the role grants permission.
+func (r CourseRole) Can(permission Permission) bool {
+	switch r {
+	case RoleOwner:
+		return true
+	case RoleEditor:
+		return permission != PermManageCourse
+	case RoleTeachingAssistant:
+		return permission == PermViewContent || permission == PermGrade || permission == PermModerate
+	}
+	return false
+}
+
+// Invitable reports whether the role can be granted through an invitation.
+// Ownership only changes hands through a transfer.
+func (r CourseRole) Invitable() bool {
+	return r == RoleEditor || r == RoleTeachingAssistant
+}
+
+type CollaboratorStatus string
+
+const (
+	CollaboratorInvited  CollaboratorStatus = "INVITED"
+	CollaboratorAccepted CollaboratorStatus = "ACCEPTED"
+)
+
+// Collaborator is a user invited to work on someone else's course. The role
+// only takes effect once the invitation is accepted.
+type Collaborator struct {
+	CourseID   string
+	UserID     string
+	Role       CourseRole
+	Status     CollaboratorStatus
+	InvitedBy  string
+	InvitedAt  time.Time
+	AcceptedAt *time.Time
+}
+
+// Active reports whether the collaborator's role is in effect.
+func (c *Collaborator) Active() bool {
+	return c.Status == CollaboratorAccepted
+}
@@ -0,0 +1,44 @@
+package domain
+
+import "testing"
+
+func TestCourseRoleCan(t *testing.T) {
+	permissions := []Permission{PermViewContent, PermGrade, PermModerate, PermEditContent, PermManageCourse}
+
+	tests := []struct {
+		role CourseRole
+		want []bool
+	}{
+		{RoleOwner, []bool{true, true, true, true, true}},
+		{RoleEditor, []bool{true, true, true, true, false}},
+		{RoleTeachingAssistant, []bool{true, true, true, false, false}},
+		{"", []bool{false, false, false, false, false}},
+		{"ADMIN", []bool{false, false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		for i, permission := range permissions {
+			if got := tt.role.Can(permission); got != tt.want[i] {
+				t.Errorf("%q.Can(%d) = %v, want %v", tt.role, permission, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestCourseRoleInvitable(t *testing.T) {
+	tests := []struct {
+		role CourseRole
+		want bool
+	}{
+		{RoleEditor, true},
+		{RoleTeachingAssistant, true},
+		{RoleOwner, false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Invitable(); got != tt.want {
+			t.Errorf("%q.Invitable() = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+}
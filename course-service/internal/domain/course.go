@@ -9,6 +9,8 @@ var (
 	ErrCourseNotFound = errors.New("course not found")
 	ErrUnauthorized   = errors.New("unauthorized")
 	ErrInvalidInput   = errors.New("invalid input")
+	ErrNotPublished   = errors.New("course is not published")
+	ErrOrderConflict  = errors.New("content was reordered concurrently")
 )
 
 type CourseStatus string
@@ -27,11 +29,22 @@ const (
 	LevelAdvanced     CourseLevel = "ADVANCED"
 )
 
+// LessonType is what a lesson delivers. Lessons saved before types existed
+// are videos.
+type LessonType string
+
+const (
+	LessonVideo      LessonType = "VIDEO"
+	LessonQuiz       LessonType = "QUIZ"
+	LessonAssignment LessonType = "ASSIGNMENT"
+)
+
 type Course struct {
 	ID              string
 	Title           string
 	Description     string
 	InstructorID    string
+	InstructorName  string
 	ThumbnailURL    string
 	Status          CourseStatus
 	Level           CourseLevel
@@ -43,6 +56,12 @@ type Course struct {
 	UpdatedAt       time.Time
 	EnrolledCount   int
 	AverageRating   float64
+	PublishAt       *time.Time
+	ArchiveAt       *time.Time
+	DefaultLocale   string
+	// Locale is the language Title and Description are in. It's the
+	// DefaultLocale unless they've been localized for the caller.
+	Locale string
 }
 
 type Module struct {
@@ -51,21 +70,31 @@ type Module struct {
 	Title       string
 	Description string
 	OrderIndex  int
+	Release     *ReleaseRule
 	CreatedAt   time.Time
 }
 
 type Lesson struct {
 	ID              string
 	ModuleID        string
+	Type            LessonType
 	Title           string
 	Description     string
 	VideoID         string
 	DurationSeconds int
 	OrderIndex      int
 	IsPreview       bool
+	Release         *ReleaseRule
 	CreatedAt       time.Time
 }
 
+// EditsThroughDraft reports whether the course's content only changes by
+// publishing a draft revision. That holds from the first publish on, also
+// once the course is archived, as enrolled students still see it.
+func (c *Course) EditsThroughDraft() bool {
+	return c.Status == StatusPublished || c.Status == StatusArchived
+}
+
 func (c *Course) Validate() error {
 	if c.Title == "" || len(c.Title) > 255 {
 		return ErrInvalidInput
@@ -83,6 +112,21 @@ func (c *Course) Validate() error {
 	return nil
 }
 
+// ValidateSchedule checks a publish/archive schedule. A publish time in the
+// past means the course goes live as soon as it's approved.
+func ValidateSchedule(publishAt, archiveAt *time.Time, now time.Time) error {
+	if archiveAt == nil {
+		return nil
+	}
+	if !archiveAt.After(now) {
+		return ErrInvalidInput
+	}
+	if publishAt != nil && !archiveAt.After(*publishAt) {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
 func (m *Module) Validate() error {
 	if m.Title == "" || len(m.Title) > 255 {
 		return ErrInvalidInput
@@ -90,6 +134,9 @@ func (m *Module) Validate() error {
 	if m.OrderIndex < 0 {
 		return ErrInvalidInput
 	}
+	if m.Release != nil {
+		return m.Release.Validate()
+	}
 	return nil
 }
 
@@ -103,5 +150,17 @@ func (l *Lesson) Validate() error {
 	if l.DurationSeconds < 0 {
 		return ErrInvalidInput
 	}
+	switch l.Type {
+	case LessonVideo:
+	case LessonQuiz, LessonAssignment:
+		if l.VideoID != "" {
+			return ErrInvalidInput
+		}
+	default:
+		return ErrInvalidInput
+	}
+	if l.Release != nil {
+		return l.Release.Validate()
+	}
 	return nil
 }
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrThreadNotFound = errors.New("discussion thread not found")
+	ErrReplyNotFound  = errors.New("discussion reply not found")
+	ErrThreadLocked   = errors.New("discussion thread is locked")
+)
+
+// Thread is a question asked in a course's discussions, either about one of
+// its lessons or, with an empty LessonID, about the course as a whole.
+// AnswerReplyID is the reply staff marked as answering it. Upvoted is whether
+// the user it was loaded for has upvoted it.
+type Thread struct {
+	ID             string
+	CourseID       string
+	LessonID       string
+	AuthorID       string
+	Title          string
+	Body           string
+	Pinned         bool
+	Locked         bool
+	Hidden         bool
+	AnswerReplyID  string
+	Upvotes        int
+	Upvoted        bool
+	ReplyCount     int
+	LastActivityAt time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (t *Thread) Validate() error {
+	if strings.TrimSpace(t.Title) == "" || len(t.Title) > 255 {
+		return ErrInvalidInput
+	}
+	if strings.TrimSpace(t.Body) == "" || len(t.Body) > 10000 {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// Reply answers a thread, or with a ParentID, another reply in it.
+type Reply struct {
+	ID        string
+	ThreadID  string
+	ParentID  string
+	AuthorID  string
+	Body      string
+	Hidden    bool
+	Upvotes   int
+	Upvoted   bool
+	CreatedAt time.Time
+}
+
+func (r *Reply) Validate() error {
+	if strings.TrimSpace(r.Body) == "" || len(r.Body) > 10000 {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// ModerationAction is what a course's staff can do to a thread or reply.
+// Only hiding applies to replies.
+type ModerationAction string
+
+const (
+	ModerationPin    ModerationAction = "PIN"
+	ModerationUnpin  ModerationAction = "UNPIN"
+	ModerationLock   ModerationAction = "LOCK"
+	ModerationUnlock ModerationAction = "UNLOCK"
+	ModerationHide   ModerationAction = "HIDE"
+	ModerationUnhide ModerationAction = "UNHIDE"
+)
+
+// Apply carries out the action on the thread.
+func (a ModerationAction) Apply(t *Thread) error {
+	switch a {
+	case ModerationPin, ModerationUnpin:
+		t.Pinned = a == ModerationPin
+	case ModerationLock, ModerationUnlock:
+		t.Locked = a == ModerationLock
+	case ModerationHide, ModerationUnhide:
+		t.Hidden = a == ModerationHide
+	default:
+		return ErrInvalidInput
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestThreadValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		thread  Thread
+		wantErr error
+	}{
+		{"title and body", Thread{Title: "Why does this deadlock?", Body: "See lesson 3"}, nil},
+		{"longest title and body", Thread{Title: strings.Repeat("a", 255), Body: strings.Repeat("a", 10000)}, nil},
+		{"blank title", Thread{Title: " ", Body: "See lesson 3"}, ErrInvalidInput},
+		{"title too long", Thread{Title: strings.Repeat("a", 256), Body: "See lesson 3"}, ErrInvalidInput},
+		{"blank body", Thread{Title: "Why does this deadlock?", Body: "\t"}, ErrInvalidInput},
+		{"body too long", Thread{Title: "Why does this deadlock?", Body: strings.Repeat("a", 10001)}, ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.thread.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReplyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   Reply
+		wantErr error
+	}{
+		{"body", Reply{Body: "Unlock the mutex first"}, nil},
+		{"longest body", Reply{Body: strings.Repeat("a", 10000)}, nil},
+		{"blank body", Reply{Body: "  "}, ErrInvalidInput},
+		{"body too long", Reply{Body: strings.Repeat("a", 10001)}, ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.reply.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModerationActionApply(t *testing.T) {
+	tests := []struct {
+		action  ModerationAction
+		from    Thread
+		want    Thread
+		wantErr error
+	}{
+		{action: ModerationPin, want: Thread{Pinned: true}},
+		{action: ModerationUnpin, from: Thread{Pinned: true, Locked: true}, want: Thread{Locked: true}},
+		{action: ModerationLock, from: Thread{Hidden: true}, want: Thread{Locked: true, Hidden: true}},
+		{action: ModerationUnlock, from: Thread{Locked: true}},
+		{action: ModerationHide, from: Thread{Pinned: true}, want: Thread{Pinned: true, Hidden: true}},
+		{action: ModerationUnhide, from: Thread{Hidden: true}},
+		{action: "DELETE", from: Thread{Pinned: true}, want: Thread{Pinned: true}, wantErr: ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			thread := tt.from
+			if err := tt.action.Apply(&thread); err != tt.wantErr {
+				t.Fatalf("Apply() error = %v, want %v", err, tt.wantErr)
+			}
+			if thread != tt.want {
+				t.Errorf("thread = %+v, want %+v", thread, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidLocale            = errors.New("locale must be a language tag such as \"fr\" or \"pt-BR\"")
+	ErrUnknownTranslationTarget = errors.New("translated module or lesson is not part of the course")
+	ErrTranslationNotFound      = errors.New("course has no translations in that locale")
+	ErrDefaultLocaleTranslation = errors.New("the course's own text is in its default locale, which can't also be translated")
+)
+
+// DefaultLocale is the locale of courses created without one.
+const DefaultLocale = "en"
+
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// NormalizeLocale validates a BCP 47 language tag and puts it in canonical
+// case: "PT-br" becomes "pt-BR" and "zh-hant" becomes "zh-Hant".
+func NormalizeLocale(tag string) (string, error) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if len(tag) > 35 || !localePattern.MatchString(tag) {
+		return "", ErrInvalidLocale
+	}
+
+	parts := strings.Split(tag, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch len(parts[i]) {
+		case 2:
+			parts[i] = strings.ToUpper(parts[i])
+		case 4:
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		default:
+			parts[i] = strings.ToLower(parts[i])
+		}
+	}
+	return strings.Join(parts, "-"), nil
+}
+
+// baseLanguage is the language subtag of a normalized locale.
+func baseLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return language
+}
+
+// ParseAcceptLanguage returns the locales of an Accept-Language header, most
+// preferred first. Wildcards and malformed entries are skipped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var entries []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale, err := NormalizeLocale(tag)
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			entries = append(entries, weighted{locale: locale, q: q})
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b weighted) int {
+		switch {
+		case a.q > b.q:
+			return -1
+		case a.q < b.q:
+			return 1
+		}
+		return 0
+	})
+
+	locales := make([]string, len(entries))
+	for i, e := range entries {
+		locales[i] = e.locale
+	}
+	return locales
+}
+
+// MatchLocale picks which of a course's available locales to serve a caller
+// who prefers the given ones. A preferred "fr-CH" falls back to "fr", and a
+// bare "fr" accepts any French, before moving on to the next preference.
+// available starts with the course's default locale, which is served when
+// nothing matches.
+func MatchLocale(preferred, available []string) string {
+	for _, want := range preferred {
+		for _, candidate := range []string{want, baseLanguage(want)} {
+			for _, have := range available {
+				if have == candidate || (!strings.Contains(candidate, "-") && baseLanguage(have) == candidate) {
+					return have
+				}
+			}
+		}
+	}
+	return available[0]
+}
+
+// TranslatableField is a text field that can be translated. Every
+// translatable entity has both.
+type TranslatableField string
+
+const (
+	FieldTitle       TranslatableField = "title"
+	FieldDescription TranslatableField = "description"
+)
+
+// Translation is one field of a course, module or lesson in one locale. The
+// text in the course's own fields is in its DefaultLocale.
+type Translation struct {
+	CourseID  string
+	Locale    string
+	Entity    EntityType
+	EntityID  string
+	Field     TranslatableField
+	Value     string
+	UpdatedAt time.Time
+}
+
+func (t *Translation) Validate() error {
+	switch t.Entity {
+	case EntityCourse, EntityModule, EntityLesson:
+	default:
+		return ErrInvalidInput
+	}
+	if t.Field != FieldTitle && t.Field != FieldDescription {
+		return ErrInvalidInput
+	}
+	if t.Field == FieldTitle && len(t.Value) > 255 {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// TranslationKey identifies a translatable field.
+type TranslationKey struct {
+	Entity   EntityType
+	EntityID string
+	Field    TranslatableField
+}
+
+// LocalizedText is a course's translations into one locale.
+type LocalizedText struct {
+	Locale string
+	values map[TranslationKey]string
+}
+
+// NewLocalizedText collects the translations into locale; others are
+// ignored.
+func NewLocalizedText(locale string, translations []*Translation) *LocalizedText {
+	text := &LocalizedText{Locale: locale, values: make(map[TranslationKey]string)}
+	for _, t := range translations {
+		if t.Locale == locale {
+			text.values[TranslationKey{Entity: t.Entity, EntityID: t.EntityID, Field: t.Field}] = t.Value
+		}
+	}
+	return text
+}
+
+func (t *LocalizedText) apply(entity EntityType, id string, title, description *string) {
+	if value, ok := t.values[TranslationKey{Entity: entity, EntityID: id, Field: FieldTitle}]; ok {
+		*title = value
+	}
+	if value, ok := t.values[TranslationKey{Entity: entity, EntityID: id, Field: FieldDescription}]; ok {
+		*description = value
+	}
+}
+
+// ApplyToCourse translates the course's fields and sets its Locale.
+// Untranslated fields keep the default locale's text.
+func (t *LocalizedText) ApplyToCourse(course *Course) {
+	t.apply(EntityCourse, course.ID, &course.Title, &course.Description)
+	course.Locale = t.Locale
+}
+
+func (t *LocalizedText) ApplyToModule(module *Module) {
+	t.apply(EntityModule, module.ID, &module.Title, &module.Description)
+}
+
+func (t *LocalizedText) ApplyToLesson(lesson *Lesson) {
+	t.apply(EntityLesson, lesson.ID, &lesson.Title, &lesson.Description)
+}
+
+// ApplyToContent translates the modules and lessons of content.
+func (t *LocalizedText) ApplyToContent(content *CourseContent) {
+	for _, m := range content.Modules {
+		t.apply(EntityModule, m.ID, &m.Title, &m.Description)
+		for _, l := range m.Lessons {
+			t.apply(EntityLesson, l.ID, &l.Title, &l.Description)
+		}
+	}
+}
+
+// LocaleProgress is how much of a course has been translated into a locale.
+type LocaleProgress struct {
+	Locale     string
+	Translated int
+	Total      int
+	Missing    []TranslationKey
+}
+
+func (p *LocaleProgress) Complete() bool {
+	return p.Translated == p.Total
+}
+
+// TranslationProgress reports, for every locale the course has translations
+// in, how many of the non-empty text fields of courseID and its content are
+// translated. Translations of modules and lessons that no longer exist don't
+// count.
+func TranslationProgress(courseID string, content *CourseContent, translations []*Translation) []*LocaleProgress {
+	var fields []TranslationKey
+	add := func(entity EntityType, id, title, description string) {
+		if title != "" {
+			fields = append(fields, TranslationKey{Entity: entity, EntityID: id, Field: FieldTitle})
+		}
+		if description != "" {
+			fields = append(fields, TranslationKey{Entity: entity, EntityID: id, Field: FieldDescription})
+		}
+	}
+	add(EntityCourse, courseID, content.Details.Title, content.Details.Description)
+	for _, m := range content.Modules {
+		add(EntityModule, m.ID, m.Title, m.Description)
+		for _, l := range m.Lessons {
+			add(EntityLesson, l.ID, l.Title, l.Description)
+		}
+	}
+
+	var locales []string
+	for _, t := range translations {
+		if !slices.Contains(locales, t.Locale) {
+			locales = append(locales, t.Locale)
+		}
+	}
+	slices.Sort(locales)
+
+	progress := make([]*LocaleProgress, len(locales))
+	for i, locale := range locales {
+		text := NewLocalizedText(locale, translations)
+		p := &LocaleProgress{Locale: locale, Total: len(fields)}
+		for _, key := range fields {
+			if text.values[key] != "" {
+				p.Translated++
+			} else {
+				p.Missing = append(p.Missing, key)
+			}
+		}
+		progress[i] = p
+	}
+	return progress
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		tag     string
+		want    string
+		wantErr error
+	}{
+		{tag: "en", want: "en"},
+		{tag: "PT-br", want: "pt-BR"},
+		{tag: "zh-hant", want: "zh-Hant"},
+		{tag: "zh-hant-tw", want: "zh-Hant-TW"},
+		{tag: " pt_BR ", want: "pt-BR"},
+		{tag: "es-419", want: "es-419"},
+		{tag: "", wantErr: ErrInvalidLocale},
+		{tag: "e", wantErr: ErrInvalidLocale},
+		{tag: "english", wantErr: ErrInvalidLocale},
+		{tag: "en-", wantErr: ErrInvalidLocale},
+		{tag: "*", wantErr: ErrInvalidLocale},
+		{tag: "en-" + strings.Repeat("abcdefgh-", 4), wantErr: ErrInvalidLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := NormalizeLocale(tt.tag)
+			if err != tt.wantErr || got != tt.want {
+				t.Errorf("NormalizeLocale(%q) = %q, %v, want %q, %v", tt.tag, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   []string
+	}{
+		{name: "empty", header: "", want: []string{}},
+		{name: "single", header: "fr-ch", want: []string{"fr-CH"}},
+		{name: "sorted by weight", header: "en;q=0.5, fr-CH, de;q=0.8", want: []string{"fr-CH", "de", "en"}},
+		{name: "equal weights keep their order", header: "de;q=0.7, fr;q=0.7", want: []string{"de", "fr"}},
+		{name: "wildcard and malformed entries skipped", header: "*, fr;q=abc, xx_YY, !!, es", want: []string{"xx-YY", "es"}},
+		{name: "zero weight excluded", header: "fr, en;q=0", want: []string{"fr"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseAcceptLanguage(tt.header); !slices.Equal(got, tt.want) {
+				t.Errorf("ParseAcceptLanguage(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	available := []string{"en", "de", "fr", "pt-BR"}
+
+	tests := []struct {
+		name      string
+		preferred []string
+		want      string
+	}{
+		{name: "no preference serves the default", want: "en"},
+		{name: "exact match", preferred: []string{"de"}, want: "de"},
+		{name: "regional falls back to its language", preferred: []string{"fr-CH"}, want: "fr"},
+		{name: "bare language accepts a region", preferred: []string{"pt"}, want: "pt-BR"},
+		{name: "regional doesn't match another region", preferred: []string{"pt-PT"}, want: "pt-BR"},
+		{name: "earlier preference wins", preferred: []string{"it", "fr", "de"}, want: "fr"},
+		{name: "nothing matches", preferred: []string{"ja", "ko"}, want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchLocale(tt.preferred, available); got != tt.want {
+				t.Errorf("MatchLocale(%v) = %q, want %q", tt.preferred, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslationValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		translation Translation
+		wantErr     error
+	}{
+		{"course title", Translation{Entity: EntityCourse, Field: FieldTitle, Value: "Titre"}, nil},
+		{"lesson description", Translation{Entity: EntityLesson, Field: FieldDescription, Value: strings.Repeat("a", 1000)}, nil},
+		{"unknown entity", Translation{Entity: "QUIZ", Field: FieldTitle}, ErrInvalidInput},
+		{"unknown field", Translation{Entity: EntityModule, Field: "tags"}, ErrInvalidInput},
+		{"title too long", Translation{Entity: EntityModule, Field: FieldTitle, Value: strings.Repeat("a", 256)}, ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.translation.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocalizedTextApply(t *testing.T) {
+	translations := []*Translation{
+		{Locale: "fr", Entity: EntityCourse, EntityID: "c1", Field: FieldTitle, Value: "Les bases de Go"},
+		{Locale: "fr", Entity: EntityLesson, EntityID: "l1", Field: FieldDescription, Value: "Premiers pas"},
+		{Locale: "de", Entity: EntityCourse, EntityID: "c1", Field: FieldDescription, Value: "Go lernen"},
+	}
+	text := NewLocalizedText("fr", translations)
+
+	course := &Course{ID: "c1", Title: "Go basics", Description: "Learn Go", Locale: "en"}
+	text.ApplyToCourse(course)
+	// Untranslated fields keep the default locale's text
+	if course.Title != "Les bases de Go" || course.Description != "Learn Go" || course.Locale != "fr" {
+		t.Errorf("course = %q, %q, %q, want the French title and the English description", course.Title, course.Description, course.Locale)
+	}
+
+	content := &CourseContent{Modules: []*ModuleContent{{ID: "m1", Title: "Basics", Lessons: []*LessonContent{{ID: "l1", Title: "Intro", Description: "First steps"}}}}}
+	text.ApplyToContent(content)
+	lesson := content.Modules[0].Lessons[0]
+	if content.Modules[0].Title != "Basics" || lesson.Title != "Intro" || lesson.Description != "Premiers pas" {
+		t.Errorf("content = %q, %q, %q, want only the lesson description translated", content.Modules[0].Title, lesson.Title, lesson.Description)
+	}
+}
+
+func TestTranslationProgress(t *testing.T) {
+	content := &CourseContent{
+		Details: CourseDetails{Title: "Go basics", Description: "Learn Go"},
+		Modules: []*ModuleContent{{ID: "m1", Title: "Basics", Lessons: []*LessonContent{{ID: "l1", Title: "Intro"}}}},
+	}
+	translations := []*Translation{
+		{Locale: "fr", Entity: EntityCourse, EntityID: "c1", Field: FieldTitle, Value: "Les bases de Go"},
+		{Locale: "fr", Entity: EntityCourse, EntityID: "c1", Field: FieldDescription, Value: "Apprendre Go"},
+		{Locale: "fr", Entity: EntityModule, EntityID: "m1", Field: FieldTitle, Value: "Bases"},
+		{Locale: "fr", Entity: EntityLesson, EntityID: "l1", Field: FieldTitle, Value: "Introduction"},
+		{Locale: "de", Entity: EntityCourse, EntityID: "c1", Field: FieldTitle, Value: "Go-Grundlagen"},
+		// A lesson removed since, and a field with no source text, don't count
+		{Locale: "de", Entity: EntityLesson, EntityID: "l-removed", Field: FieldTitle, Value: "Alt"},
+		{Locale: "de", Entity: EntityLesson, EntityID: "l1", Field: FieldDescription, Value: "Erste Schritte"},
+	}
+
+	progress := TranslationProgress("c1", content, translations)
+
+	if len(progress) != 2 || progress[0].Locale != "de" || progress[1].Locale != "fr" {
+		t.Fatalf("progress = %+v, want de and fr", progress)
+	}
+
+	de, fr := progress[0], progress[1]
+	if fr.Translated != 4 || fr.Total != 4 || !fr.Complete() || len(fr.Missing) != 0 {
+		t.Errorf("fr = %+v, want 4 of 4 complete", fr)
+	}
+	wantMissing := []TranslationKey{
+		{Entity: EntityCourse, EntityID: "c1", Field: FieldDescription},
+		{Entity: EntityModule, EntityID: "m1", Field: FieldTitle},
+		{Entity: EntityLesson, EntityID: "l1", Field: FieldTitle},
+	}
+	if de.Translated != 1 || de.Total != 4 || de.Complete() || !slices.Equal(de.Missing, wantMissing) {
+		t.Errorf("de = %+v, want 1 of 4 missing %v", de, wantMissing)
+	}
+}
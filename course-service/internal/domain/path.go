@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrPrerequisiteNotFound  = errors.New("prerequisite not found")
+	ErrPrerequisiteCycle     = errors.New("prerequisite would create a cycle")
+	ErrLearningPathNotFound  = errors.New("learning path not found")
+	ErrPathCourseUnavailable = errors.New("learning path courses must be published")
+	ErrPathOrder             = errors.New("learning path lists a course before its prerequisite")
+)
+
+// Prerequisite is an edge saying CourseID can only be taken once
+// RequiredCourseID has been completed.
+type Prerequisite struct {
+	CourseID         string
+	RequiredCourseID string
+	RequiredTitle    string
+	RequiredLevel    CourseLevel
+	CreatedAt        time.Time
+}
+
+// LearningPath is a curated, ordered track of courses.
+type LearningPath struct {
+	ID          string
+	Title       string
+	Description string
+	CreatedBy   string
+	CourseIDs   []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (p *LearningPath) Validate() error {
+	if strings.TrimSpace(p.Title) == "" || len(p.CourseIDs) == 0 {
+		return ErrInvalidInput
+	}
+
+	seen := make(map[string]bool, len(p.CourseIDs))
+	for _, id := range p.CourseIDs {
+		if id == "" || seen[id] {
+			return ErrInvalidInput
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// CheckOrder makes sure every prerequisite that is itself part of the path
+// comes before the course that needs it.
+func (p *LearningPath) CheckOrder(prerequisites []*Prerequisite) error {
+	position := make(map[string]int, len(p.CourseIDs))
+	for i, id := range p.CourseIDs {
+		position[id] = i
+	}
+
+	for _, pre := range prerequisites {
+		required, ok := position[pre.RequiredCourseID]
+		if !ok {
+			continue
+		}
+		if course, ok := position[pre.CourseID]; ok && required > course {
+			return ErrPathOrder
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "testing"
+
+func TestLearningPathValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		title     string
+		courseIDs []string
+		wantErr   error
+	}{
+		{"valid", "Backend track", []string{"c1", "c2"}, nil},
+		{"blank title", "  ", []string{"c1"}, ErrInvalidInput},
+		{"no courses", "Backend track", nil, ErrInvalidInput},
+		{"empty course ID", "Backend track", []string{"c1", ""}, ErrInvalidInput},
+		{"course listed twice", "Backend track", []string{"c1", "c2", "c1"}, ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := &LearningPath{Title: tt.title, CourseIDs: tt.courseIDs}
+			if err := path.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLearningPathCheckOrder(t *testing.T) {
+	// c2 needs c1, c3 needs c2 and c3 also needs c9, which isn't in the path
+	prerequisites := []*Prerequisite{
+		{CourseID: "c2", RequiredCourseID: "c1"},
+		{CourseID: "c3", RequiredCourseID: "c2"},
+		{CourseID: "c3", RequiredCourseID: "c9"},
+	}
+
+	tests := []struct {
+		name      string
+		courseIDs []string
+		wantErr   error
+	}{
+		{"prerequisites first", []string{"c1", "c2", "c3"}, nil},
+		{"unrelated courses in between", []string{"c1", "c5", "c2", "c3"}, nil},
+		{"required course outside the path", []string{"c3"}, nil},
+		{"course before its prerequisite", []string{"c2", "c1", "c3"}, ErrPathOrder},
+		{"transitive prerequisite last", []string{"c2", "c3", "c1"}, ErrPathOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := &LearningPath{Title: "Track", CourseIDs: tt.courseIDs}
+			if err := path.CheckOrder(prerequisites); err != tt.wantErr {
+				t.Errorf("CheckOrder() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
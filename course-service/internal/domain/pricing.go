@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	ErrCouponNotFound      = errors.New("coupon not found")
+	ErrCouponExists        = errors.New("coupon code already exists")
+	ErrCouponExpired       = errors.New("coupon is not active")
+	ErrCouponExhausted     = errors.New("coupon has reached its usage limit")
+	ErrCouponNotApplicable = errors.New("coupon does not apply to this course")
+	ErrSaleNotFound        = errors.New("sale not found")
+	ErrCourseNotForSale    = errors.New("course is not for sale")
+	ErrCouponNotReleasable = errors.New("enrollment has gone through; its coupon use can't be released")
+)
+
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "PERCENTAGE"
+	DiscountFixed      DiscountType = "FIXED"
+)
+
+// Discount is either a percentage off or a fixed amount off a price.
+type Discount struct {
+	Type  DiscountType
+	Value float64
+}
+
+func (d Discount) Validate() error {
+	switch d.Type {
+	case DiscountPercentage:
+		if d.Value <= 0 || d.Value > 100 {
+			return ErrInvalidInput
+		}
+	case DiscountFixed:
+		if d.Value <= 0 {
+			return ErrInvalidInput
+		}
+	default:
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// Apply returns the discounted price, never below zero and rounded to cents.
+func (d Discount) Apply(price float64) float64 {
+	switch d.Type {
+	case DiscountPercentage:
+		price -= price * d.Value / 100
+	case DiscountFixed:
+		price -= d.Value
+	}
+	return roundCents(math.Max(price, 0))
+}
+
+// Coupon is a redeemable code. An empty CourseID makes it valid for every
+// course, and a MaxUses of zero means unlimited redemptions.
+type Coupon struct {
+	ID        string
+	Code      string
+	Discount  Discount
+	CourseID  string
+	MaxUses   int
+	UsedCount int
+	StartsAt  time.Time
+	ExpiresAt *time.Time
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// NormalizeCouponCode makes codes case-insensitive.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (c *Coupon) Validate() error {
+	if c.Code == "" || c.MaxUses < 0 {
+		return ErrInvalidInput
+	}
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(c.StartsAt) {
+		return ErrInvalidInput
+	}
+	return c.Discount.Validate()
+}
+
+// CheckUsable reports why the coupon can't be applied to courseID at now, if
+// it can't.
+func (c *Coupon) CheckUsable(courseID string, now time.Time) error {
+	if c.CourseID != "" && c.CourseID != courseID {
+		return ErrCouponNotApplicable
+	}
+	if now.Before(c.StartsAt) || (c.ExpiresAt != nil && !now.Before(*c.ExpiresAt)) {
+		return ErrCouponExpired
+	}
+	if c.MaxUses > 0 && c.UsedCount >= c.MaxUses {
+		return ErrCouponExhausted
+	}
+	return nil
+}
+
+// Sale is a time-boxed discount applied automatically at checkout. An empty
+// CourseID makes it sitewide.
+type Sale struct {
+	ID        string
+	Name      string
+	CourseID  string
+	Discount  Discount
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+func (s *Sale) Validate() error {
+	if strings.TrimSpace(s.Name) == "" || !s.EndsAt.After(s.StartsAt) {
+		return ErrInvalidInput
+	}
+	return s.Discount.Validate()
+}
+
+func (s *Sale) ActiveAt(now time.Time) bool {
+	return !now.Before(s.StartsAt) && now.Before(s.EndsAt)
+}
+
+// PriceQuote is the authoritative price of a course for one checkout.
+type PriceQuote struct {
+	CourseID   string
+	ListPrice  float64
+	SalePrice  float64
+	SaleID     string
+	CouponCode string
+	Discount   float64
+	FinalPrice float64
+}
+
+// QuotePrice applies the best active sale to the list price and then the
+// coupon, if any, to the sale price. Sales don't stack with each other.
+func QuotePrice(course *Course, sales []*Sale, coupon *Coupon, now time.Time) *PriceQuote {
+	quote := &PriceQuote{
+		CourseID:  course.ID,
+		ListPrice: course.Price,
+		SalePrice: course.Price,
+	}
+
+	for _, sale := range sales {
+		if !sale.ActiveAt(now) {
+			continue
+		}
+		if price := sale.Discount.Apply(course.Price); price < quote.SalePrice {
+			quote.SalePrice = price
+			quote.SaleID = sale.ID
+		}
+	}
+
+	quote.FinalPrice = quote.SalePrice
+	if coupon != nil {
+		quote.CouponCode = coupon.Code
+		quote.FinalPrice = coupon.Discount.Apply(quote.SalePrice)
+	}
+
+	quote.Discount = roundCents(quote.ListPrice - quote.FinalPrice)
+	return quote
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuotePrice(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	course := &Course{ID: "course-1", Price: 100}
+
+	activeSale := func(id string, discount Discount) *Sale {
+		return &Sale{ID: id, Discount: discount, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+	}
+	coupon := func(discount Discount) *Coupon {
+		return &Coupon{Code: "SAVE", Discount: discount}
+	}
+
+	tests := []struct {
+		name   string
+		course *Course
+		sales  []*Sale
+		coupon *Coupon
+		want   PriceQuote
+	}{
+		{
+			name:   "list price",
+			course: course,
+			want:   PriceQuote{CourseID: "course-1", ListPrice: 100, SalePrice: 100, FinalPrice: 100},
+		},
+		{
+			name:   "best active sale wins",
+			course: course,
+			sales: []*Sale{
+				activeSale("small", Discount{Type: DiscountPercentage, Value: 10}),
+				activeSale("big", Discount{Type: DiscountFixed, Value: 25}),
+			},
+			want: PriceQuote{CourseID: "course-1", ListPrice: 100, SalePrice: 75, SaleID: "big", Discount: 25, FinalPrice: 75},
+		},
+		{
+			name:   "inactive sales are ignored",
+			course: course,
+			sales: []*Sale{
+				{ID: "over", Discount: Discount{Type: DiscountPercentage, Value: 50}, StartsAt: now.Add(-2 * time.Hour), EndsAt: now},
+				{ID: "upcoming", Discount: Discount{Type: DiscountPercentage, Value: 50}, StartsAt: now.Add(time.Minute), EndsAt: now.Add(time.Hour)},
+			},
+			want: PriceQuote{CourseID: "course-1", ListPrice: 100, SalePrice: 100, FinalPrice: 100},
+		},
+		{
+			name:   "coupon applies to the sale price",
+			course: course,
+			sales:  []*Sale{activeSale("sale", Discount{Type: DiscountPercentage, Value: 20})},
+			coupon: coupon(Discount{Type: DiscountPercentage, Value: 10}),
+			want:   PriceQuote{CourseID: "course-1", ListPrice: 100, SalePrice: 80, SaleID: "sale", CouponCode: "SAVE", Discount: 28, FinalPrice: 72},
+		},
+		{
+			name:   "price never goes below zero",
+			course: course,
+			coupon: coupon(Discount{Type: DiscountFixed, Value: 150}),
+			want:   PriceQuote{CourseID: "course-1", ListPrice: 100, SalePrice: 100, CouponCode: "SAVE", Discount: 100, FinalPrice: 0},
+		},
+		{
+			name:   "rounded to cents",
+			course: &Course{ID: "course-2", Price: 19.99},
+			coupon: coupon(Discount{Type: DiscountPercentage, Value: 33}),
+			want:   PriceQuote{CourseID: "course-2", ListPrice: 19.99, SalePrice: 19.99, CouponCode: "SAVE", Discount: 6.6, FinalPrice: 13.39},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := QuotePrice(tt.course, tt.sales, tt.coupon, now)
+			if *got != tt.want {
+				t.Errorf("QuotePrice() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCouponCheckUsable(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		coupon   Coupon
+		courseID string
+		want     error
+	}{
+		{
+			name:     "sitewide",
+			coupon:   Coupon{StartsAt: now.Add(-time.Hour)},
+			courseID: "course-1",
+		},
+		{
+			name:     "matching course",
+			coupon:   Coupon{CourseID: "course-1", StartsAt: now.Add(-time.Hour), ExpiresAt: &expires},
+			courseID: "course-1",
+		},
+		{
+			name:     "other course",
+			coupon:   Coupon{CourseID: "course-2", StartsAt: now.Add(-time.Hour)},
+			courseID: "course-1",
+			want:     ErrCouponNotApplicable,
+		},
+		{
+			name:     "not started",
+			coupon:   Coupon{StartsAt: now.Add(time.Minute)},
+			courseID: "course-1",
+			want:     ErrCouponExpired,
+		},
+		{
+			name:     "expires now",
+			coupon:   Coupon{StartsAt: now.Add(-time.Hour), ExpiresAt: &now},
+			courseID: "course-1",
+			want:     ErrCouponExpired,
+		},
+		{
+			name:     "used up",
+			coupon:   Coupon{StartsAt: now.Add(-time.Hour), MaxUses: 3, UsedCount: 3},
+			courseID: "course-1",
+			want:     ErrCouponExhausted,
+		},
+		{
+			name:     "unlimited uses",
+			coupon:   Coupon{StartsAt: now.Add(-time.Hour), UsedCount: 1000},
+			courseID: "course-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.coupon.CheckUsable(tt.courseID, now); err != tt.want {
+				t.Errorf("CheckUsable() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
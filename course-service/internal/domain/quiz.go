@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrQuestionBankNotFound = errors.New("question bank not found")
+	ErrQuestionNotFound     = errors.New("question not found")
+	ErrQuizNotFound         = errors.New("quiz not found")
+	ErrAttemptNotFound      = errors.New("quiz attempt not found")
+	ErrNotQuizLesson        = errors.New("lesson is not a quiz")
+	ErrQuestionBankInUse    = errors.New("question bank is used by a quiz")
+	ErrNotEnoughQuestions   = errors.New("question bank has too few questions")
+	ErrNoAttemptsLeft       = errors.New("no quiz attempts left")
+	ErrAttemptSubmitted     = errors.New("quiz attempt already submitted")
+	ErrAttemptInProgress    = errors.New("quiz attempt already in progress")
+)
+
+// QuizSubmitGrace is how long after its deadline an attempt is still
+// accepted, to absorb network latency.
+const QuizSubmitGrace = 30 * time.Second
+
+type QuestionType string
+
+const (
+	QuestionSingleChoice   QuestionType = "SINGLE_CHOICE"
+	QuestionMultipleChoice QuestionType = "MULTIPLE_CHOICE"
+	QuestionTrueFalse      QuestionType = "TRUE_FALSE"
+	QuestionShortAnswer    QuestionType = "SHORT_ANSWER"
+)
+
+// QuestionBank is a course's pool of questions that quizzes draw from.
+type QuestionBank struct {
+	ID            string
+	CourseID      string
+	Title         string
+	QuestionCount int
+	CreatedAt     time.Time
+}
+
+func (b *QuestionBank) Validate() error {
+	if strings.TrimSpace(b.Title) == "" || len(b.Title) > 255 {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// Question is one bank entry. Choice questions are answered by option
+// index; CorrectOptions holds the right ones. Short answers are matched
+// against AcceptedAnswers ignoring case and extra whitespace.
+type Question struct {
+	ID              string
+	BankID          string
+	Type            QuestionType
+	Prompt          string
+	Options         []string
+	CorrectOptions  []int
+	AcceptedAnswers []string
+	Points          int
+	Explanation     string
+	CreatedAt       time.Time
+}
+
+var trueFalseOptions = []string{"True", "False"}
+
+// Validate checks the question is gradable. True/false questions get their
+// fixed options filled in.
+func (q *Question) Validate() error {
+	if strings.TrimSpace(q.Prompt) == "" || q.Points < 1 {
+		return ErrInvalidInput
+	}
+
+	switch q.Type {
+	case QuestionTrueFalse:
+		if len(q.Options) == 0 {
+			q.Options = slices.Clone(trueFalseOptions)
+		}
+		if !slices.Equal(q.Options, trueFalseOptions) || len(q.CorrectOptions) != 1 {
+			return ErrInvalidInput
+		}
+	case QuestionSingleChoice:
+		if len(q.CorrectOptions) != 1 {
+			return ErrInvalidInput
+		}
+	case QuestionMultipleChoice:
+		if len(q.CorrectOptions) < 1 {
+			return ErrInvalidInput
+		}
+	case QuestionShortAnswer:
+		if len(q.Options) != 0 || len(q.CorrectOptions) != 0 || len(q.AcceptedAnswers) == 0 {
+			return ErrInvalidInput
+		}
+		for _, answer := range q.AcceptedAnswers {
+			if normalizeAnswer(answer) == "" {
+				return ErrInvalidInput
+			}
+		}
+		return nil
+	default:
+		return ErrInvalidInput
+	}
+
+	if len(q.Options) < 2 || len(q.AcceptedAnswers) != 0 {
+		return ErrInvalidInput
+	}
+	seen := make(map[int]bool)
+	for _, i := range q.CorrectOptions {
+		if i < 0 || i >= len(q.Options) || seen[i] {
+			return ErrInvalidInput
+		}
+		seen[i] = true
+	}
+	return nil
+}
+
+// Grade reports whether answer is fully correct. Multiple choice needs
+// exactly the correct options; there is no partial credit.
+func (q *Question) Grade(answer QuizAnswer) bool {
+	if q.Type == QuestionShortAnswer {
+		given := normalizeAnswer(answer.Text)
+		for _, accepted := range q.AcceptedAnswers {
+			if given == normalizeAnswer(accepted) {
+				return true
+			}
+		}
+		return false
+	}
+
+	selected := slices.Clone(answer.SelectedOptions)
+	slices.Sort(selected)
+	selected = slices.Compact(selected)
+	correct := slices.Clone(q.CorrectOptions)
+	slices.Sort(correct)
+	return slices.Equal(selected, correct)
+}
+
+func normalizeAnswer(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// Quiz configures a QUIZ lesson. Each attempt draws QuestionCount questions
+// at random from the bank, or all of them when it is zero. Zero time limit
+// and max attempts mean unlimited. A passing attempt completes the lesson
+// when CountsTowardCompletion is set.
+type Quiz struct {
+	LessonID               string
+	CourseID               string
+	BankID                 string
+	QuestionCount          int
+	TimeLimitSeconds       int
+	MaxAttempts            int
+	PassPercentage         int
+	CountsTowardCompletion bool
+	UpdatedAt              time.Time
+}
+
+func (q *Quiz) Validate() error {
+	if q.BankID == "" || q.QuestionCount < 0 || q.TimeLimitSeconds < 0 || q.MaxAttempts < 0 {
+		return ErrInvalidInput
+	}
+	if q.PassPercentage < 0 || q.PassPercentage > 100 {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+type QuizAnswer struct {
+	QuestionID      string `json:"question_id"`
+	SelectedOptions []int  `json:"selected_options,omitempty"`
+	Text            string `json:"text,omitempty"`
+	Correct         bool   `json:"correct"`
+}
+
+// QuizAttempt is one learner's go at a quiz. The questions are fixed when it
+// starts; it is graded once, on submission.
+type QuizAttempt struct {
+	ID          string
+	LessonID    string
+	CourseID    string
+	UserID      string
+	QuestionIDs []string
+	Answers     []QuizAnswer
+	StartedAt   time.Time
+	Deadline    *time.Time
+	SubmittedAt *time.Time
+	Score       int
+	MaxScore    int
+	Percentage  float64
+	Passed      bool
+	Expired     bool
+}
+
+func (a *QuizAttempt) IsOpen() bool {
+	return a.SubmittedAt == nil
+}
+
+// IsExpired reports whether the attempt can no longer be submitted.
+func (a *QuizAttempt) IsExpired(now time.Time) bool {
+	return a.Deadline != nil && now.After(a.Deadline.Add(QuizSubmitGrace))
+}
+
+// Grade scores answers against questions, keyed by ID, and closes the
+// attempt. Answers to questions outside the attempt are dropped. An attempt
+// submitted after its deadline scores zero.
+func (a *QuizAttempt) Grade(questions map[string]*Question, answers []QuizAnswer, passPercentage int, now time.Time) error {
+	if !a.IsOpen() {
+		return ErrAttemptSubmitted
+	}
+
+	byQuestion := make(map[string]QuizAnswer, len(answers))
+	for _, answer := range answers {
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	a.Expired = a.IsExpired(now)
+	a.Answers = nil
+	a.Score, a.MaxScore = 0, 0
+	for _, id := range a.QuestionIDs {
+		question := questions[id]
+		if question == nil {
+			continue
+		}
+		a.MaxScore += question.Points
+
+		answer, ok := byQuestion[id]
+		if !ok || a.Expired {
+			continue
+		}
+		answer.Correct = question.Grade(answer)
+		if answer.Correct {
+			a.Score += question.Points
+		}
+		a.Answers = append(a.Answers, answer)
+	}
+
+	if a.MaxScore > 0 {
+		a.Percentage = float64(a.Score) * 100 / float64(a.MaxScore)
+	}
+	a.Passed = !a.Expired && a.Percentage >= float64(passPercentage)
+	a.SubmittedAt = &now
+	return nil
+}
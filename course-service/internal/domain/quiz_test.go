@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuestionGrade(t *testing.T) {
+	single := &Question{Type: QuestionSingleChoice, Options: []string{"a", "b", "c"}, CorrectOptions: []int{1}}
+	multiple := &Question{Type: QuestionMultipleChoice, Options: []string{"a", "b", "c", "d"}, CorrectOptions: []int{2, 0}}
+	trueFalse := &Question{Type: QuestionTrueFalse, Options: []string{"True", "False"}, CorrectOptions: []int{0}}
+	short := &Question{Type: QuestionShortAnswer, AcceptedAnswers: []string{"New  York", "NYC"}}
+
+	tests := []struct {
+		name     string
+		question *Question
+		answer   QuizAnswer
+		want     bool
+	}{
+		{"single choice correct", single, QuizAnswer{SelectedOptions: []int{1}}, true},
+		{"single choice wrong", single, QuizAnswer{SelectedOptions: []int{0}}, false},
+		{"single choice with extra option", single, QuizAnswer{SelectedOptions: []int{1, 2}}, false},
+		{"no answer", single, QuizAnswer{}, false},
+		{"multiple choice in any order", multiple, QuizAnswer{SelectedOptions: []int{0, 2}}, true},
+		{"multiple choice repeated option", multiple, QuizAnswer{SelectedOptions: []int{2, 0, 2}}, true},
+		{"multiple choice partly right", multiple, QuizAnswer{SelectedOptions: []int{0}}, false},
+		{"multiple choice too many", multiple, QuizAnswer{SelectedOptions: []int{0, 1, 2}}, false},
+		{"true/false correct", trueFalse, QuizAnswer{SelectedOptions: []int{0}}, true},
+		{"true/false wrong", trueFalse, QuizAnswer{SelectedOptions: []int{1}}, false},
+		{"short answer ignores case and spacing", short, QuizAnswer{Text: "  new york "}, true},
+		{"short answer alternative", short, QuizAnswer{Text: "nyc"}, true},
+		{"short answer wrong", short, QuizAnswer{Text: "Boston"}, false},
+		{"short answer empty", short, QuizAnswer{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.question.Grade(tt.answer); got != tt.want {
+				t.Errorf("Grade() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuizAttemptGrade(t *testing.T) {
+	started := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	deadline := started.Add(10 * time.Minute)
+
+	questions := map[string]*Question{
+		"q1": {ID: "q1", Type: QuestionSingleChoice, Options: []string{"a", "b"}, CorrectOptions: []int{0}, Points: 1},
+		"q2": {ID: "q2", Type: QuestionShortAnswer, AcceptedAnswers: []string{"go"}, Points: 3},
+		"q3": {ID: "q3", Type: QuestionSingleChoice, Options: []string{"a", "b"}, CorrectOptions: []int{1}, Points: 1},
+	}
+
+	tests := []struct {
+		name        string
+		questionIDs []string
+		deadline    *time.Time
+		answers     []QuizAnswer
+		pass        int
+		now         time.Time
+		wantScore   int
+		wantMax     int
+		wantPercent float64
+		wantPassed  bool
+		wantExpired bool
+		wantAnswers int
+	}{
+		{
+			name:        "all correct",
+			questionIDs: []string{"q1", "q2"},
+			answers:     []QuizAnswer{{QuestionID: "q1", SelectedOptions: []int{0}}, {QuestionID: "q2", Text: "Go"}},
+			pass:        100,
+			now:         started.Add(time.Minute),
+			wantScore:   4, wantMax: 4, wantPercent: 100, wantPassed: true, wantAnswers: 2,
+		},
+		{
+			name:        "weighted by points",
+			questionIDs: []string{"q1", "q2"},
+			answers:     []QuizAnswer{{QuestionID: "q1", SelectedOptions: []int{1}}, {QuestionID: "q2", Text: "go"}},
+			pass:        80,
+			now:         started.Add(time.Minute),
+			wantScore:   3, wantMax: 4, wantPercent: 75, wantPassed: false, wantAnswers: 2,
+		},
+		{
+			name:        "unanswered questions still count toward the maximum",
+			questionIDs: []string{"q1", "q3"},
+			answers:     []QuizAnswer{{QuestionID: "q1", SelectedOptions: []int{0}}},
+			pass:        50,
+			now:         started.Add(time.Minute),
+			wantScore:   1, wantMax: 2, wantPercent: 50, wantPassed: true, wantAnswers: 1,
+		},
+		{
+			name:        "answers outside the attempt are dropped",
+			questionIDs: []string{"q1"},
+			answers:     []QuizAnswer{{QuestionID: "q1", SelectedOptions: []int{0}}, {QuestionID: "q2", Text: "go"}},
+			pass:        100,
+			now:         started.Add(time.Minute),
+			wantScore:   1, wantMax: 1, wantPercent: 100, wantPassed: true, wantAnswers: 1,
+		},
+		{
+			name:        "within the grace period",
+			questionIDs: []string{"q1"},
+			deadline:    &deadline,
+			answers:     []QuizAnswer{{QuestionID: "q1", SelectedOptions: []int{0}}},
+			pass:        100,
+			now:         deadline.Add(QuizSubmitGrace),
+			wantScore:   1, wantMax: 1, wantPercent: 100, wantPassed: true, wantAnswers: 1,
+		},
+		{
+			name:        "late submission scores zero",
+			questionIDs: []string{"q1", "q2"},
+			deadline:    &deadline,
+			answers:     []QuizAnswer{{QuestionID: "q1", SelectedOptions: []int{0}}, {QuestionID: "q2", Text: "go"}},
+			pass:        0,
+			now:         deadline.Add(QuizSubmitGrace + time.Second),
+			wantScore:   0, wantMax: 4, wantPercent: 0, wantPassed: false, wantExpired: true, wantAnswers: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempt := &QuizAttempt{QuestionIDs: tt.questionIDs, StartedAt: started, Deadline: tt.deadline}
+
+			if err := attempt.Grade(questions, tt.answers, tt.pass, tt.now); err != nil {
+				t.Fatalf("Grade() error = %v", err)
+			}
+			if attempt.Score != tt.wantScore || attempt.MaxScore != tt.wantMax || attempt.Percentage != tt.wantPercent {
+				t.Errorf("score = %d/%d (%v%%), want %d/%d (%v%%)",
+					attempt.Score, attempt.MaxScore, attempt.Percentage, tt.wantScore, tt.wantMax, tt.wantPercent)
+			}
+			if attempt.Passed != tt.wantPassed || attempt.Expired != tt.wantExpired {
+				t.Errorf("passed, expired = %v, %v, want %v, %v", attempt.Passed, attempt.Expired, tt.wantPassed, tt.wantExpired)
+			}
+			if len(attempt.Answers) != tt.wantAnswers {
+				t.Errorf("kept %d answers, want %d", len(attempt.Answers), tt.wantAnswers)
+			}
+			if attempt.SubmittedAt == nil || !attempt.SubmittedAt.Equal(tt.now) {
+				t.Errorf("SubmittedAt = %v, want %v", attempt.SubmittedAt, tt.now)
+			}
+		})
+	}
+
+	t.Run("already submitted", func(t *testing.T) {
+		submitted := started.Add(time.Minute)
+		attempt := &QuizAttempt{QuestionIDs: []string{"q1"}, SubmittedAt: &submitted}
+
+		if err := attempt.Grade(questions, nil, 0, started.Add(2*time.Minute)); err != ErrAttemptSubmitted {
+			t.Errorf("Grade() error = %v, want %v", err, ErrAttemptSubmitted)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var ErrNotEnrolled = errors.New("not enrolled in course")
+
+type ReleaseType string
+
+const (
+	ReleaseOnDate              ReleaseType = "ON_DATE"
+	ReleaseAfterEnrollment     ReleaseType = "AFTER_ENROLLMENT"
+	ReleaseAfterPreviousModule ReleaseType = "AFTER_PREVIOUS_MODULE"
+)
+
+// ReleaseRule holds a module or lesson back from enrolled students until a
+// fixed date, a number of days after they enrolled, or until they complete
+// every lesson of the previous module. A nil rule releases on enrollment.
+type ReleaseRule struct {
+	Type ReleaseType `json:"type"`
+	At   *time.Time  `json:"at,omitempty"`
+	Days int         `json:"days,omitempty"`
+}
+
+func (r *ReleaseRule) Validate() error {
+	switch r.Type {
+	case ReleaseOnDate:
+		if r.At == nil || r.Days != 0 {
+			return ErrInvalidInput
+		}
+	case ReleaseAfterEnrollment:
+		if r.Days < 1 || r.At != nil {
+			return ErrInvalidInput
+		}
+	case ReleaseAfterPreviousModule:
+		if r.At != nil || r.Days != 0 {
+			return ErrInvalidInput
+		}
+	default:
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+func (r *ReleaseRule) Clone() *ReleaseRule {
+	if r == nil {
+		return nil
+	}
+	rule := *r
+	if r.At != nil {
+		at := *r.At
+		rule.At = &at
+	}
+	return &rule
+}
+
+// String describes the rule for revision diffs. A nil rule is empty.
+func (r *ReleaseRule) String() string {
+	if r == nil {
+		return ""
+	}
+	switch r.Type {
+	case ReleaseOnDate:
+		return string(r.Type) + " " + r.At.UTC().Format(time.RFC3339)
+	case ReleaseAfterEnrollment:
+		return string(r.Type) + " " + strconv.Itoa(r.Days) + "d"
+	default:
+		return string(r.Type)
+	}
+}
+
+// Learner is an enrolled student as far as release rules are concerned:
+// when they enrolled and which lessons they have completed.
+type Learner struct {
+	UserID     string
+	CourseID   string
+	EnrolledAt time.Time
+	Completed  map[string]bool
+}
+
+// Availability tells a learner whether a module or lesson is released.
+// UnlockAt is when a locked item opens, and is nil while that depends on the
+// learner's progress.
+type Availability struct {
+	Locked   bool
+	UnlockAt *time.Time
+}
+
+// VideoLesson is a live lesson that plays a given video.
+type VideoLesson struct {
+	CourseID string
+	Lesson   *Lesson
+}
+
+// Video is what course-service knows of a video hosted by video-service.
+type Video struct {
+	ID         string
+	UploaderID string
+	Status     VideoStatus
+}
+
+// LockedError denies access to content that isn't released yet.
+type LockedError struct {
+	UnlockAt *time.Time
+}
+
+func (e *LockedError) Error() string {
+	if e.UnlockAt == nil {
+		return "content unlocks once the previous module is completed"
+	}
+	return fmt.Sprintf("content unlocks at %s", e.UnlockAt.UTC().Format(time.RFC3339))
+}
+
+// Availability evaluates the release rules of every module and lesson for
+// learner at now, keyed by ID. A lesson is locked while its module is, and
+// unlocks once both its own rule and its module's are met.
+func (c *CourseContent) Availability(learner *Learner, now time.Time) map[string]Availability {
+	availability := make(map[string]Availability)
+
+	for i, m := range c.Modules {
+		previousDone := i == 0 || c.Modules[i-1].completedBy(learner)
+
+		module := m.Release.evaluate(learner, previousDone, now)
+		availability[m.ID] = module
+		for _, l := range m.Lessons {
+			availability[l.ID] = module.and(l.Release.evaluate(learner, previousDone, now))
+		}
+	}
+
+	return availability
+}
+
+func (m *ModuleContent) completedBy(learner *Learner) bool {
+	for _, l := range m.Lessons {
+		if !learner.Completed[l.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ReleaseRule) evaluate(learner *Learner, previousDone bool, now time.Time) Availability {
+	if r == nil {
+		return Availability{}
+	}
+
+	var unlockAt time.Time
+	switch r.Type {
+	case ReleaseOnDate:
+		unlockAt = *r.At
+	case ReleaseAfterEnrollment:
+		unlockAt = learner.EnrolledAt.AddDate(0, 0, r.Days)
+	case ReleaseAfterPreviousModule:
+		return Availability{Locked: !previousDone}
+	default:
+		return Availability{}
+	}
+
+	if !now.Before(unlockAt) {
+		return Availability{}
+	}
+	return Availability{Locked: true, UnlockAt: &unlockAt}
+}
+
+// and combines two conditions that must both be met.
+func (a Availability) and(b Availability) Availability {
+	switch {
+	case !a.Locked:
+		return b
+	case !b.Locked:
+		return a
+	case a.UnlockAt == nil || b.UnlockAt == nil:
+		return Availability{Locked: true}
+	case a.UnlockAt.After(*b.UnlockAt):
+		return a
+	default:
+		return b
+	}
+}
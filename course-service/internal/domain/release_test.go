@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCourseContentAvailability(t *testing.T) {
+	enrolledAt := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := enrolledAt.AddDate(0, 0, 3)
+	tomorrow := now.AddDate(0, 0, 1)
+	nextWeek := now.AddDate(0, 0, 7)
+	yesterday := now.AddDate(0, 0, -1)
+
+	onDate := func(at time.Time) *ReleaseRule { return &ReleaseRule{Type: ReleaseOnDate, At: &at} }
+	afterDays := func(days int) *ReleaseRule { return &ReleaseRule{Type: ReleaseAfterEnrollment, Days: days} }
+	afterPrevious := &ReleaseRule{Type: ReleaseAfterPreviousModule}
+
+	open := Availability{}
+	locked := func(at *time.Time) Availability { return Availability{Locked: true, UnlockAt: at} }
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name      string
+		modules   []*ModuleContent
+		completed map[string]bool
+		want      map[string]Availability
+	}{
+		{
+			name: "no rules releases everything",
+			modules: []*ModuleContent{
+				{ID: "m1", Lessons: []*LessonContent{{ID: "l1"}}},
+			},
+			want: map[string]Availability{"m1": open, "l1": open},
+		},
+		{
+			name: "date rule",
+			modules: []*ModuleContent{
+				{ID: "m1", Release: onDate(yesterday), Lessons: []*LessonContent{{ID: "l1"}}},
+				{ID: "m2", Release: onDate(tomorrow), Lessons: []*LessonContent{{ID: "l2"}}},
+			},
+			want: map[string]Availability{
+				"m1": open, "l1": open,
+				"m2": locked(at(tomorrow)), "l2": locked(at(tomorrow)),
+			},
+		},
+		{
+			name: "days after enrollment",
+			modules: []*ModuleContent{
+				{ID: "m1", Release: afterDays(3), Lessons: []*LessonContent{{ID: "l1"}}},
+				{ID: "m2", Release: afterDays(5), Lessons: []*LessonContent{{ID: "l2"}}},
+			},
+			want: map[string]Availability{
+				"m1": open, "l1": open,
+				"m2": locked(at(enrolledAt.AddDate(0, 0, 5))), "l2": locked(at(enrolledAt.AddDate(0, 0, 5))),
+			},
+		},
+		{
+			name: "previous module not completed",
+			modules: []*ModuleContent{
+				{ID: "m1", Lessons: []*LessonContent{{ID: "l1"}, {ID: "l2"}}},
+				{ID: "m2", Release: afterPrevious, Lessons: []*LessonContent{{ID: "l3"}}},
+			},
+			completed: map[string]bool{"l1": true},
+			want: map[string]Availability{
+				"m1": open, "l1": open, "l2": open,
+				"m2": locked(nil), "l3": locked(nil),
+			},
+		},
+		{
+			name: "previous module completed",
+			modules: []*ModuleContent{
+				{ID: "m1", Lessons: []*LessonContent{{ID: "l1"}, {ID: "l2"}}},
+				{ID: "m2", Release: afterPrevious, Lessons: []*LessonContent{{ID: "l3"}}},
+			},
+			completed: map[string]bool{"l1": true, "l2": true},
+			want: map[string]Availability{
+				"m1": open, "l1": open, "l2": open,
+				"m2": open, "l3": open,
+			},
+		},
+		{
+			name: "first module has no previous module",
+			modules: []*ModuleContent{
+				{ID: "m1", Release: afterPrevious, Lessons: []*LessonContent{{ID: "l1"}}},
+			},
+			want: map[string]Availability{"m1": open, "l1": open},
+		},
+		{
+			name: "lesson opens when both its rule and its module's are met",
+			modules: []*ModuleContent{
+				{ID: "m1", Release: onDate(tomorrow), Lessons: []*LessonContent{
+					{ID: "l1", Release: onDate(nextWeek)},
+					{ID: "l2", Release: onDate(yesterday)},
+				}},
+			},
+			want: map[string]Availability{
+				"m1": locked(at(tomorrow)),
+				"l1": locked(at(nextWeek)),
+				"l2": locked(at(tomorrow)),
+			},
+		},
+		{
+			name: "locked lesson in an open module",
+			modules: []*ModuleContent{
+				{ID: "m1", Lessons: []*LessonContent{{ID: "l1", Release: afterDays(10)}}},
+			},
+			want: map[string]Availability{
+				"m1": open,
+				"l1": locked(at(enrolledAt.AddDate(0, 0, 10))),
+			},
+		},
+		{
+			name: "progress lock outweighs a date",
+			modules: []*ModuleContent{
+				{ID: "m1", Lessons: []*LessonContent{{ID: "l1"}}},
+				{ID: "m2", Release: afterPrevious, Lessons: []*LessonContent{{ID: "l2", Release: onDate(tomorrow)}}},
+			},
+			want: map[string]Availability{
+				"m1": open, "l1": open,
+				"m2": locked(nil), "l2": locked(nil),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := &CourseContent{Modules: tt.modules}
+			learner := &Learner{EnrolledAt: enrolledAt, Completed: tt.completed}
+
+			got := content.Availability(learner, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Availability() returned %d items, want %d", len(got), len(tt.want))
+			}
+			for id, want := range tt.want {
+				if !sameAvailability(got[id], want) {
+					t.Errorf("Availability()[%s] = %s, want %s", id, describeAvailability(got[id]), describeAvailability(want))
+				}
+			}
+		})
+	}
+}
+
+func sameAvailability(a, b Availability) bool {
+	if a.Locked != b.Locked || (a.UnlockAt == nil) != (b.UnlockAt == nil) {
+		return false
+	}
+	return a.UnlockAt == nil || a.UnlockAt.Equal(*b.UnlockAt)
+}
+
+func describeAvailability(a Availability) string {
+	switch {
+	case !a.Locked:
+		return "open"
+	case a.UnlockAt == nil:
+		return "locked on progress"
+	default:
+		return "locked until " + a.UnlockAt.Format(time.RFC3339)
+	}
+}
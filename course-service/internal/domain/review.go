@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrSubmissionNotFound = errors.New("submission not found")
+	ErrAlreadySubmitted   = errors.New("course is already awaiting review")
+	ErrCourseUnderReview  = errors.New("course is under review")
+	ErrInvalidTransition  = errors.New("submission is not awaiting review")
+	ErrCommentRequired    = errors.New("reviewer comment is required")
+)
+
+type SubmissionStatus string
+
+const (
+	SubmissionSubmitted        SubmissionStatus = "SUBMITTED"
+	SubmissionInReview         SubmissionStatus = "IN_REVIEW"
+	SubmissionChangesRequested SubmissionStatus = "CHANGES_REQUESTED"
+	SubmissionApproved         SubmissionStatus = "APPROVED"
+	SubmissionScheduled        SubmissionStatus = "SCHEDULED"
+)
+
+// CourseSubmission is an instructor's request to publish a course, or the
+// draft of an already published one. It stays open while SUBMITTED or
+// IN_REVIEW and the course can't be edited until an admin decides. An
+// approval for a course with a future publish time is SCHEDULED until the
+// scheduler publishes it, and the course stays locked meanwhile.
+type CourseSubmission struct {
+	ID           string
+	CourseID     string
+	CourseTitle  string
+	InstructorID string
+	Status       SubmissionStatus
+	ReviewerID   string
+	Comment      string
+	SubmittedAt  time.Time
+	ReviewedAt   *time.Time
+}
+
+func (s *CourseSubmission) IsOpen() bool {
+	return s.Status == SubmissionSubmitted || s.Status == SubmissionInReview
+}
+
+func (s *CourseSubmission) StartReview(reviewerID string) error {
+	if s.Status != SubmissionSubmitted {
+		return ErrInvalidTransition
+	}
+	s.Status = SubmissionInReview
+	s.ReviewerID = reviewerID
+	return nil
+}
+
+func (s *CourseSubmission) Approve(reviewerID, comment string) error {
+	return s.decide(SubmissionApproved, reviewerID, comment)
+}
+
+// RequestChanges sends the course back to the instructor. The comment is
+// what the instructor acts on, so it can't be empty.
+func (s *CourseSubmission) RequestChanges(reviewerID, comment string) error {
+	if strings.TrimSpace(comment) == "" {
+		return ErrCommentRequired
+	}
+	return s.decide(SubmissionChangesRequested, reviewerID, comment)
+}
+
+// Schedule defers an approval until the course's publish time.
+func (s *CourseSubmission) Schedule() error {
+	if s.Status != SubmissionApproved {
+		return ErrInvalidTransition
+	}
+	s.Status = SubmissionScheduled
+	return nil
+}
+
+// Release marks a scheduled approval as published.
+func (s *CourseSubmission) Release() error {
+	if s.Status != SubmissionScheduled {
+		return ErrInvalidTransition
+	}
+	s.Status = SubmissionApproved
+	return nil
+}
+
+// Withdraw sends a scheduled approval back to the instructor when the
+// course no longer passes the checks at its publish time.
+func (s *CourseSubmission) Withdraw(comment string) error {
+	if s.Status != SubmissionScheduled {
+		return ErrInvalidTransition
+	}
+	s.Status = SubmissionChangesRequested
+	s.Comment = comment
+	return nil
+}
+
+func (s *CourseSubmission) decide(status SubmissionStatus, reviewerID, comment string) error {
+	if !s.IsOpen() {
+		return ErrInvalidTransition
+	}
+	now := time.Now()
+	s.Status = status
+	s.ReviewerID = reviewerID
+	s.Comment = comment
+	s.ReviewedAt = &now
+	return nil
+}
+
+type VideoStatus string
+
+const (
+	VideoProcessing VideoStatus = "PROCESSING"
+	VideoReady      VideoStatus = "READY"
+	VideoFailed     VideoStatus = "FAILED"
+)
+
+// CheckError lists every pre-publish check a course failed.
+type CheckError struct {
+	Failures []string
+}
+
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("course is not ready to publish: %s", strings.Join(e.Failures, "; "))
+}
+
+// CheckPublishable runs the automated pre-publish checks against content.
+// videos holds the status video-service reports for each referenced video;
+// one missing from it doesn't exist. configured holds the quiz and assignment
+// lessons that are set up.
+func CheckPublishable(content *CourseContent, videos map[string]VideoStatus, configured map[string]bool) error {
+	var failures []string
+
+	if strings.TrimSpace(content.Details.ThumbnailURL) == "" {
+		failures = append(failures, "thumbnail is missing")
+	}
+	if len(content.Modules) == 0 {
+		failures = append(failures, "course has no modules")
+	}
+
+	for _, m := range content.Modules {
+		for _, l := range m.Lessons {
+			switch {
+			case l.LessonType() == LessonQuiz:
+				if !configured[l.ID] {
+					failures = append(failures, fmt.Sprintf("quiz for lesson %q is not set up", l.Title))
+				}
+			case l.LessonType() == LessonAssignment:
+				if !configured[l.ID] {
+					failures = append(failures, fmt.Sprintf("assignment for lesson %q is not set up", l.Title))
+				}
+			case l.VideoID == "":
+				failures = append(failures, fmt.Sprintf("lesson %q has no video", l.Title))
+			case videos[l.VideoID] != VideoReady:
+				failures = append(failures, fmt.Sprintf("video for lesson %q is not ready", l.Title))
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return &CheckError{Failures: failures}
+	}
+	return nil
+}
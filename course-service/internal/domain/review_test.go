@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCourseSubmissionTransitions(t *testing.T) {
+	startReview := func(s *CourseSubmission) error { return s.StartReview("admin-1") }
+	approve := func(s *CourseSubmission) error { return s.Approve("admin-1", "") }
+	requestChanges := func(s *CourseSubmission) error { return s.RequestChanges("admin-1", "Add captions") }
+	schedule := func(s *CourseSubmission) error { return s.Schedule() }
+	release := func(s *CourseSubmission) error { return s.Release() }
+	withdraw := func(s *CourseSubmission) error { return s.Withdraw("video failed") }
+
+	tests := []struct {
+		name    string
+		from    SubmissionStatus
+		action  func(*CourseSubmission) error
+		want    SubmissionStatus
+		wantErr error
+	}{
+		{"start review", SubmissionSubmitted, startReview, SubmissionInReview, nil},
+		{"start review twice", SubmissionInReview, startReview, "", ErrInvalidTransition},
+		{"approve submitted", SubmissionSubmitted, approve, SubmissionApproved, nil},
+		{"approve in review", SubmissionInReview, approve, SubmissionApproved, nil},
+		{"approve decided", SubmissionChangesRequested, approve, "", ErrInvalidTransition},
+		{"approve scheduled", SubmissionScheduled, approve, "", ErrInvalidTransition},
+		{"request changes", SubmissionInReview, requestChanges, SubmissionChangesRequested, nil},
+		{"request changes after approval", SubmissionApproved, requestChanges, "", ErrInvalidTransition},
+		{"schedule approved", SubmissionApproved, schedule, SubmissionScheduled, nil},
+		{"schedule open", SubmissionInReview, schedule, "", ErrInvalidTransition},
+		{"release scheduled", SubmissionScheduled, release, SubmissionApproved, nil},
+		{"release approved", SubmissionApproved, release, "", ErrInvalidTransition},
+		{"withdraw scheduled", SubmissionScheduled, withdraw, SubmissionChangesRequested, nil},
+		{"withdraw approved", SubmissionApproved, withdraw, "", ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submission := &CourseSubmission{ID: "submission-1", Status: tt.from}
+
+			err := tt.action(submission)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := tt.want
+			if tt.wantErr != nil {
+				want = tt.from
+			}
+			if submission.Status != want {
+				t.Errorf("Status = %s, want %s", submission.Status, want)
+			}
+		})
+	}
+
+	t.Run("changes need a comment", func(t *testing.T) {
+		submission := &CourseSubmission{Status: SubmissionInReview}
+		if err := submission.RequestChanges("admin-1", "  "); err != ErrCommentRequired {
+			t.Errorf("RequestChanges() error = %v, want %v", err, ErrCommentRequired)
+		}
+		if submission.Status != SubmissionInReview {
+			t.Errorf("Status = %s, want %s", submission.Status, SubmissionInReview)
+		}
+	})
+
+	t.Run("scheduled submissions leave the review queue", func(t *testing.T) {
+		submission := &CourseSubmission{Status: SubmissionScheduled}
+		if submission.IsOpen() {
+			t.Error("IsOpen() = true for a scheduled submission, want false")
+		}
+	})
+}
+
+func TestValidateSchedule(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name      string
+		publishAt *time.Time
+		archiveAt *time.Time
+		wantErr   error
+	}{
+		{"nothing scheduled", nil, nil, nil},
+		{"publish only", at(time.Hour), nil, nil},
+		{"past publish time", at(-time.Hour), nil, nil},
+		{"archive after publish", at(time.Hour), at(2 * time.Hour), nil},
+		{"archive only", nil, at(time.Hour), nil},
+		{"archive in the past", nil, at(-time.Hour), ErrInvalidInput},
+		{"archive now", nil, at(0), ErrInvalidInput},
+		{"archive before publish", at(2 * time.Hour), at(time.Hour), ErrInvalidInput},
+		{"archive at publish", at(time.Hour), at(time.Hour), ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSchedule(tt.publishAt, tt.archiveAt, now); err != tt.wantErr {
+				t.Errorf("ValidateSchedule() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckPublishable(t *testing.T) {
+	const thumbnail = "https://cdn.example.com/thumb.png"
+
+	videos := map[string]VideoStatus{"video-ok": VideoReady, "video-processing": VideoProcessing, "video-failed": VideoFailed}
+	configured := map[string]bool{"quiz-ready": true, "assignment-ready": true}
+
+	module := func(lessons ...*LessonContent) *ModuleContent {
+		return &ModuleContent{ID: "module-1", Lessons: lessons}
+	}
+
+	tests := []struct {
+		name         string
+		content      *CourseContent
+		wantFailures []string
+	}{
+		{
+			name: "ready course",
+			content: &CourseContent{Details: CourseDetails{ThumbnailURL: thumbnail}, Modules: []*ModuleContent{module(
+				&LessonContent{Title: "Intro", VideoID: "video-ok"},
+				&LessonContent{ID: "quiz-ready", Title: "Check", Type: LessonQuiz},
+				&LessonContent{ID: "assignment-ready", Title: "Build", Type: LessonAssignment},
+			)}},
+		},
+		{
+			name:         "no thumbnail or modules",
+			content:      &CourseContent{Details: CourseDetails{ThumbnailURL: " "}},
+			wantFailures: []string{"thumbnail is missing", "course has no modules"},
+		},
+		{
+			name: "videos missing, processing, failed or unknown",
+			content: &CourseContent{Details: CourseDetails{ThumbnailURL: thumbnail}, Modules: []*ModuleContent{module(
+				&LessonContent{Title: "None"},
+				&LessonContent{Title: "Processing", VideoID: "video-processing"},
+				&LessonContent{Title: "Failed", VideoID: "video-failed"},
+				&LessonContent{Title: "Unknown", VideoID: "video-deleted"},
+			)}},
+			wantFailures: []string{
+				`lesson "None" has no video`,
+				`video for lesson "Processing" is not ready`,
+				`video for lesson "Failed" is not ready`,
+				`video for lesson "Unknown" is not ready`,
+			},
+		},
+		{
+			name: "activities not set up",
+			content: &CourseContent{Details: CourseDetails{ThumbnailURL: thumbnail}, Modules: []*ModuleContent{module(
+				&LessonContent{ID: "quiz-empty", Title: "Check", Type: LessonQuiz},
+				&LessonContent{ID: "assignment-empty", Title: "Build", Type: LessonAssignment},
+			)}},
+			wantFailures: []string{`quiz for lesson "Check" is not set up`, `assignment for lesson "Build" is not set up`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPublishable(tt.content, videos, configured)
+			if tt.wantFailures == nil {
+				if err != nil {
+					t.Fatalf("CheckPublishable() error = %v, want nil", err)
+				}
+				return
+			}
+
+			checkErr, ok := err.(*CheckError)
+			if !ok {
+				t.Fatalf("CheckPublishable() error = %v, want a *CheckError", err)
+			}
+			if !slices.Equal(checkErr.Failures, tt.wantFailures) {
+				t.Errorf("Failures = %q, want %q", checkErr.Failures, tt.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,561 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrRevisionNotFound = errors.New("revision not found")
+	ErrDraftNotFound    = errors.New("course has no draft")
+	ErrRevisionConflict = errors.New("draft was modified concurrently")
+)
+
+type RevisionStatus string
+
+const (
+	RevisionDraft      RevisionStatus = "DRAFT"
+	RevisionPublished  RevisionStatus = "PUBLISHED"
+	RevisionSuperseded RevisionStatus = "SUPERSEDED"
+)
+
+// CourseRevision is a full snapshot of a course's editable content. A course
+// has at most one DRAFT revision, which instructors edit while the PUBLISHED
+// revision stays live. Older published revisions are kept as SUPERSEDED
+// history. Number is assigned when a revision is published and is zero for
+// the draft.
+type CourseRevision struct {
+	ID             string
+	CourseID       string
+	Number         int
+	Status         RevisionStatus
+	Content        CourseContent
+	SourceRevision int
+	Version        int
+	CreatedBy      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	PublishedAt    *time.Time
+}
+
+type CourseContent struct {
+	Details CourseDetails    `json:"details"`
+	Modules []*ModuleContent `json:"modules"`
+}
+
+// CourseDetails are the course fields that are versioned with the content.
+// Status, counters and ratings live on the course itself.
+type CourseDetails struct {
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	ThumbnailURL string      `json:"thumbnail_url"`
+	Level        CourseLevel `json:"level"`
+	Price        float64     `json:"price"`
+	Category     string      `json:"category"`
+	Tags         []string    `json:"tags"`
+}
+
+type ModuleContent struct {
+	ID          string           `json:"id"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	OrderIndex  int              `json:"order_index"`
+	Release     *ReleaseRule     `json:"release,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Lessons     []*LessonContent `json:"lessons"`
+}
+
+type LessonContent struct {
+	ID              string       `json:"id"`
+	Type            LessonType   `json:"type,omitempty"`
+	Title           string       `json:"title"`
+	Description     string       `json:"description"`
+	VideoID         string       `json:"video_id"`
+	DurationSeconds int          `json:"duration_seconds"`
+	OrderIndex      int          `json:"order_index"`
+	IsPreview       bool         `json:"is_preview"`
+	Release         *ReleaseRule `json:"release,omitempty"`
+	CreatedAt       time.Time    `json:"created_at"`
+}
+
+func DetailsOf(course *Course) CourseDetails {
+	return CourseDetails{
+		Title:        course.Title,
+		Description:  course.Description,
+		ThumbnailURL: course.ThumbnailURL,
+		Level:        course.Level,
+		Price:        course.Price,
+		Category:     course.Category,
+		Tags:         slices.Clone(course.Tags),
+	}
+}
+
+// ApplyTo overwrites the versioned fields of course with d.
+func (d CourseDetails) ApplyTo(course *Course) {
+	course.Title = d.Title
+	course.Description = d.Description
+	course.ThumbnailURL = d.ThumbnailURL
+	course.Level = d.Level
+	course.Price = d.Price
+	course.Category = d.Category
+	course.Tags = slices.Clone(d.Tags)
+}
+
+func NewModuleContent(module *Module) *ModuleContent {
+	return &ModuleContent{
+		ID:          module.ID,
+		Title:       module.Title,
+		Description: module.Description,
+		OrderIndex:  module.OrderIndex,
+		Release:     module.Release.Clone(),
+		CreatedAt:   module.CreatedAt,
+	}
+}
+
+func NewLessonContent(lesson *Lesson) *LessonContent {
+	return &LessonContent{
+		ID:              lesson.ID,
+		Type:            lesson.Type,
+		Title:           lesson.Title,
+		Description:     lesson.Description,
+		VideoID:         lesson.VideoID,
+		DurationSeconds: lesson.DurationSeconds,
+		OrderIndex:      lesson.OrderIndex,
+		IsPreview:       lesson.IsPreview,
+		Release:         lesson.Release.Clone(),
+		CreatedAt:       lesson.CreatedAt,
+	}
+}
+
+func (m *ModuleContent) ToModule(courseID string) *Module {
+	return &Module{
+		ID:          m.ID,
+		CourseID:    courseID,
+		Title:       m.Title,
+		Description: m.Description,
+		OrderIndex:  m.OrderIndex,
+		Release:     m.Release.Clone(),
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func (l *LessonContent) ToLesson(moduleID string) *Lesson {
+	return &Lesson{
+		ID:              l.ID,
+		ModuleID:        moduleID,
+		Type:            l.LessonType(),
+		Title:           l.Title,
+		Description:     l.Description,
+		VideoID:         l.VideoID,
+		DurationSeconds: l.DurationSeconds,
+		OrderIndex:      l.OrderIndex,
+		IsPreview:       l.IsPreview,
+		Release:         l.Release.Clone(),
+		CreatedAt:       l.CreatedAt,
+	}
+}
+
+// LessonType treats content saved before lesson types existed as video.
+func (l *LessonContent) LessonType() LessonType {
+	if l.Type == "" {
+		return LessonVideo
+	}
+	return l.Type
+}
+
+func (c *CourseContent) Module(id string) *ModuleContent {
+	for _, m := range c.Modules {
+		if m.ID == id {
+			return m
+		}
+	}
+	return nil
+}
+
+func (c *CourseContent) RemoveModule(id string) bool {
+	for i, m := range c.Modules {
+		if m.ID == id {
+			c.Modules = slices.Delete(c.Modules, i, i+1)
+			c.renumberModules()
+			return true
+		}
+	}
+	return false
+}
+
+// ReorderModules puts the modules in the order of ids, which must name every
+// module exactly once.
+func (c *CourseContent) ReorderModules(ids []string) error {
+	if len(ids) != len(c.Modules) {
+		return ErrInvalidInput
+	}
+
+	ordered := make([]*ModuleContent, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		m := c.Module(id)
+		if m == nil || seen[id] {
+			return ErrInvalidInput
+		}
+		seen[id] = true
+		ordered = append(ordered, m)
+	}
+
+	c.Modules = ordered
+	c.renumberModules()
+	return nil
+}
+
+// MoveLesson moves a lesson to position within the target module, which may
+// be the module it is already in. A position past the end appends.
+func (c *CourseContent) MoveLesson(lessonID, targetModuleID string, position int) error {
+	if position < 0 {
+		return ErrInvalidInput
+	}
+
+	target := c.Module(targetModuleID)
+	if target == nil {
+		return ErrCourseNotFound
+	}
+
+	var lesson *LessonContent
+	for _, m := range c.Modules {
+		if lesson = m.Lesson(lessonID); lesson != nil {
+			m.RemoveLesson(lessonID)
+			break
+		}
+	}
+	if lesson == nil {
+		return ErrCourseNotFound
+	}
+
+	position = min(position, len(target.Lessons))
+	target.Lessons = slices.Insert(target.Lessons, position, lesson)
+	target.renumberLessons()
+	return nil
+}
+
+func (c *CourseContent) renumberModules() {
+	for i, m := range c.Modules {
+		m.OrderIndex = i
+	}
+}
+
+func (c *CourseContent) NextModuleIndex() int {
+	next := 0
+	for _, m := range c.Modules {
+		if m.OrderIndex >= next {
+			next = m.OrderIndex + 1
+		}
+	}
+	return next
+}
+
+func (m *ModuleContent) Lesson(id string) *LessonContent {
+	for _, l := range m.Lessons {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
+
+func (m *ModuleContent) RemoveLesson(id string) bool {
+	for i, l := range m.Lessons {
+		if l.ID == id {
+			m.Lessons = slices.Delete(m.Lessons, i, i+1)
+			m.renumberLessons()
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ModuleContent) renumberLessons() {
+	for i, l := range m.Lessons {
+		l.OrderIndex = i
+	}
+}
+
+func (m *ModuleContent) NextLessonIndex() int {
+	next := 0
+	for _, l := range m.Lessons {
+		if l.OrderIndex >= next {
+			next = l.OrderIndex + 1
+		}
+	}
+	return next
+}
+
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "ADDED"
+	ChangeRemoved  ChangeType = "REMOVED"
+	ChangeModified ChangeType = "MODIFIED"
+)
+
+type EntityType string
+
+const (
+	EntityCourse EntityType = "COURSE"
+	EntityModule EntityType = "MODULE"
+	EntityLesson EntityType = "LESSON"
+)
+
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+type ContentChange struct {
+	EntityType EntityType
+	EntityID   string
+	Change     ChangeType
+	Fields     []FieldChange
+}
+
+// DiffContent lists the changes that turn from into to. Course details come
+// first, followed by modules and lessons in the order they appear in to, with
+// removals after the entries that remain.
+func DiffContent(courseID string, from, to *CourseContent) []ContentChange {
+	var changes []ContentChange
+
+	if fields := diffFields(detailFields(from.Details), detailFields(to.Details)); len(fields) > 0 {
+		changes = append(changes, ContentChange{EntityType: EntityCourse, EntityID: courseID, Change: ChangeModified, Fields: fields})
+	}
+
+	fromLessons := make(map[string]lessonInModule)
+	for _, m := range from.Modules {
+		for _, l := range m.Lessons {
+			fromLessons[l.ID] = lessonInModule{moduleID: m.ID, lesson: l}
+		}
+	}
+	toLessons := make(map[string]bool)
+
+	for _, m := range to.Modules {
+		if old := from.Module(m.ID); old == nil {
+			changes = append(changes, ContentChange{EntityType: EntityModule, EntityID: m.ID, Change: ChangeAdded, Fields: diffFields(nil, moduleFields(m))})
+		} else if fields := diffFields(moduleFields(old), moduleFields(m)); len(fields) > 0 {
+			changes = append(changes, ContentChange{EntityType: EntityModule, EntityID: m.ID, Change: ChangeModified, Fields: fields})
+		}
+
+		for _, l := range m.Lessons {
+			toLessons[l.ID] = true
+			old, ok := fromLessons[l.ID]
+			if !ok {
+				changes = append(changes, ContentChange{EntityType: EntityLesson, EntityID: l.ID, Change: ChangeAdded, Fields: diffFields(nil, lessonFields(m.ID, l))})
+			} else if fields := diffFields(lessonFields(old.moduleID, old.lesson), lessonFields(m.ID, l)); len(fields) > 0 {
+				changes = append(changes, ContentChange{EntityType: EntityLesson, EntityID: l.ID, Change: ChangeModified, Fields: fields})
+			}
+		}
+	}
+
+	for _, m := range from.Modules {
+		for _, l := range m.Lessons {
+			if !toLessons[l.ID] {
+				changes = append(changes, ContentChange{EntityType: EntityLesson, EntityID: l.ID, Change: ChangeRemoved, Fields: diffFields(lessonFields(m.ID, l), nil)})
+			}
+		}
+		if to.Module(m.ID) == nil {
+			changes = append(changes, ContentChange{EntityType: EntityModule, EntityID: m.ID, Change: ChangeRemoved, Fields: diffFields(moduleFields(m), nil)})
+		}
+	}
+
+	return changes
+}
+
+type lessonInModule struct {
+	moduleID string
+	lesson   *LessonContent
+}
+
+// field is a named, stringified value. Slices keep diff output in a stable
+// order, which a map wouldn't.
+type field struct {
+	name  string
+	value string
+}
+
+func detailFields(d CourseDetails) []field {
+	return []field{
+		{"title", d.Title},
+		{"description", d.Description},
+		{"thumbnail_url", d.ThumbnailURL},
+		{"level", string(d.Level)},
+		{"price", strconv.FormatFloat(d.Price, 'f', 2, 64)},
+		{"category", d.Category},
+		{"tags", strings.Join(d.Tags, ",")},
+	}
+}
+
+func moduleFields(m *ModuleContent) []field {
+	return []field{
+		{"title", m.Title},
+		{"description", m.Description},
+		{"order_index", strconv.Itoa(m.OrderIndex)},
+		{"release", m.Release.String()},
+	}
+}
+
+func lessonFields(moduleID string, l *LessonContent) []field {
+	return []field{
+		{"module_id", moduleID},
+		{"type", string(l.LessonType())},
+		{"title", l.Title},
+		{"description", l.Description},
+		{"video_id", l.VideoID},
+		{"duration_seconds", strconv.Itoa(l.DurationSeconds)},
+		{"order_index", strconv.Itoa(l.OrderIndex)},
+		{"is_preview", strconv.FormatBool(l.IsPreview)},
+		{"release", l.Release.String()},
+	}
+}
+
+// diffFields compares two field lists with the same names in the same order.
+// A nil side stands for an entity that doesn't exist, so every field of the
+// other side is reported.
+func diffFields(from, to []field) []FieldChange {
+	var changes []FieldChange
+	switch {
+	case from == nil:
+		for _, f := range to {
+			changes = append(changes, FieldChange{Field: f.name, To: f.value})
+		}
+	case to == nil:
+		for _, f := range from {
+			changes = append(changes, FieldChange{Field: f.name, From: f.value})
+		}
+	default:
+		for i := range to {
+			if from[i].value != to[i].value {
+				changes = append(changes, FieldChange{Field: to[i].name, From: from[i].value, To: to[i].value})
+			}
+		}
+	}
+	return changes
+}
+
+// Copy deep-copies the content with fresh module and lesson IDs, as for a
+// new course. Lessons keep their video references. It also returns the new
+// ID of each lesson, keyed by the ID it was copied from.
+func (c *CourseContent) Copy(newID func() string, now time.Time) (*CourseContent, map[string]string) {
+	details := c.Details
+	details.Tags = slices.Clone(c.Details.Tags)
+
+	content := &CourseContent{Details: details}
+	lessonIDs := make(map[string]string)
+	for _, m := range c.Modules {
+		module := *m
+		module.ID = newID()
+		module.CreatedAt = now
+		module.Release = m.Release.Clone()
+		module.Lessons = nil
+		for _, l := range m.Lessons {
+			lesson := *l
+			lesson.ID = newID()
+			lesson.CreatedAt = now
+			lesson.Release = l.Release.Clone()
+			module.Lessons = append(module.Lessons, &lesson)
+			lessonIDs[l.ID] = lesson.ID
+		}
+		content.Modules = append(content.Modules, &module)
+	}
+
+	return content, lessonIDs
+}
+
+// LessonActivities are the quizzes and assignments set up on lessons, along
+// with the question banks the quizzes draw from.
+type LessonActivities struct {
+	Banks       []*QuestionBank
+	Questions   []*Question
+	Quizzes     []*Quiz
+	Assignments []*Assignment
+}
+
+// Copy moves the activities into courseID, onto the lessons they were copied
+// to as given by lessonIDs, with fresh bank and question IDs. Activities of
+// lessons that weren't copied, and quizzes whose bank is missing, are left
+// out.
+func (a *LessonActivities) Copy(courseID string, lessonIDs map[string]string, newID func() string, now time.Time) *LessonActivities {
+	activities := &LessonActivities{}
+
+	bankIDs := make(map[string]string, len(a.Banks))
+	for _, b := range a.Banks {
+		bank := *b
+		bank.ID = newID()
+		bank.CourseID = courseID
+		bank.CreatedAt = now
+		bankIDs[b.ID] = bank.ID
+		activities.Banks = append(activities.Banks, &bank)
+	}
+
+	for _, q := range a.Questions {
+		bankID, ok := bankIDs[q.BankID]
+		if !ok {
+			continue
+		}
+		question := *q
+		question.ID = newID()
+		question.BankID = bankID
+		question.Options = slices.Clone(q.Options)
+		question.CorrectOptions = slices.Clone(q.CorrectOptions)
+		question.AcceptedAnswers = slices.Clone(q.AcceptedAnswers)
+		question.CreatedAt = now
+		activities.Questions = append(activities.Questions, &question)
+	}
+
+	for _, q := range a.Quizzes {
+		lessonID, ok := lessonIDs[q.LessonID]
+		bankID, hasBank := bankIDs[q.BankID]
+		if !ok || !hasBank {
+			continue
+		}
+		quiz := *q
+		quiz.LessonID = lessonID
+		quiz.CourseID = courseID
+		quiz.BankID = bankID
+		quiz.UpdatedAt = now
+		activities.Quizzes = append(activities.Quizzes, &quiz)
+	}
+
+	for _, as := range a.Assignments {
+		lessonID, ok := lessonIDs[as.LessonID]
+		if !ok {
+			continue
+		}
+		assignment := *as
+		assignment.LessonID = lessonID
+		assignment.CourseID = courseID
+		assignment.Rubric = slices.Clone(as.Rubric)
+		assignment.UpdatedAt = now
+		activities.Assignments = append(activities.Assignments, &assignment)
+	}
+
+	return activities
+}
+
+func (c *CourseContent) LessonCount() int {
+	count := 0
+	for _, m := range c.Modules {
+		count += len(m.Lessons)
+	}
+	return count
+}
+
+// DurationMinutes is the content's running time rounded up to whole minutes,
+// as stored in Course.DurationMinutes.
+func (c *CourseContent) DurationMinutes() int {
+	seconds := 0
+	for _, m := range c.Modules {
+		for _, l := range m.Lessons {
+			seconds += l.DurationSeconds
+		}
+	}
+	return (seconds + 59) / 60
+}
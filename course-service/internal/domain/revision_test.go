@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func testContent() *CourseContent {
+	return &CourseContent{Modules: []*ModuleContent{
+		{ID: "m1", OrderIndex: 0, Lessons: []*LessonContent{{ID: "l1", OrderIndex: 0}, {ID: "l2", OrderIndex: 1}, {ID: "l3", OrderIndex: 2}}},
+		{ID: "m2", OrderIndex: 1, Lessons: []*LessonContent{{ID: "l4", OrderIndex: 0}}},
+		{ID: "m3", OrderIndex: 2},
+	}}
+}
+
+// moduleOrder and lessonOrder list IDs in order, failing the test when an
+// OrderIndex doesn't match its position.
+func moduleOrder(t *testing.T, c *CourseContent) []string {
+	t.Helper()
+	var ids []string
+	for i, m := range c.Modules {
+		if m.OrderIndex != i {
+			t.Errorf("module %s OrderIndex = %d, want %d", m.ID, m.OrderIndex, i)
+		}
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+func lessonOrder(t *testing.T, m *ModuleContent) []string {
+	t.Helper()
+	var ids []string
+	for i, l := range m.Lessons {
+		if l.OrderIndex != i {
+			t.Errorf("lesson %s OrderIndex = %d, want %d", l.ID, l.OrderIndex, i)
+		}
+		ids = append(ids, l.ID)
+	}
+	return ids
+}
+
+func TestCourseContentReorderModules(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []string
+		wantErr error
+		want    []string
+	}{
+		{name: "new order", ids: []string{"m3", "m1", "m2"}, want: []string{"m3", "m1", "m2"}},
+		{name: "same order", ids: []string{"m1", "m2", "m3"}, want: []string{"m1", "m2", "m3"}},
+		{name: "missing module", ids: []string{"m1", "m2"}, wantErr: ErrInvalidInput},
+		{name: "repeated module", ids: []string{"m1", "m1", "m2"}, wantErr: ErrInvalidInput},
+		{name: "unknown module", ids: []string{"m1", "m2", "m4"}, wantErr: ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := testContent()
+
+			err := content.ReorderModules(tt.ids)
+			if err != tt.wantErr {
+				t.Fatalf("ReorderModules() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				tt.want = []string{"m1", "m2", "m3"}
+			}
+			if got := moduleOrder(t, content); !slices.Equal(got, tt.want) {
+				t.Errorf("modules = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCourseContentMoveLesson(t *testing.T) {
+	tests := []struct {
+		name     string
+		lessonID string
+		target   string
+		position int
+		wantErr  error
+		want     map[string][]string
+	}{
+		{
+			name:     "earlier in the same module",
+			lessonID: "l3", target: "m1", position: 0,
+			want: map[string][]string{"m1": {"l3", "l1", "l2"}, "m2": {"l4"}},
+		},
+		{
+			name:     "later in the same module",
+			lessonID: "l1", target: "m1", position: 1,
+			want: map[string][]string{"m1": {"l2", "l1", "l3"}, "m2": {"l4"}},
+		},
+		{
+			name:     "into another module",
+			lessonID: "l2", target: "m2", position: 0,
+			want: map[string][]string{"m1": {"l1", "l3"}, "m2": {"l2", "l4"}},
+		},
+		{
+			name:     "past the end appends",
+			lessonID: "l1", target: "m2", position: 10,
+			want: map[string][]string{"m1": {"l2", "l3"}, "m2": {"l4", "l1"}},
+		},
+		{
+			name:     "into an empty module",
+			lessonID: "l4", target: "m3", position: 0,
+			want: map[string][]string{"m1": {"l1", "l2", "l3"}, "m2": nil, "m3": {"l4"}},
+		},
+		{
+			name:     "negative position",
+			lessonID: "l1", target: "m2", position: -1,
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:     "unknown target module",
+			lessonID: "l1", target: "m9", position: 0,
+			wantErr: ErrCourseNotFound,
+		},
+		{
+			name:     "unknown lesson",
+			lessonID: "l9", target: "m1", position: 0,
+			wantErr: ErrCourseNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := testContent()
+
+			err := content.MoveLesson(tt.lessonID, tt.target, tt.position)
+			if err != tt.wantErr {
+				t.Fatalf("MoveLesson() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				tt.want = map[string][]string{"m1": {"l1", "l2", "l3"}, "m2": {"l4"}}
+			}
+			for moduleID, want := range tt.want {
+				if got := lessonOrder(t, content.Module(moduleID)); !slices.Equal(got, want) {
+					t.Errorf("%s lessons = %v, want %v", moduleID, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCourseContentRemoveRenumbers(t *testing.T) {
+	t.Run("module", func(t *testing.T) {
+		content := testContent()
+
+		if !content.RemoveModule("m1") {
+			t.Fatal("RemoveModule() = false, want true")
+		}
+		if got, want := moduleOrder(t, content), []string{"m2", "m3"}; !slices.Equal(got, want) {
+			t.Errorf("modules = %v, want %v", got, want)
+		}
+		if content.RemoveModule("m1") {
+			t.Error("RemoveModule() of a removed module = true, want false")
+		}
+	})
+
+	t.Run("lesson", func(t *testing.T) {
+		content := testContent()
+		module := content.Module("m1")
+
+		if !module.RemoveLesson("l2") {
+			t.Fatal("RemoveLesson() = false, want true")
+		}
+		if got, want := lessonOrder(t, module), []string{"l1", "l3"}; !slices.Equal(got, want) {
+			t.Errorf("lessons = %v, want %v", got, want)
+		}
+		if module.RemoveLesson("l4") {
+			t.Error("RemoveLesson() of another module's lesson = true, want false")
+		}
+	})
+}
+
+func TestCourseContentCopy(t *testing.T) {
+	source := testContent()
+	source.Details = CourseDetails{Title: "Go", Tags: []string{"go"}}
+	source.Modules[0].Lessons[0].VideoID = "video-1"
+
+	next := 0
+	newID := func() string {
+		next++
+		return fmt.Sprintf("new-%d", next)
+	}
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	copied, lessonIDs := source.Copy(newID, now)
+
+	if got, want := moduleOrder(t, copied), []string{"new-1", "new-5", "new-7"}; !slices.Equal(got, want) {
+		t.Errorf("modules = %v, want %v", got, want)
+	}
+	if got, want := lessonOrder(t, copied.Modules[0]), []string{"new-2", "new-3", "new-4"}; !slices.Equal(got, want) {
+		t.Errorf("lessons = %v, want %v", got, want)
+	}
+	if want := map[string]string{"l1": "new-2", "l2": "new-3", "l3": "new-4", "l4": "new-6"}; !maps.Equal(lessonIDs, want) {
+		t.Errorf("lessonIDs = %v, want %v", lessonIDs, want)
+	}
+
+	lesson := copied.Modules[0].Lessons[0]
+	if lesson.VideoID != "video-1" {
+		t.Errorf("VideoID = %q, want the source's video-1", lesson.VideoID)
+	}
+	if !lesson.CreatedAt.Equal(now) || !copied.Modules[0].CreatedAt.Equal(now) {
+		t.Errorf("CreatedAt = %v, want %v", lesson.CreatedAt, now)
+	}
+
+	// Editing the copy leaves the source alone
+	copied.Details.Tags[0] = "rust"
+	lesson.Title = "Edited"
+	copied.Modules[0].Lessons = nil
+	if source.Details.Tags[0] != "go" || source.Modules[0].Lessons[0].Title != "" || len(source.Modules[0].Lessons) != 3 {
+		t.Error("editing the copy changed the source")
+	}
+}
+
+func TestCourseContentDurationMinutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		seconds []int
+		want    int
+	}{
+		{name: "no lessons", want: 0},
+		{name: "whole minutes", seconds: []int{60, 120}, want: 3},
+		{name: "rounds up", seconds: []int{61}, want: 2},
+		{name: "adds seconds before rounding", seconds: []int{30, 30, 1}, want: 2},
+		{name: "lessons without a duration", seconds: []int{0, 0}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Spread the lessons over two modules, as the total covers all of them
+			content := &CourseContent{Modules: []*ModuleContent{{ID: "m1"}, {ID: "m2"}}}
+			for i, seconds := range tt.seconds {
+				m := content.Modules[i%2]
+				m.Lessons = append(m.Lessons, &LessonContent{DurationSeconds: seconds})
+			}
+
+			if got := content.DurationMinutes(); got != tt.want {
+				t.Errorf("DurationMinutes() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
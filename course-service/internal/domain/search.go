@@ -0,0 +1,57 @@
+package domain
+
+// PriceBand buckets course prices for filtering and facet counts.
+type PriceBand string
+
+const (
+	PriceFree    PriceBand = "FREE"
+	PriceUnder20 PriceBand = "UNDER_20"
+	Price20To50  PriceBand = "20_TO_50"
+	Price50To100 PriceBand = "50_TO_100"
+	PriceOver100 PriceBand = "OVER_100"
+)
+
+// CourseQuery selects courses for listing and search. Text is matched
+// against title, description, tags and instructor name, tolerating typos in
+// the title. Category matches the category and all its subcategories.
+// Language matches courses whose default locale is in that language or whose
+// title has been translated into it.
+type CourseQuery struct {
+	Text      string
+	Category  *string
+	Language  *string
+	Status    *CourseStatus
+	Level     *CourseLevel
+	PriceBand *PriceBand
+	Page      int
+	PageSize  int
+}
+
+// SearchHit is one course matching a search. Rank is zero, and the
+// highlights empty, when no search text was given.
+type SearchHit struct {
+	Course         *Course
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+// SearchFacets count the matching courses per category, level and price
+// band. Each facet ignores its own filter, so every choice in it stays
+// visible after one has been picked.
+type SearchFacets struct {
+	Categories []FacetCount
+	Levels     []FacetCount
+	PriceBands []FacetCount
+}
+
+type SearchResult struct {
+	Hits   []*SearchHit
+	Total  int
+	Facets SearchFacets
+}
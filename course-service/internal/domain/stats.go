@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// EnrollmentStatus is the state of an enrollment as far as course-service
+// knows from enrollment-service's events. FAILED and CANCELLED are final, so
+// an event arriving late can't bring an enrollment back.
+type EnrollmentStatus string
+
+const (
+	EnrollmentActive    EnrollmentStatus = "ACTIVE"
+	EnrollmentFailed    EnrollmentStatus = "FAILED"
+	EnrollmentCancelled EnrollmentStatus = "CANCELLED"
+)
+
+// CourseEnrollment records one enrollment against a course. It's what
+// Course.EnrolledCount is counted from.
+type CourseEnrollment struct {
+	EnrollmentID string
+	CourseID     string
+	UserID       string
+	Status       EnrollmentStatus
+	UpdatedAt    time.Time
+}
+
+// Counts reports whether the enrollment counts towards EnrolledCount.
+func (e *CourseEnrollment) Counts() bool {
+	return e.Status == EnrollmentActive
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrTemplateNotFound = errors.New("course template not found")
+
+// CourseTemplate is an admin-curated starting point for new courses. It keeps
+// a snapshot of the source course's content, so later edits to that course
+// don't change the template.
+type CourseTemplate struct {
+	ID          string
+	Name        string
+	Description string
+	Content     CourseContent
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+func (t *CourseTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" || len(t.Name) > 255 {
+		return ErrInvalidInput
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Package download serves stored files over plain HTTP to holders of a
+// signed link, so clients can fetch attachments without a gRPC stream.
+package download
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/service"
+	"go.uber.org/zap"
+)
+
+type handler struct {
+	attachmentService service.AttachmentService
+	logger            *zap.Logger
+}
+
+// NewHandler serves the links issued by AttachmentService.GetDownloadURL.
+func NewHandler(attachmentService service.AttachmentService, logger *zap.Logger) http.Handler {
+	h := &handler{attachmentService: attachmentService, logger: logger}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /attachments/{id}", h.attachment)
+	return mux
+}
+
+func (h *handler) attachment(w http.ResponseWriter, r *http.Request) {
+	attachment, file, err := h.attachmentService.OpenDownload(r.Context(), r.PathValue("id"), r.URL.Query())
+	switch err {
+	case nil:
+	case domain.ErrInvalidDownloadURL:
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case domain.ErrAttachmentNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	default:
+		h.logger.Error("failed to open attachment", zap.String("attachment_id", r.PathValue("id")), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	contentType := attachment.MimeType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("ETag", strconv.Quote(attachment.Checksum))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	if _, err := io.Copy(w, file); err != nil {
+		h.logger.Warn("attachment download interrupted", zap.String("attachment_id", attachment.ID), zap.Error(err))
+	}
+}
@@ -1,25 +1,87 @@
 package grpc
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/dmehra2102/learning-platform/course-service/internal/archive"
 	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/course-service/internal/service"
 	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
 	pb "github.com/dmehra2102/learning-platform/shared/proto/course"
+	grpcLib "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const roleAdmin = "ADMIN"
+
 type CourseHandler struct {
 	pb.UnimplementedCourseServiceServer
-	service service.CourseService
+	service             service.CourseService
+	reviewService       service.ReviewService
+	pathService         service.PathService
+	pricingService      service.PricingService
+	bundleService       service.BundleService
+	templateService     service.TemplateService
+	transferService     service.TransferService
+	releaseService      service.ReleaseService
+	quizService         service.QuizService
+	assignmentService   service.AssignmentService
+	attachmentService   service.AttachmentService
+	collaboratorService service.CollaboratorService
+	statsService        service.StatsService
+	categoryService     service.CategoryService
+	translationService  service.TranslationService
+	announcementService service.AnnouncementService
+	discussionService   service.DiscussionService
 }
 
-func NewCourseHandler(service service.CourseService) *CourseHandler {
-	return &CourseHandler{service: service}
+func NewCourseHandler(
+	service service.CourseService,
+	reviewService service.ReviewService,
+	pathService service.PathService,
+	pricingService service.PricingService,
+	bundleService service.BundleService,
+	templateService service.TemplateService,
+	transferService service.TransferService,
+	releaseService service.ReleaseService,
+	quizService service.QuizService,
+	assignmentService service.AssignmentService,
+	attachmentService service.AttachmentService,
+	collaboratorService service.CollaboratorService,
+	statsService service.StatsService,
+	categoryService service.CategoryService,
+	translationService service.TranslationService,
+	announcementService service.AnnouncementService,
+	discussionService service.DiscussionService,
+) *CourseHandler {
+	return &CourseHandler{
+		service:             service,
+		reviewService:       reviewService,
+		pathService:         pathService,
+		pricingService:      pricingService,
+		bundleService:       bundleService,
+		templateService:     templateService,
+		transferService:     transferService,
+		releaseService:      releaseService,
+		quizService:         quizService,
+		assignmentService:   assignmentService,
+		attachmentService:   attachmentService,
+		collaboratorService: collaboratorService,
+		statsService:        statsService,
+		categoryService:     categoryService,
+		translationService:  translationService,
+		announcementService: announcementService,
+		discussionService:   discussionService,
+	}
 }
 
 func (h *CourseHandler) CreateCourse(ctx context.Context, req *pb.CreateCourseRequest) (*pb.CourseResponse, error) {
@@ -29,16 +91,20 @@ func (h *CourseHandler) CreateCourse(ctx context.Context, req *pb.CreateCourseRe
 	}
 
 	course, err := h.service.CreateCourse(ctx, instructorID, service.CreateCourseRequest{
-		Title:        req.Title,
-		Description:  req.Description,
-		ThumbnailURL: req.ThumbnailUrl,
-		Level:        levelFromProto(req.Level),
-		Price:        req.Price,
-		Category:     req.Category,
-		Tags:         req.Tags,
+		Title:         req.Title,
+		Description:   req.Description,
+		ThumbnailURL:  req.ThumbnailUrl,
+		Level:         levelFromProto(req.Level),
+		Price:         req.Price,
+		Category:      req.Category,
+		Tags:          req.Tags,
+		DefaultLocale: req.DefaultLocale,
 	})
 
 	if err != nil {
+		if err == domain.ErrUnknownCategory || err == domain.ErrInvalidLocale {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -54,6 +120,10 @@ func (h *CourseHandler) GetCourse(ctx context.Context, req *pb.GetCourseRequest)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := h.translationService.LocalizeCourses(ctx, []*domain.Course{course}, requestLocales(ctx)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &pb.CourseResponse{Course: courseToProto(course)}, nil
 }
 
@@ -85,9 +155,18 @@ func (h *CourseHandler) UpdateCourse(ctx context.Context, req *pb.UpdateCourseRe
 		if err == domain.ErrUnauthorized {
 			return nil, status.Error(codes.PermissionDenied, "unauthorized")
 		}
+		if err == domain.ErrUnknownCategory {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "course not found")
 		}
+		if err == domain.ErrRevisionConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -128,28 +207,62 @@ func (h *CourseHandler) ListCourses(ctx context.Context, req *pb.ListCoursesRequ
 		PageSize: pageSize,
 		Category: req.Category,
 		Search:   req.Search,
+		Language: req.Language,
 	}
 
 	if req.Level != nil {
 		level := levelFromProto(*req.Level)
 		filter.Level = &level
 	}
+	if req.PriceBand != nil {
+		band := priceBandFromProto(*req.PriceBand)
+		filter.PriceBand = &band
+	}
 
-	courses, total, err := h.service.ListCourses(ctx, filter)
+	result, err := h.service.ListCourses(ctx, filter)
 	if err != nil {
+		if err == domain.ErrInvalidLocale {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	pbCourses := make([]*pb.Course, len(courses))
-	for i, course := range courses {
-		pbCourses[i] = courseToProto(course)
+	courses := make([]*domain.Course, len(result.Hits))
+	for i, hit := range result.Hits {
+		courses[i] = hit.Course
+	}
+	if err := h.translationService.LocalizeCourses(ctx, courses, requestLocales(ctx)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCourses := make([]*pb.Course, len(result.Hits))
+	pbHits := make([]*pb.CourseSearchHit, len(result.Hits))
+	for i, hit := range result.Hits {
+		pbCourses[i] = courseToProto(hit.Course)
+		pbHits[i] = &pb.CourseSearchHit{
+			CourseId:       hit.Course.ID,
+			Rank:           hit.Rank,
+			TitleHighlight: hit.TitleHighlight,
+			Snippet:        hit.Snippet,
+		}
+		// Highlights are of the default locale's text
+		if hit.Course.Locale != hit.Course.DefaultLocale {
+			pbHits[i].TitleHighlight = hit.Course.Title
+			pbHits[i].Snippet = ""
+		}
 	}
 
 	return &pb.ListCoursesResponse{
 		Courses:  pbCourses,
-		Total:    int32(total),
+		Total:    int32(result.Total),
 		Page:     req.Page,
 		PageSize: req.PageSize,
+		Hits:     pbHits,
+		Facets: &pb.SearchFacets{
+			Categories: facetCountsToProto(result.Facets.Categories),
+			Levels:     facetCountsToProto(result.Facets.Levels),
+			PriceBands: facetCountsToProto(result.Facets.PriceBands),
+		},
 	}, nil
 }
 
@@ -159,7 +272,7 @@ func (h *CourseHandler) PublishCourse(ctx context.Context, req *pb.PublishCourse
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	course, err := h.service.PublishCourse(ctx, req.Id, instructorID)
+	course, submission, err := h.reviewService.SubmitCourse(ctx, req.Id, instructorID)
 	if err != nil {
 		if err == domain.ErrUnauthorized {
 			return nil, status.Error(codes.PermissionDenied, "unauthorized")
@@ -167,10 +280,23 @@ func (h *CourseHandler) PublishCourse(ctx context.Context, req *pb.PublishCourse
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "course not found")
 		}
+		if err == domain.ErrDraftNotFound {
+			return nil, status.Error(codes.FailedPrecondition, "course has no changes to publish")
+		}
+		if err == domain.ErrAlreadySubmitted {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		var checkErr *domain.CheckError
+		if errors.As(err, &checkErr) {
+			return nil, status.Error(codes.FailedPrecondition, checkErr.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+	return &pb.CourseResponse{
+		Course:     courseToProto(course),
+		Submission: submissionToProto(submission),
+	}, nil
 }
 
 func (h *CourseHandler) GetCoursesByInstructor(ctx context.Context, req *pb.GetCoursesByInstructorRequest) (*pb.ListCoursesResponse, error) {
@@ -189,6 +315,10 @@ func (h *CourseHandler) GetCoursesByInstructor(ctx context.Context, req *pb.GetC
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := h.translationService.LocalizeCourses(ctx, courses, requestLocales(ctx)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	pbCourses := make([]*pb.Course, len(courses))
 	for i, course := range courses {
 		pbCourses[i] = courseToProto(course)
@@ -220,6 +350,12 @@ func (h *CourseHandler) AddModule(ctx context.Context, req *pb.AddModuleRequest)
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "course not found")
 		}
+		if err == domain.ErrRevisionConflict || err == domain.ErrOrderConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -240,6 +376,12 @@ func (h *CourseHandler) UpdateModule(ctx context.Context, req *pb.UpdateModuleRe
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "not found")
 		}
+		if err == domain.ErrRevisionConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -259,6 +401,12 @@ func (h *CourseHandler) DeleteModule(ctx context.Context, req *pb.DeleteModuleRe
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "not found")
 		}
+		if err == domain.ErrRevisionConflict || err == domain.ErrOrderConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -266,11 +414,56 @@ func (h *CourseHandler) DeleteModule(ctx context.Context, req *pb.DeleteModuleRe
 }
 
 func (h *CourseHandler) GetModules(ctx context.Context, req *pb.GetModulesRequest) (*pb.ListModulesResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
 	modules, err := h.service.GetModules(ctx, req.CourseId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	availability, err := h.releaseService.CourseAvailability(ctx, req.CourseId, userID)
+	if err != nil {
+		return nil, releaseErrorToStatus(err)
+	}
+
+	pbModules := make([]*pb.Module, len(modules))
+	for i, module := range modules {
+		pbModules[i] = moduleToProto(module)
+		pbModules[i].Locked, pbModules[i].UnlockAt = lockToProto(availability[module.ID])
+	}
+
+	return &pb.ListModulesResponse{Modules: pbModules}, nil
+}
+
+func (h *CourseHandler) ReorderModules(ctx context.Context, req *pb.ReorderModulesRequest) (*pb.ListModulesResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	modules, err := h.service.ReorderModules(ctx, req.CourseId, instructorID, req.ModuleIds)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		if err == domain.ErrInvalidInput {
+			return nil, status.Error(codes.InvalidArgument, "module_ids must list every module of the course exactly once")
+		}
+		if err == domain.ErrRevisionConflict || err == domain.ErrOrderConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	pbModules := make([]*pb.Module, len(modules))
 	for i, module := range modules {
 		pbModules[i] = moduleToProto(module)
@@ -286,6 +479,7 @@ func (h *CourseHandler) AddLesson(ctx context.Context, req *pb.AddLessonRequest)
 	}
 
 	lesson, err := h.service.AddLesson(ctx, req.ModuleId, req.CourseId, instructorID, service.AddLessonRequest{
+		Type:            lessonTypeFromProto(req.Type),
 		Title:           req.Title,
 		Description:     req.Description,
 		VideoID:         req.VideoId,
@@ -300,6 +494,15 @@ func (h *CourseHandler) AddLesson(ctx context.Context, req *pb.AddLessonRequest)
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "not found")
 		}
+		if err == domain.ErrRevisionConflict || err == domain.ErrOrderConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if err == domain.ErrInvalidInput {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -312,11 +515,18 @@ func (h *CourseHandler) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRe
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	lesson, err := h.service.UpdateLesson(ctx, req.Id, req.ModuleId, req.CourseId, instructorID, service.UpdateLessonRequest{
-		Title:           req.Title,
-		Description:     req.Description,
-		IsPreview:       req.IsPreview,
-	})
+	updateReq := service.UpdateLessonRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		IsPreview:   req.IsPreview,
+	}
+
+	if req.DurationSeconds != nil {
+		duration := int(*req.DurationSeconds)
+		updateReq.DurationSeconds = &duration
+	}
+
+	lesson, err := h.service.UpdateLesson(ctx, req.Id, req.ModuleId, req.CourseId, instructorID, updateReq)
 
 	if err != nil {
 		if err == domain.ErrUnauthorized {
@@ -325,6 +535,12 @@ func (h *CourseHandler) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRe
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "not found")
 		}
+		if err == domain.ErrRevisionConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -344,6 +560,12 @@ func (h *CourseHandler) DeleteLesson(ctx context.Context, req *pb.DeleteLessonRe
 		if err == domain.ErrCourseNotFound {
 			return nil, status.Error(codes.NotFound, "not found")
 		}
+		if err == domain.ErrRevisionConflict || err == domain.ErrOrderConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -351,93 +573,2938 @@ func (h *CourseHandler) DeleteLesson(ctx context.Context, req *pb.DeleteLessonRe
 }
 
 func (h *CourseHandler) GetLessons(ctx context.Context, req *pb.GetLessonsRequest) (*pb.ListLessonsResponse, error) {
-	lessons, err := h.service.GetLessons(ctx, req.ModuleId)
+	userID, err := interceptor.GetUserID(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	lessons, availability, err := h.releaseService.GetModuleLessons(ctx, req.ModuleId, userID)
+	if err != nil {
+		return nil, releaseErrorToStatus(err)
 	}
 
 	pbLessons := make([]*pb.Lesson, len(lessons))
 	for i, lesson := range lessons {
 		pbLessons[i] = lessonToProto(lesson)
+		pbLessons[i].Locked, pbLessons[i].UnlockAt = lockToProto(availability[lesson.ID])
 	}
 
 	return &pb.ListLessonsResponse{Lessons: pbLessons}, nil
 }
 
-func courseToProto(course *domain.Course) *pb.Course {
-	return &pb.Course{
-		Id:              course.ID,
-		Title:           course.Title,
-		Description:     course.Description,
-		InstructorId:    course.InstructorID,
-		ThumbnailUrl:    course.ThumbnailURL,
-		Status:          statusToProto(course.Status),
-		Level:           levelToProto(course.Level),
-		Price:           course.Price,
-		Category:        course.Category,
-		Tags:            course.Tags,
-		DurationMinutes: int32(course.DurationMinutes),
-		CreatedAt:       timestamppb.New(course.CreatedAt),
-		UpdatedAt:       timestamppb.New(course.UpdatedAt),
-		EnrolledCount:   int32(course.EnrolledCount),
-		AverageRating:   course.AverageRating,
+func (h *CourseHandler) MoveLesson(ctx context.Context, req *pb.MoveLessonRequest) (*pb.LessonResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	lesson, err := h.service.MoveLesson(ctx, req.LessonId, req.TargetModuleId, req.CourseId, instructorID, int(req.Position))
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		if err == domain.ErrInvalidInput {
+			return nil, status.Error(codes.InvalidArgument, "position cannot be negative")
+		}
+		if err == domain.ErrRevisionConflict || err == domain.ErrOrderConflict {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.LessonResponse{Lesson: lessonToProto(lesson)}, nil
+}
+
+func (h *CourseHandler) GetCourseDraft(ctx context.Context, req *pb.GetCourseDraftRequest) (*pb.CourseContentResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, content, err := h.service.GetCourseDraft(ctx, req.CourseId, instructorID)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound || err == domain.ErrDraftNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	return &pb.CourseContentResponse{
+		Course:  courseToProto(course),
+		Modules: contentModulesToProto(course.ID, content, nil),
+	}, nil
 }
 
-func moduleToProto(module *domain.Module) *pb.Module {
-	return &pb.Module{
-		Id:          module.ID,
-		CourseId:    module.CourseID,
-		Title:       module.Title,
-		Description: module.Description,
-		OrderIndex:  int32(module.OrderIndex),
-		CreatedAt:   timestamppb.New(module.CreatedAt),
+func (h *CourseHandler) DiscardCourseDraft(ctx context.Context, req *pb.DiscardCourseDraftRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.service.DiscardCourseDraft(ctx, req.CourseId, instructorID); err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound || err == domain.ErrDraftNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err == domain.ErrCourseUnderReview {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	return &emptypb.Empty{}, nil
 }
 
-func lessonToProto(lesson *domain.Lesson) *pb.Lesson {
-	return &pb.Lesson{
-		Id:              lesson.ID,
-		ModuleId:        lesson.ModuleID,
-		Title:           lesson.Title,
-		Description:     lesson.Description,
-		VideoId:         lesson.VideoID,
-		DurationSeconds: int32(lesson.DurationSeconds),
-		OrderIndex:      int32(lesson.OrderIndex),
-		IsPreview:       lesson.IsPreview,
-		CreatedAt:       timestamppb.New(lesson.CreatedAt),
+func (h *CourseHandler) ListCourseRevisions(ctx context.Context, req *pb.ListCourseRevisionsRequest) (*pb.ListCourseRevisionsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	revisions, err := h.service.ListCourseRevisions(ctx, req.CourseId, instructorID)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbRevisions := make([]*pb.CourseRevision, len(revisions))
+	for i, revision := range revisions {
+		pbRevisions[i] = revisionToProto(revision)
 	}
+
+	return &pb.ListCourseRevisionsResponse{Revisions: pbRevisions}, nil
 }
 
-func statusToProto(status domain.CourseStatus) pb.CourseStatus {
-	switch status {
-	case domain.StatusPublished:
-		return pb.CourseStatus_PUBLISHED
-	case domain.StatusArchived:
-		return pb.CourseStatus_ARCHIVED
-	default:
-		return pb.CourseStatus_DRAFT
+func (h *CourseHandler) DiffCourseRevisions(ctx context.Context, req *pb.DiffCourseRevisionsRequest) (*pb.DiffCourseRevisionsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	var from, to *int
+	if req.FromRevision != nil {
+		n := int(*req.FromRevision)
+		from = &n
+	}
+	if req.ToRevision != nil {
+		n := int(*req.ToRevision)
+		to = &n
+	}
+
+	changes, err := h.service.DiffCourseRevisions(ctx, req.CourseId, instructorID, from, to)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound || err == domain.ErrRevisionNotFound || err == domain.ErrDraftNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbChanges := make([]*pb.ContentChange, len(changes))
+	for i, change := range changes {
+		pbChanges[i] = contentChangeToProto(change)
 	}
+
+	return &pb.DiffCourseRevisionsResponse{Changes: pbChanges}, nil
 }
 
-func levelToProto(level domain.CourseLevel) pb.CourseLevel {
-	switch level {
-	case domain.LevelIntermediate:
-		return pb.CourseLevel_INTERMEDIATE
-	case domain.LevelAdvanced:
-		return pb.CourseLevel_ADVANCED
-	default:
-		return pb.CourseLevel_BEGINNER
+func (h *CourseHandler) RollbackCourse(ctx context.Context, req *pb.RollbackCourseRequest) (*pb.CourseResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, err := h.reviewService.RollbackCourse(ctx, req.CourseId, instructorID, int(req.Revision))
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrInvalidInput {
+			return nil, status.Error(codes.InvalidArgument, "revision must be positive")
+		}
+		if err == domain.ErrCourseNotFound || err == domain.ErrRevisionNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err == domain.ErrAlreadySubmitted {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		var checkErr *domain.CheckError
+		if errors.As(err, &checkErr) {
+			return nil, status.Error(codes.FailedPrecondition, checkErr.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
 }
 
-func levelFromProto(level pb.CourseLevel) domain.CourseLevel {
-	switch level {
-	case pb.CourseLevel_ADVANCED:
-		return domain.LevelAdvanced
-	case pb.CourseLevel_INTERMEDIATE:
-		return domain.LevelIntermediate
-	default:
-		return domain.LevelBeginner
+func (h *CourseHandler) ScheduleCourse(ctx context.Context, req *pb.ScheduleCourseRequest) (*pb.CourseResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	var publishAt, archiveAt *time.Time
+	if req.PublishAt != nil {
+		t := req.PublishAt.AsTime()
+		publishAt = &t
+	}
+	if req.ArchiveAt != nil {
+		t := req.ArchiveAt.AsTime()
+		archiveAt = &t
+	}
+
+	course, err := h.service.ScheduleCourse(ctx, req.CourseId, instructorID, publishAt, archiveAt)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		if err == domain.ErrInvalidInput {
+			return nil, status.Error(codes.InvalidArgument, "archive time must be in the future and after the publish time")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+}
+
+func (h *CourseHandler) ArchiveCourse(ctx context.Context, req *pb.ArchiveCourseRequest) (*pb.CourseResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, err := h.service.ArchiveCourse(ctx, req.CourseId, instructorID)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		if err == domain.ErrNotPublished {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+}
+
+func (h *CourseHandler) ListCourseSubmissions(ctx context.Context, req *pb.ListCourseSubmissionsRequest) (*pb.ListSubmissionsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	submissions, err := h.reviewService.ListCourseSubmissions(ctx, req.CourseId, instructorID)
+	if err != nil {
+		if err == domain.ErrUnauthorized {
+			return nil, status.Error(codes.PermissionDenied, "unauthorized")
+		}
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return submissionsToProto(submissions, len(submissions)), nil
+}
+
+func (h *CourseHandler) ListReviewQueue(ctx context.Context, req *pb.ListReviewQueueRequest) (*pb.ListSubmissionsResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	submissions, total, err := h.reviewService.ListReviewQueue(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return submissionsToProto(submissions, total), nil
+}
+
+func (h *CourseHandler) StartCourseReview(ctx context.Context, req *pb.StartCourseReviewRequest) (*pb.SubmissionResponse, error) {
+	reviewerID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := h.reviewService.StartReview(ctx, req.SubmissionId, reviewerID)
+	if err != nil {
+		return nil, reviewErrorToStatus(err)
+	}
+
+	return &pb.SubmissionResponse{Submission: submissionToProto(submission)}, nil
+}
+
+func (h *CourseHandler) ApproveCourse(ctx context.Context, req *pb.ReviewDecisionRequest) (*pb.SubmissionResponse, error) {
+	reviewerID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := h.reviewService.ApproveCourse(ctx, req.SubmissionId, reviewerID, req.Comment)
+	if err != nil {
+		return nil, reviewErrorToStatus(err)
+	}
+
+	return &pb.SubmissionResponse{Submission: submissionToProto(submission)}, nil
+}
+
+func (h *CourseHandler) RejectCourse(ctx context.Context, req *pb.ReviewDecisionRequest) (*pb.SubmissionResponse, error) {
+	reviewerID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := h.reviewService.RejectCourse(ctx, req.SubmissionId, reviewerID, req.Comment)
+	if err != nil {
+		return nil, reviewErrorToStatus(err)
+	}
+
+	return &pb.SubmissionResponse{Submission: submissionToProto(submission)}, nil
+}
+
+func (h *CourseHandler) AddCoursePrerequisite(ctx context.Context, req *pb.CoursePrerequisiteRequest) (*pb.ListCoursePrerequisitesResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	prerequisites, err := h.pathService.AddPrerequisite(ctx, req.CourseId, req.RequiredCourseId, instructorID)
+	if err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return prerequisitesToProto(prerequisites), nil
+}
+
+func (h *CourseHandler) RemoveCoursePrerequisite(ctx context.Context, req *pb.CoursePrerequisiteRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.pathService.RemovePrerequisite(ctx, req.CourseId, req.RequiredCourseId, instructorID); err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) ListCoursePrerequisites(ctx context.Context, req *pb.ListCoursePrerequisitesRequest) (*pb.ListCoursePrerequisitesResponse, error) {
+	prerequisites, err := h.pathService.ListPrerequisites(ctx, req.CourseId)
+	if err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return prerequisitesToProto(prerequisites), nil
+}
+
+func (h *CourseHandler) CreateLearningPath(ctx context.Context, req *pb.CreateLearningPathRequest) (*pb.LearningPathResponse, error) {
+	creatorID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := h.pathService.CreateLearningPath(ctx, creatorID, service.LearningPathRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		CourseIDs:   req.CourseIds,
+	})
+	if err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return &pb.LearningPathResponse{Path: learningPathToProto(path)}, nil
+}
+
+func (h *CourseHandler) UpdateLearningPath(ctx context.Context, req *pb.UpdateLearningPathRequest) (*pb.LearningPathResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	path, err := h.pathService.UpdateLearningPath(ctx, req.Id, service.LearningPathRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		CourseIDs:   req.CourseIds,
+	})
+	if err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return &pb.LearningPathResponse{Path: learningPathToProto(path)}, nil
+}
+
+func (h *CourseHandler) GetLearningPath(ctx context.Context, req *pb.GetLearningPathRequest) (*pb.LearningPathResponse, error) {
+	path, err := h.pathService.GetLearningPath(ctx, req.Id)
+	if err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return &pb.LearningPathResponse{Path: learningPathToProto(path)}, nil
+}
+
+func (h *CourseHandler) DeleteLearningPath(ctx context.Context, req *pb.DeleteLearningPathRequest) (*emptypb.Empty, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.pathService.DeleteLearningPath(ctx, req.Id); err != nil {
+		return nil, pathErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) ListLearningPaths(ctx context.Context, req *pb.ListLearningPathsRequest) (*pb.ListLearningPathsResponse, error) {
+	paths, total, err := h.pathService.ListLearningPaths(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPaths := make([]*pb.LearningPath, len(paths))
+	for i, path := range paths {
+		pbPaths[i] = learningPathToProto(path)
+	}
+
+	return &pb.ListLearningPathsResponse{Paths: pbPaths, Total: int32(total)}, nil
+}
+
+func pathErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrPrerequisiteNotFound, domain.ErrLearningPathNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "learning path needs a title and distinct courses")
+	case domain.ErrPrerequisiteCycle, domain.ErrPathCourseUnavailable, domain.ErrPathOrder:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *CourseHandler) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	caller, err := pricingCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	couponReq := service.CouponRequest{
+		Code:     req.Code,
+		Discount: discountFromProto(req.DiscountType, req.DiscountValue),
+		CourseID: req.CourseId,
+		MaxUses:  int(req.MaxUses),
+	}
+	if req.StartsAt != nil {
+		startsAt := req.StartsAt.AsTime()
+		couponReq.StartsAt = &startsAt
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		couponReq.ExpiresAt = &expiresAt
+	}
+
+	coupon, err := h.pricingService.CreateCoupon(ctx, caller, couponReq)
+	if err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &pb.CouponResponse{Coupon: couponToProto(coupon)}, nil
+}
+
+func (h *CourseHandler) ListCoupons(ctx context.Context, req *pb.ListCouponsRequest) (*pb.ListCouponsResponse, error) {
+	caller, err := pricingCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coupons, err := h.pricingService.ListCoupons(ctx, caller, req.CourseId)
+	if err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	pbCoupons := make([]*pb.Coupon, len(coupons))
+	for i, coupon := range coupons {
+		pbCoupons[i] = couponToProto(coupon)
+	}
+
+	return &pb.ListCouponsResponse{Coupons: pbCoupons}, nil
+}
+
+func (h *CourseHandler) DeleteCoupon(ctx context.Context, req *pb.DeleteCouponRequest) (*emptypb.Empty, error) {
+	caller, err := pricingCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.pricingService.DeleteCoupon(ctx, caller, req.Id); err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) CreateSale(ctx context.Context, req *pb.CreateSaleRequest) (*pb.SaleResponse, error) {
+	caller, err := pricingCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartsAt == nil || req.EndsAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "sale needs a start and an end")
+	}
+
+	sale, err := h.pricingService.CreateSale(ctx, caller, service.SaleRequest{
+		Name:     req.Name,
+		CourseID: req.CourseId,
+		Discount: discountFromProto(req.DiscountType, req.DiscountValue),
+		StartsAt: req.StartsAt.AsTime(),
+		EndsAt:   req.EndsAt.AsTime(),
+	})
+	if err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &pb.SaleResponse{Sale: saleToProto(sale)}, nil
+}
+
+func (h *CourseHandler) ListSales(ctx context.Context, req *pb.ListSalesRequest) (*pb.ListSalesResponse, error) {
+	caller, err := pricingCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := h.pricingService.ListSales(ctx, caller, req.CourseId)
+	if err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	pbSales := make([]*pb.Sale, len(sales))
+	for i, sale := range sales {
+		pbSales[i] = saleToProto(sale)
+	}
+
+	return &pb.ListSalesResponse{Sales: pbSales}, nil
+}
+
+func (h *CourseHandler) DeleteSale(ctx context.Context, req *pb.DeleteSaleRequest) (*emptypb.Empty, error) {
+	caller, err := pricingCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.pricingService.DeleteSale(ctx, caller, req.Id); err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) QuotePrice(ctx context.Context, req *pb.QuotePriceRequest) (*pb.PriceQuoteResponse, error) {
+	quote, err := h.pricingService.QuotePrice(ctx, req.CourseId, req.CouponCode)
+	if err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &pb.PriceQuoteResponse{Quote: priceQuoteToProto(quote)}, nil
+}
+
+func (h *CourseHandler) RedeemCoupon(ctx context.Context, req *pb.RedeemCouponRequest) (*pb.PriceQuoteResponse, error) {
+	userID, err := requireService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := h.pricingService.RedeemCoupon(ctx, req.CourseId, req.CouponCode, userID, req.EnrollmentId)
+	if err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &pb.PriceQuoteResponse{Quote: priceQuoteToProto(quote)}, nil
+}
+
+func (h *CourseHandler) ReleaseCoupon(ctx context.Context, req *pb.ReleaseCouponRequest) (*emptypb.Empty, error) {
+	userID, err := requireService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.pricingService.ReleaseCoupon(ctx, req.EnrollmentId, userID); err != nil {
+		return nil, pricingErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) CreateBundle(ctx context.Context, req *pb.CreateBundleRequest) (*pb.BundleResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	bundle, err := h.bundleService.CreateBundle(ctx, instructorID, service.BundleRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		CourseIDs:   req.CourseIds,
+		Price:       req.Price,
+	})
+	if err != nil {
+		return nil, bundleErrorToStatus(err)
+	}
+
+	return &pb.BundleResponse{Bundle: bundleToProto(bundle)}, nil
+}
+
+func (h *CourseHandler) UpdateBundle(ctx context.Context, req *pb.UpdateBundleRequest) (*pb.BundleResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	bundle, err := h.bundleService.UpdateBundle(ctx, req.Id, instructorID, service.BundleRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		CourseIDs:   req.CourseIds,
+		Price:       req.Price,
+	})
+	if err != nil {
+		return nil, bundleErrorToStatus(err)
+	}
+
+	return &pb.BundleResponse{Bundle: bundleToProto(bundle)}, nil
+}
+
+func (h *CourseHandler) DeleteBundle(ctx context.Context, req *pb.DeleteBundleRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.bundleService.DeleteBundle(ctx, req.Id, instructorID); err != nil {
+		return nil, bundleErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) GetBundle(ctx context.Context, req *pb.GetBundleRequest) (*pb.BundleResponse, error) {
+	bundle, err := h.bundleService.GetBundle(ctx, req.Id)
+	if err != nil {
+		return nil, bundleErrorToStatus(err)
+	}
+
+	return &pb.BundleResponse{Bundle: bundleToProto(bundle)}, nil
+}
+
+func (h *CourseHandler) ListBundles(ctx context.Context, req *pb.ListBundlesRequest) (*pb.ListBundlesResponse, error) {
+	bundles, total, err := h.bundleService.ListBundles(ctx, req.InstructorId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbBundles := make([]*pb.Bundle, len(bundles))
+	for i, bundle := range bundles {
+		pbBundles[i] = bundleToProto(bundle)
+	}
+
+	return &pb.ListBundlesResponse{Bundles: pbBundles, Total: int32(total)}, nil
+}
+
+func (h *CourseHandler) QuoteBundle(ctx context.Context, req *pb.QuoteBundleRequest) (*pb.BundleQuoteResponse, error) {
+	quote, err := h.bundleService.QuoteBundle(ctx, req.BundleId)
+	if err != nil {
+		return nil, bundleErrorToStatus(err)
+	}
+
+	courses := make([]*pb.Course, len(quote.Courses))
+	for i, course := range quote.Courses {
+		courses[i] = courseToProto(course)
+	}
+
+	return &pb.BundleQuoteResponse{
+		Bundle:    bundleToProto(quote.Bundle),
+		Courses:   courses,
+		ListPrice: quote.ListPrice,
+		Savings:   quote.Savings,
+	}, nil
+}
+
+func bundleErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrBundleNotFound, domain.ErrCourseNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "bundles can only contain your own courses")
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "bundle needs a title, a price and at least two distinct courses")
+	case domain.ErrCourseNotForSale:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *CourseHandler) CloneCourse(ctx context.Context, req *pb.CloneCourseRequest) (*pb.CourseResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, err := h.service.CloneCourse(ctx, req.CourseId, instructorID, req.Title)
+	if err != nil {
+		return nil, templateErrorToStatus(err)
+	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+}
+
+func (h *CourseHandler) CreateCourseTemplate(ctx context.Context, req *pb.CreateCourseTemplateRequest) (*pb.CourseTemplateResponse, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := h.templateService.CreateTemplate(ctx, adminID, service.CreateTemplateRequest{
+		CourseID:    req.CourseId,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, templateErrorToStatus(err)
+	}
+
+	return templateResponseToProto(template), nil
+}
+
+func (h *CourseHandler) GetCourseTemplate(ctx context.Context, req *pb.GetCourseTemplateRequest) (*pb.CourseTemplateResponse, error) {
+	template, err := h.templateService.GetTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, templateErrorToStatus(err)
+	}
+
+	return templateResponseToProto(template), nil
+}
+
+func (h *CourseHandler) ListCourseTemplates(ctx context.Context, req *pb.ListCourseTemplatesRequest) (*pb.ListCourseTemplatesResponse, error) {
+	templates, total, err := h.templateService.ListTemplates(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbTemplates := make([]*pb.CourseTemplate, len(templates))
+	for i, template := range templates {
+		pbTemplates[i] = templateToProto(template)
+	}
+
+	return &pb.ListCourseTemplatesResponse{Templates: pbTemplates, Total: int32(total)}, nil
+}
+
+func (h *CourseHandler) DeleteCourseTemplate(ctx context.Context, req *pb.DeleteCourseTemplateRequest) (*emptypb.Empty, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.templateService.DeleteTemplate(ctx, req.Id); err != nil {
+		return nil, templateErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) CreateCourseFromTemplate(ctx context.Context, req *pb.CreateCourseFromTemplateRequest) (*pb.CourseResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, err := h.templateService.CreateCourseFromTemplate(ctx, req.TemplateId, instructorID, req.Title)
+	if err != nil {
+		return nil, templateErrorToStatus(err)
+	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+}
+
+// streamChunkSize is how much file data each streamed message carries.
+const streamChunkSize = 64 << 10
+
+func (h *CourseHandler) ExportCourse(req *pb.ExportCourseRequest, stream grpcLib.ServerStreamingServer[pb.ExportCourseChunk]) error {
+	ctx := stream.Context()
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	w := bufio.NewWriterSize(chunkWriter{send: func(p []byte) error {
+		return stream.Send(&pb.ExportCourseChunk{Data: p})
+	}}, streamChunkSize)
+	if err := h.transferService.ExportCourse(ctx, req.CourseId, instructorID, w); err != nil {
+		return transferErrorToStatus(err)
+	}
+	if err := w.Flush(); err != nil {
+		return transferErrorToStatus(err)
+	}
+
+	return nil
+}
+
+func (h *CourseHandler) ImportCourse(stream grpcLib.ClientStreamingServer[pb.ImportCourseRequest, pb.CourseResponse]) error {
+	ctx := stream.Context()
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "import metadata is required")
+	}
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry the import metadata")
+	}
+
+	var data []byte
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data = append(data, msg.GetChunk()...)
+		if len(data) > archive.MaxSize {
+			return transferErrorToStatus(domain.ErrArchiveTooLarge)
+		}
+	}
+
+	importReq := service.ImportRequest{
+		Format:      archiveFormatFromProto(metadata.Format),
+		Title:       metadata.Title,
+		Description: metadata.Description,
+		Category:    metadata.Category,
+	}
+	if metadata.Level != nil {
+		level := levelFromProto(*metadata.Level)
+		importReq.Level = &level
+	}
+
+	course, err := h.transferService.ImportCourse(ctx, instructorID, importReq, data)
+	if err != nil {
+		return transferErrorToStatus(err)
+	}
+
+	return stream.SendAndClose(&pb.CourseResponse{Course: courseToProto(course)})
+}
+
+// chunkWriter sends everything written to it as stream messages.
+type chunkWriter struct {
+	send func(p []byte) error
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w.send(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// chunkReader reads the chunks of a client stream as one file. recv returns
+// io.EOF once the client is done.
+type chunkReader struct {
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (h *CourseHandler) SetModuleRelease(ctx context.Context, req *pb.SetModuleReleaseRequest) (*pb.ModuleResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	module, err := h.service.SetModuleRelease(ctx, req.ModuleId, req.CourseId, instructorID, releaseRuleFromProto(req.Release))
+	if err != nil {
+		return nil, releaseErrorToStatus(err)
+	}
+
+	return &pb.ModuleResponse{Module: moduleToProto(module)}, nil
+}
+
+func (h *CourseHandler) SetLessonRelease(ctx context.Context, req *pb.SetLessonReleaseRequest) (*pb.LessonResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	lesson, err := h.service.SetLessonRelease(ctx, req.LessonId, req.ModuleId, req.CourseId, instructorID, releaseRuleFromProto(req.Release))
+	if err != nil {
+		return nil, releaseErrorToStatus(err)
+	}
+
+	return &pb.LessonResponse{Lesson: lessonToProto(lesson)}, nil
+}
+
+func (h *CourseHandler) GetCourseContent(ctx context.Context, req *pb.GetCourseContentRequest) (*pb.CourseContentResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, content, availability, err := h.releaseService.GetCourseContent(ctx, req.CourseId, userID)
+	if err != nil {
+		return nil, releaseErrorToStatus(err)
+	}
+
+	if err := h.translationService.LocalizeContent(ctx, course, content, requestLocales(ctx)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CourseContentResponse{
+		Course:  courseToProto(course),
+		Modules: contentModulesToProto(course.ID, content, availability),
+	}, nil
+}
+
+func (h *CourseHandler) AuthorizeVideoStream(ctx context.Context, req *pb.AuthorizeVideoStreamRequest) (*pb.VideoStreamAuthorization, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	videoLesson, err := h.releaseService.AuthorizeVideo(ctx, req.VideoId, userID)
+	if err != nil {
+		return nil, releaseErrorToStatus(err)
+	}
+
+	return &pb.VideoStreamAuthorization{CourseId: videoLesson.CourseID, LessonId: videoLesson.Lesson.ID}, nil
+}
+
+func (h *CourseHandler) CreateQuestionBank(ctx context.Context, req *pb.CreateQuestionBankRequest) (*pb.QuestionBankResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	bank, err := h.quizService.CreateQuestionBank(ctx, req.CourseId, instructorID, req.Title)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.QuestionBankResponse{Bank: questionBankToProto(bank)}, nil
+}
+
+func (h *CourseHandler) ListQuestionBanks(ctx context.Context, req *pb.ListQuestionBanksRequest) (*pb.ListQuestionBanksResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	banks, err := h.quizService.ListQuestionBanks(ctx, req.CourseId, instructorID)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	pbBanks := make([]*pb.QuestionBank, len(banks))
+	for i, bank := range banks {
+		pbBanks[i] = questionBankToProto(bank)
+	}
+
+	return &pb.ListQuestionBanksResponse{Banks: pbBanks}, nil
+}
+
+func (h *CourseHandler) DeleteQuestionBank(ctx context.Context, req *pb.DeleteQuestionBankRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.quizService.DeleteQuestionBank(ctx, req.Id, instructorID); err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) AddQuestion(ctx context.Context, req *pb.AddQuestionRequest) (*pb.QuestionResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	question, err := h.quizService.AddQuestion(ctx, req.BankId, instructorID, &domain.Question{
+		Type:            questionTypeFromProto(req.Type),
+		Prompt:          req.Prompt,
+		Options:         req.Options,
+		CorrectOptions:  intsFromProto(req.CorrectOptions),
+		AcceptedAnswers: req.AcceptedAnswers,
+		Points:          int(req.Points),
+		Explanation:     req.Explanation,
+	})
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.QuestionResponse{Question: questionToProto(question, true)}, nil
+}
+
+func (h *CourseHandler) DeleteQuestion(ctx context.Context, req *pb.DeleteQuestionRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.quizService.DeleteQuestion(ctx, req.Id, instructorID); err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) ListQuestions(ctx context.Context, req *pb.ListQuestionsRequest) (*pb.ListQuestionsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	questions, err := h.quizService.ListQuestions(ctx, req.BankId, instructorID)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.ListQuestionsResponse{Questions: questionsToProto(questions, true)}, nil
+}
+
+func (h *CourseHandler) SetLessonQuiz(ctx context.Context, req *pb.SetLessonQuizRequest) (*pb.LessonQuizResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	quiz, err := h.quizService.SetLessonQuiz(ctx, req.LessonId, req.CourseId, instructorID, &domain.Quiz{
+		BankID:                 req.BankId,
+		QuestionCount:          int(req.QuestionCount),
+		TimeLimitSeconds:       int(req.TimeLimitSeconds),
+		MaxAttempts:            int(req.MaxAttempts),
+		PassPercentage:         int(req.PassPercentage),
+		CountsTowardCompletion: req.CountsTowardCompletion,
+	})
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.LessonQuizResponse{Quiz: quizToProto(quiz)}, nil
+}
+
+func (h *CourseHandler) GetLessonQuiz(ctx context.Context, req *pb.GetLessonQuizRequest) (*pb.LessonQuizResponse, error) {
+	if _, err := interceptor.GetUserID(ctx); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	quiz, err := h.quizService.GetLessonQuiz(ctx, req.LessonId)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.LessonQuizResponse{Quiz: quizToProto(quiz)}, nil
+}
+
+func (h *CourseHandler) StartQuizAttempt(ctx context.Context, req *pb.StartQuizAttemptRequest) (*pb.QuizAttemptResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	attempt, questions, err := h.quizService.StartQuizAttempt(ctx, req.LessonId, userID)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.QuizAttemptResponse{
+		Attempt:   quizAttemptToProto(attempt),
+		Questions: questionsToProto(questions, false),
+	}, nil
+}
+
+func (h *CourseHandler) SubmitQuizAttempt(ctx context.Context, req *pb.SubmitQuizAttemptRequest) (*pb.QuizAttemptResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	answers := make([]domain.QuizAnswer, len(req.Answers))
+	for i, answer := range req.Answers {
+		answers[i] = domain.QuizAnswer{
+			QuestionID:      answer.QuestionId,
+			SelectedOptions: intsFromProto(answer.SelectedOptions),
+			Text:            answer.Text,
+		}
+	}
+
+	attempt, err := h.quizService.SubmitQuizAttempt(ctx, req.AttemptId, userID, answers)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	return &pb.QuizAttemptResponse{Attempt: quizAttemptToProto(attempt)}, nil
+}
+
+func (h *CourseHandler) ListQuizAttempts(ctx context.Context, req *pb.ListQuizAttemptsRequest) (*pb.ListQuizAttemptsResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	attempts, err := h.quizService.ListQuizAttempts(ctx, req.LessonId, userID)
+	if err != nil {
+		return nil, quizErrorToStatus(err)
+	}
+
+	pbAttempts := make([]*pb.QuizAttempt, len(attempts))
+	for i, attempt := range attempts {
+		pbAttempts[i] = quizAttemptToProto(attempt)
+	}
+
+	return &pb.ListQuizAttemptsResponse{Attempts: pbAttempts}, nil
+}
+
+func (h *CourseHandler) SetLessonAssignment(ctx context.Context, req *pb.SetLessonAssignmentRequest) (*pb.LessonAssignmentResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	assignment := &domain.Assignment{Instructions: req.Instructions}
+	if req.DueAt != nil {
+		dueAt := req.DueAt.AsTime()
+		assignment.DueAt = &dueAt
+	}
+	for _, criterion := range req.Rubric {
+		assignment.Rubric = append(assignment.Rubric, domain.RubricCriterion{
+			Title:       criterion.Title,
+			Description: criterion.Description,
+			MaxPoints:   int(criterion.MaxPoints),
+		})
+	}
+
+	assignment, err = h.assignmentService.SetLessonAssignment(ctx, req.LessonId, req.CourseId, instructorID, assignment)
+	if err != nil {
+		return nil, assignmentErrorToStatus(err)
+	}
+
+	return &pb.LessonAssignmentResponse{Assignment: assignmentToProto(assignment)}, nil
+}
+
+func (h *CourseHandler) GetLessonAssignment(ctx context.Context, req *pb.GetLessonAssignmentRequest) (*pb.LessonAssignmentResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	assignment, err := h.assignmentService.GetLessonAssignment(ctx, req.LessonId, userID)
+	if err != nil {
+		return nil, assignmentErrorToStatus(err)
+	}
+
+	return &pb.LessonAssignmentResponse{Assignment: assignmentToProto(assignment)}, nil
+}
+
+func (h *CourseHandler) SubmitAssignment(stream grpcLib.ClientStreamingServer[pb.SubmitAssignmentRequest, pb.AssignmentSubmissionResponse]) error {
+	ctx := stream.Context()
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "upload metadata is required")
+	}
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry the upload metadata")
+	}
+
+	body := &chunkReader{recv: func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.GetChunk(), nil
+	}}
+
+	submission, err := h.assignmentService.SubmitAssignment(ctx, metadata.LessonId, userID, service.Upload{
+		FileName:    metadata.FileName,
+		ContentType: metadata.ContentType,
+		Body:        body,
+	})
+	if err != nil {
+		return assignmentErrorToStatus(err)
+	}
+
+	return stream.SendAndClose(&pb.AssignmentSubmissionResponse{Submission: assignmentSubmissionToProto(submission)})
+}
+
+func (h *CourseHandler) GetMyAssignmentSubmission(ctx context.Context, req *pb.GetMyAssignmentSubmissionRequest) (*pb.AssignmentSubmissionResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	submission, err := h.assignmentService.GetMySubmission(ctx, req.LessonId, userID)
+	if err != nil {
+		return nil, assignmentErrorToStatus(err)
+	}
+
+	return &pb.AssignmentSubmissionResponse{Submission: assignmentSubmissionToProto(submission)}, nil
+}
+
+func (h *CourseHandler) DownloadAssignmentSubmission(req *pb.DownloadAssignmentSubmissionRequest, stream grpcLib.ServerStreamingServer[pb.FileChunk]) error {
+	ctx := stream.Context()
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	_, file, err := h.assignmentService.OpenSubmissionFile(ctx, req.SubmissionId, userID)
+	if err != nil {
+		return assignmentErrorToStatus(err)
+	}
+	defer file.Close()
+
+	w := bufio.NewWriterSize(chunkWriter{send: func(p []byte) error {
+		return stream.Send(&pb.FileChunk{Data: p})
+	}}, streamChunkSize)
+	if _, err := io.Copy(w, file); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := w.Flush(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (h *CourseHandler) ListGradingQueue(ctx context.Context, req *pb.ListGradingQueueRequest) (*pb.ListAssignmentSubmissionsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	submissions, total, err := h.assignmentService.ListGradingQueue(ctx, req.CourseId, req.LessonId, instructorID, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, assignmentErrorToStatus(err)
+	}
+
+	pbSubmissions := make([]*pb.AssignmentSubmission, len(submissions))
+	for i, submission := range submissions {
+		pbSubmissions[i] = assignmentSubmissionToProto(submission)
+	}
+
+	return &pb.ListAssignmentSubmissionsResponse{Submissions: pbSubmissions, Total: int32(total)}, nil
+}
+
+func (h *CourseHandler) GradeAssignmentSubmission(ctx context.Context, req *pb.GradeAssignmentSubmissionRequest) (*pb.AssignmentSubmissionResponse, error) {
+	graderID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	scores := make([]domain.CriterionScore, len(req.Scores))
+	for i, score := range req.Scores {
+		scores[i] = domain.CriterionScore{
+			Criterion: int(score.Criterion),
+			Points:    int(score.Points),
+			Comment:   score.Comment,
+		}
+	}
+
+	submission, err := h.assignmentService.GradeSubmission(ctx, req.SubmissionId, graderID, scores, req.Feedback)
+	if err != nil {
+		return nil, assignmentErrorToStatus(err)
+	}
+
+	return &pb.AssignmentSubmissionResponse{Submission: assignmentSubmissionToProto(submission)}, nil
+}
+
+func (h *CourseHandler) UploadLessonAttachment(stream grpcLib.ClientStreamingServer[pb.UploadLessonAttachmentRequest, pb.LessonAttachmentResponse]) error {
+	ctx := stream.Context()
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "upload metadata is required")
+	}
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry the upload metadata")
+	}
+
+	body := &chunkReader{recv: func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.GetChunk(), nil
+	}}
+
+	attachment, err := h.attachmentService.UploadAttachment(ctx, metadata.LessonId, metadata.CourseId, instructorID, service.Upload{
+		FileName:    metadata.Name,
+		ContentType: metadata.MimeType,
+		Body:        body,
+	})
+	if err != nil {
+		return attachmentErrorToStatus(err)
+	}
+
+	return stream.SendAndClose(&pb.LessonAttachmentResponse{Attachment: attachmentToProto(attachment)})
+}
+
+func (h *CourseHandler) ListLessonAttachments(ctx context.Context, req *pb.ListLessonAttachmentsRequest) (*pb.ListLessonAttachmentsResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	attachments, err := h.attachmentService.ListAttachments(ctx, req.LessonId, req.CourseId, userID)
+	if err != nil {
+		return nil, attachmentErrorToStatus(err)
+	}
+
+	pbAttachments := make([]*pb.LessonAttachment, len(attachments))
+	for i, attachment := range attachments {
+		pbAttachments[i] = attachmentToProto(attachment)
+	}
+
+	return &pb.ListLessonAttachmentsResponse{Attachments: pbAttachments}, nil
+}
+
+func (h *CourseHandler) DeleteLessonAttachment(ctx context.Context, req *pb.DeleteLessonAttachmentRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.attachmentService.DeleteAttachment(ctx, req.AttachmentId, instructorID); err != nil {
+		return nil, attachmentErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) GetAttachmentDownloadURL(ctx context.Context, req *pb.GetAttachmentDownloadURLRequest) (*pb.AttachmentDownloadURLResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	attachment, link, expiresAt, err := h.attachmentService.GetDownloadURL(ctx, req.AttachmentId, userID)
+	if err != nil {
+		return nil, attachmentErrorToStatus(err)
+	}
+
+	return &pb.AttachmentDownloadURLResponse{
+		Attachment: attachmentToProto(attachment),
+		Url:        link,
+		ExpiresAt:  timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (h *CourseHandler) InviteCollaborator(ctx context.Context, req *pb.InviteCollaboratorRequest) (*pb.CollaboratorResponse, error) {
+	ownerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	collaborator, err := h.collaboratorService.InviteCollaborator(ctx, req.CourseId, ownerID, req.UserId, courseRoleFromProto(req.Role))
+	if err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return &pb.CollaboratorResponse{Collaborator: collaboratorToProto(collaborator)}, nil
+}
+
+func (h *CourseHandler) AcceptCollaboratorInvitation(ctx context.Context, req *pb.AcceptCollaboratorInvitationRequest) (*pb.CollaboratorResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	collaborator, err := h.collaboratorService.AcceptInvitation(ctx, req.CourseId, userID)
+	if err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return &pb.CollaboratorResponse{Collaborator: collaboratorToProto(collaborator)}, nil
+}
+
+func (h *CourseHandler) ListCollaborators(ctx context.Context, req *pb.ListCollaboratorsRequest) (*pb.ListCollaboratorsResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	collaborators, err := h.collaboratorService.ListCollaborators(ctx, req.CourseId, userID)
+	if err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return collaboratorsToProto(collaborators), nil
+}
+
+func (h *CourseHandler) ListMyCollaborations(ctx context.Context, _ *emptypb.Empty) (*pb.ListCollaboratorsResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	collaborators, err := h.collaboratorService.ListMyCollaborations(ctx, userID)
+	if err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return collaboratorsToProto(collaborators), nil
+}
+
+func (h *CourseHandler) UpdateCollaboratorRole(ctx context.Context, req *pb.UpdateCollaboratorRoleRequest) (*pb.CollaboratorResponse, error) {
+	ownerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	collaborator, err := h.collaboratorService.UpdateCollaboratorRole(ctx, req.CourseId, ownerID, req.UserId, courseRoleFromProto(req.Role))
+	if err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return &pb.CollaboratorResponse{Collaborator: collaboratorToProto(collaborator)}, nil
+}
+
+func (h *CourseHandler) RemoveCollaborator(ctx context.Context, req *pb.RemoveCollaboratorRequest) (*emptypb.Empty, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.collaboratorService.RemoveCollaborator(ctx, req.CourseId, callerID, req.UserId); err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) TransferCourseOwnership(ctx context.Context, req *pb.TransferCourseOwnershipRequest) (*pb.CourseResponse, error) {
+	ownerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, err := h.collaboratorService.TransferOwnership(ctx, req.CourseId, ownerID, req.NewOwnerId)
+	if err != nil {
+		return nil, collaboratorErrorToStatus(err)
+	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+}
+
+func (h *CourseHandler) ReconcileCourseStats(ctx context.Context, req *pb.ReconcileCourseStatsRequest) (*pb.ReconcileCourseStatsResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.CourseId == "" {
+		count, err := h.statsService.ReconcileAll(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &pb.ReconcileCourseStatsResponse{CoursesReconciled: int32(count)}, nil
+	}
+
+	if err := h.statsService.ReconcileCourse(ctx, req.CourseId); err != nil {
+		if err == domain.ErrCourseNotFound {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ReconcileCourseStatsResponse{CoursesReconciled: 1}, nil
+}
+
+func (h *CourseHandler) ListCategories(ctx context.Context, _ *emptypb.Empty) (*pb.ListCategoriesResponse, error) {
+	categories, err := h.categoryService.ListCategories(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCategories := make([]*pb.Category, len(categories))
+	for i, category := range categories {
+		pbCategories[i] = categoryToProto(category)
+	}
+
+	return &pb.ListCategoriesResponse{Categories: pbCategories}, nil
+}
+
+func (h *CourseHandler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	category, err := h.categoryService.CreateCategory(ctx, service.CreateCategoryRequest{
+		Slug:       req.Slug,
+		ParentSlug: req.ParentSlug,
+		Name:       req.Name,
+		SortOrder:  int(req.SortOrder),
+	})
+	if err != nil {
+		return nil, categoryErrorToStatus(err)
+	}
+
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (h *CourseHandler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	updateReq := service.UpdateCategoryRequest{
+		ParentSlug: req.ParentSlug,
+		Name:       req.Name,
+	}
+
+	if req.SortOrder != nil {
+		sortOrder := int(*req.SortOrder)
+		updateReq.SortOrder = &sortOrder
+	}
+
+	category, err := h.categoryService.UpdateCategory(ctx, req.Slug, updateReq)
+	if err != nil {
+		return nil, categoryErrorToStatus(err)
+	}
+
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (h *CourseHandler) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.categoryService.DeleteCategory(ctx, req.Slug, req.ReassignTo); err != nil {
+		return nil, categoryErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) SetCourseDefaultLocale(ctx context.Context, req *pb.SetCourseDefaultLocaleRequest) (*pb.CourseResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, err := h.translationService.SetDefaultLocale(ctx, req.CourseId, instructorID, req.Locale)
+	if err != nil {
+		return nil, translationErrorToStatus(err)
+	}
+
+	return &pb.CourseResponse{Course: courseToProto(course)}, nil
+}
+
+func (h *CourseHandler) PutCourseTranslations(ctx context.Context, req *pb.PutCourseTranslationsRequest) (*pb.ListCourseTranslationsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	inputs := make([]service.TranslationInput, len(req.Translations))
+	for i, t := range req.Translations {
+		inputs[i] = service.TranslationInput{
+			Entity:   entityTypeFromProto(t.EntityType),
+			EntityID: t.EntityId,
+			Field:    translatableFieldFromProto(t.Field),
+			Value:    t.Value,
+		}
+	}
+
+	translations, err := h.translationService.PutTranslations(ctx, req.CourseId, instructorID, req.Locale, inputs)
+	if err != nil {
+		return nil, translationErrorToStatus(err)
+	}
+
+	return translationsToProto(translations), nil
+}
+
+func (h *CourseHandler) ListCourseTranslations(ctx context.Context, req *pb.ListCourseTranslationsRequest) (*pb.ListCourseTranslationsResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	translations, err := h.translationService.ListTranslations(ctx, req.CourseId, instructorID, req.Locale)
+	if err != nil {
+		return nil, translationErrorToStatus(err)
+	}
+
+	return translationsToProto(translations), nil
+}
+
+func (h *CourseHandler) DeleteCourseLocale(ctx context.Context, req *pb.DeleteCourseLocaleRequest) (*emptypb.Empty, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.translationService.DeleteLocale(ctx, req.CourseId, instructorID, req.Locale); err != nil {
+		return nil, translationErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CourseHandler) GetTranslationStatus(ctx context.Context, req *pb.GetTranslationStatusRequest) (*pb.TranslationStatusResponse, error) {
+	instructorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	course, progress, err := h.translationService.GetTranslationStatus(ctx, req.CourseId, instructorID)
+	if err != nil {
+		return nil, translationErrorToStatus(err)
+	}
+
+	pbLocales := make([]*pb.LocaleTranslationStatus, len(progress))
+	for i, p := range progress {
+		missing := make([]*pb.TranslationKey, len(p.Missing))
+		for j, key := range p.Missing {
+			missing[j] = &pb.TranslationKey{
+				EntityType: entityTypeToProto(key.Entity),
+				EntityId:   key.EntityID,
+				Field:      translatableFieldToProto(key.Field),
+			}
+		}
+		pbLocales[i] = &pb.LocaleTranslationStatus{
+			Locale:     p.Locale,
+			Translated: int32(p.Translated),
+			Total:      int32(p.Total),
+			Complete:   p.Complete(),
+			Missing:    missing,
+		}
+	}
+
+	return &pb.TranslationStatusResponse{DefaultLocale: course.DefaultLocale, Locales: pbLocales}, nil
+}
+
+func (h *CourseHandler) CreateAnnouncement(ctx context.Context, req *pb.CreateAnnouncementRequest) (*pb.AnnouncementResponse, error) {
+	authorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	createReq := service.CreateAnnouncementRequest{
+		Title: req.Title,
+		Body:  req.Body,
+	}
+	if req.SendAt != nil {
+		t := req.SendAt.AsTime()
+		createReq.SendAt = &t
+	}
+
+	announcement, err := h.announcementService.CreateAnnouncement(ctx, req.CourseId, authorID, createReq)
+	if err != nil {
+		return nil, announcementErrorToStatus(err)
+	}
+
+	return &pb.AnnouncementResponse{Announcement: announcementToProto(announcement)}, nil
+}
+
+func (h *CourseHandler) ListAnnouncements(ctx context.Context, req *pb.ListAnnouncementsRequest) (*pb.ListAnnouncementsResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	announcements, total, err := h.announcementService.ListAnnouncements(ctx, req.CourseId, userID, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, announcementErrorToStatus(err)
+	}
+
+	pbAnnouncements := make([]*pb.Announcement, len(announcements))
+	for i, announcement := range announcements {
+		pbAnnouncements[i] = announcementToProto(announcement)
+	}
+
+	return &pb.ListAnnouncementsResponse{Announcements: pbAnnouncements, Total: int32(total)}, nil
+}
+
+func (h *CourseHandler) CreateDiscussionThread(ctx context.Context, req *pb.CreateDiscussionThreadRequest) (*pb.DiscussionThreadResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	thread, err := h.discussionService.CreateThread(ctx, req.CourseId, req.LessonId, userID, req.Title, req.Body)
+	if err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	return &pb.DiscussionThreadResponse{Thread: discussionThreadToProto(thread)}, nil
+}
+
+func (h *CourseHandler) ListDiscussionThreads(ctx context.Context, req *pb.ListDiscussionThreadsRequest) (*pb.ListDiscussionThreadsResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	threads, total, err := h.discussionService.ListThreads(ctx, req.CourseId, req.LessonId, userID, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	pbThreads := make([]*pb.DiscussionThread, len(threads))
+	for i, thread := range threads {
+		pbThreads[i] = discussionThreadToProto(thread)
+	}
+
+	return &pb.ListDiscussionThreadsResponse{Threads: pbThreads, Total: int32(total)}, nil
+}
+
+func (h *CourseHandler) GetDiscussionThread(ctx context.Context, req *pb.GetDiscussionThreadRequest) (*pb.GetDiscussionThreadResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	thread, replies, err := h.discussionService.GetThread(ctx, req.ThreadId, userID)
+	if err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	pbReplies := make([]*pb.DiscussionReply, len(replies))
+	for i, reply := range replies {
+		pbReplies[i] = discussionReplyToProto(reply)
+	}
+
+	return &pb.GetDiscussionThreadResponse{Thread: discussionThreadToProto(thread), Replies: pbReplies}, nil
+}
+
+func (h *CourseHandler) ReplyToDiscussion(ctx context.Context, req *pb.ReplyToDiscussionRequest) (*pb.DiscussionReplyResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	reply, err := h.discussionService.Reply(ctx, req.ThreadId, req.ParentReplyId, userID, req.Body)
+	if err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	return &pb.DiscussionReplyResponse{Reply: discussionReplyToProto(reply)}, nil
+}
+
+func (h *CourseHandler) VoteDiscussion(ctx context.Context, req *pb.VoteDiscussionRequest) (*pb.VoteDiscussionResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	upvotes, err := h.discussionService.Vote(ctx, req.ThreadId, req.ReplyId, userID, req.Upvote)
+	if err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	return &pb.VoteDiscussionResponse{Upvotes: int32(upvotes)}, nil
+}
+
+func (h *CourseHandler) MarkDiscussionAnswer(ctx context.Context, req *pb.MarkDiscussionAnswerRequest) (*pb.DiscussionThreadResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	thread, err := h.discussionService.MarkAnswer(ctx, req.ThreadId, req.ReplyId, userID)
+	if err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	return &pb.DiscussionThreadResponse{Thread: discussionThreadToProto(thread)}, nil
+}
+
+func (h *CourseHandler) ModerateDiscussion(ctx context.Context, req *pb.ModerateDiscussionRequest) (*emptypb.Empty, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.discussionService.Moderate(ctx, req.ThreadId, req.ReplyId, userID, moderationActionFromProto(req.Action)); err != nil {
+		return nil, discussionErrorToStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// requestLocales returns the locales in the caller's accept-language
+// metadata, most preferred first.
+func requestLocales(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	return domain.ParseAcceptLanguage(strings.Join(md.Get("accept-language"), ","))
+}
+
+func translationErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrTranslationNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrInvalidLocale, domain.ErrUnknownTranslationTarget:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "translations need an entity type and field, and titles are limited to 255 characters")
+	case domain.ErrDefaultLocaleTranslation:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func announcementErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrNotEnrolled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "title (up to 255 characters) and body are required")
+	case domain.ErrAnnouncementRateLimited:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func discussionErrorToStatus(err error) error {
+	var lockedErr *domain.LockedError
+	if errors.As(err, &lockedErr) {
+		return status.Error(codes.PermissionDenied, lockedErr.Error())
+	}
+
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrThreadNotFound, domain.ErrReplyNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrNotEnrolled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrThreadLocked:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func assignmentErrorToStatus(err error) error {
+	var lockedErr *domain.LockedError
+	if errors.As(err, &lockedErr) {
+		return status.Error(codes.PermissionDenied, lockedErr.Error())
+	}
+
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrAssignmentNotFound, domain.ErrAssignmentSubmissionNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrNotEnrolled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrFileTooLarge:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case domain.ErrNotAssignmentLesson, domain.ErrAssignmentGraded:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func attachmentErrorToStatus(err error) error {
+	var lockedErr *domain.LockedError
+	if errors.As(err, &lockedErr) {
+		return status.Error(codes.PermissionDenied, lockedErr.Error())
+	}
+
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrAttachmentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrNotEnrolled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrFileTooLarge:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func collaboratorErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrCollaboratorNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "editors and teaching assistants can be invited, owners can't")
+	case domain.ErrAlreadyCollaborator:
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func categoryErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCategoryNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrCategoryExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "slug must be lowercase words joined by hyphens and name is required")
+	case domain.ErrCategoryCycle:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrCategoryInUse:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func quizErrorToStatus(err error) error {
+	var lockedErr *domain.LockedError
+	if errors.As(err, &lockedErr) {
+		return status.Error(codes.PermissionDenied, lockedErr.Error())
+	}
+
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrQuestionBankNotFound, domain.ErrQuestionNotFound,
+		domain.ErrQuizNotFound, domain.ErrAttemptNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrNotEnrolled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrNotQuizLesson, domain.ErrQuestionBankInUse, domain.ErrNotEnoughQuestions,
+		domain.ErrNoAttemptsLeft, domain.ErrAttemptSubmitted:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func releaseErrorToStatus(err error) error {
+	var lockedErr *domain.LockedError
+	if errors.As(err, &lockedErr) {
+		return status.Error(codes.PermissionDenied, lockedErr.Error())
+	}
+
+	switch err {
+	case domain.ErrCourseNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrNotEnrolled:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "invalid release rule")
+	case domain.ErrRevisionConflict:
+		return status.Error(codes.Aborted, err.Error())
+	case domain.ErrCourseUnderReview:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func transferErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrInvalidArchive, domain.ErrUnsupportedArchive, domain.ErrUnknownCategory:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "imported course needs a title, description and category")
+	case domain.ErrArchiveTooLarge:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func templateErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrTemplateNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrInvalidInput, domain.ErrUnknownCategory:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// pricingCaller identifies who is managing coupons or sales. Impersonating
+// admins act with the target user's rights.
+func pricingCaller(ctx context.Context) (service.Caller, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return service.Caller{}, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	role, _ := interceptor.GetUserRole(ctx)
+	_, impersonating := interceptor.GetActorID(ctx)
+
+	return service.Caller{UserID: userID, IsAdmin: role == roleAdmin && !impersonating}, nil
+}
+
+func pricingErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrCourseNotFound, domain.ErrCouponNotFound, domain.ErrSaleNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrUnauthorized:
+		return status.Error(codes.PermissionDenied, "unauthorized")
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, "invalid discount or schedule")
+	case domain.ErrCouponExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case domain.ErrCouponExpired, domain.ErrCouponExhausted, domain.ErrCouponNotApplicable, domain.ErrCourseNotForSale,
+		domain.ErrCouponNotReleasable:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// requireAdmin returns the caller's user ID if they are an admin acting as
+// themselves.
+func requireAdmin(ctx context.Context) (string, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	role, err := interceptor.GetUserRole(ctx)
+	if err != nil || role != roleAdmin {
+		return "", status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	if _, impersonating := interceptor.GetActorID(ctx); impersonating {
+		return "", status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	return userID, nil
+}
+
+// requireService returns the user a service is calling on behalf of, making
+// sure the call came from a service and not from a user.
+func requireService(ctx context.Context) (string, error) {
+	if _, ok := interceptor.GetService(ctx); !ok {
+		return "", status.Error(codes.PermissionDenied, "service token required")
+	}
+
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil || userID == "" {
+		return "", status.Error(codes.InvalidArgument, "no user to act on behalf of")
+	}
+
+	return userID, nil
+}
+
+func reviewErrorToStatus(err error) error {
+	switch err {
+	case domain.ErrSubmissionNotFound, domain.ErrCourseNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrInvalidTransition, domain.ErrDraftNotFound:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case domain.ErrCommentRequired:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrRevisionConflict:
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	var checkErr *domain.CheckError
+	if errors.As(err, &checkErr) {
+		return status.Error(codes.FailedPrecondition, checkErr.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func courseToProto(course *domain.Course) *pb.Course {
+	pbCourse := &pb.Course{
+		Id:              course.ID,
+		Title:           course.Title,
+		Description:     course.Description,
+		InstructorId:    course.InstructorID,
+		ThumbnailUrl:    course.ThumbnailURL,
+		Status:          statusToProto(course.Status),
+		Level:           levelToProto(course.Level),
+		Price:           course.Price,
+		Category:        course.Category,
+		Tags:            course.Tags,
+		DurationMinutes: int32(course.DurationMinutes),
+		CreatedAt:       timestamppb.New(course.CreatedAt),
+		UpdatedAt:       timestamppb.New(course.UpdatedAt),
+		EnrolledCount:   int32(course.EnrolledCount),
+		AverageRating:   course.AverageRating,
+		InstructorName:  course.InstructorName,
+		DefaultLocale:   course.DefaultLocale,
+		Locale:          course.Locale,
+	}
+	if course.PublishAt != nil {
+		pbCourse.PublishAt = timestamppb.New(*course.PublishAt)
+	}
+	if course.ArchiveAt != nil {
+		pbCourse.ArchiveAt = timestamppb.New(*course.ArchiveAt)
+	}
+	return pbCourse
+}
+
+func moduleToProto(module *domain.Module) *pb.Module {
+	return &pb.Module{
+		Id:          module.ID,
+		CourseId:    module.CourseID,
+		Title:       module.Title,
+		Description: module.Description,
+		OrderIndex:  int32(module.OrderIndex),
+		CreatedAt:   timestamppb.New(module.CreatedAt),
+		Release:     releaseRuleToProto(module.Release),
+	}
+}
+
+func lessonToProto(lesson *domain.Lesson) *pb.Lesson {
+	return &pb.Lesson{
+		Id:              lesson.ID,
+		ModuleId:        lesson.ModuleID,
+		Title:           lesson.Title,
+		Description:     lesson.Description,
+		VideoId:         lesson.VideoID,
+		DurationSeconds: int32(lesson.DurationSeconds),
+		OrderIndex:      int32(lesson.OrderIndex),
+		IsPreview:       lesson.IsPreview,
+		CreatedAt:       timestamppb.New(lesson.CreatedAt),
+		Release:         releaseRuleToProto(lesson.Release),
+		Type:            lessonTypeToProto(lesson.Type),
+	}
+}
+
+func revisionToProto(revision *domain.CourseRevision) *pb.CourseRevision {
+	pbRevision := &pb.CourseRevision{
+		Id:             revision.ID,
+		CourseId:       revision.CourseID,
+		Number:         int32(revision.Number),
+		Status:         revisionStatusToProto(revision.Status),
+		SourceRevision: int32(revision.SourceRevision),
+		CreatedBy:      revision.CreatedBy,
+		CreatedAt:      timestamppb.New(revision.CreatedAt),
+		UpdatedAt:      timestamppb.New(revision.UpdatedAt),
+	}
+	if revision.PublishedAt != nil {
+		pbRevision.PublishedAt = timestamppb.New(*revision.PublishedAt)
+	}
+	return pbRevision
+}
+
+func contentChangeToProto(change domain.ContentChange) *pb.ContentChange {
+	fields := make([]*pb.FieldChange, len(change.Fields))
+	for i, field := range change.Fields {
+		fields[i] = &pb.FieldChange{Field: field.Field, From: field.From, To: field.To}
+	}
+
+	pbChange := &pb.ContentChange{
+		EntityType: entityTypeToProto(change.EntityType),
+		EntityId:   change.EntityID,
+		Fields:     fields,
+	}
+
+	switch change.Change {
+	case domain.ChangeAdded:
+		pbChange.Change = pb.ChangeType_CHANGE_ADDED
+	case domain.ChangeRemoved:
+		pbChange.Change = pb.ChangeType_CHANGE_REMOVED
+	default:
+		pbChange.Change = pb.ChangeType_CHANGE_MODIFIED
+	}
+
+	return pbChange
+}
+
+func announcementToProto(announcement *domain.Announcement) *pb.Announcement {
+	pbAnnouncement := &pb.Announcement{
+		Id:         announcement.ID,
+		CourseId:   announcement.CourseID,
+		AuthorId:   announcement.AuthorID,
+		Title:      announcement.Title,
+		Body:       announcement.Body,
+		SendAt:     timestamppb.New(announcement.SendAt),
+		Recipients: int32(announcement.Recipients),
+		Delivered:  int32(announcement.Delivered),
+		Failed:     int32(announcement.Failed),
+		CreatedAt:  timestamppb.New(announcement.CreatedAt),
+	}
+
+	switch announcement.Status {
+	case domain.AnnouncementSending:
+		pbAnnouncement.Status = pb.AnnouncementStatus_ANNOUNCEMENT_SENDING
+	case domain.AnnouncementSent:
+		pbAnnouncement.Status = pb.AnnouncementStatus_ANNOUNCEMENT_SENT
+	default:
+		pbAnnouncement.Status = pb.AnnouncementStatus_ANNOUNCEMENT_SCHEDULED
+	}
+
+	if announcement.SentAt != nil {
+		pbAnnouncement.SentAt = timestamppb.New(*announcement.SentAt)
+	}
+	return pbAnnouncement
+}
+
+func discussionThreadToProto(thread *domain.Thread) *pb.DiscussionThread {
+	return &pb.DiscussionThread{
+		Id:             thread.ID,
+		CourseId:       thread.CourseID,
+		LessonId:       thread.LessonID,
+		AuthorId:       thread.AuthorID,
+		Title:          thread.Title,
+		Body:           thread.Body,
+		Pinned:         thread.Pinned,
+		Locked:         thread.Locked,
+		Hidden:         thread.Hidden,
+		AnswerReplyId:  thread.AnswerReplyID,
+		Upvotes:        int32(thread.Upvotes),
+		Upvoted:        thread.Upvoted,
+		ReplyCount:     int32(thread.ReplyCount),
+		LastActivityAt: timestamppb.New(thread.LastActivityAt),
+		CreatedAt:      timestamppb.New(thread.CreatedAt),
+		UpdatedAt:      timestamppb.New(thread.UpdatedAt),
+	}
+}
+
+func discussionReplyToProto(reply *domain.Reply) *pb.DiscussionReply {
+	return &pb.DiscussionReply{
+		Id:        reply.ID,
+		ThreadId:  reply.ThreadID,
+		ParentId:  reply.ParentID,
+		AuthorId:  reply.AuthorID,
+		Body:      reply.Body,
+		Hidden:    reply.Hidden,
+		Upvotes:   int32(reply.Upvotes),
+		Upvoted:   reply.Upvoted,
+		CreatedAt: timestamppb.New(reply.CreatedAt),
+	}
+}
+
+func moderationActionFromProto(action pb.DiscussionModerationAction) domain.ModerationAction {
+	switch action {
+	case pb.DiscussionModerationAction_DISCUSSION_PIN:
+		return domain.ModerationPin
+	case pb.DiscussionModerationAction_DISCUSSION_UNPIN:
+		return domain.ModerationUnpin
+	case pb.DiscussionModerationAction_DISCUSSION_LOCK:
+		return domain.ModerationLock
+	case pb.DiscussionModerationAction_DISCUSSION_UNLOCK:
+		return domain.ModerationUnlock
+	case pb.DiscussionModerationAction_DISCUSSION_HIDE:
+		return domain.ModerationHide
+	case pb.DiscussionModerationAction_DISCUSSION_UNHIDE:
+		return domain.ModerationUnhide
+	}
+	return ""
+}
+
+func entityTypeToProto(entityType domain.EntityType) pb.EntityType {
+	switch entityType {
+	case domain.EntityModule:
+		return pb.EntityType_ENTITY_MODULE
+	case domain.EntityLesson:
+		return pb.EntityType_ENTITY_LESSON
+	default:
+		return pb.EntityType_ENTITY_COURSE
+	}
+}
+
+func entityTypeFromProto(entityType pb.EntityType) domain.EntityType {
+	switch entityType {
+	case pb.EntityType_ENTITY_MODULE:
+		return domain.EntityModule
+	case pb.EntityType_ENTITY_LESSON:
+		return domain.EntityLesson
+	default:
+		return domain.EntityCourse
+	}
+}
+
+func translatableFieldToProto(field domain.TranslatableField) pb.TranslatableField {
+	if field == domain.FieldDescription {
+		return pb.TranslatableField_FIELD_DESCRIPTION
+	}
+	return pb.TranslatableField_FIELD_TITLE
+}
+
+func translatableFieldFromProto(field pb.TranslatableField) domain.TranslatableField {
+	if field == pb.TranslatableField_FIELD_DESCRIPTION {
+		return domain.FieldDescription
+	}
+	return domain.FieldTitle
+}
+
+func translationsToProto(translations []*domain.Translation) *pb.ListCourseTranslationsResponse {
+	pbTranslations := make([]*pb.CourseTranslation, len(translations))
+	for i, t := range translations {
+		pbTranslations[i] = &pb.CourseTranslation{
+			Locale:     t.Locale,
+			EntityType: entityTypeToProto(t.Entity),
+			EntityId:   t.EntityID,
+			Field:      translatableFieldToProto(t.Field),
+			Value:      t.Value,
+			UpdatedAt:  timestamppb.New(t.UpdatedAt),
+		}
+	}
+	return &pb.ListCourseTranslationsResponse{Translations: pbTranslations}
+}
+
+func revisionStatusToProto(status domain.RevisionStatus) pb.RevisionStatus {
+	switch status {
+	case domain.RevisionPublished:
+		return pb.RevisionStatus_REVISION_PUBLISHED
+	case domain.RevisionSuperseded:
+		return pb.RevisionStatus_REVISION_SUPERSEDED
+	default:
+		return pb.RevisionStatus_REVISION_DRAFT
+	}
+}
+
+func submissionToProto(submission *domain.CourseSubmission) *pb.CourseSubmission {
+	pbSubmission := &pb.CourseSubmission{
+		Id:           submission.ID,
+		CourseId:     submission.CourseID,
+		CourseTitle:  submission.CourseTitle,
+		InstructorId: submission.InstructorID,
+		Status:       submissionStatusToProto(submission.Status),
+		ReviewerId:   submission.ReviewerID,
+		Comment:      submission.Comment,
+		SubmittedAt:  timestamppb.New(submission.SubmittedAt),
+	}
+	if submission.ReviewedAt != nil {
+		pbSubmission.ReviewedAt = timestamppb.New(*submission.ReviewedAt)
+	}
+	return pbSubmission
+}
+
+func submissionsToProto(submissions []*domain.CourseSubmission, total int) *pb.ListSubmissionsResponse {
+	pbSubmissions := make([]*pb.CourseSubmission, len(submissions))
+	for i, submission := range submissions {
+		pbSubmissions[i] = submissionToProto(submission)
+	}
+
+	return &pb.ListSubmissionsResponse{
+		Submissions: pbSubmissions,
+		Total:       int32(total),
+	}
+}
+
+func submissionStatusToProto(status domain.SubmissionStatus) pb.SubmissionStatus {
+	switch status {
+	case domain.SubmissionInReview:
+		return pb.SubmissionStatus_SUBMISSION_IN_REVIEW
+	case domain.SubmissionChangesRequested:
+		return pb.SubmissionStatus_SUBMISSION_CHANGES_REQUESTED
+	case domain.SubmissionApproved:
+		return pb.SubmissionStatus_SUBMISSION_APPROVED
+	case domain.SubmissionScheduled:
+		return pb.SubmissionStatus_SUBMISSION_SCHEDULED
+	default:
+		return pb.SubmissionStatus_SUBMISSION_SUBMITTED
+	}
+}
+
+func statusToProto(status domain.CourseStatus) pb.CourseStatus {
+	switch status {
+	case domain.StatusPublished:
+		return pb.CourseStatus_PUBLISHED
+	case domain.StatusArchived:
+		return pb.CourseStatus_ARCHIVED
+	default:
+		return pb.CourseStatus_DRAFT
+	}
+}
+
+func levelToProto(level domain.CourseLevel) pb.CourseLevel {
+	switch level {
+	case domain.LevelIntermediate:
+		return pb.CourseLevel_INTERMEDIATE
+	case domain.LevelAdvanced:
+		return pb.CourseLevel_ADVANCED
+	default:
+		return pb.CourseLevel_BEGINNER
+	}
+}
+
+func levelFromProto(level pb.CourseLevel) domain.CourseLevel {
+	switch level {
+	case pb.CourseLevel_ADVANCED:
+		return domain.LevelAdvanced
+	case pb.CourseLevel_INTERMEDIATE:
+		return domain.LevelIntermediate
+	default:
+		return domain.LevelBeginner
+	}
+}
+
+func priceBandFromProto(band pb.PriceBand) domain.PriceBand {
+	switch band {
+	case pb.PriceBand_PRICE_UNDER_20:
+		return domain.PriceUnder20
+	case pb.PriceBand_PRICE_20_TO_50:
+		return domain.Price20To50
+	case pb.PriceBand_PRICE_50_TO_100:
+		return domain.Price50To100
+	case pb.PriceBand_PRICE_OVER_100:
+		return domain.PriceOver100
+	default:
+		return domain.PriceFree
+	}
+}
+
+func facetCountsToProto(counts []domain.FacetCount) []*pb.FacetCount {
+	pbCounts := make([]*pb.FacetCount, len(counts))
+	for i, count := range counts {
+		pbCounts[i] = &pb.FacetCount{Value: count.Value, Count: int32(count.Count)}
+	}
+	return pbCounts
+}
+
+func prerequisitesToProto(prerequisites []*domain.Prerequisite) *pb.ListCoursePrerequisitesResponse {
+	pbPrerequisites := make([]*pb.CoursePrerequisite, len(prerequisites))
+	for i, prerequisite := range prerequisites {
+		pbPrerequisites[i] = &pb.CoursePrerequisite{
+			CourseId:            prerequisite.CourseID,
+			RequiredCourseId:    prerequisite.RequiredCourseID,
+			RequiredCourseTitle: prerequisite.RequiredTitle,
+			RequiredCourseLevel: levelToProto(prerequisite.RequiredLevel),
+			CreatedAt:           timestamppb.New(prerequisite.CreatedAt),
+		}
+	}
+
+	return &pb.ListCoursePrerequisitesResponse{Prerequisites: pbPrerequisites}
+}
+
+func learningPathToProto(path *domain.LearningPath) *pb.LearningPath {
+	return &pb.LearningPath{
+		Id:          path.ID,
+		Title:       path.Title,
+		Description: path.Description,
+		CreatedBy:   path.CreatedBy,
+		CourseIds:   path.CourseIDs,
+		CreatedAt:   timestamppb.New(path.CreatedAt),
+		UpdatedAt:   timestamppb.New(path.UpdatedAt),
+	}
+}
+
+func discountFromProto(discountType pb.DiscountType, value float64) domain.Discount {
+	if discountType == pb.DiscountType_DISCOUNT_FIXED {
+		return domain.Discount{Type: domain.DiscountFixed, Value: value}
+	}
+	return domain.Discount{Type: domain.DiscountPercentage, Value: value}
+}
+
+func discountTypeToProto(discountType domain.DiscountType) pb.DiscountType {
+	if discountType == domain.DiscountFixed {
+		return pb.DiscountType_DISCOUNT_FIXED
+	}
+	return pb.DiscountType_DISCOUNT_PERCENTAGE
+}
+
+func couponToProto(coupon *domain.Coupon) *pb.Coupon {
+	pbCoupon := &pb.Coupon{
+		Id:            coupon.ID,
+		Code:          coupon.Code,
+		DiscountType:  discountTypeToProto(coupon.Discount.Type),
+		DiscountValue: coupon.Discount.Value,
+		CourseId:      coupon.CourseID,
+		MaxUses:       int32(coupon.MaxUses),
+		UsedCount:     int32(coupon.UsedCount),
+		StartsAt:      timestamppb.New(coupon.StartsAt),
+		CreatedBy:     coupon.CreatedBy,
+		CreatedAt:     timestamppb.New(coupon.CreatedAt),
+	}
+	if coupon.ExpiresAt != nil {
+		pbCoupon.ExpiresAt = timestamppb.New(*coupon.ExpiresAt)
+	}
+	return pbCoupon
+}
+
+func saleToProto(sale *domain.Sale) *pb.Sale {
+	return &pb.Sale{
+		Id:            sale.ID,
+		Name:          sale.Name,
+		CourseId:      sale.CourseID,
+		DiscountType:  discountTypeToProto(sale.Discount.Type),
+		DiscountValue: sale.Discount.Value,
+		StartsAt:      timestamppb.New(sale.StartsAt),
+		EndsAt:        timestamppb.New(sale.EndsAt),
+		CreatedBy:     sale.CreatedBy,
+		CreatedAt:     timestamppb.New(sale.CreatedAt),
+	}
+}
+
+func priceQuoteToProto(quote *domain.PriceQuote) *pb.PriceQuote {
+	return &pb.PriceQuote{
+		CourseId:   quote.CourseID,
+		ListPrice:  quote.ListPrice,
+		SalePrice:  quote.SalePrice,
+		SaleId:     quote.SaleID,
+		CouponCode: quote.CouponCode,
+		Discount:   quote.Discount,
+		FinalPrice: quote.FinalPrice,
+	}
+}
+
+func bundleToProto(bundle *domain.Bundle) *pb.Bundle {
+	return &pb.Bundle{
+		Id:           bundle.ID,
+		Title:        bundle.Title,
+		Description:  bundle.Description,
+		InstructorId: bundle.InstructorID,
+		CourseIds:    bundle.CourseIDs,
+		Price:        bundle.Price,
+		CreatedAt:    timestamppb.New(bundle.CreatedAt),
+		UpdatedAt:    timestamppb.New(bundle.UpdatedAt),
+	}
+}
+
+// contentModulesToProto converts content, marking what availability locks.
+// A nil availability leaves everything unlocked.
+func contentModulesToProto(courseID string, content *domain.CourseContent, availability map[string]domain.Availability) []*pb.ModuleWithLessons {
+	pbModules := make([]*pb.ModuleWithLessons, len(content.Modules))
+	for i, module := range content.Modules {
+		pbLessons := make([]*pb.Lesson, len(module.Lessons))
+		for j, lesson := range module.Lessons {
+			pbLessons[j] = lessonToProto(lesson.ToLesson(module.ID))
+			pbLessons[j].Locked, pbLessons[j].UnlockAt = lockToProto(availability[lesson.ID])
+		}
+		pbModule := moduleToProto(module.ToModule(courseID))
+		pbModule.Locked, pbModule.UnlockAt = lockToProto(availability[module.ID])
+		pbModules[i] = &pb.ModuleWithLessons{
+			Module:  pbModule,
+			Lessons: pbLessons,
+		}
+	}
+	return pbModules
+}
+
+func lockToProto(availability domain.Availability) (bool, *timestamppb.Timestamp) {
+	if availability.UnlockAt == nil {
+		return availability.Locked, nil
+	}
+	return availability.Locked, timestamppb.New(*availability.UnlockAt)
+}
+
+func releaseRuleToProto(rule *domain.ReleaseRule) *pb.ReleaseRule {
+	if rule == nil {
+		return nil
+	}
+
+	pbRule := &pb.ReleaseRule{DaysAfterEnrollment: int32(rule.Days)}
+	switch rule.Type {
+	case domain.ReleaseOnDate:
+		pbRule.Type = pb.ReleaseType_RELEASE_ON_DATE
+	case domain.ReleaseAfterEnrollment:
+		pbRule.Type = pb.ReleaseType_RELEASE_AFTER_ENROLLMENT
+	case domain.ReleaseAfterPreviousModule:
+		pbRule.Type = pb.ReleaseType_RELEASE_AFTER_PREVIOUS_MODULE
+	}
+	if rule.At != nil {
+		pbRule.ReleaseAt = timestamppb.New(*rule.At)
+	}
+	return pbRule
+}
+
+// releaseRuleFromProto returns nil for an unset or immediate rule.
+func releaseRuleFromProto(pbRule *pb.ReleaseRule) *domain.ReleaseRule {
+	if pbRule == nil {
+		return nil
+	}
+
+	rule := &domain.ReleaseRule{Days: int(pbRule.DaysAfterEnrollment)}
+	switch pbRule.Type {
+	case pb.ReleaseType_RELEASE_ON_DATE:
+		rule.Type = domain.ReleaseOnDate
+	case pb.ReleaseType_RELEASE_AFTER_ENROLLMENT:
+		rule.Type = domain.ReleaseAfterEnrollment
+	case pb.ReleaseType_RELEASE_AFTER_PREVIOUS_MODULE:
+		rule.Type = domain.ReleaseAfterPreviousModule
+	default:
+		return nil
+	}
+	if pbRule.ReleaseAt != nil {
+		at := pbRule.ReleaseAt.AsTime()
+		rule.At = &at
+	}
+	return rule
+}
+
+func templateToProto(template *domain.CourseTemplate) *pb.CourseTemplate {
+	return &pb.CourseTemplate{
+		Id:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		CourseTitle: template.Content.Details.Title,
+		Category:    template.Content.Details.Category,
+		Level:       levelToProto(template.Content.Details.Level),
+		ModuleCount: int32(len(template.Content.Modules)),
+		LessonCount: int32(template.Content.LessonCount()),
+		CreatedBy:   template.CreatedBy,
+		CreatedAt:   timestamppb.New(template.CreatedAt),
+	}
+}
+
+func templateResponseToProto(template *domain.CourseTemplate) *pb.CourseTemplateResponse {
+	return &pb.CourseTemplateResponse{
+		Template: templateToProto(template),
+		Modules:  contentModulesToProto("", &template.Content, nil),
+	}
+}
+
+func archiveFormatFromProto(format pb.ArchiveFormat) domain.ArchiveFormat {
+	switch format {
+	case pb.ArchiveFormat_ARCHIVE_SCORM_1_2:
+		return domain.ArchiveSCORM12
+	default:
+		return domain.ArchiveNative
+	}
+}
+
+func lessonTypeToProto(lessonType domain.LessonType) pb.LessonType {
+	switch lessonType {
+	case domain.LessonQuiz:
+		return pb.LessonType_LESSON_QUIZ
+	case domain.LessonAssignment:
+		return pb.LessonType_LESSON_ASSIGNMENT
+	default:
+		return pb.LessonType_LESSON_VIDEO
+	}
+}
+
+func lessonTypeFromProto(lessonType pb.LessonType) domain.LessonType {
+	switch lessonType {
+	case pb.LessonType_LESSON_QUIZ:
+		return domain.LessonQuiz
+	case pb.LessonType_LESSON_ASSIGNMENT:
+		return domain.LessonAssignment
+	default:
+		return domain.LessonVideo
+	}
+}
+
+func questionTypeToProto(questionType domain.QuestionType) pb.QuestionType {
+	switch questionType {
+	case domain.QuestionMultipleChoice:
+		return pb.QuestionType_MULTIPLE_CHOICE
+	case domain.QuestionTrueFalse:
+		return pb.QuestionType_TRUE_FALSE
+	case domain.QuestionShortAnswer:
+		return pb.QuestionType_SHORT_ANSWER
+	default:
+		return pb.QuestionType_SINGLE_CHOICE
+	}
+}
+
+func questionTypeFromProto(questionType pb.QuestionType) domain.QuestionType {
+	switch questionType {
+	case pb.QuestionType_MULTIPLE_CHOICE:
+		return domain.QuestionMultipleChoice
+	case pb.QuestionType_TRUE_FALSE:
+		return domain.QuestionTrueFalse
+	case pb.QuestionType_SHORT_ANSWER:
+		return domain.QuestionShortAnswer
+	default:
+		return domain.QuestionSingleChoice
+	}
+}
+
+func questionBankToProto(bank *domain.QuestionBank) *pb.QuestionBank {
+	return &pb.QuestionBank{
+		Id:            bank.ID,
+		CourseId:      bank.CourseID,
+		Title:         bank.Title,
+		QuestionCount: int32(bank.QuestionCount),
+		CreatedAt:     timestamppb.New(bank.CreatedAt),
+	}
+}
+
+// questionToProto leaves out the answer key and explanation unless
+// withAnswers is set, which is only for the instructor.
+func questionToProto(question *domain.Question, withAnswers bool) *pb.Question {
+	pbQuestion := &pb.Question{
+		Id:        question.ID,
+		BankId:    question.BankID,
+		Type:      questionTypeToProto(question.Type),
+		Prompt:    question.Prompt,
+		Options:   question.Options,
+		Points:    int32(question.Points),
+		CreatedAt: timestamppb.New(question.CreatedAt),
+	}
+	if withAnswers {
+		pbQuestion.CorrectOptions = intsToProto(question.CorrectOptions)
+		pbQuestion.AcceptedAnswers = question.AcceptedAnswers
+		pbQuestion.Explanation = question.Explanation
+	}
+	return pbQuestion
+}
+
+func questionsToProto(questions []*domain.Question, withAnswers bool) []*pb.Question {
+	pbQuestions := make([]*pb.Question, len(questions))
+	for i, question := range questions {
+		pbQuestions[i] = questionToProto(question, withAnswers)
+	}
+	return pbQuestions
+}
+
+func quizToProto(quiz *domain.Quiz) *pb.LessonQuiz {
+	return &pb.LessonQuiz{
+		LessonId:               quiz.LessonID,
+		CourseId:               quiz.CourseID,
+		BankId:                 quiz.BankID,
+		QuestionCount:          int32(quiz.QuestionCount),
+		TimeLimitSeconds:       int32(quiz.TimeLimitSeconds),
+		MaxAttempts:            int32(quiz.MaxAttempts),
+		PassPercentage:         int32(quiz.PassPercentage),
+		CountsTowardCompletion: quiz.CountsTowardCompletion,
+		UpdatedAt:              timestamppb.New(quiz.UpdatedAt),
+	}
+}
+
+func quizAttemptToProto(attempt *domain.QuizAttempt) *pb.QuizAttempt {
+	pbAttempt := &pb.QuizAttempt{
+		Id:          attempt.ID,
+		LessonId:    attempt.LessonID,
+		CourseId:    attempt.CourseID,
+		UserId:      attempt.UserID,
+		QuestionIds: attempt.QuestionIDs,
+		StartedAt:   timestamppb.New(attempt.StartedAt),
+		Score:       int32(attempt.Score),
+		MaxScore:    int32(attempt.MaxScore),
+		Percentage:  attempt.Percentage,
+		Passed:      attempt.Passed,
+		Expired:     attempt.Expired,
+	}
+	for _, answer := range attempt.Answers {
+		pbAttempt.Answers = append(pbAttempt.Answers, &pb.QuizAnswer{
+			QuestionId:      answer.QuestionID,
+			SelectedOptions: intsToProto(answer.SelectedOptions),
+			Text:            answer.Text,
+			Correct:         answer.Correct,
+		})
+	}
+	if attempt.Deadline != nil {
+		pbAttempt.Deadline = timestamppb.New(*attempt.Deadline)
+	}
+	if attempt.SubmittedAt != nil {
+		pbAttempt.SubmittedAt = timestamppb.New(*attempt.SubmittedAt)
+	}
+	return pbAttempt
+}
+
+func intsToProto(values []int) []int32 {
+	out := make([]int32, len(values))
+	for i, v := range values {
+		out[i] = int32(v)
+	}
+	return out
+}
+
+func intsFromProto(values []int32) []int {
+	out := make([]int, len(values))
+	for i, v := range values {
+		out[i] = int(v)
+	}
+	return out
+}
+
+func assignmentToProto(assignment *domain.Assignment) *pb.LessonAssignment {
+	pbAssignment := &pb.LessonAssignment{
+		LessonId:     assignment.LessonID,
+		CourseId:     assignment.CourseID,
+		Instructions: assignment.Instructions,
+		MaxScore:     int32(assignment.MaxScore()),
+		UpdatedAt:    timestamppb.New(assignment.UpdatedAt),
+	}
+	for _, criterion := range assignment.Rubric {
+		pbAssignment.Rubric = append(pbAssignment.Rubric, &pb.RubricCriterion{
+			Title:       criterion.Title,
+			Description: criterion.Description,
+			MaxPoints:   int32(criterion.MaxPoints),
+		})
+	}
+	if assignment.DueAt != nil {
+		pbAssignment.DueAt = timestamppb.New(*assignment.DueAt)
+	}
+	return pbAssignment
+}
+
+func assignmentSubmissionToProto(submission *domain.AssignmentSubmission) *pb.AssignmentSubmission {
+	pbSubmission := &pb.AssignmentSubmission{
+		Id:          submission.ID,
+		LessonId:    submission.LessonID,
+		CourseId:    submission.CourseID,
+		UserId:      submission.UserID,
+		FileName:    submission.FileName,
+		ContentType: submission.ContentType,
+		SizeBytes:   submission.SizeBytes,
+		Late:        submission.Late,
+		SubmittedAt: timestamppb.New(submission.SubmittedAt),
+		Score:       int32(submission.Score),
+		MaxScore:    int32(submission.MaxScore),
+		Feedback:    submission.Feedback,
+		GradedBy:    submission.GradedBy,
+	}
+	if submission.Status == domain.AssignmentGraded {
+		pbSubmission.Status = pb.AssignmentStatus_ASSIGNMENT_GRADED
+	}
+	for _, score := range submission.Scores {
+		pbSubmission.Scores = append(pbSubmission.Scores, &pb.CriterionScore{
+			Criterion: int32(score.Criterion),
+			Points:    int32(score.Points),
+			Comment:   score.Comment,
+		})
+	}
+	if submission.GradedAt != nil {
+		pbSubmission.GradedAt = timestamppb.New(*submission.GradedAt)
+	}
+	return pbSubmission
+}
+
+func attachmentToProto(attachment *domain.Attachment) *pb.LessonAttachment {
+	return &pb.LessonAttachment{
+		Id:        attachment.ID,
+		LessonId:  attachment.LessonID,
+		CourseId:  attachment.CourseID,
+		Name:      attachment.Name,
+		MimeType:  attachment.MimeType,
+		SizeBytes: attachment.SizeBytes,
+		Checksum:  attachment.Checksum,
+		CreatedAt: timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func collaboratorToProto(collaborator *domain.Collaborator) *pb.Collaborator {
+	pbCollaborator := &pb.Collaborator{
+		CourseId:  collaborator.CourseID,
+		UserId:    collaborator.UserID,
+		Role:      courseRoleToProto(collaborator.Role),
+		InvitedBy: collaborator.InvitedBy,
+		InvitedAt: timestamppb.New(collaborator.InvitedAt),
+	}
+	if collaborator.Active() {
+		pbCollaborator.Status = pb.CollaboratorStatus_COLLABORATOR_ACCEPTED
+	}
+	if collaborator.AcceptedAt != nil {
+		pbCollaborator.AcceptedAt = timestamppb.New(*collaborator.AcceptedAt)
+	}
+	return pbCollaborator
+}
+
+func collaboratorsToProto(collaborators []*domain.Collaborator) *pb.ListCollaboratorsResponse {
+	pbCollaborators := make([]*pb.Collaborator, len(collaborators))
+	for i, collaborator := range collaborators {
+		pbCollaborators[i] = collaboratorToProto(collaborator)
+	}
+	return &pb.ListCollaboratorsResponse{Collaborators: pbCollaborators}
+}
+
+func courseRoleToProto(role domain.CourseRole) pb.CourseRole {
+	switch role {
+	case domain.RoleOwner:
+		return pb.CourseRole_ROLE_OWNER
+	case domain.RoleTeachingAssistant:
+		return pb.CourseRole_ROLE_TEACHING_ASSISTANT
+	default:
+		return pb.CourseRole_ROLE_EDITOR
+	}
+}
+
+func courseRoleFromProto(role pb.CourseRole) domain.CourseRole {
+	switch role {
+	case pb.CourseRole_ROLE_OWNER:
+		return domain.RoleOwner
+	case pb.CourseRole_ROLE_TEACHING_ASSISTANT:
+		return domain.RoleTeachingAssistant
+	default:
+		return domain.RoleEditor
+	}
+}
+
+func categoryToProto(category *domain.Category) *pb.Category {
+	return &pb.Category{
+		Slug:       category.Slug,
+		ParentSlug: category.ParentSlug,
+		Name:       category.Name,
+		SortOrder:  int32(category.SortOrder),
+		CreatedAt:  timestamppb.New(category.CreatedAt),
+		UpdatedAt:  timestamppb.New(category.UpdatedAt),
 	}
 }
@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/dmehra2102/learning-platform/shared/proto/notification"
+	"google.golang.org/grpc"
+)
+
+// Message is a notification to one user. It always shows up in-app; with
+// Email it's emailed too.
+type Message struct {
+	UserID  string
+	Subject string
+	Body    string
+	Data    map[string]string
+	Email   bool
+}
+
+// Client sends notifications through notification-service.
+type Client interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type client struct {
+	notifications pb.NotificationServiceClient
+}
+
+// NewClient talks to notification-service over conn, which must
+// authenticate as course-service.
+func NewClient(conn *grpc.ClientConn) Client {
+	return &client{notifications: pb.NewNotificationServiceClient(conn)}
+}
+
+func (c *client) Send(ctx context.Context, msg Message) error {
+	types := []pb.NotificaitionType{pb.NotificaitionType_IN_APP}
+	if msg.Email {
+		types = append(types, pb.NotificaitionType_EMAIL)
+	}
+
+	_, err := c.notifications.SendNotification(ctx, &pb.SendNotificationRequest{
+		UserId:  msg.UserID,
+		Types:   types,
+		Subject: msg.Subject,
+		Message: msg.Body,
+		Data:    msg.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type AnnouncementRepository interface {
+	Create(ctx context.Context, announcement *domain.Announcement) error
+	GetByID(ctx context.Context, id string) (*domain.Announcement, error)
+	ListByCourse(ctx context.Context, courseID string, sentOnly bool, page, pageSize int) ([]*domain.Announcement, int, error)
+	ListDue(ctx context.Context, now time.Time) ([]*domain.Announcement, error)
+	Claim(ctx context.Context, id string) (bool, error)
+	Requeue(ctx context.Context, id string) error
+	RecordDelivery(ctx context.Context, id string, recipients, delivered, failed int, at time.Time) error
+}
+
+type announcementRepository struct {
+	db *database.DB
+}
+
+func NewAnnouncementRepository(db *database.DB) AnnouncementRepository {
+	return &announcementRepository{db: db}
+}
+
+const announcementColumns = `id, course_id, author_id, title, body, status, send_at, sent_at, recipients, delivered, failed, created_at`
+
+// Create saves the announcement unless the course already has
+// AnnouncementLimit others going out within AnnouncementWindow of it.
+func (r *announcementRepository) Create(ctx context.Context, announcement *domain.Announcement) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		// Serialize announcements per course so concurrent ones can't both
+		// squeeze under the limit
+		var courseID string
+		err := tx.QueryRowContext(ctx, `SELECT id FROM courses WHERE id = $1 FOR UPDATE`, announcement.CourseID).Scan(&courseID)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock course: %w", err)
+		}
+
+		var nearby int
+		if err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM course_announcements
+			WHERE course_id = $1 AND send_at > $2 AND send_at < $3
+		`, announcement.CourseID,
+			announcement.SendAt.Add(-domain.AnnouncementWindow),
+			announcement.SendAt.Add(domain.AnnouncementWindow),
+		).Scan(&nearby); err != nil {
+			return fmt.Errorf("failed to count announcements: %w", err)
+		}
+		if nearby >= domain.AnnouncementLimit {
+			return domain.ErrAnnouncementRateLimited
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO course_announcements (id, course_id, author_id, title, body, status, send_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, announcement.ID, announcement.CourseID, announcement.AuthorID, announcement.Title,
+			announcement.Body, announcement.Status, announcement.SendAt, announcement.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to create announcement: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *announcementRepository) GetByID(ctx context.Context, id string) (*domain.Announcement, error) {
+	query := `SELECT ` + announcementColumns + ` FROM course_announcements WHERE id = $1`
+
+	announcement, err := scanAnnouncement(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAnnouncementNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+
+	return announcement, nil
+}
+
+// ListByCourse returns the course's announcements, latest first. With
+// sentOnly, those still waiting for their send time are left out.
+func (r *announcementRepository) ListByCourse(ctx context.Context, courseID string, sentOnly bool, page, pageSize int) ([]*domain.Announcement, int, error) {
+	offset := (page - 1) * pageSize
+
+	where := `course_id = $1`
+	args := []any{courseID}
+	if sentOnly {
+		where += ` AND status <> $2`
+		args = append(args, domain.AnnouncementScheduled)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM course_announcements WHERE `+where, args...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count announcements: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM course_announcements WHERE %s
+		ORDER BY send_at DESC, id DESC LIMIT $%d OFFSET $%d
+	`, announcementColumns, where, len(args)+1, len(args)+2)
+	args = append(args, pageSize, offset)
+
+	announcements, err := r.list(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return announcements, total, nil
+}
+
+// ListDue returns scheduled announcements whose send time has come.
+func (r *announcementRepository) ListDue(ctx context.Context, now time.Time) ([]*domain.Announcement, error) {
+	query := `
+		SELECT ` + announcementColumns + ` FROM course_announcements
+		WHERE status = $1 AND send_at <= $2
+		ORDER BY send_at
+	`
+
+	return r.list(ctx, query, domain.AnnouncementScheduled, now)
+}
+
+// Claim moves a scheduled announcement to SENDING. It reports false if it was
+// no longer scheduled, e.g. because another scheduler claimed it first.
+func (r *announcementRepository) Claim(ctx context.Context, id string) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE course_announcements SET status = $1 WHERE id = $2 AND status = $3`,
+		domain.AnnouncementSending, id, domain.AnnouncementScheduled,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim announcement: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+// Requeue puts a claimed announcement back to be tried again.
+func (r *announcementRepository) Requeue(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE course_announcements SET status = $1 WHERE id = $2 AND status = $3`,
+		domain.AnnouncementScheduled, id, domain.AnnouncementSending,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to requeue announcement: %w", err)
+	}
+
+	return nil
+}
+
+func (r *announcementRepository) RecordDelivery(ctx context.Context, id string, recipients, delivered, failed int, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE course_announcements
+		SET status = $1, recipients = $2, delivered = $3, failed = $4, sent_at = $5
+		WHERE id = $6
+	`, domain.AnnouncementSent, recipients, delivered, failed, at, id)
+	if err != nil {
+		return fmt.Errorf("failed to record announcement delivery: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
+
+func (r *announcementRepository) list(ctx context.Context, query string, args ...any) ([]*domain.Announcement, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	defer rows.Close()
+
+	var announcements []*domain.Announcement
+	for rows.Next() {
+		announcement, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan announcement: %w", err)
+		}
+		announcements = append(announcements, announcement)
+	}
+
+	return announcements, nil
+}
+
+func scanAnnouncement(row rowScanner) (*domain.Announcement, error) {
+	var a domain.Announcement
+	var sentAt sql.NullTime
+
+	if err := row.Scan(
+		&a.ID, &a.CourseID, &a.AuthorID, &a.Title, &a.Body, &a.Status,
+		&a.SendAt, &sentAt, &a.Recipients, &a.Delivered, &a.Failed, &a.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if sentAt.Valid {
+		a.SentAt = &sentAt.Time
+	}
+	return &a, nil
+}
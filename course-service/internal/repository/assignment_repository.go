@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/lib/pq"
+)
+
+type AssignmentRepository interface {
+	UpsertAssignment(ctx context.Context, assignment *domain.Assignment) error
+	GetAssignment(ctx context.Context, lessonID string) (*domain.Assignment, error)
+	ReadyAssignments(ctx context.Context, lessonIDs []string) (map[string]bool, error)
+	UpsertSubmission(ctx context.Context, submission *domain.AssignmentSubmission) (previousFileKey string, err error)
+	GetSubmission(ctx context.Context, id string) (*domain.AssignmentSubmission, error)
+	GetSubmissionFor(ctx context.Context, lessonID, userID string) (*domain.AssignmentSubmission, error)
+	ListUngraded(ctx context.Context, courseID, lessonID string, page, pageSize int) ([]*domain.AssignmentSubmission, int, error)
+	SaveGrade(ctx context.Context, submission *domain.AssignmentSubmission) error
+}
+
+type assignmentRepository struct {
+	db *database.DB
+}
+
+func NewAssignmentRepository(db *database.DB) AssignmentRepository {
+	return &assignmentRepository{db: db}
+}
+
+const assignmentSubmissionColumns = `id, lesson_id, course_id, user_id, file_key, file_name, content_type, size_bytes, status, late,
+	submitted_at, scores, score, max_score, feedback, graded_by, graded_at`
+
+func (r *assignmentRepository) UpsertAssignment(ctx context.Context, assignment *domain.Assignment) error {
+	rubric, err := json.Marshal(assignment.Rubric)
+	if err != nil {
+		return fmt.Errorf("failed to encode rubric: %w", err)
+	}
+
+	var dueAt any
+	if assignment.DueAt != nil {
+		dueAt = *assignment.DueAt
+	}
+
+	query := `
+		INSERT INTO lesson_assignments (lesson_id, course_id, instructions, due_at, rubric, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (lesson_id) DO UPDATE
+		SET instructions = EXCLUDED.instructions, due_at = EXCLUDED.due_at, rubric = EXCLUDED.rubric,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		assignment.LessonID, assignment.CourseID, assignment.Instructions, dueAt, rubric, assignment.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to save assignment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *assignmentRepository) GetAssignment(ctx context.Context, lessonID string) (*domain.Assignment, error) {
+	query := `SELECT lesson_id, course_id, instructions, due_at, rubric, updated_at FROM lesson_assignments WHERE lesson_id = $1`
+
+	var assignment domain.Assignment
+	var dueAt sql.NullTime
+	var rubric []byte
+
+	err := r.db.QueryRowContext(ctx, query, lessonID).Scan(
+		&assignment.LessonID, &assignment.CourseID, &assignment.Instructions, &dueAt, &rubric, &assignment.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAssignmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment: %w", err)
+	}
+
+	if err := json.Unmarshal(rubric, &assignment.Rubric); err != nil {
+		return nil, fmt.Errorf("failed to decode rubric: %w", err)
+	}
+	if dueAt.Valid {
+		assignment.DueAt = &dueAt.Time
+	}
+
+	return &assignment, nil
+}
+
+// ReadyAssignments returns which of lessonIDs have their assignment set up.
+func (r *assignmentRepository) ReadyAssignments(ctx context.Context, lessonIDs []string) (map[string]bool, error) {
+	ready := make(map[string]bool)
+	if len(lessonIDs) == 0 {
+		return ready, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT lesson_id FROM lesson_assignments WHERE lesson_id = ANY($1::uuid[])`,
+		pq.Array(lessonIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check assignments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var lessonID string
+		if err := rows.Scan(&lessonID); err != nil {
+			return nil, fmt.Errorf("failed to scan assignment: %w", err)
+		}
+		ready[lessonID] = true
+	}
+
+	return ready, nil
+}
+
+// UpsertSubmission records a learner's upload. A second upload replaces the
+// first, keeping its ID, and returns the file key it replaced so the old file
+// can be removed. Graded submissions can't be replaced.
+func (r *assignmentRepository) UpsertSubmission(ctx context.Context, submission *domain.AssignmentSubmission) (string, error) {
+	query := `
+		WITH previous AS (
+			SELECT file_key FROM assignment_submissions WHERE lesson_id = $2 AND user_id = $4
+		)
+		INSERT INTO assignment_submissions (id, lesson_id, course_id, user_id, file_key, file_name, content_type, size_bytes, status, late, submitted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (lesson_id, user_id) DO UPDATE
+		SET file_key = EXCLUDED.file_key, file_name = EXCLUDED.file_name, content_type = EXCLUDED.content_type,
+			size_bytes = EXCLUDED.size_bytes, late = EXCLUDED.late, submitted_at = EXCLUDED.submitted_at
+		WHERE assignment_submissions.status <> $12
+		RETURNING id, (SELECT file_key FROM previous)
+	`
+
+	var previous sql.NullString
+	err := r.db.QueryRowContext(ctx, query,
+		submission.ID, submission.LessonID, submission.CourseID, submission.UserID, submission.FileKey,
+		submission.FileName, submission.ContentType, submission.SizeBytes, submission.Status, submission.Late,
+		submission.SubmittedAt, domain.AssignmentGraded,
+	).Scan(&submission.ID, &previous)
+	if err == sql.ErrNoRows {
+		return "", domain.ErrAssignmentGraded
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to save assignment submission: %w", err)
+	}
+
+	return previous.String, nil
+}
+
+func (r *assignmentRepository) GetSubmission(ctx context.Context, id string) (*domain.AssignmentSubmission, error) {
+	query := `SELECT ` + assignmentSubmissionColumns + ` FROM assignment_submissions WHERE id = $1`
+
+	submission, err := scanAssignmentSubmission(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAssignmentSubmissionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment submission: %w", err)
+	}
+
+	return submission, nil
+}
+
+func (r *assignmentRepository) GetSubmissionFor(ctx context.Context, lessonID, userID string) (*domain.AssignmentSubmission, error) {
+	query := `SELECT ` + assignmentSubmissionColumns + ` FROM assignment_submissions WHERE lesson_id = $1 AND user_id = $2`
+
+	submission, err := scanAssignmentSubmission(r.db.QueryRowContext(ctx, query, lessonID, userID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAssignmentSubmissionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment submission: %w", err)
+	}
+
+	return submission, nil
+}
+
+// ListUngraded returns a course's grading queue, oldest submission first,
+// optionally narrowed to one assignment.
+func (r *assignmentRepository) ListUngraded(ctx context.Context, courseID, lessonID string, page, pageSize int) ([]*domain.AssignmentSubmission, int, error) {
+	offset := (page - 1) * pageSize
+
+	where := `WHERE course_id = $1 AND status = $2 AND ($3 = '' OR lesson_id::text = $3)`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM assignment_submissions `+where,
+		courseID, domain.AssignmentSubmitted, lessonID,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count assignment submissions: %w", err)
+	}
+
+	query := `SELECT ` + assignmentSubmissionColumns + ` FROM assignment_submissions ` + where + `
+		ORDER BY submitted_at ASC LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.QueryContext(ctx, query, courseID, domain.AssignmentSubmitted, lessonID, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list assignment submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var submissions []*domain.AssignmentSubmission
+	for rows.Next() {
+		submission, err := scanAssignmentSubmission(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan assignment submission: %w", err)
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, total, nil
+}
+
+// SaveGrade stores a grade unless the submission was graded meanwhile.
+func (r *assignmentRepository) SaveGrade(ctx context.Context, submission *domain.AssignmentSubmission) error {
+	scores, err := json.Marshal(submission.Scores)
+	if err != nil {
+		return fmt.Errorf("failed to encode scores: %w", err)
+	}
+
+	query := `
+		UPDATE assignment_submissions
+		SET status = $1, scores = $2, score = $3, max_score = $4, feedback = $5, graded_by = $6, graded_at = $7
+		WHERE id = $8 AND status = $9
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		submission.Status, scores, submission.Score, submission.MaxScore, submission.Feedback,
+		submission.GradedBy, *submission.GradedAt, submission.ID, domain.AssignmentSubmitted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to grade assignment submission: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAssignmentGraded
+	}
+
+	return nil
+}
+
+func scanAssignmentSubmission(row rowScanner) (*domain.AssignmentSubmission, error) {
+	var submission domain.AssignmentSubmission
+	var scores []byte
+	var gradedBy sql.NullString
+	var gradedAt sql.NullTime
+
+	if err := row.Scan(
+		&submission.ID, &submission.LessonID, &submission.CourseID, &submission.UserID, &submission.FileKey,
+		&submission.FileName, &submission.ContentType, &submission.SizeBytes, &submission.Status, &submission.Late,
+		&submission.SubmittedAt, &scores, &submission.Score, &submission.MaxScore, &submission.Feedback,
+		&gradedBy, &gradedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(scores, &submission.Scores); err != nil {
+		return nil, fmt.Errorf("failed to decode scores: %w", err)
+	}
+	submission.GradedBy = gradedBy.String
+	if gradedAt.Valid {
+		submission.GradedAt = &gradedAt.Time
+	}
+
+	return &submission, nil
+}
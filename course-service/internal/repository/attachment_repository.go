@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *domain.Attachment) error
+	GetByID(ctx context.Context, id string) (*domain.Attachment, error)
+	ListByLesson(ctx context.Context, lessonID string) ([]*domain.Attachment, error)
+	Delete(ctx context.Context, id string) error
+	ListOrphaned(ctx context.Context, limit int) ([]*domain.Attachment, error)
+}
+
+type attachmentRepository struct {
+	db *database.DB
+}
+
+func NewAttachmentRepository(db *database.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+const attachmentColumns = `id, lesson_id, course_id, name, mime_type, size_bytes, checksum, storage_key, created_by, created_at`
+
+func (r *attachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
+	query := `
+		INSERT INTO lesson_attachments (` + attachmentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		attachment.ID, attachment.LessonID, attachment.CourseID, attachment.Name, attachment.MimeType,
+		attachment.SizeBytes, attachment.Checksum, attachment.StorageKey, attachment.CreatedBy, attachment.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *attachmentRepository) GetByID(ctx context.Context, id string) (*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM lesson_attachments WHERE id = $1`
+
+	attachment, err := scanAttachment(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+func (r *attachmentRepository) ListByLesson(ctx context.Context, lessonID string) ([]*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM lesson_attachments WHERE lesson_id = $1 ORDER BY created_at ASC`
+
+	return r.list(ctx, query, lessonID)
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM lesson_attachments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+// ListOrphaned returns attachments whose lesson is gone: it is neither a live
+// lesson nor part of any revision kept for its course. Every revision counts,
+// not just the draft, since rolling back republishes an earlier one with its
+// lessons. That leaves lessons deleted before they were ever published, and
+// everything of a deleted course, whose revisions go with it.
+func (r *attachmentRepository) ListOrphaned(ctx context.Context, limit int) ([]*domain.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + ` FROM lesson_attachments a
+		WHERE NOT EXISTS (SELECT 1 FROM lessons l WHERE l.id = a.lesson_id)
+		AND NOT EXISTS (
+			SELECT 1 FROM course_revisions r
+			WHERE r.course_id = a.course_id
+			AND jsonb_path_exists(r.content, '$.modules[*].lessons[*] ? (@.id == $id)', jsonb_build_object('id', a.lesson_id::text))
+		)
+		ORDER BY created_at ASC
+		LIMIT $1
+	`
+
+	return r.list(ctx, query, limit)
+}
+
+func (r *attachmentRepository) list(ctx context.Context, query string, args ...any) ([]*domain.Attachment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*domain.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+func scanAttachment(row rowScanner) (*domain.Attachment, error) {
+	var attachment domain.Attachment
+	if err := row.Scan(
+		&attachment.ID, &attachment.LessonID, &attachment.CourseID, &attachment.Name, &attachment.MimeType,
+		&attachment.SizeBytes, &attachment.Checksum, &attachment.StorageKey, &attachment.CreatedBy, &attachment.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type BundleRepository interface {
+	Create(ctx context.Context, bundle *domain.Bundle) error
+	GetByID(ctx context.Context, id string) (*domain.Bundle, error)
+	Update(ctx context.Context, bundle *domain.Bundle) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Bundle, int, error)
+}
+
+type bundleRepository struct {
+	db *database.DB
+}
+
+func NewBundleRepository(db *database.DB) BundleRepository {
+	return &bundleRepository{db: db}
+}
+
+func (r *bundleRepository) Create(ctx context.Context, bundle *domain.Bundle) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			INSERT INTO bundles (id, title, description, instructor_id, price, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`
+
+		if _, err := tx.ExecContext(ctx, query,
+			bundle.ID, bundle.Title, bundle.Description, bundle.InstructorID,
+			bundle.Price, bundle.CreatedAt, bundle.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to create bundle: %w", err)
+		}
+
+		return insertBundleCourses(ctx, tx, bundle)
+	})
+}
+
+func (r *bundleRepository) GetByID(ctx context.Context, id string) (*domain.Bundle, error) {
+	query := `SELECT id, title, description, instructor_id, price, created_at, updated_at FROM bundles WHERE id = $1`
+
+	var bundle domain.Bundle
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&bundle.ID, &bundle.Title, &bundle.Description, &bundle.InstructorID,
+		&bundle.Price, &bundle.CreatedAt, &bundle.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrBundleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bundle: %w", err)
+	}
+
+	if err := r.loadCourses(ctx, []*domain.Bundle{&bundle}); err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+func (r *bundleRepository) Update(ctx context.Context, bundle *domain.Bundle) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `UPDATE bundles SET title = $1, description = $2, price = $3, updated_at = $4 WHERE id = $5`
+
+		result, err := tx.ExecContext(ctx, query, bundle.Title, bundle.Description, bundle.Price, bundle.UpdatedAt, bundle.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update bundle: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return domain.ErrBundleNotFound
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM bundle_courses WHERE bundle_id = $1`, bundle.ID); err != nil {
+			return fmt.Errorf("failed to clear bundle courses: %w", err)
+		}
+
+		return insertBundleCourses(ctx, tx, bundle)
+	})
+}
+
+func (r *bundleRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM bundles WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete bundle: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrBundleNotFound
+	}
+
+	return nil
+}
+
+// List returns bundles newest first, limited to one instructor's when
+// instructorID is set.
+func (r *bundleRepository) List(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Bundle, int, error) {
+	offset := (page - 1) * pageSize
+
+	where := ``
+	args := []any{}
+	if instructorID != "" {
+		where = `WHERE instructor_id = $1`
+		args = append(args, instructorID)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM bundles `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count bundles: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, title, description, instructor_id, price, created_at, updated_at
+		FROM bundles %s
+		ORDER BY created_at DESC LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, pageSize, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list bundles: %w", err)
+	}
+	defer rows.Close()
+
+	var bundles []*domain.Bundle
+	for rows.Next() {
+		var bundle domain.Bundle
+		if err := rows.Scan(
+			&bundle.ID, &bundle.Title, &bundle.Description, &bundle.InstructorID,
+			&bundle.Price, &bundle.CreatedAt, &bundle.UpdatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan bundle: %w", err)
+		}
+		bundles = append(bundles, &bundle)
+	}
+
+	if err := r.loadCourses(ctx, bundles); err != nil {
+		return nil, 0, err
+	}
+
+	return bundles, total, nil
+}
+
+func (r *bundleRepository) loadCourses(ctx context.Context, bundles []*domain.Bundle) error {
+	if len(bundles) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*domain.Bundle, len(bundles))
+	ids := make([]string, len(bundles))
+	for i, bundle := range bundles {
+		byID[bundle.ID] = bundle
+		ids[i] = bundle.ID
+	}
+
+	query := `SELECT bundle_id, course_id FROM bundle_courses WHERE bundle_id = ANY($1) ORDER BY bundle_id, position`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get bundle courses: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bundleID, courseID string
+		if err := rows.Scan(&bundleID, &courseID); err != nil {
+			return fmt.Errorf("failed to scan bundle course: %w", err)
+		}
+		byID[bundleID].CourseIDs = append(byID[bundleID].CourseIDs, courseID)
+	}
+
+	return nil
+}
+
+func insertBundleCourses(ctx context.Context, tx *sqlx.Tx, bundle *domain.Bundle) error {
+	for i, courseID := range bundle.CourseIDs {
+		query := `INSERT INTO bundle_courses (bundle_id, course_id, position) VALUES ($1, $2, $3)`
+		if _, err := tx.ExecContext(ctx, query, bundle.ID, courseID, i); err != nil {
+			return fmt.Errorf("failed to add bundle course: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *domain.Category) error
+	Get(ctx context.Context, slug string) (*domain.Category, error)
+	List(ctx context.Context) ([]*domain.Category, error)
+	Update(ctx context.Context, category *domain.Category) error
+	Delete(ctx context.Context, slug, reassignTo string) error
+}
+
+type categoryRepository struct {
+	db *database.DB
+}
+
+func NewCategoryRepository(db *database.DB) CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+const categoryColumns = `slug, parent_slug, name, sort_order, created_at, updated_at`
+
+// categorySubtreeQuery selects the slug of the category given as its only
+// parameter and those of all its descendants. The parameter is written as a
+// format verb so it can be numbered into a larger query.
+const categorySubtreeQuery = `
+	WITH RECURSIVE subtree AS (
+		SELECT slug FROM categories WHERE slug = $%[1]d
+		UNION ALL
+		SELECT c.slug FROM categories c JOIN subtree s ON c.parent_slug = s.slug
+	)
+	SELECT slug FROM subtree
+`
+
+func (r *categoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		if err := checkCategoryParent(ctx, tx, category.Slug, category.ParentSlug); err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO categories (`+categoryColumns+`)
+			VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)
+			ON CONFLICT (slug) DO NOTHING
+		`, category.Slug, category.ParentSlug, category.Name, category.SortOrder, category.CreatedAt, category.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create category: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return domain.ErrCategoryExists
+		}
+
+		return nil
+	})
+}
+
+func (r *categoryRepository) Get(ctx context.Context, slug string) (*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE slug = $1`
+
+	category, err := scanCategory(r.db.QueryRowContext(ctx, query, slug))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return category, nil
+}
+
+// List returns the whole tree, siblings in display order.
+func (r *categoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories ORDER BY sort_order, name`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*domain.Category
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+// Update changes the category's name, position and parent. The slug stays.
+func (r *categoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		// Two concurrent moves could each pass the cycle check and together
+		// make a loop
+		if _, err := tx.ExecContext(ctx, `LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+			return fmt.Errorf("failed to lock categories: %w", err)
+		}
+
+		if err := checkCategoryParent(ctx, tx, category.Slug, category.ParentSlug); err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE categories SET parent_slug = NULLIF($1, ''), name = $2, sort_order = $3, updated_at = $4
+			WHERE slug = $5
+		`, category.ParentSlug, category.Name, category.SortOrder, category.UpdatedAt, category.Slug)
+		if err != nil {
+			return fmt.Errorf("failed to update category: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return domain.ErrCategoryNotFound
+		}
+
+		return nil
+	})
+}
+
+// Delete removes a category without subcategories. Courses, drafts and
+// templates using it are moved to reassignTo; without one, the category must
+// be unused.
+func (r *categoryRepository) Delete(ctx context.Context, slug, reassignTo string) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var inUse bool
+		if err := tx.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM categories WHERE parent_slug = $1)`, slug,
+		).Scan(&inUse); err != nil {
+			return fmt.Errorf("failed to check subcategories: %w", err)
+		}
+		if inUse {
+			return domain.ErrCategoryInUse
+		}
+
+		if reassignTo == "" {
+			if err := tx.QueryRowContext(ctx, `
+				SELECT EXISTS (SELECT 1 FROM courses WHERE category = $1)
+					OR EXISTS (SELECT 1 FROM course_revisions WHERE content #>> '{details,category}' = $1)
+					OR EXISTS (SELECT 1 FROM course_templates WHERE content #>> '{details,category}' = $1)
+			`, slug).Scan(&inUse); err != nil {
+				return fmt.Errorf("failed to check category usage: %w", err)
+			}
+			if inUse {
+				return domain.ErrCategoryInUse
+			}
+		} else if err := reassignCategory(ctx, tx, slug, reassignTo); err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE slug = $1`, slug)
+		if err != nil {
+			return fmt.Errorf("failed to delete category: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return domain.ErrCategoryNotFound
+		}
+
+		return nil
+	})
+}
+
+// reassignCategory moves everything in category from to category to,
+// including past revisions so a rollback can't bring from back. Drafts get a
+// new version so an editor holding the old one has to reload.
+func reassignCategory(ctx context.Context, tx *sqlx.Tx, from, to string) error {
+	// Lock the target so it can't be deleted before we commit
+	err := tx.QueryRowContext(ctx, `SELECT slug FROM categories WHERE slug = $1 FOR SHARE`, to).Scan(&to)
+	if err == sql.ErrNoRows {
+		return domain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock category: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE courses SET category = $1 WHERE category = $2`, to, from); err != nil {
+		return fmt.Errorf("failed to reassign courses: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE course_revisions
+		SET content = jsonb_set(content, '{details,category}', to_jsonb($1::text)), version = version + 1
+		WHERE content #>> '{details,category}' = $2
+	`, to, from); err != nil {
+		return fmt.Errorf("failed to reassign revisions: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE course_templates
+		SET content = jsonb_set(content, '{details,category}', to_jsonb($1::text))
+		WHERE content #>> '{details,category}' = $2
+	`, to, from); err != nil {
+		return fmt.Errorf("failed to reassign templates: %w", err)
+	}
+
+	return nil
+}
+
+// checkCategoryParent makes sure parent exists and isn't slug or one of its
+// descendants.
+func checkCategoryParent(ctx context.Context, tx *sqlx.Tx, slug, parent string) error {
+	if parent == "" {
+		return nil
+	}
+
+	var ancestors, loops int
+	err := tx.QueryRowContext(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT slug, parent_slug FROM categories WHERE slug = $1
+			UNION ALL
+			SELECT c.slug, c.parent_slug FROM categories c JOIN ancestors a ON c.slug = a.parent_slug
+		)
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE slug = $2) FROM ancestors
+	`, parent, slug).Scan(&ancestors, &loops)
+	if err != nil {
+		return fmt.Errorf("failed to check parent category: %w", err)
+	}
+
+	if ancestors == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	if loops > 0 {
+		return domain.ErrCategoryCycle
+	}
+
+	return nil
+}
+
+func scanCategory(row rowScanner) (*domain.Category, error) {
+	var category domain.Category
+	var parentSlug sql.NullString
+
+	if err := row.Scan(
+		&category.Slug, &parentSlug, &category.Name, &category.SortOrder,
+		&category.CreatedAt, &category.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	category.ParentSlug = parentSlug.String
+	return &category, nil
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type CollaboratorRepository interface {
+	Invite(ctx context.Context, collaborator *domain.Collaborator) error
+	Get(ctx context.Context, courseID, userID string) (*domain.Collaborator, error)
+	ListByCourse(ctx context.Context, courseID string) ([]*domain.Collaborator, error)
+	ListByUser(ctx context.Context, userID string) ([]*domain.Collaborator, error)
+	Accept(ctx context.Context, courseID, userID string, at time.Time) error
+	UpdateRole(ctx context.Context, courseID, userID string, role domain.CourseRole) error
+	Remove(ctx context.Context, courseID, userID string) error
+	TransferOwnership(ctx context.Context, courseID, fromUserID, toUserID string, at time.Time) error
+}
+
+type collaboratorRepository struct {
+	db *database.DB
+}
+
+func NewCollaboratorRepository(db *database.DB) CollaboratorRepository {
+	return &collaboratorRepository{db: db}
+}
+
+const collaboratorColumns = `course_id, user_id, role, status, invited_by, invited_at, accepted_at`
+
+func (r *collaboratorRepository) Invite(ctx context.Context, collaborator *domain.Collaborator) error {
+	query := `
+		INSERT INTO course_collaborators (` + collaboratorColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, NULL)
+		ON CONFLICT (course_id, user_id) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		collaborator.CourseID, collaborator.UserID, collaborator.Role, collaborator.Status,
+		collaborator.InvitedBy, collaborator.InvitedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to invite collaborator: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAlreadyCollaborator
+	}
+
+	return nil
+}
+
+func (r *collaboratorRepository) Get(ctx context.Context, courseID, userID string) (*domain.Collaborator, error) {
+	query := `SELECT ` + collaboratorColumns + ` FROM course_collaborators WHERE course_id = $1 AND user_id = $2`
+
+	collaborator, err := scanCollaborator(r.db.QueryRowContext(ctx, query, courseID, userID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrCollaboratorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collaborator: %w", err)
+	}
+
+	return collaborator, nil
+}
+
+func (r *collaboratorRepository) ListByCourse(ctx context.Context, courseID string) ([]*domain.Collaborator, error) {
+	query := `SELECT ` + collaboratorColumns + ` FROM course_collaborators WHERE course_id = $1 ORDER BY invited_at ASC`
+
+	return r.list(ctx, query, courseID)
+}
+
+func (r *collaboratorRepository) ListByUser(ctx context.Context, userID string) ([]*domain.Collaborator, error) {
+	query := `SELECT ` + collaboratorColumns + ` FROM course_collaborators WHERE user_id = $1 ORDER BY invited_at DESC`
+
+	return r.list(ctx, query, userID)
+}
+
+// Accept puts a pending invitation into effect.
+func (r *collaboratorRepository) Accept(ctx context.Context, courseID, userID string, at time.Time) error {
+	query := `
+		UPDATE course_collaborators SET status = $1, accepted_at = $2
+		WHERE course_id = $3 AND user_id = $4 AND status = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		domain.CollaboratorAccepted, at, courseID, userID, domain.CollaboratorInvited,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCollaboratorNotFound
+	}
+
+	return nil
+}
+
+func (r *collaboratorRepository) UpdateRole(ctx context.Context, courseID, userID string, role domain.CourseRole) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE course_collaborators SET role = $1 WHERE course_id = $2 AND user_id = $3`,
+		role, courseID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update collaborator role: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCollaboratorNotFound
+	}
+
+	return nil
+}
+
+func (r *collaboratorRepository) Remove(ctx context.Context, courseID, userID string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM course_collaborators WHERE course_id = $1 AND user_id = $2`,
+		courseID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove collaborator: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCollaboratorNotFound
+	}
+
+	return nil
+}
+
+// TransferOwnership hands the course from its owner to one of its active
+// collaborators, who stops being a collaborator. The previous owner stays on
+// as an editor.
+func (r *collaboratorRepository) TransferOwnership(ctx context.Context, courseID, fromUserID, toUserID string, at time.Time) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		result, err := tx.ExecContext(ctx,
+			`DELETE FROM course_collaborators WHERE course_id = $1 AND user_id = $2 AND status = $3`,
+			courseID, toUserID, domain.CollaboratorAccepted,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to remove new owner from collaborators: %w", err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return domain.ErrCollaboratorNotFound
+		}
+
+		// Guard on the current owner so two concurrent transfers can't both
+		// succeed
+		result, err = tx.ExecContext(ctx, `
+			UPDATE courses
+			SET instructor_id = $1, instructor_name = COALESCE((SELECT name FROM instructor_names WHERE user_id = $1), ''),
+				updated_at = $2
+			WHERE id = $3 AND instructor_id = $4
+		`, toUserID, at, courseID, fromUserID)
+		if err != nil {
+			return fmt.Errorf("failed to transfer course: %w", err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return domain.ErrUnauthorized
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO course_collaborators (`+collaboratorColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)
+		`, courseID, fromUserID, domain.RoleEditor, domain.CollaboratorAccepted, toUserID, at); err != nil {
+			return fmt.Errorf("failed to keep previous owner as collaborator: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *collaboratorRepository) list(ctx context.Context, query string, args ...any) ([]*domain.Collaborator, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collaborators: %w", err)
+	}
+	defer rows.Close()
+
+	var collaborators []*domain.Collaborator
+	for rows.Next() {
+		collaborator, err := scanCollaborator(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan collaborator: %w", err)
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+
+	return collaborators, nil
+}
+
+func scanCollaborator(row rowScanner) (*domain.Collaborator, error) {
+	var collaborator domain.Collaborator
+	var acceptedAt sql.NullTime
+
+	if err := row.Scan(
+		&collaborator.CourseID, &collaborator.UserID, &collaborator.Role, &collaborator.Status,
+		&collaborator.InvitedBy, &collaborator.InvitedAt, &acceptedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if acceptedAt.Valid {
+		collaborator.AcceptedAt = &acceptedAt.Time
+	}
+	return &collaborator, nil
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type CouponRepository interface {
+	Create(ctx context.Context, coupon *domain.Coupon) error
+	GetByID(ctx context.Context, id string) (*domain.Coupon, error)
+	GetByCode(ctx context.Context, code string) (*domain.Coupon, error)
+	ListByCourse(ctx context.Context, courseID string) ([]*domain.Coupon, error)
+	Delete(ctx context.Context, id string) error
+	Redeem(ctx context.Context, code, courseID, enrollmentID, userID string, now time.Time) (*domain.Coupon, error)
+	Release(ctx context.Context, enrollmentID, userID string) error
+}
+
+type couponRepository struct {
+	db *database.DB
+}
+
+func NewCouponRepository(db *database.DB) CouponRepository {
+	return &couponRepository{db: db}
+}
+
+const couponColumns = `id, code, discount_type, discount_value, course_id, max_uses, used_count, starts_at, expires_at, created_by, created_at`
+
+func (r *couponRepository) Create(ctx context.Context, coupon *domain.Coupon) error {
+	query := `
+		INSERT INTO coupons (id, code, discount_type, discount_value, course_id, max_uses, used_count, starts_at, expires_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (code) DO NOTHING
+	`
+
+	var courseID, expiresAt any
+	if coupon.CourseID != "" {
+		courseID = coupon.CourseID
+	}
+	if coupon.ExpiresAt != nil {
+		expiresAt = *coupon.ExpiresAt
+	}
+
+	result, err := r.db.ExecContext(ctx, query,
+		coupon.ID, coupon.Code, coupon.Discount.Type, coupon.Discount.Value, courseID,
+		coupon.MaxUses, coupon.UsedCount, coupon.StartsAt, expiresAt, coupon.CreatedBy, coupon.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create coupon: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCouponExists
+	}
+
+	return nil
+}
+
+func (r *couponRepository) GetByID(ctx context.Context, id string) (*domain.Coupon, error) {
+	return r.get(ctx, `SELECT `+couponColumns+` FROM coupons WHERE id = $1`, id)
+}
+
+func (r *couponRepository) GetByCode(ctx context.Context, code string) (*domain.Coupon, error) {
+	return r.get(ctx, `SELECT `+couponColumns+` FROM coupons WHERE code = $1`, code)
+}
+
+func (r *couponRepository) get(ctx context.Context, query string, arg string) (*domain.Coupon, error) {
+	coupon, err := scanCoupon(r.db.QueryRowContext(ctx, query, arg))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coupon: %w", err)
+	}
+
+	return coupon, nil
+}
+
+// ListByCourse lists the coupons scoped to courseID, or the global ones when
+// courseID is empty.
+func (r *couponRepository) ListByCourse(ctx context.Context, courseID string) ([]*domain.Coupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupons WHERE course_id IS NULL ORDER BY created_at DESC`
+	args := []any{}
+	if courseID != "" {
+		query = `SELECT ` + couponColumns + ` FROM coupons WHERE course_id = $1 ORDER BY created_at DESC`
+		args = append(args, courseID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coupons: %w", err)
+	}
+	defer rows.Close()
+
+	var coupons []*domain.Coupon
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan coupon: %w", err)
+		}
+		coupons = append(coupons, coupon)
+	}
+
+	return coupons, nil
+}
+
+func (r *couponRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM coupons WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete coupon: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCouponNotFound
+	}
+
+	return nil
+}
+
+// Redeem uses up one redemption of the coupon for an enrollment. The coupon
+// row is locked so concurrent checkouts can't overshoot MaxUses. Redeeming
+// twice for the same enrollment only counts once.
+func (r *couponRepository) Redeem(ctx context.Context, code, courseID, enrollmentID, userID string, now time.Time) (*domain.Coupon, error) {
+	var coupon *domain.Coupon
+
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		coupon, err = scanCoupon(tx.QueryRowContext(ctx, `SELECT `+couponColumns+` FROM coupons WHERE code = $1 FOR UPDATE`, code))
+		if err == sql.ErrNoRows {
+			return domain.ErrCouponNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get coupon: %w", err)
+		}
+
+		var redeemed bool
+		if err := tx.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM coupon_redemptions WHERE enrollment_id = $1 AND coupon_id = $2)`,
+			enrollmentID, coupon.ID,
+		).Scan(&redeemed); err != nil {
+			return fmt.Errorf("failed to check coupon redemption: %w", err)
+		}
+		if redeemed {
+			return nil
+		}
+
+		if err := coupon.CheckUsable(courseID, now); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE coupons SET used_count = used_count + 1 WHERE id = $1`, coupon.ID); err != nil {
+			return fmt.Errorf("failed to redeem coupon: %w", err)
+		}
+
+		query := `
+			INSERT INTO coupon_redemptions (enrollment_id, coupon_id, user_id, course_id, redeemed_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`
+		if _, err := tx.ExecContext(ctx, query, enrollmentID, coupon.ID, userID, courseID, now); err != nil {
+			return fmt.Errorf("failed to record coupon redemption: %w", err)
+		}
+
+		coupon.UsedCount++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+// Release gives back the redemption held by an enrollment that didn't go
+// through. Releasing an enrollment with no redemption is a no-op.
+func (r *couponRepository) Release(ctx context.Context, enrollmentID, userID string) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		// Only a pending enrollment, which course-service hasn't heard of
+		// yet, or a failed one can give its use back
+		var status domain.EnrollmentStatus
+		err := tx.QueryRowContext(ctx,
+			`SELECT status FROM course_enrollments WHERE enrollment_id = $1`, enrollmentID,
+		).Scan(&status)
+		switch {
+		case err == sql.ErrNoRows:
+		case err != nil:
+			return fmt.Errorf("failed to get enrollment: %w", err)
+		case status != domain.EnrollmentFailed:
+			return domain.ErrCouponNotReleasable
+		}
+
+		var couponID string
+		err = tx.QueryRowContext(ctx,
+			`DELETE FROM coupon_redemptions WHERE enrollment_id = $1 AND user_id = $2 RETURNING coupon_id`, enrollmentID, userID,
+		).Scan(&couponID)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to release coupon redemption: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE coupons SET used_count = used_count - 1 WHERE id = $1 AND used_count > 0`, couponID); err != nil {
+			return fmt.Errorf("failed to release coupon: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func scanCoupon(row rowScanner) (*domain.Coupon, error) {
+	var coupon domain.Coupon
+	var courseID sql.NullString
+	var expiresAt sql.NullTime
+
+	if err := row.Scan(
+		&coupon.ID, &coupon.Code, &coupon.Discount.Type, &coupon.Discount.Value, &courseID,
+		&coupon.MaxUses, &coupon.UsedCount, &coupon.StartsAt, &expiresAt, &coupon.CreatedBy, &coupon.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	coupon.CourseID = courseID.String
+	if expiresAt.Valid {
+		coupon.ExpiresAt = &expiresAt.Time
+	}
+
+	return &coupon, nil
+}
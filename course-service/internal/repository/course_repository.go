@@ -3,22 +3,37 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 )
 
 type CourseRepository interface {
 	Create(ctx context.Context, course *domain.Course) error
+	CreateWithContent(ctx context.Context, course *domain.Course, content *domain.CourseContent, activities *domain.LessonActivities) error
+	GetLessonActivities(ctx context.Context, lessonIDs []string) (*domain.LessonActivities, error)
 	GetByID(ctx context.Context, id string) (*domain.Course, error)
 	Update(ctx context.Context, course *domain.Course) error
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, page, pageSize int, category *string, status *domain.CourseStatus, search *string, level *domain.CourseLevel) ([]*domain.Course, int, error)
+	Search(ctx context.Context, q domain.CourseQuery) ([]*domain.SearchHit, int, error)
+	Facets(ctx context.Context, q domain.CourseQuery) (*domain.SearchFacets, error)
 	GetByInstructor(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Course, int, error)
-	UpdateEnrolledCount(ctx context.Context, courseID string, increment int) error
+	RecordEnrollment(ctx context.Context, enrollment *domain.CourseEnrollment) error
+	ReconcileStats(ctx context.Context, courseID string) error
+	ReconcileAllStats(ctx context.Context) (int, error)
 	UpdateAverageRating(ctx context.Context, courseID string, rating float64) error
+	SetSchedule(ctx context.Context, courseID string, publishAt, archiveAt *time.Time, now time.Time) error
+	Archive(ctx context.Context, courseID string, now time.Time) error
+	ListDueForArchive(ctx context.Context, now time.Time) ([]string, error)
+	SetInstructorName(ctx context.Context, instructorID, name string, at time.Time) error
+	ListUnnamedInstructors(ctx context.Context, afterID string, limit int) ([]string, error)
+	SetDefaultLocale(ctx context.Context, courseID, locale string, at time.Time) error
 }
 
 type courseRepository struct {
@@ -29,17 +44,19 @@ func NewCourseRepository(db *database.DB) CourseRepository {
 	return &courseRepository{db: db}
 }
 
+const courseColumns = `id, title, description, instructor_id, instructor_name, thumbnail_url, status, level, price, category, tags, duration_minutes, created_at, updated_at, enrolled_count, average_rating, publish_at, archive_at, default_locale`
+
 func (r *courseRepository) Create(ctx context.Context, course *domain.Course) error {
 	query := `
-		INSERT INTO courses (id, title, description, instructor_id, thumbnail_url, status, level, price, category, tags, duration_minutes, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		INSERT INTO courses (id, title, description, instructor_id, thumbnail_url, status, level, price, category, tags, duration_minutes, created_at, updated_at, default_locale, instructor_name)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, COALESCE((SELECT name FROM instructor_names WHERE user_id = $4), ''))
 	`
 
 	_, err := r.db.ExecContext(ctx, query,
 		course.ID, course.Title, course.Description, course.InstructorID,
 		course.ThumbnailURL, course.Status, course.Level, course.Price,
 		course.Category, pq.Array(course.Tags), course.DurationMinutes,
-		course.CreatedAt, course.UpdatedAt,
+		course.CreatedAt, course.UpdatedAt, course.DefaultLocale,
 	)
 
 	if err != nil {
@@ -49,19 +66,212 @@ func (r *courseRepository) Create(ctx context.Context, course *domain.Course) er
 	return nil
 }
 
-func (r *courseRepository) GetByID(ctx context.Context, id string) (*domain.Course, error) {
-	query := `
-		SELECT id, title, description, instructor_id, thumbnail_url, status, level, price, category, tags, duration_minutes, created_at, updated_at, enrolled_count, average_rating FROM courses WHERE id = $1
+// CreateWithContent inserts a new course together with its modules and
+// lessons, and the quizzes and assignments in activities if any, all or
+// nothing.
+func (r *courseRepository) CreateWithContent(ctx context.Context, course *domain.Course, content *domain.CourseContent, activities *domain.LessonActivities) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			INSERT INTO courses (id, title, description, instructor_id, thumbnail_url, status, level, price, category, tags, duration_minutes, created_at, updated_at, default_locale, instructor_name)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, COALESCE((SELECT name FROM instructor_names WHERE user_id = $4), ''))
+		`
+
+		if _, err := tx.ExecContext(ctx, query,
+			course.ID, course.Title, course.Description, course.InstructorID,
+			course.ThumbnailURL, course.Status, course.Level, course.Price,
+			course.Category, pq.Array(course.Tags), course.DurationMinutes,
+			course.CreatedAt, course.UpdatedAt, course.DefaultLocale,
+		); err != nil {
+			return fmt.Errorf("failed to create course: %w", err)
+		}
+
+		for _, m := range content.Modules {
+			query := `
+				INSERT INTO modules (id, course_id, title, description, order_index, release_rule, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+			`
+			if _, err := tx.ExecContext(ctx, query, m.ID, course.ID, m.Title, m.Description, m.OrderIndex, releaseValue(m.Release), m.CreatedAt); err != nil {
+				return fmt.Errorf("failed to create module: %w", err)
+			}
+
+			for _, l := range m.Lessons {
+				query := `
+					INSERT INTO lessons (id, module_id, title, description, video_id, duration_seconds, order_index, is_preview, type, release_rule, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+				`
+				if _, err := tx.ExecContext(ctx, query,
+					l.ID, m.ID, l.Title, l.Description, l.VideoID,
+					l.DurationSeconds, l.OrderIndex, l.IsPreview, l.LessonType(), releaseValue(l.Release), l.CreatedAt,
+				); err != nil {
+					return fmt.Errorf("failed to create lesson: %w", err)
+				}
+			}
+		}
+
+		if activities == nil {
+			return nil
+		}
+		return createActivities(ctx, tx, activities)
+	})
+}
+
+func createActivities(ctx context.Context, tx *sqlx.Tx, activities *domain.LessonActivities) error {
+	for _, b := range activities.Banks {
+		query := `INSERT INTO question_banks (id, course_id, title, created_at) VALUES ($1, $2, $3, $4)`
+		if _, err := tx.ExecContext(ctx, query, b.ID, b.CourseID, b.Title, b.CreatedAt); err != nil {
+			return fmt.Errorf("failed to create question bank: %w", err)
+		}
+	}
+
+	for _, q := range activities.Questions {
+		query := `
+			INSERT INTO quiz_questions (` + questionColumns + `)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`
+		if _, err := tx.ExecContext(ctx, query,
+			q.ID, q.BankID, q.Type, q.Prompt, pq.Array(q.Options),
+			pq.Array(toInt64s(q.CorrectOptions)), pq.Array(q.AcceptedAnswers),
+			q.Points, q.Explanation, q.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to create question: %w", err)
+		}
+	}
+
+	for _, q := range activities.Quizzes {
+		query := `INSERT INTO lesson_quizzes (` + quizColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+		if _, err := tx.ExecContext(ctx, query,
+			q.LessonID, q.CourseID, q.BankID, q.QuestionCount, q.TimeLimitSeconds,
+			q.MaxAttempts, q.PassPercentage, q.CountsTowardCompletion, q.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to create quiz: %w", err)
+		}
+	}
+
+	for _, a := range activities.Assignments {
+		rubric, err := json.Marshal(a.Rubric)
+		if err != nil {
+			return fmt.Errorf("failed to encode rubric: %w", err)
+		}
+
+		var dueAt any
+		if a.DueAt != nil {
+			dueAt = *a.DueAt
+		}
+
+		query := `
+			INSERT INTO lesson_assignments (lesson_id, course_id, instructions, due_at, rubric, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+		if _, err := tx.ExecContext(ctx, query, a.LessonID, a.CourseID, a.Instructions, dueAt, rubric, a.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to create assignment: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetLessonActivities returns the quizzes and assignments set up on
+// lessonIDs. Banks are those of the courses the lessons belong to, whether
+// or not a quiz uses them yet.
+func (r *courseRepository) GetLessonActivities(ctx context.Context, lessonIDs []string) (*domain.LessonActivities, error) {
+	activities := &domain.LessonActivities{}
+	if len(lessonIDs) == 0 {
+		return activities, nil
+	}
+	ids := pq.Array(lessonIDs)
+
+	bankQuery := `
+		SELECT ` + bankColumns + ` FROM question_banks b
+		WHERE b.course_id IN (
+			SELECT m.course_id FROM lessons l JOIN modules m ON m.id = l.module_id WHERE l.id = ANY($1::uuid[])
+		) OR b.id IN (SELECT bank_id FROM lesson_quizzes WHERE lesson_id = ANY($1::uuid[]))
+		ORDER BY b.created_at, b.id
 	`
+	rows, err := r.db.QueryContext(ctx, bankQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question banks: %w", err)
+	}
+	defer rows.Close()
 
-	var course domain.Course
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		course.ID, &course.Title, &course.Description, &course.InstructorID,
-		&course.ThumbnailURL, &course.Status, &course.Level, &course.Price,
-		&course.Category, pq.Array(&course.Tags), &course.DurationMinutes,
-		&course.CreatedAt, &course.UpdatedAt, &course.EnrolledCount, &course.AverageRating,
+	var bankIDs []string
+	for rows.Next() {
+		bank, err := scanBank(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question bank: %w", err)
+		}
+		activities.Banks = append(activities.Banks, bank)
+		bankIDs = append(bankIDs, bank.ID)
+	}
+
+	if len(bankIDs) > 0 {
+		query := `SELECT ` + questionColumns + ` FROM quiz_questions WHERE bank_id = ANY($1::uuid[]) ORDER BY created_at, id`
+		rows, err := r.db.QueryContext(ctx, query, pq.Array(bankIDs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list questions: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			question, err := scanQuestion(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan question: %w", err)
+			}
+			activities.Questions = append(activities.Questions, question)
+		}
+	}
+
+	quizRows, err := r.db.QueryContext(ctx, `SELECT `+quizColumns+` FROM lesson_quizzes WHERE lesson_id = ANY($1::uuid[])`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quizzes: %w", err)
+	}
+	defer quizRows.Close()
+
+	for quizRows.Next() {
+		var quiz domain.Quiz
+		if err := quizRows.Scan(
+			&quiz.LessonID, &quiz.CourseID, &quiz.BankID, &quiz.QuestionCount, &quiz.TimeLimitSeconds,
+			&quiz.MaxAttempts, &quiz.PassPercentage, &quiz.CountsTowardCompletion, &quiz.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan quiz: %w", err)
+		}
+		activities.Quizzes = append(activities.Quizzes, &quiz)
+	}
+
+	assignmentRows, err := r.db.QueryContext(ctx,
+		`SELECT lesson_id, course_id, instructions, due_at, rubric, updated_at FROM lesson_assignments WHERE lesson_id = ANY($1::uuid[])`,
+		ids,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+	defer assignmentRows.Close()
+
+	for assignmentRows.Next() {
+		var assignment domain.Assignment
+		var dueAt sql.NullTime
+		var rubric []byte
 
+		if err := assignmentRows.Scan(
+			&assignment.LessonID, &assignment.CourseID, &assignment.Instructions, &dueAt, &rubric, &assignment.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan assignment: %w", err)
+		}
+		if err := json.Unmarshal(rubric, &assignment.Rubric); err != nil {
+			return nil, fmt.Errorf("failed to decode rubric: %w", err)
+		}
+		if dueAt.Valid {
+			assignment.DueAt = &dueAt.Time
+		}
+		activities.Assignments = append(activities.Assignments, &assignment)
+	}
+
+	return activities, nil
+}
+
+func (r *courseRepository) GetByID(ctx context.Context, id string) (*domain.Course, error) {
+	query := `SELECT ` + courseColumns + ` FROM courses WHERE id = $1`
+
+	course, err := scanCourse(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrCourseNotFound
 	}
@@ -69,7 +279,7 @@ func (r *courseRepository) GetByID(ctx context.Context, id string) (*domain.Cour
 		return nil, fmt.Errorf("failed to get course: %w", err)
 	}
 
-	return &course, nil
+	return course, nil
 }
 
 func (r *courseRepository) Update(ctx context.Context, course *domain.Course) error {
@@ -111,71 +321,147 @@ func (r *courseRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *courseRepository) List(ctx context.Context, page, pageSize int, category *string, status *domain.CourseStatus, search *string, level *domain.CourseLevel) ([]*domain.Course, int, error) {
-	offset := (page - 1) * pageSize
-
-	query := `
-		SELECT id,title,description, instructor_id,thumbnail_url, status, level, price, category, tags, duration_minutes, created_at, updated_at, enrolled_count, average_rating FROM courses WHERE 1=1
-	`
-	countQuery := `SELECT COUNT(*) fROM courses where 1-1`
-	args := []any{}
-	argCount := 1
-
-	if category != nil {
-		query += fmt.Sprintf(" AND category = %d", argCount)
-		countQuery += fmt.Sprintf(" AND category = %d", argCount)
-		args = append(args, *category)
-		argCount++
-	}
-	if status != nil {
-		query += fmt.Sprintf(" AND status = %d", argCount)
-		countQuery += fmt.Sprintf(" AND status = %d", argCount)
-		args = append(args, *status)
-		argCount++
-	}
-	if level != nil {
-		query += fmt.Sprintf(" AND level = $%d", argCount)
-		countQuery += fmt.Sprintf(" AND level = $%d", argCount)
-		args = append(args, *level)
-		argCount++
-	}
-	if search != nil {
-		query += fmt.Sprintf("AND (title ILIKE $%d OR description ILIKE $%d)", argCount, argCount)
-		countQuery += fmt.Sprintf("AND (title ILIKE $%d OR description ILIKR $%d)", argCount, argCount)
-		searchItem := "%" + *search + "%"
-		args = append(args, searchItem)
-		argCount++
-	}
+// Search lists courses matching q, best match first when there is search
+// text and newest first otherwise. Archived courses stay reachable by ID for
+// enrolled students but are never listed.
+func (r *courseRepository) Search(ctx context.Context, q domain.CourseQuery) ([]*domain.SearchHit, int, error) {
+	where, args := searchFilter(q, "")
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM courses WHERE `+where, args...).Scan(&total); err != nil {
 		return nil, 0, fmt.Errorf("failed to count courses: %w", err)
 	}
 
-	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, pageSize, offset)
+	// Search text is always $1 when present
+	ranking := `0::float8 AS rank, title AS title_highlight, '' AS snippet`
+	order := `created_at DESC`
+	if q.Text != "" {
+		ranking = `
+			ts_rank(search_vector, websearch_to_tsquery('english', $1)) + similarity(title, $1) * 0.5 AS rank,
+			ts_headline('english', title, websearch_to_tsquery('english', $1), 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS title_highlight,
+			ts_headline('english', description, websearch_to_tsquery('english', $1), 'MaxFragments=2, MaxWords=25, MinWords=8, StartSel=<mark>, StopSel=</mark>') AS snippet
+		`
+		order = `rank DESC, enrolled_count DESC, created_at DESC`
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, %s
+		FROM courses WHERE %s
+		ORDER BY %s LIMIT $%d OFFSET $%d
+	`, courseColumns, ranking, where, order, len(args)+1, len(args)+2)
+	args = append(args, q.PageSize, (q.Page-1)*q.PageSize)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list courses: %w", err)
+		return nil, 0, fmt.Errorf("failed to search courses: %w", err)
 	}
 	defer rows.Close()
 
-	var courses []*domain.Course
+	var hits []*domain.SearchHit
 	for rows.Next() {
-		var course domain.Course
-		if err := rows.Scan(
-			&course.ID, &course.Title, &course.Description, &course.InstructorID,
-			&course.ThumbnailURL, &course.Status, &course.Level, &course.Price,
-			&course.Category, pq.Array(&course.Tags), &course.DurationMinutes,
-			&course.CreatedAt, &course.UpdatedAt, &course.EnrolledCount, &course.AverageRating,
-		); err != nil {
+		var hit domain.SearchHit
+		course, err := scanCourse(extraScanner{row: rows, extra: []any{&hit.Rank, &hit.TitleHighlight, &hit.Snippet}})
+		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan course: %w", err)
 		}
-		courses = append(courses, &course)
+		hit.Course = course
+		hits = append(hits, &hit)
 	}
 
-	return courses, total, nil
+	return hits, total, nil
+}
+
+// Facets counts the courses matching q by category, level and price band.
+func (r *courseRepository) Facets(ctx context.Context, q domain.CourseQuery) (*domain.SearchFacets, error) {
+	var facets domain.SearchFacets
+	var err error
+
+	if facets.Categories, err = r.facet(ctx, q, facetCategory, `category`); err != nil {
+		return nil, err
+	}
+	if facets.Levels, err = r.facet(ctx, q, facetLevel, `level`); err != nil {
+		return nil, err
+	}
+	if facets.PriceBands, err = r.facet(ctx, q, facetPriceBand, priceBandExpr); err != nil {
+		return nil, err
+	}
+
+	return &facets, nil
+}
+
+func (r *courseRepository) facet(ctx context.Context, q domain.CourseQuery, name, expr string) ([]domain.FacetCount, error) {
+	where, args := searchFilter(q, name)
+	query := fmt.Sprintf(`
+		SELECT %s AS value, COUNT(*) FROM courses WHERE %s
+		GROUP BY value ORDER BY COUNT(*) DESC, value
+	`, expr, where)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s facet: %w", name, err)
+	}
+	defer rows.Close()
+
+	var counts []domain.FacetCount
+	for rows.Next() {
+		var count domain.FacetCount
+		if err := rows.Scan(&count.Value, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan %s facet: %w", name, err)
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, nil
+}
+
+const (
+	facetCategory  = "category"
+	facetLevel     = "level"
+	facetPriceBand = "price_band"
+)
+
+const priceBandExpr = `CASE
+	WHEN price = 0 THEN 'FREE'
+	WHEN price < 20 THEN 'UNDER_20'
+	WHEN price < 50 THEN '20_TO_50'
+	WHEN price < 100 THEN '50_TO_100'
+	ELSE 'OVER_100' END`
+
+// searchFilter builds the WHERE clause for q, leaving out the filter for the
+// facet named skip so the facet counts every choice it offers.
+func searchFilter(q domain.CourseQuery, skip string) (string, []any) {
+	clauses := []string{`status <> 'ARCHIVED'`}
+	args := []any{}
+
+	add := func(clause string, arg any) {
+		args = append(args, arg)
+		clauses = append(clauses, fmt.Sprintf(clause, len(args)))
+	}
+
+	if q.Text != "" {
+		add(`(search_vector @@ websearch_to_tsquery('english', $%[1]d) OR title %% $%[1]d OR $%[1]d <%% title)`, q.Text)
+	}
+	if q.Status != nil {
+		add(`status = $%d`, *q.Status)
+	}
+	if q.Category != nil && skip != facetCategory {
+		add(`category IN (`+categorySubtreeQuery+`)`, *q.Category)
+	}
+	if q.Language != nil {
+		add(`(default_locale = $%[1]d OR default_locale LIKE $%[1]d || '-%%' OR EXISTS (
+			SELECT 1 FROM course_translations t
+			WHERE t.course_id = courses.id AND t.entity_id = courses.id AND t.field = 'title'
+				AND (t.locale = $%[1]d OR t.locale LIKE $%[1]d || '-%%')
+		))`, *q.Language)
+	}
+	if q.Level != nil && skip != facetLevel {
+		add(`level = $%d`, *q.Level)
+	}
+	if q.PriceBand != nil && skip != facetPriceBand {
+		add(`(`+priceBandExpr+`) = $%d`, *q.PriceBand)
+	}
+
+	return strings.Join(clauses, " AND "), args
 }
 
 func (r *courseRepository) GetByInstructor(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Course, int, error) {
@@ -188,8 +474,7 @@ func (r *courseRepository) GetByInstructor(ctx context.Context, instructorID str
 	}
 
 	query := `
-		SELECT id, title, description, instructor_id, thumbnail_url, status, level, price,
-		    category, tags, duration_minutes, created_at, updated_at, enrolled_count, average_rating
+		SELECT ` + courseColumns + `
 		FROM courses WHERE instructor_id = $1
 		ORDER BY created_at DESC LIMIT $2 OFFSET $3
 	`
@@ -202,31 +487,79 @@ func (r *courseRepository) GetByInstructor(ctx context.Context, instructorID str
 
 	var courses []*domain.Course
 	for rows.Next() {
-		var course domain.Course
-		if err := rows.Scan(
-			&course.ID, &course.Title, &course.Description, &course.InstructorID,
-			&course.ThumbnailURL, &course.Status, &course.Level, &course.Price,
-			&course.Category, pq.Array(&course.Tags), &course.DurationMinutes,
-			&course.CreatedAt, &course.UpdatedAt, &course.EnrolledCount, &course.AverageRating,
-		); err != nil {
+		course, err := scanCourse(rows)
+		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan course: %w", err)
 		}
-		courses = append(courses, &course)
+		courses = append(courses, course)
 	}
 
 	return courses, total, nil
 }
 
-func (r *courseRepository) UpdateEnrolledCount(ctx context.Context, courseID string, increment int) error {
-	query := `
-		UPDATE courses
-		SET enrolled_count = enrolled_count + $1
-		WHERE id = $2
-	`
+// RecordEnrollment applies an enrollment event and moves enrolled_count by
+// however much it changed. Events are deduplicated by enrollment ID: an
+// enrollment is counted once when it becomes active and uncounted once when
+// it fails or is cancelled, and one that failed or was cancelled stays so.
+func (r *courseRepository) RecordEnrollment(ctx context.Context, enrollment *domain.CourseEnrollment) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO course_enrollments (enrollment_id, course_id, user_id, status, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (enrollment_id) DO NOTHING
+		`, enrollment.EnrollmentID, enrollment.CourseID, enrollment.UserID, enrollment.Status, enrollment.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to record enrollment: %w", err)
+		}
+
+		delta := 0
+		if rows, _ := result.RowsAffected(); rows > 0 {
+			if enrollment.Counts() {
+				delta = 1
+			}
+		} else if !enrollment.Counts() {
+			result, err := tx.ExecContext(ctx,
+				`UPDATE course_enrollments SET status = $1, updated_at = $2 WHERE enrollment_id = $3 AND status = $4`,
+				enrollment.Status, enrollment.UpdatedAt, enrollment.EnrollmentID, domain.EnrollmentActive,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update enrollment: %w", err)
+			}
+			if rows, _ := result.RowsAffected(); rows > 0 {
+				delta = -1
+			}
+		}
+
+		if delta == 0 {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE courses SET enrolled_count = GREATEST(enrolled_count + $1, 0) WHERE id = $2`,
+			delta, enrollment.CourseID,
+		); err != nil {
+			return fmt.Errorf("failed to update enrolled count: %w", err)
+		}
+
+		return nil
+	})
+}
 
-	result, err := r.db.ExecContext(ctx, query, increment, courseID)
+// reconcileStatsQuery recomputes duration_minutes and enrolled_count from the
+// live lessons and the recorded enrollments.
+const reconcileStatsQuery = `
+	UPDATE courses c
+	SET duration_minutes = (` + courseDurationQuery + `),
+		enrolled_count = (
+			SELECT COUNT(*) FROM course_enrollments e
+			WHERE e.course_id = c.id AND e.status = $1
+		)
+`
+
+func (r *courseRepository) ReconcileStats(ctx context.Context, courseID string) error {
+	result, err := r.db.ExecContext(ctx, reconcileStatsQuery+` WHERE c.id = $2`, domain.EnrollmentActive, courseID)
 	if err != nil {
-		return fmt.Errorf("failed to update enrolled count: %w", err)
+		return fmt.Errorf("failed to reconcile course stats: %w", err)
 	}
 
 	rows, _ := result.RowsAffected()
@@ -237,6 +570,17 @@ func (r *courseRepository) UpdateEnrolledCount(ctx context.Context, courseID str
 	return nil
 }
 
+// ReconcileAllStats reconciles every course and returns how many there were.
+func (r *courseRepository) ReconcileAllStats(ctx context.Context) (int, error) {
+	result, err := r.db.ExecContext(ctx, reconcileStatsQuery, domain.EnrollmentActive)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reconcile course stats: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	return int(rows), nil
+}
+
 func (r *courseRepository) UpdateAverageRating(ctx context.Context, courseID string, rating float64) error {
 	query := `
 		UPDATE courses
@@ -256,3 +600,206 @@ func (r *courseRepository) UpdateAverageRating(ctx context.Context, courseID str
 
 	return nil
 }
+
+func (r *courseRepository) SetSchedule(ctx context.Context, courseID string, publishAt, archiveAt *time.Time, now time.Time) error {
+	query := `UPDATE courses SET publish_at = $1, archive_at = $2, updated_at = $3 WHERE id = $4`
+
+	var publish, archive any
+	if publishAt != nil {
+		publish = *publishAt
+	}
+	if archiveAt != nil {
+		archive = *archiveAt
+	}
+
+	result, err := r.db.ExecContext(ctx, query, publish, archive, now, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to set course schedule: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCourseNotFound
+	}
+
+	return nil
+}
+
+// Archive takes a published course off sale. Only published courses can be
+// archived, so two schedulers racing on the same course can't both win.
+func (r *courseRepository) Archive(ctx context.Context, courseID string, now time.Time) error {
+	query := `UPDATE courses SET status = $1, updated_at = $2 WHERE id = $3 AND status = $4`
+
+	result, err := r.db.ExecContext(ctx, query, domain.StatusArchived, now, courseID, domain.StatusPublished)
+	if err != nil {
+		return fmt.Errorf("failed to archive course: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrNotPublished
+	}
+
+	return nil
+}
+
+// ListDueForArchive returns published courses whose archive time has passed.
+func (r *courseRepository) ListDueForArchive(ctx context.Context, now time.Time) ([]string, error) {
+	query := `SELECT id FROM courses WHERE status = $1 AND archive_at <= $2 ORDER BY archive_at`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.StatusPublished, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses due for archive: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan course id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// ListUnnamedInstructors returns up to limit instructors, in ID order after
+// afterID, whose name hasn't been recorded yet.
+func (r *courseRepository) ListUnnamedInstructors(ctx context.Context, afterID string, limit int) ([]string, error) {
+	query := `
+		SELECT DISTINCT c.instructor_id FROM courses c
+		WHERE c.instructor_id > $1
+		AND NOT EXISTS (SELECT 1 FROM instructor_names n WHERE n.user_id = c.instructor_id)
+		ORDER BY c.instructor_id LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unnamed instructors: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan instructor id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// SetInstructorName records the display name searched for an instructor's
+// courses. Updates older than the stored name are ignored, since events can
+// arrive out of order.
+func (r *courseRepository) SetInstructorName(ctx context.Context, instructorID, name string, at time.Time) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			INSERT INTO instructor_names (user_id, name, updated_at) VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) DO UPDATE SET name = EXCLUDED.name, updated_at = EXCLUDED.updated_at
+			WHERE instructor_names.updated_at <= EXCLUDED.updated_at
+		`
+
+		result, err := tx.ExecContext(ctx, query, instructorID, name, at)
+		if err != nil {
+			return fmt.Errorf("failed to save instructor name: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE courses SET instructor_name = $1 WHERE instructor_id = $2`, name, instructorID); err != nil {
+			return fmt.Errorf("failed to update course instructor name: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// extraScanner scans columns selected after the course columns into extra.
+type extraScanner struct {
+	row   rowScanner
+	extra []any
+}
+
+func (s extraScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
+
+func (r *courseRepository) SetDefaultLocale(ctx context.Context, courseID, locale string, at time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE courses SET default_locale = $1, updated_at = $2 WHERE id = $3`,
+		locale, at, courseID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set default locale: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrCourseNotFound
+	}
+
+	return nil
+}
+
+// courseDurationQuery is the running time of course c's live lessons,
+// rounded up to whole minutes.
+const courseDurationQuery = `
+	SELECT (COALESCE(SUM(l.duration_seconds), 0) + 59) / 60
+	FROM lessons l JOIN modules m ON m.id = l.module_id
+	WHERE m.course_id = c.id
+`
+
+// syncCourseDuration recomputes the course's duration_minutes. Anything that
+// adds, changes or removes live lessons calls it in the same transaction.
+func syncCourseDuration(ctx context.Context, tx *sqlx.Tx, courseID string) error {
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE courses c SET duration_minutes = (`+courseDurationQuery+`) WHERE c.id = $1`,
+		courseID,
+	); err != nil {
+		return fmt.Errorf("failed to update course duration: %w", err)
+	}
+	return nil
+}
+
+// syncModuleCourseDuration is syncCourseDuration for the course moduleID
+// belongs to.
+func syncModuleCourseDuration(ctx context.Context, tx *sqlx.Tx, moduleID string) error {
+	var courseID string
+	if err := tx.QueryRowContext(ctx, `SELECT course_id FROM modules WHERE id = $1`, moduleID).Scan(&courseID); err != nil {
+		return fmt.Errorf("failed to get module course: %w", err)
+	}
+	return syncCourseDuration(ctx, tx, courseID)
+}
+
+func scanCourse(row rowScanner) (*domain.Course, error) {
+	var course domain.Course
+	var publishAt, archiveAt sql.NullTime
+
+	if err := row.Scan(
+		&course.ID, &course.Title, &course.Description, &course.InstructorID, &course.InstructorName,
+		&course.ThumbnailURL, &course.Status, &course.Level, &course.Price,
+		&course.Category, pq.Array(&course.Tags), &course.DurationMinutes,
+		&course.CreatedAt, &course.UpdatedAt, &course.EnrolledCount, &course.AverageRating,
+		&publishAt, &archiveAt, &course.DefaultLocale,
+	); err != nil {
+		return nil, err
+	}
+	course.Locale = course.DefaultLocale
+
+	if publishAt.Valid {
+		course.PublishAt = &publishAt.Time
+	}
+	if archiveAt.Valid {
+		course.ArchiveAt = &archiveAt.Time
+	}
+
+	return &course, nil
+}
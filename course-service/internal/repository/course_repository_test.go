@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+)
+
+func TestSearchFilter(t *testing.T) {
+	category := "programming"
+	level := domain.LevelBeginner
+	band := domain.PriceUnder20
+	query := domain.CourseQuery{Text: "golang", Category: &category, Level: &level, PriceBand: &band}
+
+	tests := []struct {
+		name        string
+		q           domain.CourseQuery
+		skip        string
+		wantArgs    []any
+		wantClauses []string
+		notClauses  []string
+	}{
+		{
+			name:        "no filters lists everything but archived courses",
+			wantClauses: []string{`status <> 'ARCHIVED'`},
+			notClauses:  []string{"$1"},
+		},
+		{
+			name:     "search text is $1 and filters follow in order",
+			q:        query,
+			wantArgs: []any{"golang", category, level, band},
+			wantClauses: []string{
+				`websearch_to_tsquery('english', $1)`,
+				`title % $1`,
+				`$1 <% title`,
+				`WHERE slug = $2`,
+				`level = $3`,
+				`) = $4`,
+			},
+		},
+		{
+			name:        "a facet ignores its own filter",
+			q:           query,
+			skip:        facetLevel,
+			wantArgs:    []any{"golang", category, band},
+			wantClauses: []string{`WHERE slug = $2`, `) = $3`},
+			notClauses:  []string{`level =`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args := searchFilter(tt.q, tt.skip)
+
+			if len(args) != len(tt.wantArgs) {
+				t.Fatalf("args = %v, want %v", args, tt.wantArgs)
+			}
+			for i := range args {
+				if args[i] != tt.wantArgs[i] {
+					t.Errorf("args[%d] = %v, want %v", i, args[i], tt.wantArgs[i])
+				}
+			}
+			if strings.Contains(where, "%!") || strings.Contains(where, "%d") {
+				t.Errorf("WHERE clause has unfilled placeholders: %s", where)
+			}
+			for _, clause := range tt.wantClauses {
+				if !strings.Contains(where, clause) {
+					t.Errorf("WHERE clause is missing %q: %s", clause, where)
+				}
+			}
+			for _, clause := range tt.notClauses {
+				if strings.Contains(where, clause) {
+					t.Errorf("WHERE clause has %q: %s", clause, where)
+				}
+			}
+		})
+	}
+}
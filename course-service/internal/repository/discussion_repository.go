@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+)
+
+// DiscussionRepository stores discussion threads, their replies and upvotes.
+// Threads and replies are loaded for a viewer, whose own upvotes are flagged.
+type DiscussionRepository interface {
+	CreateThread(ctx context.Context, thread *domain.Thread) error
+	GetThread(ctx context.Context, id, viewerID string) (*domain.Thread, error)
+	ListThreads(ctx context.Context, courseID, lessonID string, includeHidden bool, viewerID string, page, pageSize int) ([]*domain.Thread, int, error)
+	UpdateThread(ctx context.Context, thread *domain.Thread) error
+	CreateReply(ctx context.Context, reply *domain.Reply) error
+	GetReply(ctx context.Context, id, viewerID string) (*domain.Reply, error)
+	ListReplies(ctx context.Context, threadID, viewerID string) ([]*domain.Reply, error)
+	SetReplyHidden(ctx context.Context, id string, hidden bool) error
+	Vote(ctx context.Context, threadID, targetID, userID string, upvote bool) (int, error)
+}
+
+type discussionRepository struct {
+	db *database.DB
+}
+
+func NewDiscussionRepository(db *database.DB) DiscussionRepository {
+	return &discussionRepository{db: db}
+}
+
+const (
+	threadColumns = `t.id, t.course_id, t.lesson_id, t.author_id, t.title, t.body, t.pinned, t.locked, t.hidden,
+		t.answer_reply_id, t.upvotes, t.reply_count, t.last_activity_at, t.created_at, t.updated_at`
+	replyColumns = `r.id, r.thread_id, r.parent_id, r.author_id, r.body, r.hidden, r.upvotes, r.created_at`
+)
+
+// upvotedBy selects whether the user bound to the placeholder numbered param
+// has upvoted the thread or reply aliased as alias. It goes after the
+// thread or reply columns.
+func upvotedBy(alias string, param int) string {
+	return fmt.Sprintf(`EXISTS (SELECT 1 FROM discussion_votes v WHERE v.target_id = %s.id AND v.user_id = $%d)`, alias, param)
+}
+
+func (r *discussionRepository) CreateThread(ctx context.Context, thread *domain.Thread) error {
+	query := `
+		INSERT INTO discussion_threads (id, course_id, lesson_id, author_id, title, body, last_activity_at, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		thread.ID, thread.CourseID, thread.LessonID, thread.AuthorID, thread.Title, thread.Body,
+		thread.LastActivityAt, thread.CreatedAt, thread.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create discussion thread: %w", err)
+	}
+
+	return nil
+}
+
+func (r *discussionRepository) GetThread(ctx context.Context, id, viewerID string) (*domain.Thread, error) {
+	query := `SELECT ` + threadColumns + `, ` + upvotedBy("t", 2) + ` FROM discussion_threads t WHERE t.id = $1`
+
+	thread, err := scanThread(r.db.QueryRowContext(ctx, query, id, viewerID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrThreadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get discussion thread: %w", err)
+	}
+
+	return thread, nil
+}
+
+// ListThreads returns the course's threads, or with a lessonID those about
+// that lesson, pinned ones first and then by latest activity.
+func (r *discussionRepository) ListThreads(ctx context.Context, courseID, lessonID string, includeHidden bool, viewerID string, page, pageSize int) ([]*domain.Thread, int, error) {
+	offset := (page - 1) * pageSize
+
+	where := `t.course_id = $1`
+	args := []any{courseID}
+	if lessonID != "" {
+		args = append(args, lessonID)
+		where += fmt.Sprintf(` AND t.lesson_id = $%d`, len(args))
+	}
+	if !includeHidden {
+		where += ` AND NOT t.hidden`
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM discussion_threads t WHERE `+where, args...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count discussion threads: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, %s FROM discussion_threads t WHERE %s
+		ORDER BY t.pinned DESC, t.last_activity_at DESC LIMIT $%d OFFSET $%d
+	`, threadColumns, upvotedBy("t", len(args)+3), where, len(args)+1, len(args)+2)
+	args = append(args, pageSize, offset, viewerID)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list discussion threads: %w", err)
+	}
+	defer rows.Close()
+
+	var threads []*domain.Thread
+	for rows.Next() {
+		thread, err := scanThread(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan discussion thread: %w", err)
+		}
+		threads = append(threads, thread)
+	}
+
+	return threads, total, nil
+}
+
+// UpdateThread saves the thread's moderation state and answer.
+func (r *discussionRepository) UpdateThread(ctx context.Context, thread *domain.Thread) error {
+	query := `
+		UPDATE discussion_threads
+		SET pinned = $1, locked = $2, hidden = $3, answer_reply_id = NULLIF($4, '')::uuid, updated_at = $5
+		WHERE id = $6
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		thread.Pinned, thread.Locked, thread.Hidden, thread.AnswerReplyID, thread.UpdatedAt, thread.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update discussion thread: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrThreadNotFound
+	}
+
+	return nil
+}
+
+// CreateReply saves the reply and bumps the thread's activity. The thread is
+// locked while it does, so a reply can't slip in as the thread is locked.
+func (r *discussionRepository) CreateReply(ctx context.Context, reply *domain.Reply) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var locked bool
+		err := tx.QueryRowContext(ctx,
+			`SELECT locked FROM discussion_threads WHERE id = $1 FOR UPDATE`, reply.ThreadID,
+		).Scan(&locked)
+		if err == sql.ErrNoRows {
+			return domain.ErrThreadNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock discussion thread: %w", err)
+		}
+		if locked {
+			return domain.ErrThreadLocked
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO discussion_replies (id, thread_id, parent_id, author_id, body, created_at)
+			VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6)
+		`, reply.ID, reply.ThreadID, reply.ParentID, reply.AuthorID, reply.Body, reply.CreatedAt); err != nil {
+			return fmt.Errorf("failed to create discussion reply: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE discussion_threads SET reply_count = reply_count + 1, last_activity_at = $1 WHERE id = $2`,
+			reply.CreatedAt, reply.ThreadID,
+		); err != nil {
+			return fmt.Errorf("failed to update discussion thread activity: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *discussionRepository) GetReply(ctx context.Context, id, viewerID string) (*domain.Reply, error) {
+	query := `SELECT ` + replyColumns + `, ` + upvotedBy("r", 2) + ` FROM discussion_replies r WHERE r.id = $1`
+
+	reply, err := scanReply(r.db.QueryRowContext(ctx, query, id, viewerID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrReplyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get discussion reply: %w", err)
+	}
+
+	return reply, nil
+}
+
+// ListReplies returns every reply in the thread, oldest first. Nesting is
+// left to the caller through each reply's ParentID.
+func (r *discussionRepository) ListReplies(ctx context.Context, threadID, viewerID string) ([]*domain.Reply, error) {
+	query := `
+		SELECT ` + replyColumns + `, ` + upvotedBy("r", 2) + ` FROM discussion_replies r
+		WHERE r.thread_id = $1
+		ORDER BY r.created_at, r.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, threadID, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list discussion replies: %w", err)
+	}
+	defer rows.Close()
+
+	var replies []*domain.Reply
+	for rows.Next() {
+		reply, err := scanReply(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan discussion reply: %w", err)
+		}
+		replies = append(replies, reply)
+	}
+
+	return replies, nil
+}
+
+func (r *discussionRepository) SetReplyHidden(ctx context.Context, id string, hidden bool) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE discussion_replies SET hidden = $1 WHERE id = $2`, hidden, id)
+	if err != nil {
+		return fmt.Errorf("failed to update discussion reply: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrReplyNotFound
+	}
+
+	return nil
+}
+
+// Vote adds or withdraws userID's upvote on the thread, or on one of its
+// replies when targetID is a reply, and returns the target's upvotes.
+// Voting the same way twice changes nothing.
+func (r *discussionRepository) Vote(ctx context.Context, threadID, targetID, userID string, upvote bool) (int, error) {
+	table, notFound := "discussion_threads", domain.ErrThreadNotFound
+	if targetID != threadID {
+		table, notFound = "discussion_replies", domain.ErrReplyNotFound
+	}
+
+	var upvotes int
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var result sql.Result
+		var err error
+		if upvote {
+			result, err = tx.ExecContext(ctx, `
+				INSERT INTO discussion_votes (target_id, user_id, thread_id)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (target_id, user_id) DO NOTHING
+			`, targetID, userID, threadID)
+		} else {
+			result, err = tx.ExecContext(ctx,
+				`DELETE FROM discussion_votes WHERE target_id = $1 AND user_id = $2`, targetID, userID,
+			)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to record discussion vote: %w", err)
+		}
+
+		delta := 0
+		if rows, _ := result.RowsAffected(); rows > 0 {
+			delta = 1
+			if !upvote {
+				delta = -1
+			}
+		}
+
+		err = tx.QueryRowContext(ctx,
+			fmt.Sprintf(`UPDATE %s SET upvotes = upvotes + $1 WHERE id = $2 RETURNING upvotes`, table),
+			delta, targetID,
+		).Scan(&upvotes)
+		if err == sql.ErrNoRows {
+			return notFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update discussion upvotes: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return upvotes, nil
+}
+
+func scanThread(row rowScanner) (*domain.Thread, error) {
+	var t domain.Thread
+	var lessonID, answerReplyID sql.NullString
+
+	if err := row.Scan(
+		&t.ID, &t.CourseID, &lessonID, &t.AuthorID, &t.Title, &t.Body, &t.Pinned, &t.Locked, &t.Hidden,
+		&answerReplyID, &t.Upvotes, &t.ReplyCount, &t.LastActivityAt, &t.CreatedAt, &t.UpdatedAt, &t.Upvoted,
+	); err != nil {
+		return nil, err
+	}
+
+	t.LessonID = lessonID.String
+	t.AnswerReplyID = answerReplyID.String
+	return &t, nil
+}
+
+func scanReply(row rowScanner) (*domain.Reply, error) {
+	var reply domain.Reply
+	var parentID sql.NullString
+
+	if err := row.Scan(
+		&reply.ID, &reply.ThreadID, &parentID, &reply.AuthorID, &reply.Body, &reply.Hidden,
+		&reply.Upvotes, &reply.CreatedAt, &reply.Upvoted,
+	); err != nil {
+		return nil, err
+	}
+
+	reply.ParentID = parentID.String
+	return &reply, nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+)
+
+// LearnerRepository keeps the enrollments and lesson completions that
+// release rules are evaluated against, as reported by the enrollment and
+// progress services.
+type LearnerRepository interface {
+	RecordEnrollment(ctx context.Context, enrollmentID, userID, courseID string, enrolledAt time.Time) error
+	RemoveEnrollment(ctx context.Context, enrollmentID, userID, courseID string) error
+	RecordLessonCompleted(ctx context.Context, userID, courseID, lessonID string, at time.Time) error
+	Get(ctx context.Context, userID, courseID string) (*domain.Learner, error)
+	ListUserIDs(ctx context.Context, courseID, afterUserID string, limit int) ([]string, error)
+}
+
+type learnerRepository struct {
+	db *database.DB
+}
+
+func NewLearnerRepository(db *database.DB) LearnerRepository {
+	return &learnerRepository{db: db}
+}
+
+// RecordEnrollment keeps the earliest enrollment time, so a redelivered or
+// repeated enrollment can't push drip releases back. An enrollment already
+// known to have failed or been cancelled is skipped, as its events can
+// arrive out of order.
+func (r *learnerRepository) RecordEnrollment(ctx context.Context, enrollmentID, userID, courseID string, enrolledAt time.Time) error {
+	query := `
+		INSERT INTO course_learners (user_id, course_id, enrollment_id, enrolled_at)
+		SELECT $1, $2, $3, $4
+		WHERE NOT EXISTS (
+			SELECT 1 FROM course_enrollments WHERE enrollment_id = $3 AND status <> $5
+		)
+		ON CONFLICT (user_id, course_id) DO UPDATE
+		SET enrolled_at = LEAST(course_learners.enrolled_at, EXCLUDED.enrolled_at),
+			enrollment_id = EXCLUDED.enrollment_id
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, courseID, enrollmentID, enrolledAt, domain.EnrollmentActive); err != nil {
+		return fmt.Errorf("failed to record enrollment: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEnrollment takes away the access the enrollment gave. A learner who
+// has since enrolled again under another enrollment keeps theirs. Lesson
+// completions are kept for if they come back.
+func (r *learnerRepository) RemoveEnrollment(ctx context.Context, enrollmentID, userID, courseID string) error {
+	query := `
+		DELETE FROM course_learners
+		WHERE user_id = $1 AND course_id = $2 AND (enrollment_id = $3 OR enrollment_id IS NULL)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, courseID, enrollmentID); err != nil {
+		return fmt.Errorf("failed to remove enrollment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *learnerRepository) RecordLessonCompleted(ctx context.Context, userID, courseID, lessonID string, at time.Time) error {
+	query := `
+		INSERT INTO learner_lesson_completions (user_id, lesson_id, course_id, completed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, lesson_id) DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, lessonID, courseID, at); err != nil {
+		return fmt.Errorf("failed to record lesson completion: %w", err)
+	}
+
+	return nil
+}
+
+func (r *learnerRepository) Get(ctx context.Context, userID, courseID string) (*domain.Learner, error) {
+	learner := &domain.Learner{UserID: userID, CourseID: courseID, Completed: make(map[string]bool)}
+
+	err := r.db.QueryRowContext(ctx,
+		`SELECT enrolled_at FROM course_learners WHERE user_id = $1 AND course_id = $2`,
+		userID, courseID,
+	).Scan(&learner.EnrolledAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learner: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT lesson_id FROM learner_lesson_completions WHERE user_id = $1 AND course_id = $2`,
+		userID, courseID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list completed lessons: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var lessonID string
+		if err := rows.Scan(&lessonID); err != nil {
+			return nil, fmt.Errorf("failed to scan completed lesson: %w", err)
+		}
+		learner.Completed[lessonID] = true
+	}
+
+	return learner, nil
+}
+
+// ListUserIDs returns up to limit of the course's learners, in user ID order
+// after afterUserID. Start from uuid.Nil to page through all of them.
+func (r *learnerRepository) ListUserIDs(ctx context.Context, courseID, afterUserID string, limit int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT user_id FROM course_learners WHERE course_id = $1 AND user_id > $2 ORDER BY user_id LIMIT $3`,
+		courseID, afterUserID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list learners: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan learner: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
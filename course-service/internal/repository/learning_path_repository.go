@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type LearningPathRepository interface {
+	Create(ctx context.Context, path *domain.LearningPath) error
+	GetByID(ctx context.Context, id string) (*domain.LearningPath, error)
+	Update(ctx context.Context, path *domain.LearningPath) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, page, pageSize int) ([]*domain.LearningPath, int, error)
+}
+
+type learningPathRepository struct {
+	db *database.DB
+}
+
+func NewLearningPathRepository(db *database.DB) LearningPathRepository {
+	return &learningPathRepository{db: db}
+}
+
+func (r *learningPathRepository) Create(ctx context.Context, path *domain.LearningPath) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `
+			INSERT INTO learning_paths (id, title, description, created_by, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+
+		if _, err := tx.ExecContext(ctx, query,
+			path.ID, path.Title, path.Description, path.CreatedBy, path.CreatedAt, path.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to create learning path: %w", err)
+		}
+
+		return insertPathCourses(ctx, tx, path)
+	})
+}
+
+func (r *learningPathRepository) GetByID(ctx context.Context, id string) (*domain.LearningPath, error) {
+	query := `SELECT id, title, description, created_by, created_at, updated_at FROM learning_paths WHERE id = $1`
+
+	var path domain.LearningPath
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&path.ID, &path.Title, &path.Description, &path.CreatedBy, &path.CreatedAt, &path.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrLearningPathNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learning path: %w", err)
+	}
+
+	if err := r.loadCourses(ctx, []*domain.LearningPath{&path}); err != nil {
+		return nil, err
+	}
+
+	return &path, nil
+}
+
+// Update replaces the path's details and its whole course list.
+func (r *learningPathRepository) Update(ctx context.Context, path *domain.LearningPath) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `UPDATE learning_paths SET title = $1, description = $2, updated_at = $3 WHERE id = $4`
+
+		result, err := tx.ExecContext(ctx, query, path.Title, path.Description, path.UpdatedAt, path.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update learning path: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return domain.ErrLearningPathNotFound
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM learning_path_courses WHERE path_id = $1`, path.ID); err != nil {
+			return fmt.Errorf("failed to clear learning path courses: %w", err)
+		}
+
+		return insertPathCourses(ctx, tx, path)
+	})
+}
+
+func (r *learningPathRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM learning_paths WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete learning path: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrLearningPathNotFound
+	}
+
+	return nil
+}
+
+func (r *learningPathRepository) List(ctx context.Context, page, pageSize int) ([]*domain.LearningPath, int, error) {
+	offset := (page - 1) * pageSize
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM learning_paths`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count learning paths: %w", err)
+	}
+
+	query := `
+		SELECT id, title, description, created_by, created_at, updated_at
+		FROM learning_paths
+		ORDER BY created_at DESC LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list learning paths: %w", err)
+	}
+	defer rows.Close()
+
+	var paths []*domain.LearningPath
+	for rows.Next() {
+		var path domain.LearningPath
+		if err := rows.Scan(
+			&path.ID, &path.Title, &path.Description, &path.CreatedBy, &path.CreatedAt, &path.UpdatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan learning path: %w", err)
+		}
+		paths = append(paths, &path)
+	}
+
+	if err := r.loadCourses(ctx, paths); err != nil {
+		return nil, 0, err
+	}
+
+	return paths, total, nil
+}
+
+func (r *learningPathRepository) loadCourses(ctx context.Context, paths []*domain.LearningPath) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*domain.LearningPath, len(paths))
+	ids := make([]string, len(paths))
+	for i, path := range paths {
+		byID[path.ID] = path
+		ids[i] = path.ID
+	}
+
+	query := `SELECT path_id, course_id FROM learning_path_courses WHERE path_id = ANY($1) ORDER BY path_id, position`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get learning path courses: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pathID, courseID string
+		if err := rows.Scan(&pathID, &courseID); err != nil {
+			return fmt.Errorf("failed to scan learning path course: %w", err)
+		}
+		byID[pathID].CourseIDs = append(byID[pathID].CourseIDs, courseID)
+	}
+
+	return nil
+}
+
+func insertPathCourses(ctx context.Context, tx *sqlx.Tx, path *domain.LearningPath) error {
+	for i, courseID := range path.CourseIDs {
+		query := `INSERT INTO learning_path_courses (path_id, course_id, position) VALUES ($1, $2, $3)`
+		if _, err := tx.ExecContext(ctx, query, path.ID, courseID, i); err != nil {
+			return fmt.Errorf("failed to add learning path course: %w", err)
+		}
+	}
+	return nil
+}
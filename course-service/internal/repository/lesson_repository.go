@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 
 	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type LessonRepository interface {
@@ -15,7 +18,8 @@ type LessonRepository interface {
 	GetByModuleID(ctx context.Context, moduleID string) ([]*domain.Lesson, error)
 	Update(ctx context.Context, lesson *domain.Lesson) error
 	Delete(ctx context.Context, id string) error
-	GetMaxOrderIndex(ctx context.Context, moduleID string) (int, error)
+	Move(ctx context.Context, courseID, lessonID, targetModuleID string, position int) error
+	ListByVideoID(ctx context.Context, videoID string) ([]*domain.VideoLesson, error)
 }
 
 type lessonRepository struct {
@@ -26,32 +30,46 @@ func NewLessonRepository(db *database.DB) LessonRepository {
 	return &lessonRepository{db: db}
 }
 
+const lessonColumns = `l.id, l.module_id, l.title, l.description, l.video_id, l.duration_seconds, l.order_index, l.is_preview, l.type, l.release_rule, l.created_at`
+
+// Create appends the lesson to its module. The module row is locked so
+// concurrent additions and moves take the next index one at a time.
 func (r *lessonRepository) Create(ctx context.Context, lesson *domain.Lesson) error {
-	query := `
-		INSERT INTO lessons (id, module_id, title,description, video_id, duration_seconds, order_index, is_preview, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-	`
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		if err := lockModules(ctx, tx, "", lesson.ModuleID); err != nil {
+			return err
+		}
 
-	_, err := r.db.ExecContext(ctx, query,
-		lesson.ID, lesson.ModuleID, lesson.Title, lesson.Description,
-		lesson.VideoID, lesson.DurationSeconds, lesson.OrderIndex, lesson.IsPreview, lesson.CreatedAt,
-	)
+		var orderIndex int
+		err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(order_index), -1) + 1 FROM lessons WHERE module_id = $1`, lesson.ModuleID).Scan(&orderIndex)
+		if err != nil {
+			return fmt.Errorf("failed to get next order index: %w", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("failed to create lesson: %w", err)
-	}
+		query := `
+			INSERT INTO lessons (id, module_id, title,description, video_id, duration_seconds, order_index, is_preview, type, release_rule, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`
 
-	return nil
+		_, err = tx.ExecContext(ctx, query,
+			lesson.ID, lesson.ModuleID, lesson.Title, lesson.Description,
+			lesson.VideoID, lesson.DurationSeconds, orderIndex, lesson.IsPreview, lesson.Type, releaseValue(lesson.Release), lesson.CreatedAt,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to create lesson: %w", err)
+		}
+
+		lesson.OrderIndex = orderIndex
+		return syncModuleCourseDuration(ctx, tx, lesson.ModuleID)
+	})
+
+	return orderConflict(err)
 }
 
 func (r *lessonRepository) GetByID(ctx context.Context, id string) (*domain.Lesson, error) {
-	query := `SELECT id, module_id, title, description, video_id, duration_seconds, 	 order_index, is_preview, created_at FROM lessons WHERE id = $1`
-
-	var lesson domain.Lesson
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&lesson.ID, &lesson.ModuleID, &lesson.Title, &lesson.Description,
-		&lesson.VideoID, &lesson.DurationSeconds, &lesson.OrderIndex, &lesson.IsPreview, &lesson.CreatedAt,
-	)
+	query := `SELECT ` + lessonColumns + ` FROM lessons l WHERE l.id = $1`
 
+	lesson, err := scanLesson(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrCourseNotFound
 	}
@@ -59,12 +77,12 @@ func (r *lessonRepository) GetByID(ctx context.Context, id string) (*domain.Less
 		return nil, fmt.Errorf("failed to get lesson: %w", err)
 	}
 
-	return &lesson, nil
+	return lesson, nil
 }
 
 func (r *lessonRepository) GetByModuleID(ctx context.Context, moduleID string) ([]*domain.Lesson, error) {
 	query := `
-		SELECT id, module_id, title, description, video_id, duration_seconds, order_index, is_preview, created_at FROM lessons WHERE module_id = $1 ORDER BY order_index
+		SELECT ` + lessonColumns + ` FROM lessons l WHERE l.module_id = $1 ORDER BY l.order_index
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, moduleID)
@@ -75,59 +93,247 @@ func (r *lessonRepository) GetByModuleID(ctx context.Context, moduleID string) (
 
 	var lessons []*domain.Lesson
 	for rows.Next() {
-		var lesson domain.Lesson
-		if err := rows.Scan(
-			&lesson.ID, &lesson.ModuleID, &lesson.Title, &lesson.Description,
-			&lesson.VideoID, &lesson.DurationSeconds, &lesson.OrderIndex, &lesson.IsPreview, &lesson.CreatedAt,
-		); err != nil {
+		lesson, err := scanLesson(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan lesson: %w", err)
 		}
 
-		lessons = append(lessons, &lesson)
+		lessons = append(lessons, lesson)
 	}
 
 	return lessons, nil
 }
 
 func (r *lessonRepository) Update(ctx context.Context, lesson *domain.Lesson) error {
-	query := `UPDATE lessons SET title = $1, description = $2, order_index = $3, is_preview = $4 WHERE id = $5`
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := `UPDATE lessons SET title = $1, description = $2, order_index = $3, is_preview = $4, release_rule = $5, duration_seconds = $6 WHERE id = $7`
+
+		result, err := tx.ExecContext(ctx, query, lesson.Title, lesson.Description, lesson.OrderIndex, lesson.IsPreview, releaseValue(lesson.Release), lesson.DurationSeconds, lesson.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update lesson: %w", err)
+		}
+
+		rows, _ := result.RowsAffected()
+		if rows == 0 {
+			return domain.ErrCourseNotFound
+		}
+
+		return syncModuleCourseDuration(ctx, tx, lesson.ModuleID)
+	})
+}
+
+// Delete removes the lesson, closes the gap it leaves in the module's
+// ordering and takes it off the course's duration.
+func (r *lessonRepository) Delete(ctx context.Context, id string) error {
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var moduleID string
+		err := tx.QueryRowContext(ctx, `SELECT module_id FROM lessons WHERE id = $1`, id).Scan(&moduleID)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get lesson: %w", err)
+		}
+
+		if err := lockModules(ctx, tx, "", moduleID); err != nil {
+			return err
+		}
+
+		// A move may have taken the lesson elsewhere before the lock
+		var orderIndex int
+		err = tx.QueryRowContext(ctx, `DELETE FROM lessons WHERE id = $1 AND module_id = $2 RETURNING order_index`, id, moduleID).Scan(&orderIndex)
+		if err == sql.ErrNoRows {
+			return domain.ErrOrderConflict
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete lesson: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`UPDATE lessons SET order_index = order_index - 1 WHERE module_id = $1 AND order_index > $2`,
+			moduleID, orderIndex,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to renumber lessons: %w", err)
+		}
+
+		return syncModuleCourseDuration(ctx, tx, moduleID)
+	})
+
+	return orderConflict(err)
+}
+
+// Move places the lesson at position within targetModuleID, renumbering both
+// the source and target modules. A position past the end appends. The lesson
+// and target module must both belong to courseID, which is checked under the
+// locks so a concurrent move can't slip the lesson out of the course.
+func (r *lessonRepository) Move(ctx context.Context, courseID, lessonID, targetModuleID string, position int) error {
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var sourceModuleID string
+		err := tx.QueryRowContext(ctx, `
+			SELECT l.module_id FROM lessons l JOIN modules m ON m.id = l.module_id
+			WHERE l.id = $1 AND m.course_id = $2
+		`, lessonID, courseID).Scan(&sourceModuleID)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get lesson: %w", err)
+		}
+
+		if err := lockModules(ctx, tx, courseID, sourceModuleID, targetModuleID); err != nil {
+			return err
+		}
+
+		// Recheck now that both modules are locked
+		err = tx.QueryRowContext(ctx, `SELECT module_id FROM lessons WHERE id = $1 FOR UPDATE`, lessonID).Scan(&sourceModuleID)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock lesson: %w", err)
+		}
+
+		source, err := lockedLessonIDs(ctx, tx, sourceModuleID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(source, lessonID) {
+			return domain.ErrOrderConflict
+		}
+		source = slices.DeleteFunc(source, func(id string) bool { return id == lessonID })
 
-	result, err := r.db.ExecContext(ctx, query, lesson.Title, lesson.Description, lesson.OrderIndex, lesson.IsPreview, lesson.ID)
+		target := source
+		if targetModuleID != sourceModuleID {
+			if target, err = lockedLessonIDs(ctx, tx, targetModuleID); err != nil {
+				return err
+			}
+		}
+
+		position = min(position, len(target))
+		target = slices.Insert(target, position, lessonID)
+
+		if targetModuleID != sourceModuleID {
+			if err := renumberLessons(ctx, tx, sourceModuleID, source); err != nil {
+				return err
+			}
+		}
+		return renumberLessons(ctx, tx, targetModuleID, target)
+	})
+
+	return orderConflict(err)
+}
+
+// ListByVideoID returns every live lesson that plays videoID. Cloned courses
+// share videos, so there can be several.
+func (r *lessonRepository) ListByVideoID(ctx context.Context, videoID string) ([]*domain.VideoLesson, error) {
+	query := `
+		SELECT m.course_id, ` + lessonColumns + `
+		FROM lessons l JOIN modules m ON m.id = l.module_id
+		WHERE l.video_id = $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, videoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lessons by video: %w", err)
+	}
+	defer rows.Close()
+
+	var lessons []*domain.VideoLesson
+	for rows.Next() {
+		var courseID string
+		lesson, err := scanLesson(prefixScanner{row: rows, prefix: []any{&courseID}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lesson: %w", err)
+		}
+		lessons = append(lessons, &domain.VideoLesson{CourseID: courseID, Lesson: lesson})
+	}
+
+	return lessons, nil
+}
+
+// lockModules locks the module rows, which every change to the order of
+// their lessons takes first. They're locked in ID order so two moves between
+// the same modules can't deadlock. With a courseID every module must belong
+// to that course.
+func lockModules(ctx context.Context, tx *sqlx.Tx, courseID string, moduleIDs ...string) error {
+	ids := slices.Compact(slices.Sorted(slices.Values(moduleIDs)))
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id FROM modules WHERE id = ANY($1::uuid[]) AND ($2 = '' OR course_id::text = $2)
+		ORDER BY id FOR UPDATE
+	`, pq.Array(ids), courseID)
 	if err != nil {
-		return fmt.Errorf("failed to update lesson: %w", err)
+		return fmt.Errorf("failed to lock modules: %w", err)
 	}
+	defer rows.Close()
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
+	locked := 0
+	for rows.Next() {
+		locked++
+	}
+	if locked != len(ids) {
 		return domain.ErrCourseNotFound
 	}
 
 	return nil
 }
 
-func (r *lessonRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM lessons WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+func lockedLessonIDs(ctx context.Context, tx *sqlx.Tx, moduleID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM lessons WHERE module_id = $1 ORDER BY order_index FOR UPDATE`, moduleID)
 	if err != nil {
-		return fmt.Errorf("failed to delete lesson: %w", err)
+		return nil, fmt.Errorf("failed to lock lessons: %w", err)
 	}
+	defer rows.Close()
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return domain.ErrCourseNotFound
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan lesson: %w", err)
+		}
+		ids = append(ids, id)
 	}
 
+	return ids, nil
+}
+
+// renumberLessons assigns consecutive order indexes to ids within moduleID.
+// (module_id, order_index) is unique but deferred until commit.
+func renumberLessons(ctx context.Context, tx *sqlx.Tx, moduleID string, ids []string) error {
+	for i, id := range ids {
+		if _, err := tx.ExecContext(ctx, `UPDATE lessons SET module_id = $1, order_index = $2 WHERE id = $3`, moduleID, i, id); err != nil {
+			return fmt.Errorf("failed to renumber lesson: %w", err)
+		}
+	}
 	return nil
 }
 
-func (r *lessonRepository) GetMaxOrderIndex(ctx context.Context, moduleID string) (int, error) {
-	query := `SELECT COALESCE(MAX(order_index), -1) FROM lessons WHERE module_id = $1`
+func scanLesson(row rowScanner) (*domain.Lesson, error) {
+	var lesson domain.Lesson
+	var release []byte
 
-	var maxIndex int
-	err := r.db.QueryRowContext(ctx, query, moduleID).Scan(&maxIndex)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get max order index: %w", err)
+	if err := row.Scan(
+		&lesson.ID, &lesson.ModuleID, &lesson.Title, &lesson.Description,
+		&lesson.VideoID, &lesson.DurationSeconds, &lesson.OrderIndex, &lesson.IsPreview, &lesson.Type, &release, &lesson.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if lesson.Release, err = parseRelease(release); err != nil {
+		return nil, err
 	}
 
-	return maxIndex, nil
+	return &lesson, nil
+}
+
+// prefixScanner scans columns selected before the lesson columns into prefix.
+type prefixScanner struct {
+	row    rowScanner
+	prefix []any
+}
+
+func (s prefixScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(s.prefix, dest...)...)
 }
@@ -3,10 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type ModuleRepository interface {
@@ -15,7 +19,7 @@ type ModuleRepository interface {
 	GetByCourseID(ctx context.Context, courseID string) ([]*domain.Module, error)
 	Update(ctx context.Context, module *domain.Module) error
 	Delete(ctx context.Context, id string) error
-	GetMaxOrderIndex(ctx context.Context, courseID string) (int, error)
+	Reorder(ctx context.Context, courseID string, orderedIDs []string) error
 }
 
 type moduleRepository struct {
@@ -26,30 +30,47 @@ func NewModuleRepository(db *database.DB) ModuleRepository {
 	return &moduleRepository{db: db}
 }
 
+// Create appends the module to its course. The course row is locked so
+// concurrent additions and reorders take the next index one at a time.
 func (r *moduleRepository) Create(ctx context.Context, module *domain.Module) error {
-	query := `
-		INSERT INTO modules (id, course_id, title, description, order_index, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-	`
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		if err := lockCourse(ctx, tx, module.CourseID); err != nil {
+			return err
+		}
 
-	_, err := r.db.ExecContext(ctx, query,
-		module.ID, module.CourseID, module.Title, module.Description, module.OrderIndex, module.CreatedAt,
-	)
+		var orderIndex int
+		err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(order_index), -1) + 1 FROM modules WHERE course_id = $1`, module.CourseID).Scan(&orderIndex)
+		if err != nil {
+			return fmt.Errorf("failed to get next order index: %w", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("failed to create module: %w", err)
-	}
+		query := `
+			INSERT INTO modules (id, course_id, title, description, order_index, release_rule, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`
 
-	return nil
+		_, err = tx.ExecContext(ctx, query,
+			module.ID, module.CourseID, module.Title, module.Description, orderIndex, releaseValue(module.Release), module.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create module: %w", err)
+		}
+
+		module.OrderIndex = orderIndex
+		return nil
+	})
+
+	return orderConflict(err)
 }
 
 func (r *moduleRepository) GetByID(ctx context.Context, id string) (*domain.Module, error) {
-	query := `SELECT id, course_id, title, description, order_index, created_at
+	query := `SELECT id, course_id, title, description, order_index, release_rule, created_at
 		FROM modules WHERE id = $1`
 
 	var module domain.Module
+	var release []byte
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&module.ID, &module.CourseID, &module.Title, &module.Description, &module.OrderIndex, &module.CreatedAt,
+		&module.ID, &module.CourseID, &module.Title, &module.Description, &module.OrderIndex, &release, &module.CreatedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -59,12 +80,16 @@ func (r *moduleRepository) GetByID(ctx context.Context, id string) (*domain.Modu
 		return nil, fmt.Errorf("failed to get module: %w", err)
 	}
 
+	if module.Release, err = parseRelease(release); err != nil {
+		return nil, err
+	}
+
 	return &module, nil
 }
 
 func (r *moduleRepository) GetByCourseID(ctx context.Context, courseID string) ([]*domain.Module, error) {
 	query := `
-		SELECT id, course_id, title, description, order_index, created_at 
+		SELECT id, course_id, title, description, order_index, release_rule, created_at
 		FROM modules WHERE course_id = $1 ORDER BY order_index
 	`
 
@@ -77,17 +102,23 @@ func (r *moduleRepository) GetByCourseID(ctx context.Context, courseID string) (
 	var modules []*domain.Module
 	for rows.Next() {
 		var module domain.Module
+		var release []byte
 		if err := rows.Scan(
 			&module.ID,
 			&module.CourseID,
 			&module.Title,
 			&module.Description,
 			&module.OrderIndex,
+			&release,
 			&module.CreatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan module: %w", err)
 		}
 
+		if module.Release, err = parseRelease(release); err != nil {
+			return nil, err
+		}
+
 		modules = append(modules, &module)
 	}
 
@@ -95,9 +126,9 @@ func (r *moduleRepository) GetByCourseID(ctx context.Context, courseID string) (
 }
 
 func (r *moduleRepository) Update(ctx context.Context, module *domain.Module) error {
-	qyery := `UPDATE modules SET title = $1, description = $2, order_index = $3 WHERE id = $4`
+	qyery := `UPDATE modules SET title = $1, description = $2, order_index = $3, release_rule = $4 WHERE id = $5`
 
-	result, err := r.db.ExecContext(ctx, qyery, module.Title, module.Description, module.OrderIndex, module.ID)
+	result, err := r.db.ExecContext(ctx, qyery, module.Title, module.Description, module.OrderIndex, releaseValue(module.Release), module.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update module: %w", err)
 	}
@@ -110,29 +141,148 @@ func (r *moduleRepository) Update(ctx context.Context, module *domain.Module) er
 	return nil
 }
 
+// Delete removes the module and closes the gap it leaves in the course's
+// ordering.
 func (r *moduleRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM modules WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var courseID string
+		err := tx.QueryRowContext(ctx, `SELECT course_id FROM modules WHERE id = $1`, id).Scan(&courseID)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get module: %w", err)
+		}
+
+		if err := lockCourse(ctx, tx, courseID); err != nil {
+			return err
+		}
+
+		var orderIndex int
+		err = tx.QueryRowContext(ctx, `DELETE FROM modules WHERE id = $1 RETURNING order_index`, id).Scan(&orderIndex)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete module: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`UPDATE modules SET order_index = order_index - 1 WHERE course_id = $1 AND order_index > $2`,
+			courseID, orderIndex,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to renumber modules: %w", err)
+		}
+
+		// The module's lessons went with it
+		return syncCourseDuration(ctx, tx, courseID)
+	})
+
+	return orderConflict(err)
+}
+
+// Reorder renumbers the course's modules to follow orderedIDs, which must list
+// every module of the course exactly once.
+func (r *moduleRepository) Reorder(ctx context.Context, courseID string, orderedIDs []string) error {
+	err := r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		if err := lockCourse(ctx, tx, courseID); err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, `SELECT id FROM modules WHERE course_id = $1 FOR UPDATE`, courseID)
+		if err != nil {
+			return fmt.Errorf("failed to lock modules: %w", err)
+		}
+
+		current := make(map[string]bool)
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan module: %w", err)
+			}
+			current[id] = true
+		}
+		rows.Close()
+
+		if err := checkPermutation(current, orderedIDs); err != nil {
+			return err
+		}
+
+		// (course_id, order_index) is unique but deferred, so intermediate
+		// duplicates while renumbering are fine
+		for i, id := range orderedIDs {
+			if _, err := tx.ExecContext(ctx, `UPDATE modules SET order_index = $1 WHERE id = $2`, i, id); err != nil {
+				return fmt.Errorf("failed to reorder module: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	return orderConflict(err)
+}
+
+// lockCourse locks the course row, which every change to the order of its
+// modules takes first.
+func lockCourse(ctx context.Context, tx *sqlx.Tx, courseID string) error {
+	var id string
+	err := tx.QueryRowContext(ctx, `SELECT id FROM courses WHERE id = $1 FOR UPDATE`, courseID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return domain.ErrCourseNotFound
+	}
 	if err != nil {
-		return fmt.Errorf("failed to delete module: %w", err)
+		return fmt.Errorf("failed to lock course: %w", err)
+	}
+	return nil
+}
+
+// orderConflict reports a clash on the deferred (parent, order_index) unique
+// constraints, which only surfaces at commit, as domain.ErrOrderConflict.
+func orderConflict(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrOrderConflict
 	}
+	return err
+}
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return domain.ErrCourseNotFound
+// checkPermutation reports whether ids names every member of current exactly
+// once.
+func checkPermutation(current map[string]bool, ids []string) error {
+	if len(ids) != len(current) {
+		return domain.ErrInvalidInput
+	}
+
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !current[id] || seen[id] {
+			return domain.ErrInvalidInput
+		}
+		seen[id] = true
 	}
 
 	return nil
 }
 
-func (r *moduleRepository) GetMaxOrderIndex(ctx context.Context, courseID string) (int, error) {
-	query := `SELECT COALESCE(MAX(order_index), -1) FROM modules WHERE course_id = $1`
-
-	var maxIndex int
-	err := r.db.QueryRowContext(ctx, query, courseID).Scan(&maxIndex)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get max order index: %w", err)
+// releaseValue encodes a release rule for the nullable release_rule column.
+// The rule is a plain struct, so encoding can't fail.
+func releaseValue(rule *domain.ReleaseRule) any {
+	if rule == nil {
+		return nil
 	}
+	data, _ := json.Marshal(rule)
+	return data
+}
 
-	return maxIndex, nil
+func parseRelease(data []byte) (*domain.ReleaseRule, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var rule domain.ReleaseRule
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return nil, fmt.Errorf("failed to decode release rule: %w", err)
+	}
+	return &rule, nil
 }
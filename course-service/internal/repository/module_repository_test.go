@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/lib/pq"
+)
+
+func TestCheckPermutation(t *testing.T) {
+	current := map[string]bool{"m1": true, "m2": true, "m3": true}
+
+	tests := []struct {
+		name    string
+		ids     []string
+		wantErr error
+	}{
+		{"every module once", []string{"m3", "m1", "m2"}, nil},
+		{"missing module", []string{"m1", "m2"}, domain.ErrInvalidInput},
+		{"repeated module", []string{"m1", "m2", "m2"}, domain.ErrInvalidInput},
+		{"module from elsewhere", []string{"m1", "m2", "m4"}, domain.ErrInvalidInput},
+		{"extra module", []string{"m1", "m2", "m3", "m4"}, domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkPermutation(current, tt.ids); err != tt.wantErr {
+				t.Errorf("checkPermutation() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderConflict(t *testing.T) {
+	other := errors.New("connection reset")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"nil", nil, nil},
+		{"unique violation at commit", fmt.Errorf("failed to commit transaction: %w", &pq.Error{Code: "23505"}), domain.ErrOrderConflict},
+		{"other constraint", &pq.Error{Code: "23503"}, nil},
+		{"domain error", domain.ErrCourseNotFound, domain.ErrCourseNotFound},
+		{"other error", other, other},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := orderConflict(tt.err)
+			if tt.want == nil && tt.err != nil {
+				// Anything but a unique violation passes through
+				tt.want = tt.err
+			}
+			if got != tt.want {
+				t.Errorf("orderConflict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
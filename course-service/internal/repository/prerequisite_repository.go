@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type PrerequisiteRepository interface {
+	Add(ctx context.Context, prerequisite *domain.Prerequisite) error
+	Remove(ctx context.Context, courseID, requiredCourseID string) error
+	ListByCourse(ctx context.Context, courseID string) ([]*domain.Prerequisite, error)
+	ListAmong(ctx context.Context, courseIDs []string) ([]*domain.Prerequisite, error)
+}
+
+type prerequisiteRepository struct {
+	db *database.DB
+}
+
+func NewPrerequisiteRepository(db *database.DB) PrerequisiteRepository {
+	return &prerequisiteRepository{db: db}
+}
+
+// prerequisiteLockKey serializes writers to the prerequisite graph. Two edges
+// added concurrently can each pass the cycle check and still close a cycle
+// together.
+const prerequisiteLockKey = 7301
+
+// Add inserts the edge unless the course is already reachable from the
+// required course, which would make the graph cyclic. Adding an existing
+// edge is a no-op.
+func (r *prerequisiteRepository) Add(ctx context.Context, prerequisite *domain.Prerequisite) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, prerequisiteLockKey); err != nil {
+			return fmt.Errorf("failed to lock prerequisites: %w", err)
+		}
+
+		reachQuery := `
+			WITH RECURSIVE reach(id) AS (
+				SELECT $1::uuid
+				UNION
+				SELECT p.required_course_id FROM course_prerequisites p JOIN reach r ON p.course_id = r.id
+			)
+			SELECT EXISTS (SELECT 1 FROM reach WHERE id = $2)
+		`
+
+		var cyclic bool
+		if err := tx.QueryRowContext(ctx, reachQuery, prerequisite.RequiredCourseID, prerequisite.CourseID).Scan(&cyclic); err != nil {
+			return fmt.Errorf("failed to check prerequisite cycle: %w", err)
+		}
+		if cyclic {
+			return domain.ErrPrerequisiteCycle
+		}
+
+		query := `
+			INSERT INTO course_prerequisites (course_id, required_course_id, created_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+		`
+
+		if _, err := tx.ExecContext(ctx, query, prerequisite.CourseID, prerequisite.RequiredCourseID, prerequisite.CreatedAt); err != nil {
+			return fmt.Errorf("failed to add prerequisite: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *prerequisiteRepository) Remove(ctx context.Context, courseID, requiredCourseID string) error {
+	query := `DELETE FROM course_prerequisites WHERE course_id = $1 AND required_course_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, courseID, requiredCourseID)
+	if err != nil {
+		return fmt.Errorf("failed to remove prerequisite: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrPrerequisiteNotFound
+	}
+
+	return nil
+}
+
+func (r *prerequisiteRepository) ListByCourse(ctx context.Context, courseID string) ([]*domain.Prerequisite, error) {
+	query := `
+		SELECT p.course_id, p.required_course_id, c.title, c.level, p.created_at
+		FROM course_prerequisites p JOIN courses c ON c.id = p.required_course_id
+		WHERE p.course_id = $1
+		ORDER BY p.created_at
+	`
+
+	return r.list(ctx, query, courseID)
+}
+
+// ListAmong returns the edges whose dependent course is one of courseIDs.
+func (r *prerequisiteRepository) ListAmong(ctx context.Context, courseIDs []string) ([]*domain.Prerequisite, error) {
+	if len(courseIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT p.course_id, p.required_course_id, c.title, c.level, p.created_at
+		FROM course_prerequisites p JOIN courses c ON c.id = p.required_course_id
+		WHERE p.course_id = ANY($1)
+	`
+
+	return r.list(ctx, query, pq.Array(courseIDs))
+}
+
+func (r *prerequisiteRepository) list(ctx context.Context, query string, args ...any) ([]*domain.Prerequisite, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prerequisites: %w", err)
+	}
+	defer rows.Close()
+
+	var prerequisites []*domain.Prerequisite
+	for rows.Next() {
+		var prerequisite domain.Prerequisite
+		if err := rows.Scan(
+			&prerequisite.CourseID, &prerequisite.RequiredCourseID, &prerequisite.RequiredTitle,
+			&prerequisite.RequiredLevel, &prerequisite.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan prerequisite: %w", err)
+		}
+		prerequisites = append(prerequisites, &prerequisite)
+	}
+
+	return prerequisites, nil
+}
@@ -0,0 +1,465 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/lib/pq"
+)
+
+type QuizRepository interface {
+	CreateBank(ctx context.Context, bank *domain.QuestionBank) error
+	GetBank(ctx context.Context, id string) (*domain.QuestionBank, error)
+	ListBanks(ctx context.Context, courseID string) ([]*domain.QuestionBank, error)
+	DeleteBank(ctx context.Context, id string) error
+	CreateQuestion(ctx context.Context, question *domain.Question) error
+	GetQuestion(ctx context.Context, id string) (*domain.Question, error)
+	DeleteQuestion(ctx context.Context, id string) error
+	ListQuestions(ctx context.Context, bankID string) ([]*domain.Question, error)
+	GetQuestions(ctx context.Context, ids []string) (map[string]*domain.Question, error)
+	UpsertQuiz(ctx context.Context, quiz *domain.Quiz) error
+	GetQuiz(ctx context.Context, lessonID string) (*domain.Quiz, error)
+	ReadyQuizzes(ctx context.Context, lessonIDs []string) (map[string]bool, error)
+	CreateAttempt(ctx context.Context, attempt *domain.QuizAttempt) error
+	GetAttempt(ctx context.Context, id string) (*domain.QuizAttempt, error)
+	GetOpenAttempt(ctx context.Context, lessonID, userID string) (*domain.QuizAttempt, error)
+	CountAttempts(ctx context.Context, lessonID, userID string) (int, error)
+	ListAttempts(ctx context.Context, lessonID, userID string) ([]*domain.QuizAttempt, error)
+	SubmitAttempt(ctx context.Context, attempt *domain.QuizAttempt) error
+}
+
+type quizRepository struct {
+	db *database.DB
+}
+
+func NewQuizRepository(db *database.DB) QuizRepository {
+	return &quizRepository{db: db}
+}
+
+const (
+	bankColumns     = `b.id, b.course_id, b.title, b.created_at, (SELECT COUNT(*) FROM quiz_questions q WHERE q.bank_id = b.id)`
+	questionColumns = `id, bank_id, type, prompt, options, correct_options, accepted_answers, points, explanation, created_at`
+	quizColumns     = `lesson_id, course_id, bank_id, question_count, time_limit_seconds, max_attempts, pass_percentage, counts_toward_completion, updated_at`
+	attemptColumns  = `id, lesson_id, course_id, user_id, question_ids, answers, started_at, deadline, submitted_at, score, max_score, percentage, passed, expired`
+)
+
+func (r *quizRepository) CreateBank(ctx context.Context, bank *domain.QuestionBank) error {
+	query := `INSERT INTO question_banks (id, course_id, title, created_at) VALUES ($1, $2, $3, $4)`
+
+	if _, err := r.db.ExecContext(ctx, query, bank.ID, bank.CourseID, bank.Title, bank.CreatedAt); err != nil {
+		return fmt.Errorf("failed to create question bank: %w", err)
+	}
+
+	return nil
+}
+
+func (r *quizRepository) GetBank(ctx context.Context, id string) (*domain.QuestionBank, error) {
+	query := `SELECT ` + bankColumns + ` FROM question_banks b WHERE b.id = $1`
+
+	bank, err := scanBank(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrQuestionBankNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question bank: %w", err)
+	}
+
+	return bank, nil
+}
+
+func (r *quizRepository) ListBanks(ctx context.Context, courseID string) ([]*domain.QuestionBank, error) {
+	query := `SELECT ` + bankColumns + ` FROM question_banks b WHERE b.course_id = $1 ORDER BY b.created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question banks: %w", err)
+	}
+	defer rows.Close()
+
+	var banks []*domain.QuestionBank
+	for rows.Next() {
+		bank, err := scanBank(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question bank: %w", err)
+		}
+		banks = append(banks, bank)
+	}
+
+	return banks, nil
+}
+
+// DeleteBank removes a bank and its questions, unless a quiz draws from it.
+func (r *quizRepository) DeleteBank(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM question_banks
+		WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM lesson_quizzes WHERE bank_id = $1)
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete question bank: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		if _, err := r.GetBank(ctx, id); err != nil {
+			return err
+		}
+		return domain.ErrQuestionBankInUse
+	}
+
+	return nil
+}
+
+func (r *quizRepository) CreateQuestion(ctx context.Context, question *domain.Question) error {
+	query := `
+		INSERT INTO quiz_questions (id, bank_id, type, prompt, options, correct_options, accepted_answers, points, explanation, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		question.ID, question.BankID, question.Type, question.Prompt, pq.Array(question.Options),
+		pq.Array(toInt64s(question.CorrectOptions)), pq.Array(question.AcceptedAnswers),
+		question.Points, question.Explanation, question.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create question: %w", err)
+	}
+
+	return nil
+}
+
+func (r *quizRepository) GetQuestion(ctx context.Context, id string) (*domain.Question, error) {
+	query := `SELECT ` + questionColumns + ` FROM quiz_questions WHERE id = $1`
+
+	question, err := scanQuestion(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrQuestionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question: %w", err)
+	}
+
+	return question, nil
+}
+
+// DeleteQuestion removes a question from its bank. Attempts that drew it
+// keep their recorded answers but no longer count it.
+func (r *quizRepository) DeleteQuestion(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM quiz_questions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete question: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrQuestionNotFound
+	}
+
+	return nil
+}
+
+func (r *quizRepository) ListQuestions(ctx context.Context, bankID string) ([]*domain.Question, error) {
+	query := `SELECT ` + questionColumns + ` FROM quiz_questions WHERE bank_id = $1 ORDER BY created_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, bankID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list questions: %w", err)
+	}
+	defer rows.Close()
+
+	var questions []*domain.Question
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		questions = append(questions, question)
+	}
+
+	return questions, nil
+}
+
+func (r *quizRepository) GetQuestions(ctx context.Context, ids []string) (map[string]*domain.Question, error) {
+	questions := make(map[string]*domain.Question)
+	if len(ids) == 0 {
+		return questions, nil
+	}
+
+	query := `SELECT ` + questionColumns + ` FROM quiz_questions WHERE id = ANY($1::uuid[])`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		questions[question.ID] = question
+	}
+
+	return questions, nil
+}
+
+func (r *quizRepository) UpsertQuiz(ctx context.Context, quiz *domain.Quiz) error {
+	query := `
+		INSERT INTO lesson_quizzes (` + quizColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (lesson_id) DO UPDATE
+		SET bank_id = EXCLUDED.bank_id, question_count = EXCLUDED.question_count,
+			time_limit_seconds = EXCLUDED.time_limit_seconds, max_attempts = EXCLUDED.max_attempts,
+			pass_percentage = EXCLUDED.pass_percentage, counts_toward_completion = EXCLUDED.counts_toward_completion,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		quiz.LessonID, quiz.CourseID, quiz.BankID, quiz.QuestionCount, quiz.TimeLimitSeconds,
+		quiz.MaxAttempts, quiz.PassPercentage, quiz.CountsTowardCompletion, quiz.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to save quiz: %w", err)
+	}
+
+	return nil
+}
+
+func (r *quizRepository) GetQuiz(ctx context.Context, lessonID string) (*domain.Quiz, error) {
+	query := `SELECT ` + quizColumns + ` FROM lesson_quizzes WHERE lesson_id = $1`
+
+	var quiz domain.Quiz
+	err := r.db.QueryRowContext(ctx, query, lessonID).Scan(
+		&quiz.LessonID, &quiz.CourseID, &quiz.BankID, &quiz.QuestionCount, &quiz.TimeLimitSeconds,
+		&quiz.MaxAttempts, &quiz.PassPercentage, &quiz.CountsTowardCompletion, &quiz.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrQuizNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz: %w", err)
+	}
+
+	return &quiz, nil
+}
+
+// ReadyQuizzes returns which of lessonIDs have a quiz whose bank holds
+// enough questions to draw an attempt.
+func (r *quizRepository) ReadyQuizzes(ctx context.Context, lessonIDs []string) (map[string]bool, error) {
+	ready := make(map[string]bool)
+	if len(lessonIDs) == 0 {
+		return ready, nil
+	}
+
+	query := `
+		SELECT z.lesson_id
+		FROM lesson_quizzes z
+		WHERE z.lesson_id = ANY($1::uuid[])
+			AND (SELECT COUNT(*) FROM quiz_questions q WHERE q.bank_id = z.bank_id) >= GREATEST(z.question_count, 1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(lessonIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check quizzes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var lessonID string
+		if err := rows.Scan(&lessonID); err != nil {
+			return nil, fmt.Errorf("failed to scan quiz: %w", err)
+		}
+		ready[lessonID] = true
+	}
+
+	return ready, nil
+}
+
+// CreateAttempt starts an attempt. The partial unique index allows one open
+// attempt per learner and quiz.
+func (r *quizRepository) CreateAttempt(ctx context.Context, attempt *domain.QuizAttempt) error {
+	query := `
+		INSERT INTO quiz_attempts (id, lesson_id, course_id, user_id, question_ids, started_at, deadline)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING
+	`
+
+	var deadline any
+	if attempt.Deadline != nil {
+		deadline = *attempt.Deadline
+	}
+
+	result, err := r.db.ExecContext(ctx, query,
+		attempt.ID, attempt.LessonID, attempt.CourseID, attempt.UserID,
+		pq.Array(attempt.QuestionIDs), attempt.StartedAt, deadline,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create quiz attempt: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAttemptInProgress
+	}
+
+	return nil
+}
+
+func (r *quizRepository) GetAttempt(ctx context.Context, id string) (*domain.QuizAttempt, error) {
+	query := `SELECT ` + attemptColumns + ` FROM quiz_attempts WHERE id = $1`
+
+	attempt, err := scanAttempt(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAttemptNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz attempt: %w", err)
+	}
+
+	return attempt, nil
+}
+
+func (r *quizRepository) GetOpenAttempt(ctx context.Context, lessonID, userID string) (*domain.QuizAttempt, error) {
+	query := `SELECT ` + attemptColumns + ` FROM quiz_attempts WHERE lesson_id = $1 AND user_id = $2 AND submitted_at IS NULL`
+
+	attempt, err := scanAttempt(r.db.QueryRowContext(ctx, query, lessonID, userID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAttemptNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open quiz attempt: %w", err)
+	}
+
+	return attempt, nil
+}
+
+func (r *quizRepository) CountAttempts(ctx context.Context, lessonID, userID string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM quiz_attempts WHERE lesson_id = $1 AND user_id = $2`,
+		lessonID, userID,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count quiz attempts: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *quizRepository) ListAttempts(ctx context.Context, lessonID, userID string) ([]*domain.QuizAttempt, error) {
+	query := `SELECT ` + attemptColumns + ` FROM quiz_attempts WHERE lesson_id = $1 AND user_id = $2 ORDER BY started_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, lessonID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiz attempts: %w", err)
+	}
+	defer rows.Close()
+
+	var attempts []*domain.QuizAttempt
+	for rows.Next() {
+		attempt, err := scanAttempt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan quiz attempt: %w", err)
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, nil
+}
+
+// SubmitAttempt records a graded attempt. Only an open attempt can be
+// submitted, so a double submit can't be graded twice.
+func (r *quizRepository) SubmitAttempt(ctx context.Context, attempt *domain.QuizAttempt) error {
+	answers, err := json.Marshal(attempt.Answers)
+	if err != nil {
+		return fmt.Errorf("failed to encode quiz answers: %w", err)
+	}
+
+	query := `
+		UPDATE quiz_attempts
+		SET answers = $1, submitted_at = $2, score = $3, max_score = $4, percentage = $5, passed = $6, expired = $7
+		WHERE id = $8 AND submitted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		answers, *attempt.SubmittedAt, attempt.Score, attempt.MaxScore,
+		attempt.Percentage, attempt.Passed, attempt.Expired, attempt.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to submit quiz attempt: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAttemptSubmitted
+	}
+
+	return nil
+}
+
+func scanBank(row rowScanner) (*domain.QuestionBank, error) {
+	var bank domain.QuestionBank
+	if err := row.Scan(&bank.ID, &bank.CourseID, &bank.Title, &bank.CreatedAt, &bank.QuestionCount); err != nil {
+		return nil, err
+	}
+	return &bank, nil
+}
+
+func scanQuestion(row rowScanner) (*domain.Question, error) {
+	var question domain.Question
+	var correct pq.Int64Array
+
+	if err := row.Scan(
+		&question.ID, &question.BankID, &question.Type, &question.Prompt, pq.Array(&question.Options),
+		&correct, pq.Array(&question.AcceptedAnswers), &question.Points, &question.Explanation, &question.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	question.CorrectOptions = toInts(correct)
+	return &question, nil
+}
+
+func scanAttempt(row rowScanner) (*domain.QuizAttempt, error) {
+	var attempt domain.QuizAttempt
+	var answers []byte
+	var deadline, submittedAt sql.NullTime
+
+	if err := row.Scan(
+		&attempt.ID, &attempt.LessonID, &attempt.CourseID, &attempt.UserID, pq.Array(&attempt.QuestionIDs),
+		&answers, &attempt.StartedAt, &deadline, &submittedAt, &attempt.Score, &attempt.MaxScore,
+		&attempt.Percentage, &attempt.Passed, &attempt.Expired,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(answers, &attempt.Answers); err != nil {
+		return nil, fmt.Errorf("failed to decode quiz answers: %w", err)
+	}
+	if deadline.Valid {
+		attempt.Deadline = &deadline.Time
+	}
+	if submittedAt.Valid {
+		attempt.SubmittedAt = &submittedAt.Time
+	}
+
+	return &attempt, nil
+}
+
+func toInt64s(values []int) []int64 {
+	out := make([]int64, len(values))
+	for i, v := range values {
+		out[i] = int64(v)
+	}
+	return out
+}
+
+func toInts(values []int64) []int {
+	out := make([]int, len(values))
+	for i, v := range values {
+		out[i] = int(v)
+	}
+	return out
+}
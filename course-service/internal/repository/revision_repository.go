@@ -0,0 +1,398 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type RevisionRepository interface {
+	GetDraft(ctx context.Context, courseID string) (*domain.CourseRevision, error)
+	CreateDraft(ctx context.Context, revision *domain.CourseRevision) error
+	SaveDraft(ctx context.Context, revision *domain.CourseRevision) error
+	DeleteDraft(ctx context.Context, courseID string) error
+	GetPublished(ctx context.Context, courseID string) (*domain.CourseRevision, error)
+	GetByNumber(ctx context.Context, courseID string, number int) (*domain.CourseRevision, error)
+	List(ctx context.Context, courseID string) ([]*domain.CourseRevision, error)
+	PublishRollback(ctx context.Context, revision *domain.CourseRevision) error
+	PublishApproved(ctx context.Context, revision *domain.CourseRevision, submission *domain.CourseSubmission, from domain.SubmissionStatus) error
+}
+
+type revisionRepository struct {
+	db *database.DB
+}
+
+func NewRevisionRepository(db *database.DB) RevisionRepository {
+	return &revisionRepository{db: db}
+}
+
+const revisionColumns = `id, course_id, COALESCE(number, 0), status, COALESCE(source_revision, 0), version, created_by, created_at, updated_at, published_at`
+
+func (r *revisionRepository) GetDraft(ctx context.Context, courseID string) (*domain.CourseRevision, error) {
+	query := `SELECT ` + revisionColumns + `, content FROM course_revisions WHERE course_id = $1 AND status = $2`
+
+	revision, err := scanRevisionWithContent(r.db.QueryRowContext(ctx, query, courseID, domain.RevisionDraft))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (r *revisionRepository) CreateDraft(ctx context.Context, revision *domain.CourseRevision) error {
+	content, err := json.Marshal(revision.Content)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision content: %w", err)
+	}
+
+	// The partial unique index allows one draft per course, so a concurrent
+	// create surfaces as a conflict rather than a second draft.
+	query := `
+		INSERT INTO course_revisions (id, course_id, status, content, version, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		revision.ID, revision.CourseID, domain.RevisionDraft, content, revision.Version,
+		revision.CreatedBy, revision.CreatedAt, revision.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create draft: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrRevisionConflict
+	}
+
+	return nil
+}
+
+func (r *revisionRepository) SaveDraft(ctx context.Context, revision *domain.CourseRevision) error {
+	content, err := json.Marshal(revision.Content)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision content: %w", err)
+	}
+
+	query := `
+		UPDATE course_revisions
+		SET content = $1, version = version + 1, updated_at = $2
+		WHERE id = $3 AND status = $4 AND version = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query, content, revision.UpdatedAt, revision.ID, domain.RevisionDraft, revision.Version)
+	if err != nil {
+		return fmt.Errorf("failed to save draft: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrRevisionConflict
+	}
+
+	revision.Version++
+	return nil
+}
+
+func (r *revisionRepository) DeleteDraft(ctx context.Context, courseID string) error {
+	query := `DELETE FROM course_revisions WHERE course_id = $1 AND status = $2`
+
+	result, err := r.db.ExecContext(ctx, query, courseID, domain.RevisionDraft)
+	if err != nil {
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrDraftNotFound
+	}
+
+	return nil
+}
+
+func (r *revisionRepository) GetPublished(ctx context.Context, courseID string) (*domain.CourseRevision, error) {
+	query := `SELECT ` + revisionColumns + `, content FROM course_revisions WHERE course_id = $1 AND status = $2`
+
+	revision, err := scanRevisionWithContent(r.db.QueryRowContext(ctx, query, courseID, domain.RevisionPublished))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get published revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (r *revisionRepository) GetByNumber(ctx context.Context, courseID string, number int) (*domain.CourseRevision, error) {
+	query := `SELECT ` + revisionColumns + `, content FROM course_revisions WHERE course_id = $1 AND number = $2`
+
+	revision, err := scanRevisionWithContent(r.db.QueryRowContext(ctx, query, courseID, number))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (r *revisionRepository) List(ctx context.Context, courseID string) ([]*domain.CourseRevision, error) {
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM course_revisions WHERE course_id = $1
+		ORDER BY number DESC NULLS FIRST
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.CourseRevision
+	for rows.Next() {
+		var revision domain.CourseRevision
+		var publishedAt sql.NullTime
+		if err := rows.Scan(
+			&revision.ID, &revision.CourseID, &revision.Number, &revision.Status, &revision.SourceRevision,
+			&revision.Version, &revision.CreatedBy, &revision.CreatedAt, &revision.UpdatedAt, &publishedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan revision: %w", err)
+		}
+		if publishedAt.Valid {
+			revision.PublishedAt = &publishedAt.Time
+		}
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, nil
+}
+
+// PublishRollback publishes revision, a copy of an earlier one, unless the
+// course has a submission awaiting review or scheduled to go live. Either
+// would be published over the rollback, or have its review skipped by it.
+func (r *revisionRepository) PublishRollback(ctx context.Context, revision *domain.CourseRevision) error {
+	return r.publish(ctx, revision, func(tx *sqlx.Tx) error {
+		var open bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (SELECT 1 FROM course_submissions WHERE course_id = $1 AND status IN ($2, $3, $4))
+		`, revision.CourseID, domain.SubmissionSubmitted, domain.SubmissionInReview, domain.SubmissionScheduled).Scan(&open)
+		if err != nil {
+			return fmt.Errorf("failed to check open submissions: %w", err)
+		}
+		if open {
+			return domain.ErrAlreadySubmitted
+		}
+		return nil
+	})
+}
+
+// PublishApproved publishes the revision and moves the submission that
+// approved it on from its from status, all or nothing.
+func (r *revisionRepository) PublishApproved(ctx context.Context, revision *domain.CourseRevision, submission *domain.CourseSubmission, from domain.SubmissionStatus) error {
+	return r.publish(ctx, revision, func(tx *sqlx.Tx) error {
+		return updateSubmission(ctx, tx, submission, from)
+	})
+}
+
+// publish makes revision the live content of its course in one transaction:
+// the course row, modules and lessons are rewritten to match the snapshot, the
+// previously published revision is superseded and revision gets the next
+// number. A DRAFT revision is promoted in place and must still be at the
+// version it was read at; any other revision is stored as a new row, which is
+// how the initial publish and rollbacks are recorded. also runs in the same
+// transaction when it's set.
+func (r *revisionRepository) publish(ctx context.Context, revision *domain.CourseRevision, also func(tx *sqlx.Tx) error) error {
+	content, err := json.Marshal(revision.Content)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision content: %w", err)
+	}
+
+	now := time.Now()
+
+	err = r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		// Lock the course so concurrent publishes number revisions serially
+		var courseID string
+		err := tx.QueryRowContext(ctx, `SELECT id FROM courses WHERE id = $1 FOR UPDATE`, revision.CourseID).Scan(&courseID)
+		if err == sql.ErrNoRows {
+			return domain.ErrCourseNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock course: %w", err)
+		}
+
+		var number int
+		err = tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(number), 0) + 1 FROM course_revisions WHERE course_id = $1`, revision.CourseID).Scan(&number)
+		if err != nil {
+			return fmt.Errorf("failed to get next revision number: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`UPDATE course_revisions SET status = $1, updated_at = $2 WHERE course_id = $3 AND status = $4`,
+			domain.RevisionSuperseded, now, revision.CourseID, domain.RevisionPublished,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to supersede published revision: %w", err)
+		}
+
+		var sourceRevision any
+		if revision.SourceRevision > 0 {
+			sourceRevision = revision.SourceRevision
+		}
+
+		if revision.Status == domain.RevisionDraft {
+			result, err := tx.ExecContext(ctx, `
+				UPDATE course_revisions
+				SET number = $1, status = $2, content = $3, version = version + 1, updated_at = $4, published_at = $4
+				WHERE id = $5 AND status = $6 AND version = $7
+			`, number, domain.RevisionPublished, content, now, revision.ID, domain.RevisionDraft, revision.Version)
+			if err != nil {
+				return fmt.Errorf("failed to publish draft: %w", err)
+			}
+			rows, _ := result.RowsAffected()
+			if rows == 0 {
+				return domain.ErrRevisionConflict
+			}
+		} else {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO course_revisions (id, course_id, number, status, content, source_revision, version, created_by, created_at, updated_at, published_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9, $9)
+			`, revision.ID, revision.CourseID, number, domain.RevisionPublished, content, sourceRevision, revision.Version, revision.CreatedBy, now)
+			if err != nil {
+				return fmt.Errorf("failed to create revision: %w", err)
+			}
+		}
+
+		if err := applyContent(ctx, tx, revision.CourseID, &revision.Content, now); err != nil {
+			return err
+		}
+
+		if also != nil {
+			if err := also(tx); err != nil {
+				return err
+			}
+		}
+
+		revision.Number = number
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if revision.Status == domain.RevisionDraft {
+		revision.Version++
+	}
+	revision.Status = domain.RevisionPublished
+	revision.UpdatedAt = now
+	revision.PublishedAt = &now
+	return nil
+}
+
+// applyContent rewrites the live course, modules and lessons to match content.
+// Lessons and modules missing from content are deleted; the rest are upserted
+// by ID so existing IDs referenced by enrollments stay stable.
+func applyContent(ctx context.Context, tx *sqlx.Tx, courseID string, content *domain.CourseContent, now time.Time) error {
+	details := content.Details
+	_, err := tx.ExecContext(ctx, `
+		UPDATE courses
+		SET title = $1, description = $2, thumbnail_url = $3, level = $4, price = $5, category = $6, tags = $7, status = $8, updated_at = $9,
+			archive_at = CASE WHEN archive_at <= $9 THEN NULL ELSE archive_at END, duration_minutes = $11
+		WHERE id = $10
+	`, details.Title, details.Description, details.ThumbnailURL, details.Level, details.Price,
+		details.Category, pq.Array(details.Tags), domain.StatusPublished, now, courseID, content.DurationMinutes(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update course: %w", err)
+	}
+
+	var moduleIDs, lessonIDs []string
+	for _, m := range content.Modules {
+		moduleIDs = append(moduleIDs, m.ID)
+		for _, l := range m.Lessons {
+			lessonIDs = append(lessonIDs, l.ID)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM lessons
+		WHERE module_id IN (SELECT id FROM modules WHERE course_id = $1) AND NOT (id = ANY($2::uuid[]))
+	`, courseID, pq.Array(lessonIDs))
+	if err != nil {
+		return fmt.Errorf("failed to delete removed lessons: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM modules WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))`,
+		courseID, pq.Array(moduleIDs),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete removed modules: %w", err)
+	}
+
+	for _, m := range content.Modules {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO modules (id, course_id, title, description, order_index, release_rule, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id) DO UPDATE
+			SET title = EXCLUDED.title, description = EXCLUDED.description, order_index = EXCLUDED.order_index,
+				release_rule = EXCLUDED.release_rule
+		`, m.ID, courseID, m.Title, m.Description, m.OrderIndex, releaseValue(m.Release), m.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to upsert module: %w", err)
+		}
+
+		for _, l := range m.Lessons {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO lessons (id, module_id, title, description, video_id, duration_seconds, order_index, is_preview, type, release_rule, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+				ON CONFLICT (id) DO UPDATE
+				SET module_id = EXCLUDED.module_id, title = EXCLUDED.title, description = EXCLUDED.description,
+					video_id = EXCLUDED.video_id, duration_seconds = EXCLUDED.duration_seconds,
+					order_index = EXCLUDED.order_index, is_preview = EXCLUDED.is_preview, type = EXCLUDED.type,
+					release_rule = EXCLUDED.release_rule
+			`, l.ID, m.ID, l.Title, l.Description, l.VideoID, l.DurationSeconds, l.OrderIndex, l.IsPreview, l.LessonType(), releaseValue(l.Release), l.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("failed to upsert lesson: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func scanRevisionWithContent(row *sql.Row) (*domain.CourseRevision, error) {
+	var revision domain.CourseRevision
+	var publishedAt sql.NullTime
+	var content []byte
+
+	if err := row.Scan(
+		&revision.ID, &revision.CourseID, &revision.Number, &revision.Status, &revision.SourceRevision,
+		&revision.Version, &revision.CreatedBy, &revision.CreatedAt, &revision.UpdatedAt, &publishedAt,
+		&content,
+	); err != nil {
+		return nil, err
+	}
+
+	if publishedAt.Valid {
+		revision.PublishedAt = &publishedAt.Time
+	}
+	if err := json.Unmarshal(content, &revision.Content); err != nil {
+		return nil, fmt.Errorf("failed to decode revision content: %w", err)
+	}
+
+	return &revision, nil
+}
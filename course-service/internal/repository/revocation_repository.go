@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+)
+
+// RevocationRepository keeps the token cutoffs of suspended users, as
+// reported by user-service, so a restarted replica still rejects tokens
+// issued before a suspension.
+type RevocationRepository interface {
+	Record(ctx context.Context, userID string, revokedAt time.Time) error
+	ListSince(ctx context.Context, since time.Time) (map[string]time.Time, error)
+}
+
+type revocationRepository struct {
+	db *database.DB
+}
+
+func NewRevocationRepository(db *database.DB) RevocationRepository {
+	return &revocationRepository{db: db}
+}
+
+// Record keeps the latest cutoff, so a redelivered event can't move it back.
+func (r *revocationRepository) Record(ctx context.Context, userID string, revokedAt time.Time) error {
+	query := `
+		INSERT INTO token_revocations (user_id, revoked_at)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET revoked_at = GREATEST(token_revocations.revoked_at, EXCLUDED.revoked_at)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, revokedAt); err != nil {
+		return fmt.Errorf("failed to record token revocation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *revocationRepository) ListSince(ctx context.Context, since time.Time) (map[string]time.Time, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT user_id, revoked_at FROM token_revocations WHERE revoked_at > $1`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list token revocations: %w", err)
+	}
+	defer rows.Close()
+
+	revocations := make(map[string]time.Time)
+	for rows.Next() {
+		var userID string
+		var revokedAt time.Time
+		if err := rows.Scan(&userID, &revokedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan token revocation: %w", err)
+		}
+		revocations[userID] = revokedAt
+	}
+
+	return revocations, nil
+}
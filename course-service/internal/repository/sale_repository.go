@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+)
+
+type SaleRepository interface {
+	Create(ctx context.Context, sale *domain.Sale) error
+	GetByID(ctx context.Context, id string) (*domain.Sale, error)
+	ListByCourse(ctx context.Context, courseID string) ([]*domain.Sale, error)
+	ListActive(ctx context.Context, courseID string, now time.Time) ([]*domain.Sale, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type saleRepository struct {
+	db *database.DB
+}
+
+func NewSaleRepository(db *database.DB) SaleRepository {
+	return &saleRepository{db: db}
+}
+
+const saleColumns = `id, name, course_id, discount_type, discount_value, starts_at, ends_at, created_by, created_at`
+
+func (r *saleRepository) Create(ctx context.Context, sale *domain.Sale) error {
+	query := `
+		INSERT INTO sales (id, name, course_id, discount_type, discount_value, starts_at, ends_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	var courseID any
+	if sale.CourseID != "" {
+		courseID = sale.CourseID
+	}
+
+	if _, err := r.db.ExecContext(ctx, query,
+		sale.ID, sale.Name, courseID, sale.Discount.Type, sale.Discount.Value,
+		sale.StartsAt, sale.EndsAt, sale.CreatedBy, sale.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create sale: %w", err)
+	}
+
+	return nil
+}
+
+func (r *saleRepository) GetByID(ctx context.Context, id string) (*domain.Sale, error) {
+	sale, err := scanSale(r.db.QueryRowContext(ctx, `SELECT `+saleColumns+` FROM sales WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrSaleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sale: %w", err)
+	}
+
+	return sale, nil
+}
+
+// ListByCourse lists the sales scoped to courseID, or the sitewide ones when
+// courseID is empty.
+func (r *saleRepository) ListByCourse(ctx context.Context, courseID string) ([]*domain.Sale, error) {
+	if courseID == "" {
+		return r.list(ctx, `SELECT `+saleColumns+` FROM sales WHERE course_id IS NULL ORDER BY starts_at DESC`)
+	}
+	return r.list(ctx, `SELECT `+saleColumns+` FROM sales WHERE course_id = $1 ORDER BY starts_at DESC`, courseID)
+}
+
+// ListActive returns the course's own sales and the sitewide ones running
+// at now.
+func (r *saleRepository) ListActive(ctx context.Context, courseID string, now time.Time) ([]*domain.Sale, error) {
+	query := `
+		SELECT ` + saleColumns + ` FROM sales
+		WHERE (course_id = $1 OR course_id IS NULL) AND starts_at <= $2 AND ends_at > $2
+	`
+	return r.list(ctx, query, courseID, now)
+}
+
+func (r *saleRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM sales WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete sale: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrSaleNotFound
+	}
+
+	return nil
+}
+
+func (r *saleRepository) list(ctx context.Context, query string, args ...any) ([]*domain.Sale, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sales: %w", err)
+	}
+	defer rows.Close()
+
+	var sales []*domain.Sale
+	for rows.Next() {
+		sale, err := scanSale(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sale: %w", err)
+		}
+		sales = append(sales, sale)
+	}
+
+	return sales, nil
+}
+
+func scanSale(row rowScanner) (*domain.Sale, error) {
+	var sale domain.Sale
+	var courseID sql.NullString
+
+	if err := row.Scan(
+		&sale.ID, &sale.Name, &courseID, &sale.Discount.Type, &sale.Discount.Value,
+		&sale.StartsAt, &sale.EndsAt, &sale.CreatedBy, &sale.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	sale.CourseID = courseID.String
+	return &sale, nil
+}
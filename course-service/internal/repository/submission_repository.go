@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+)
+
+type SubmissionRepository interface {
+	Create(ctx context.Context, submission *domain.CourseSubmission) error
+	GetByID(ctx context.Context, id string) (*domain.CourseSubmission, error)
+	GetOpenByCourse(ctx context.Context, courseID string) (*domain.CourseSubmission, error)
+	ListByCourse(ctx context.Context, courseID string) ([]*domain.CourseSubmission, error)
+	ListOpen(ctx context.Context, page, pageSize int) ([]*domain.CourseSubmission, int, error)
+	ListDueScheduled(ctx context.Context, now time.Time) ([]*domain.CourseSubmission, error)
+	Update(ctx context.Context, submission *domain.CourseSubmission, from domain.SubmissionStatus) error
+}
+
+type submissionRepository struct {
+	db *database.DB
+}
+
+func NewSubmissionRepository(db *database.DB) SubmissionRepository {
+	return &submissionRepository{db: db}
+}
+
+const submissionColumns = `s.id, s.course_id, c.title, s.instructor_id, s.status, s.reviewer_id, s.comment, s.submitted_at, s.reviewed_at`
+
+func (r *submissionRepository) Create(ctx context.Context, submission *domain.CourseSubmission) error {
+	// The partial unique index allows one open submission per course
+	query := `
+		INSERT INTO course_submissions (id, course_id, instructor_id, status, reviewer_id, comment, submitted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		submission.ID, submission.CourseID, submission.InstructorID, submission.Status,
+		submission.ReviewerID, submission.Comment, submission.SubmittedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create submission: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrAlreadySubmitted
+	}
+
+	return nil
+}
+
+func (r *submissionRepository) GetByID(ctx context.Context, id string) (*domain.CourseSubmission, error) {
+	query := `SELECT ` + submissionColumns + ` FROM course_submissions s JOIN courses c ON c.id = s.course_id WHERE s.id = $1`
+
+	submission, err := scanSubmission(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrSubmissionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submission: %w", err)
+	}
+
+	return submission, nil
+}
+
+func (r *submissionRepository) GetOpenByCourse(ctx context.Context, courseID string) (*domain.CourseSubmission, error) {
+	query := `
+		SELECT ` + submissionColumns + `
+		FROM course_submissions s JOIN courses c ON c.id = s.course_id
+		WHERE s.course_id = $1 AND s.status IN ($2, $3, $4)
+	`
+
+	submission, err := scanSubmission(r.db.QueryRowContext(ctx, query,
+		courseID, domain.SubmissionSubmitted, domain.SubmissionInReview, domain.SubmissionScheduled,
+	))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrSubmissionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open submission: %w", err)
+	}
+
+	return submission, nil
+}
+
+func (r *submissionRepository) ListByCourse(ctx context.Context, courseID string) ([]*domain.CourseSubmission, error) {
+	query := `
+		SELECT ` + submissionColumns + `
+		FROM course_submissions s JOIN courses c ON c.id = s.course_id
+		WHERE s.course_id = $1
+		ORDER BY s.submitted_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var submissions []*domain.CourseSubmission
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan submission: %w", err)
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, nil
+}
+
+// ListOpen returns the admin review queue, oldest submission first.
+func (r *submissionRepository) ListOpen(ctx context.Context, page, pageSize int) ([]*domain.CourseSubmission, int, error) {
+	offset := (page - 1) * pageSize
+
+	countQuery := `SELECT COUNT(*) FROM course_submissions WHERE status IN ($1, $2)`
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, domain.SubmissionSubmitted, domain.SubmissionInReview).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count submissions: %w", err)
+	}
+
+	query := `
+		SELECT ` + submissionColumns + `
+		FROM course_submissions s JOIN courses c ON c.id = s.course_id
+		WHERE s.status IN ($1, $2)
+		ORDER BY s.submitted_at ASC LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.SubmissionSubmitted, domain.SubmissionInReview, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var submissions []*domain.CourseSubmission
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan submission: %w", err)
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, total, nil
+}
+
+// ListDueScheduled returns scheduled approvals whose course publish time has
+// passed, or was cleared after the approval.
+func (r *submissionRepository) ListDueScheduled(ctx context.Context, now time.Time) ([]*domain.CourseSubmission, error) {
+	query := `
+		SELECT ` + submissionColumns + `
+		FROM course_submissions s JOIN courses c ON c.id = s.course_id
+		WHERE s.status = $1 AND (c.publish_at IS NULL OR c.publish_at <= $2)
+		ORDER BY c.publish_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.SubmissionScheduled, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var submissions []*domain.CourseSubmission
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan submission: %w", err)
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, nil
+}
+
+// Update persists a status transition. from is the status the submission was
+// read in, so two reviewers acting at once can't both succeed.
+func (r *submissionRepository) Update(ctx context.Context, submission *domain.CourseSubmission, from domain.SubmissionStatus) error {
+	return updateSubmission(ctx, r.db, submission, from)
+}
+
+// execer is a *database.DB or a *sqlx.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// updateSubmission moves the submission on from its from status, failing
+// with ErrInvalidTransition if it has moved on already.
+func updateSubmission(ctx context.Context, db execer, submission *domain.CourseSubmission, from domain.SubmissionStatus) error {
+	query := `
+		UPDATE course_submissions
+		SET status = $1, reviewer_id = $2, comment = $3, reviewed_at = $4
+		WHERE id = $5 AND status = $6
+	`
+
+	var reviewedAt any
+	if submission.ReviewedAt != nil {
+		reviewedAt = *submission.ReviewedAt
+	}
+
+	result, err := db.ExecContext(ctx, query,
+		submission.Status, submission.ReviewerID, submission.Comment, reviewedAt, submission.ID, from,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update submission: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrInvalidTransition
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSubmission(row rowScanner) (*domain.CourseSubmission, error) {
+	var submission domain.CourseSubmission
+	var reviewedAt sql.NullTime
+
+	if err := row.Scan(
+		&submission.ID, &submission.CourseID, &submission.CourseTitle, &submission.InstructorID,
+		&submission.Status, &submission.ReviewerID, &submission.Comment, &submission.SubmittedAt, &reviewedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if reviewedAt.Valid {
+		submission.ReviewedAt = &reviewedAt.Time
+	}
+
+	return &submission, nil
+}
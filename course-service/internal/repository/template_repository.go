@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+)
+
+type TemplateRepository interface {
+	Create(ctx context.Context, template *domain.CourseTemplate) error
+	GetByID(ctx context.Context, id string) (*domain.CourseTemplate, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, page, pageSize int) ([]*domain.CourseTemplate, int, error)
+}
+
+type templateRepository struct {
+	db *database.DB
+}
+
+func NewTemplateRepository(db *database.DB) TemplateRepository {
+	return &templateRepository{db: db}
+}
+
+const templateColumns = `id, name, description, content, created_by, created_at`
+
+func (r *templateRepository) Create(ctx context.Context, template *domain.CourseTemplate) error {
+	content, err := json.Marshal(template.Content)
+	if err != nil {
+		return fmt.Errorf("failed to encode template content: %w", err)
+	}
+
+	query := `
+		INSERT INTO course_templates (id, name, description, content, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		template.ID, template.Name, template.Description, content, template.CreatedBy, template.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create template: %w", err)
+	}
+
+	return nil
+}
+
+func (r *templateRepository) GetByID(ctx context.Context, id string) (*domain.CourseTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM course_templates WHERE id = $1`
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	return template, nil
+}
+
+func (r *templateRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM course_templates WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrTemplateNotFound
+	}
+
+	return nil
+}
+
+func (r *templateRepository) List(ctx context.Context, page, pageSize int) ([]*domain.CourseTemplate, int, error) {
+	offset := (page - 1) * pageSize
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM course_templates`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count templates: %w", err)
+	}
+
+	query := `SELECT ` + templateColumns + ` FROM course_templates ORDER BY name LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.QueryContext(ctx, query, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*domain.CourseTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, total, nil
+}
+
+func scanTemplate(row rowScanner) (*domain.CourseTemplate, error) {
+	var template domain.CourseTemplate
+	var content []byte
+
+	if err := row.Scan(
+		&template.ID, &template.Name, &template.Description, &content, &template.CreatedBy, &template.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, &template.Content); err != nil {
+		return nil, fmt.Errorf("failed to decode template content: %w", err)
+	}
+
+	return &template, nil
+}
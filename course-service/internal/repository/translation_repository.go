@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type TranslationRepository interface {
+	Put(ctx context.Context, translations []*domain.Translation) error
+	ListByCourse(ctx context.Context, courseID string) ([]*domain.Translation, error)
+	ListByLocale(ctx context.Context, courseID, locale string) ([]*domain.Translation, error)
+	ListCourseText(ctx context.Context, courseIDs []string) ([]*domain.Translation, error)
+	DeleteLocale(ctx context.Context, courseID, locale string) error
+}
+
+type translationRepository struct {
+	db *database.DB
+}
+
+func NewTranslationRepository(db *database.DB) TranslationRepository {
+	return &translationRepository{db: db}
+}
+
+const translationColumns = `course_id, locale, entity_type, entity_id, field, value, updated_at`
+
+// Put saves the translations together. An empty value removes the field's
+// translation, so it falls back to the default locale again.
+func (r *translationRepository) Put(ctx context.Context, translations []*domain.Translation) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		for _, t := range translations {
+			if t.Value == "" {
+				if _, err := tx.ExecContext(ctx, `
+					DELETE FROM course_translations
+					WHERE course_id = $1 AND locale = $2 AND entity_id = $3 AND field = $4
+				`, t.CourseID, t.Locale, t.EntityID, t.Field); err != nil {
+					return fmt.Errorf("failed to delete translation: %w", err)
+				}
+				continue
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO course_translations (`+translationColumns+`)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				ON CONFLICT (course_id, locale, entity_id, field) DO UPDATE
+				SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+			`, t.CourseID, t.Locale, t.Entity, t.EntityID, t.Field, t.Value, t.UpdatedAt); err != nil {
+				return fmt.Errorf("failed to save translation: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *translationRepository) ListByCourse(ctx context.Context, courseID string) ([]*domain.Translation, error) {
+	query := `SELECT ` + translationColumns + ` FROM course_translations WHERE course_id = $1 ORDER BY locale`
+
+	return r.list(ctx, query, courseID)
+}
+
+func (r *translationRepository) ListByLocale(ctx context.Context, courseID, locale string) ([]*domain.Translation, error) {
+	query := `SELECT ` + translationColumns + ` FROM course_translations WHERE course_id = $1 AND locale = $2`
+
+	return r.list(ctx, query, courseID, locale)
+}
+
+// ListCourseText returns the translations of the courses' own title and
+// description, in every locale, as needed for catalog listings.
+func (r *translationRepository) ListCourseText(ctx context.Context, courseIDs []string) ([]*domain.Translation, error) {
+	query := `SELECT ` + translationColumns + ` FROM course_translations WHERE course_id = ANY($1) AND entity_type = $2`
+
+	return r.list(ctx, query, pq.Array(courseIDs), domain.EntityCourse)
+}
+
+func (r *translationRepository) DeleteLocale(ctx context.Context, courseID, locale string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM course_translations WHERE course_id = $1 AND locale = $2`,
+		courseID, locale,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete translations: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrTranslationNotFound
+	}
+
+	return nil
+}
+
+func (r *translationRepository) list(ctx context.Context, query string, args ...any) ([]*domain.Translation, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+	defer rows.Close()
+
+	var translations []*domain.Translation
+	for rows.Next() {
+		var t domain.Translation
+		if err := rows.Scan(&t.CourseID, &t.Locale, &t.Entity, &t.EntityID, &t.Field, &t.Value, &t.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan translation: %w", err)
+		}
+		translations = append(translations, &t)
+	}
+
+	return translations, nil
+}
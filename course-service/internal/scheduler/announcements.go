@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/service"
+	"go.uber.org/zap"
+)
+
+// AnnouncementScheduler periodically sends announcements whose scheduled
+// time has come.
+type AnnouncementScheduler struct {
+	announcementService service.AnnouncementService
+	interval            time.Duration
+	logger              *zap.Logger
+}
+
+func NewAnnouncementScheduler(
+	announcementService service.AnnouncementService,
+	interval time.Duration,
+	logger *zap.Logger,
+) *AnnouncementScheduler {
+	return &AnnouncementScheduler{
+		announcementService: announcementService,
+		interval:            interval,
+		logger:              logger,
+	}
+}
+
+// Start runs until ctx is cancelled.
+func (s *AnnouncementScheduler) Start(ctx context.Context) {
+	s.logger.Info("starting announcement scheduler", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping announcement scheduler")
+			return
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+func (s *AnnouncementScheduler) runOnce(ctx context.Context) {
+	if sent, err := s.announcementService.SendDueAnnouncements(ctx, time.Now()); err != nil {
+		s.logger.Error("failed to send scheduled announcements", zap.Error(err))
+	} else if sent > 0 {
+		s.logger.Info("sent scheduled announcements", zap.Int("count", sent))
+	}
+}
@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/service"
+	"go.uber.org/zap"
+)
+
+// CleanupScheduler periodically removes the attachments of deleted lessons.
+type CleanupScheduler struct {
+	attachmentService service.AttachmentService
+	interval          time.Duration
+	logger            *zap.Logger
+}
+
+func NewCleanupScheduler(
+	attachmentService service.AttachmentService,
+	interval time.Duration,
+	logger *zap.Logger,
+) *CleanupScheduler {
+	return &CleanupScheduler{
+		attachmentService: attachmentService,
+		interval:          interval,
+		logger:            logger,
+	}
+}
+
+// Start runs until ctx is cancelled.
+func (s *CleanupScheduler) Start(ctx context.Context) {
+	s.logger.Info("starting cleanup scheduler", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping cleanup scheduler")
+			return
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+func (s *CleanupScheduler) runOnce(ctx context.Context) {
+	if deleted, err := s.attachmentService.DeleteOrphanedAttachments(ctx); err != nil {
+		s.logger.Error("failed to delete orphaned attachments", zap.Error(err))
+	} else if deleted > 0 {
+		s.logger.Info("deleted orphaned attachments", zap.Int("count", deleted))
+	}
+}
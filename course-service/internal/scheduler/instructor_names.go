@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/service"
+	"go.uber.org/zap"
+)
+
+// InstructorNameScheduler periodically looks up the names of instructors
+// course search doesn't know yet, once at start and then every interval.
+type InstructorNameScheduler struct {
+	courseService service.CourseService
+	interval      time.Duration
+	logger        *zap.Logger
+}
+
+func NewInstructorNameScheduler(
+	courseService service.CourseService,
+	interval time.Duration,
+	logger *zap.Logger,
+) *InstructorNameScheduler {
+	return &InstructorNameScheduler{
+		courseService: courseService,
+		interval:      interval,
+		logger:        logger,
+	}
+}
+
+// Start runs until ctx is cancelled.
+func (s *InstructorNameScheduler) Start(ctx context.Context) {
+	s.logger.Info("starting instructor name scheduler", zap.Duration("interval", s.interval))
+
+	s.runOnce(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping instructor name scheduler")
+			return
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+func (s *InstructorNameScheduler) runOnce(ctx context.Context) {
+	if found, err := s.courseService.BackfillInstructorNames(ctx); err != nil {
+		s.logger.Error("failed to backfill instructor names", zap.Error(err))
+	} else if found > 0 {
+		s.logger.Info("backfilled instructor names", zap.Int("count", found))
+	}
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/service"
+	"go.uber.org/zap"
+)
+
+// PublishingScheduler periodically publishes courses whose scheduled publish
+// time has come and archives those whose archive time has passed.
+type PublishingScheduler struct {
+	courseService service.CourseService
+	reviewService service.ReviewService
+	interval      time.Duration
+	logger        *zap.Logger
+}
+
+func NewPublishingScheduler(
+	courseService service.CourseService,
+	reviewService service.ReviewService,
+	interval time.Duration,
+	logger *zap.Logger,
+) *PublishingScheduler {
+	return &PublishingScheduler{
+		courseService: courseService,
+		reviewService: reviewService,
+		interval:      interval,
+		logger:        logger,
+	}
+}
+
+// Start runs until ctx is cancelled.
+func (s *PublishingScheduler) Start(ctx context.Context) {
+	s.logger.Info("starting publishing scheduler", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping publishing scheduler")
+			return
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+func (s *PublishingScheduler) runOnce(ctx context.Context) {
+	now := time.Now()
+
+	if published, err := s.reviewService.PublishDueCourses(ctx, now); err != nil {
+		s.logger.Error("failed to publish scheduled courses", zap.Error(err))
+	} else if published > 0 {
+		s.logger.Info("published scheduled courses", zap.Int("count", published))
+	}
+
+	if archived, err := s.courseService.ArchiveDueCourses(ctx, now); err != nil {
+		s.logger.Error("failed to archive due courses", zap.Error(err))
+	} else if archived > 0 {
+		s.logger.Info("archived due courses", zap.Int("count", archived))
+	}
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/notification"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateAnnouncementRequest describes a new announcement. A nil or past
+// SendAt sends it straight away.
+type CreateAnnouncementRequest struct {
+	Title  string
+	Body   string
+	SendAt *time.Time
+}
+
+// AnnouncementService lets a course's staff broadcast announcements to its
+// enrolled students. Sending is queued on the course.announcement_published
+// topic and fanned out to each student in the background.
+type AnnouncementService interface {
+	CreateAnnouncement(ctx context.Context, courseID, authorID string, req CreateAnnouncementRequest) (*domain.Announcement, error)
+	ListAnnouncements(ctx context.Context, courseID, userID string, page, pageSize int) ([]*domain.Announcement, int, error)
+	SendDueAnnouncements(ctx context.Context, now time.Time) (int, error)
+	HandleAnnouncementPublished(ctx context.Context, key, value []byte) error
+}
+
+// announcementPageSize is how many students are read at a time while
+// fanning an announcement out.
+const announcementPageSize = 200
+
+type announcementService struct {
+	courseService    CourseService
+	announcementRepo repository.AnnouncementRepository
+	learnerRepo      repository.LearnerRepository
+	notifications    notification.Client
+	producer         *kafka.Producer
+	logger           *zap.Logger
+}
+
+func NewAnnouncementService(
+	courseService CourseService,
+	announcementRepo repository.AnnouncementRepository,
+	learnerRepo repository.LearnerRepository,
+	notifications notification.Client,
+	producer *kafka.Producer,
+	logger *zap.Logger,
+) AnnouncementService {
+	return &announcementService{
+		courseService:    courseService,
+		announcementRepo: announcementRepo,
+		learnerRepo:      learnerRepo,
+		notifications:    notifications,
+		producer:         producer,
+		logger:           logger,
+	}
+}
+
+// CreateAnnouncement schedules an announcement, or sends it if it's due.
+// Owners and editors can announce.
+func (s *announcementService) CreateAnnouncement(ctx context.Context, courseID, authorID string, req CreateAnnouncementRequest) (*domain.Announcement, error) {
+	course, err := s.courseService.Authorize(ctx, courseID, authorID, domain.PermEditContent)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	announcement := &domain.Announcement{
+		ID:        uuid.New().String(),
+		CourseID:  courseID,
+		AuthorID:  authorID,
+		Title:     strings.TrimSpace(req.Title),
+		Body:      strings.TrimSpace(req.Body),
+		Status:    domain.AnnouncementScheduled,
+		SendAt:    now,
+		CreatedAt: now,
+	}
+	if req.SendAt != nil && req.SendAt.After(now) {
+		announcement.SendAt = *req.SendAt
+	}
+
+	if err := announcement.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.announcementRepo.Create(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("announcement created",
+		zap.String("announcement_id", announcement.ID),
+		zap.String("course_id", courseID),
+		zap.Time("send_at", announcement.SendAt),
+	)
+
+	if !announcement.SendAt.After(now) {
+		// If this fails the scheduler picks the announcement up instead
+		if _, err := s.send(ctx, course, announcement); err != nil {
+			s.logger.Error("failed to send announcement", zap.String("announcement_id", announcement.ID), zap.Error(err))
+		}
+	}
+
+	return announcement, nil
+}
+
+// ListAnnouncements returns the course's announcements, latest first. Staff
+// see scheduled ones and delivery stats; enrolled students see what has been
+// sent to them.
+func (s *announcementService) ListAnnouncements(ctx context.Context, courseID, userID string, page, pageSize int) ([]*domain.Announcement, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	course, err := s.courseService.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	role, err := s.courseService.Role(ctx, course, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	staff := role.Can(domain.PermViewContent)
+
+	if !staff {
+		if _, err := s.learnerRepo.Get(ctx, userID, courseID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	announcements, total, err := s.announcementRepo.ListByCourse(ctx, courseID, !staff, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !staff {
+		for _, a := range announcements {
+			a.Recipients, a.Delivered, a.Failed = 0, 0, 0
+		}
+	}
+
+	return announcements, total, nil
+}
+
+// SendDueAnnouncements sends scheduled announcements whose time has come,
+// including any whose first attempt failed.
+func (s *announcementService) SendDueAnnouncements(ctx context.Context, now time.Time) (int, error) {
+	announcements, err := s.announcementRepo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, announcement := range announcements {
+		course, err := s.courseService.GetCourse(ctx, announcement.CourseID)
+		if err != nil {
+			s.logger.Error("failed to load announcement course", zap.String("announcement_id", announcement.ID), zap.Error(err))
+			continue
+		}
+
+		ok, err := s.send(ctx, course, announcement)
+		if err != nil {
+			s.logger.Error("failed to send announcement", zap.String("announcement_id", announcement.ID), zap.Error(err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// HandleAnnouncementPublished is a kafka.MessageHandler for the
+// course.announcement_published topic. It notifies every student enrolled in
+// the course and records how delivery went. A failed notification is counted
+// rather than retried, so one unreachable student doesn't hold up the rest.
+func (s *announcementService) HandleAnnouncementPublished(ctx context.Context, key, value []byte) error {
+	var event kafka.AnnouncementPublishedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	// Only the claim that queued it sends it, so a redelivered event doesn't
+	// notify everyone twice
+	announcement, err := s.announcementRepo.GetByID(ctx, event.AnnouncementID)
+	if err == domain.ErrAnnouncementNotFound {
+		s.logger.Warn("skipping unknown announcement", zap.String("announcement_id", event.AnnouncementID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if announcement.Status != domain.AnnouncementSending {
+		return nil
+	}
+
+	recipients, delivered, failed := 0, 0, 0
+	after := uuid.Nil.String()
+	for {
+		userIDs, err := s.learnerRepo.ListUserIDs(ctx, event.CourseID, after, announcementPageSize)
+		if err != nil {
+			// Nobody has been told twice yet if this is the first page;
+			// otherwise the rest of the course misses out
+			if recipients == 0 {
+				if requeueErr := s.announcementRepo.Requeue(ctx, event.AnnouncementID); requeueErr != nil {
+					s.logger.Error("failed to requeue announcement", zap.String("announcement_id", event.AnnouncementID), zap.Error(requeueErr))
+				}
+				return err
+			}
+			s.logger.Error("failed to list announcement recipients", zap.String("announcement_id", event.AnnouncementID), zap.Error(err))
+			break
+		}
+
+		for _, userID := range userIDs {
+			recipients++
+			err := s.notifications.Send(ctx, notification.Message{
+				UserID:  userID,
+				Subject: fmt.Sprintf("%s: %s", event.CourseTitle, event.Title),
+				Body:    event.Body,
+				Data: map[string]string{
+					"type":            "course_announcement",
+					"course_id":       event.CourseID,
+					"announcement_id": event.AnnouncementID,
+				},
+				Email: true,
+			})
+			if err != nil {
+				s.logger.Warn("failed to notify student of announcement",
+					zap.String("announcement_id", event.AnnouncementID),
+					zap.String("user_id", userID),
+					zap.Error(err),
+				)
+				failed++
+				continue
+			}
+			delivered++
+		}
+
+		if len(userIDs) < announcementPageSize {
+			break
+		}
+		after = userIDs[len(userIDs)-1]
+	}
+
+	err = s.announcementRepo.RecordDelivery(ctx, event.AnnouncementID, recipients, delivered, failed, time.Now())
+	if err == domain.ErrAnnouncementNotFound {
+		// The course was deleted while the announcement was going out
+		s.logger.Warn("delivery recorded for deleted announcement", zap.String("announcement_id", event.AnnouncementID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("announcement delivered",
+		zap.String("announcement_id", event.AnnouncementID),
+		zap.String("course_id", event.CourseID),
+		zap.Int("recipients", recipients),
+		zap.Int("delivered", delivered),
+		zap.Int("failed", failed),
+	)
+	return nil
+}
+
+// send claims the announcement and queues it to be fanned out. It
+// reports false if someone else claimed it first; on failure it goes back to
+// the schedule to be retried.
+func (s *announcementService) send(ctx context.Context, course *domain.Course, announcement *domain.Announcement) (bool, error) {
+	ok, err := s.announcementRepo.Claim(ctx, announcement.ID)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	event := kafka.AnnouncementPublishedEvent{
+		AnnouncementID: announcement.ID,
+		CourseID:       announcement.CourseID,
+		CourseTitle:    course.Title,
+		Title:          announcement.Title,
+		Body:           announcement.Body,
+		Timestamp:      time.Now(),
+	}
+	if err := s.producer.PublishMessage(ctx, announcement.CourseID, event); err != nil {
+		if requeueErr := s.announcementRepo.Requeue(ctx, announcement.ID); requeueErr != nil {
+			s.logger.Error("failed to requeue announcement", zap.String("announcement_id", announcement.ID), zap.Error(requeueErr))
+		}
+		return false, err
+	}
+
+	announcement.Status = domain.AnnouncementSending
+	s.logger.Info("announcement sending", zap.String("announcement_id", announcement.ID), zap.String("course_id", announcement.CourseID))
+	return true, nil
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/notification"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (s *fakeCourseService) Role(ctx context.Context, course *domain.Course, userID string) (domain.CourseRole, error) {
+	if userID == s.ownerID {
+		return domain.RoleOwner, nil
+	}
+	return s.roles[userID], nil
+}
+
+// fakeAnnouncementRepo keeps announcements by ID. Claim and Requeue move
+// them between SCHEDULED and SENDING as the real one does.
+type fakeAnnouncementRepo struct {
+	repository.AnnouncementRepository
+	announcements map[string]*domain.Announcement
+	createErr     error
+	due           []*domain.Announcement
+	requeued      []string
+}
+
+func (r *fakeAnnouncementRepo) Create(ctx context.Context, announcement *domain.Announcement) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	r.announcements[announcement.ID] = announcement
+	return nil
+}
+
+func (r *fakeAnnouncementRepo) GetByID(ctx context.Context, id string) (*domain.Announcement, error) {
+	announcement, ok := r.announcements[id]
+	if !ok {
+		return nil, domain.ErrAnnouncementNotFound
+	}
+	return announcement, nil
+}
+
+func (r *fakeAnnouncementRepo) ListByCourse(ctx context.Context, courseID string, sentOnly bool, page, pageSize int) ([]*domain.Announcement, int, error) {
+	var announcements []*domain.Announcement
+	for _, id := range slices.Sorted(maps.Keys(r.announcements)) {
+		a := r.announcements[id]
+		if a.CourseID == courseID && !(sentOnly && a.Status == domain.AnnouncementScheduled) {
+			copied := *a
+			announcements = append(announcements, &copied)
+		}
+	}
+	return announcements, len(announcements), nil
+}
+
+func (r *fakeAnnouncementRepo) ListDue(ctx context.Context, now time.Time) ([]*domain.Announcement, error) {
+	return r.due, nil
+}
+
+func (r *fakeAnnouncementRepo) Claim(ctx context.Context, id string) (bool, error) {
+	announcement, ok := r.announcements[id]
+	if !ok || announcement.Status != domain.AnnouncementScheduled {
+		return false, nil
+	}
+	announcement.Status = domain.AnnouncementSending
+	return true, nil
+}
+
+func (r *fakeAnnouncementRepo) Requeue(ctx context.Context, id string) error {
+	r.requeued = append(r.requeued, id)
+	if announcement, ok := r.announcements[id]; ok && announcement.Status == domain.AnnouncementSending {
+		announcement.Status = domain.AnnouncementScheduled
+	}
+	return nil
+}
+
+func (r *fakeAnnouncementRepo) RecordDelivery(ctx context.Context, id string, recipients, delivered, failed int, at time.Time) error {
+	announcement, ok := r.announcements[id]
+	if !ok {
+		return domain.ErrAnnouncementNotFound
+	}
+	announcement.Status = domain.AnnouncementSent
+	announcement.Recipients, announcement.Delivered, announcement.Failed = recipients, delivered, failed
+	announcement.SentAt = &at
+	return nil
+}
+
+// fakeLearnerRepo holds each course's learners in user ID order. Listing a
+// page fails with pageErrs[afterUserID], and afters records every page asked
+// for.
+type fakeLearnerRepo struct {
+	repository.LearnerRepository
+	learners map[string][]string
+	pageErrs map[string]error
+	afters   []string
+}
+
+func (r *fakeLearnerRepo) Get(ctx context.Context, userID, courseID string) (*domain.Learner, error) {
+	if !slices.Contains(r.learners[courseID], userID) {
+		return nil, domain.ErrNotEnrolled
+	}
+	return &domain.Learner{UserID: userID, CourseID: courseID, Completed: map[string]bool{}}, nil
+}
+
+func (r *fakeLearnerRepo) ListUserIDs(ctx context.Context, courseID, afterUserID string, limit int) ([]string, error) {
+	r.afters = append(r.afters, afterUserID)
+	if err := r.pageErrs[afterUserID]; err != nil {
+		return nil, err
+	}
+
+	var userIDs []string
+	for _, userID := range r.learners[courseID] {
+		if userID > afterUserID && len(userIDs) < limit {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs, nil
+}
+
+type fakeNotificationClient struct {
+	failing map[string]bool
+	sent    []notification.Message
+}
+
+func (c *fakeNotificationClient) Send(ctx context.Context, msg notification.Message) error {
+	if c.failing[msg.UserID] {
+		return errors.New("notification-service unavailable")
+	}
+	c.sent = append(c.sent, msg)
+	return nil
+}
+
+// learnerIDs returns n user IDs that sort in the order they're numbered.
+func learnerIDs(n int) []string {
+	userIDs := make([]string, n)
+	for i := range userIDs {
+		userIDs[i] = fmt.Sprintf("user-%03d", i)
+	}
+	return userIDs
+}
+
+func newTestAnnouncementService(t *testing.T, announcements *fakeAnnouncementRepo, learners *fakeLearnerRepo, notifications *fakeNotificationClient) AnnouncementService {
+	t.Helper()
+
+	producer := kafka.NewProducer(nil, "course.announcement_published", zap.NewNop())
+	t.Cleanup(func() { producer.Close() })
+
+	courses := &fakeCourseService{
+		ownerID: "instructor-1",
+		courses: map[string]*domain.Course{"course-1": {ID: "course-1", Title: "Go", InstructorID: "instructor-1"}},
+	}
+	return NewAnnouncementService(courses, announcements, learners, notifications, producer, zap.NewNop())
+}
+
+func TestCreateAnnouncement(t *testing.T) {
+	later := time.Now().Add(time.Hour)
+	earlier := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		authorID     string
+		req          CreateAnnouncementRequest
+		createErr    error
+		wantErr      error
+		wantSendAt   *time.Time
+		wantRequeued bool
+	}{
+		{
+			name:       "scheduled for later waits",
+			authorID:   "instructor-1",
+			req:        CreateAnnouncementRequest{Title: "Live session", Body: "Moved to Friday", SendAt: &later},
+			wantSendAt: &later,
+		},
+		{
+			// The test producer can't publish, so the announcement goes back
+			// to the schedule for the scheduler to retry
+			name:         "without a send time it goes out now",
+			authorID:     "instructor-1",
+			req:          CreateAnnouncementRequest{Title: "Module 3", Body: "Is out"},
+			wantRequeued: true,
+		},
+		{
+			name:         "a past send time goes out now",
+			authorID:     "instructor-1",
+			req:          CreateAnnouncementRequest{Title: "Module 3", Body: "Is out", SendAt: &earlier},
+			wantRequeued: true,
+		},
+		{
+			name:     "not staff",
+			authorID: "student-1",
+			req:      CreateAnnouncementRequest{Title: "Module 3", Body: "Is out"},
+			wantErr:  domain.ErrUnauthorized,
+		},
+		{
+			name:     "blank title",
+			authorID: "instructor-1",
+			req:      CreateAnnouncementRequest{Title: " ", Body: "Is out"},
+			wantErr:  domain.ErrInvalidInput,
+		},
+		{
+			name:      "too many nearby",
+			authorID:  "instructor-1",
+			req:       CreateAnnouncementRequest{Title: "Module 3", Body: "Is out", SendAt: &later},
+			createErr: domain.ErrAnnouncementRateLimited,
+			wantErr:   domain.ErrAnnouncementRateLimited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			announcements := &fakeAnnouncementRepo{announcements: map[string]*domain.Announcement{}, createErr: tt.createErr}
+			svc := newTestAnnouncementService(t, announcements, &fakeLearnerRepo{}, &fakeNotificationClient{})
+
+			before := time.Now()
+			announcement, err := svc.CreateAnnouncement(context.Background(), "course-1", tt.authorID, tt.req)
+			if err != nil || tt.wantErr != nil {
+				if err != tt.wantErr {
+					t.Fatalf("CreateAnnouncement() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(announcements.announcements) != 0 {
+					t.Errorf("stored %d announcements, want none", len(announcements.announcements))
+				}
+				return
+			}
+
+			if announcements.announcements[announcement.ID] != announcement {
+				t.Error("announcement wasn't stored")
+			}
+			if announcement.Status != domain.AnnouncementScheduled {
+				t.Errorf("Status = %s, want %s", announcement.Status, domain.AnnouncementScheduled)
+			}
+			if tt.wantSendAt != nil {
+				if !announcement.SendAt.Equal(*tt.wantSendAt) {
+					t.Errorf("SendAt = %v, want %v", announcement.SendAt, *tt.wantSendAt)
+				}
+			} else if announcement.SendAt.Before(before) {
+				t.Errorf("SendAt = %v, want now", announcement.SendAt)
+			}
+			if got := len(announcements.requeued) == 1; got != tt.wantRequeued {
+				t.Errorf("requeued = %v, want requeued %v", announcements.requeued, tt.wantRequeued)
+			}
+		})
+	}
+}
+
+func TestListAnnouncements(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		wantErr   error
+		wantIDs   []string
+		wantStats bool
+	}{
+		{name: "staff see scheduled ones and stats", userID: "instructor-1", wantIDs: []string{"a-scheduled", "b-sent"}, wantStats: true},
+		{name: "students see what was sent", userID: "student-1", wantIDs: []string{"b-sent"}},
+		{name: "not enrolled", userID: "student-2", wantErr: domain.ErrNotEnrolled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			announcements := &fakeAnnouncementRepo{announcements: map[string]*domain.Announcement{
+				"a-scheduled": {ID: "a-scheduled", CourseID: "course-1", Status: domain.AnnouncementScheduled},
+				"b-sent":      {ID: "b-sent", CourseID: "course-1", Status: domain.AnnouncementSent, Recipients: 3, Delivered: 2, Failed: 1},
+				"c-other":     {ID: "c-other", CourseID: "course-2", Status: domain.AnnouncementSent},
+			}}
+			learners := &fakeLearnerRepo{learners: map[string][]string{"course-1": {"student-1"}}}
+			svc := newTestAnnouncementService(t, announcements, learners, &fakeNotificationClient{})
+
+			got, total, err := svc.ListAnnouncements(context.Background(), "course-1", tt.userID, 1, 10)
+			if err != tt.wantErr {
+				t.Fatalf("ListAnnouncements() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var ids []string
+			for _, a := range got {
+				ids = append(ids, a.ID)
+				if hasStats := a.Recipients != 0 || a.Delivered != 0 || a.Failed != 0; a.Status == domain.AnnouncementSent && hasStats != tt.wantStats {
+					t.Errorf("%s stats = %d/%d/%d, want shown %v", a.ID, a.Recipients, a.Delivered, a.Failed, tt.wantStats)
+				}
+			}
+			if !slices.Equal(ids, tt.wantIDs) || total != len(tt.wantIDs) {
+				t.Errorf("announcements = %v (total %d), want %v", ids, total, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestSendDueAnnouncements(t *testing.T) {
+	due := []*domain.Announcement{
+		{ID: "claimed-elsewhere", CourseID: "course-1", Status: domain.AnnouncementSending},
+		{ID: "course-deleted", CourseID: "course-9", Status: domain.AnnouncementScheduled},
+		{ID: "publish-fails", CourseID: "course-1", Status: domain.AnnouncementScheduled},
+	}
+	announcements := &fakeAnnouncementRepo{announcements: map[string]*domain.Announcement{}, due: due}
+	for _, a := range due {
+		announcements.announcements[a.ID] = a
+	}
+	svc := newTestAnnouncementService(t, announcements, &fakeLearnerRepo{}, &fakeNotificationClient{})
+
+	sent, err := svc.SendDueAnnouncements(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("SendDueAnnouncements() error = %v", err)
+	}
+	if sent != 0 {
+		t.Errorf("sent = %d, want 0", sent)
+	}
+	// Only the one this run claimed goes back to the schedule
+	if want := []string{"publish-fails"}; !slices.Equal(announcements.requeued, want) {
+		t.Errorf("requeued = %v, want %v", announcements.requeued, want)
+	}
+	if status := announcements.announcements["claimed-elsewhere"].Status; status != domain.AnnouncementSending {
+		t.Errorf("announcement claimed elsewhere is %s, want it left %s", status, domain.AnnouncementSending)
+	}
+}
+
+func TestHandleAnnouncementPublished(t *testing.T) {
+	errListFailed := errors.New("failed to list learners")
+
+	tests := []struct {
+		name           string
+		announcementID string
+		learners       int
+		status         domain.AnnouncementStatus
+		failing        []string
+		pageErrs       map[string]error
+		wantErr        error
+		wantAfters     []string
+		wantStatus     domain.AnnouncementStatus
+		wantStats      [3]int
+		wantRequeued   bool
+	}{
+		{
+			name:       "pages through every learner",
+			learners:   450,
+			status:     domain.AnnouncementSending,
+			wantAfters: []string{uuid.Nil.String(), "user-199", "user-399"},
+			wantStatus: domain.AnnouncementSent,
+			wantStats:  [3]int{450, 450, 0},
+		},
+		{
+			name:       "a full last page asks for one more",
+			learners:   400,
+			status:     domain.AnnouncementSending,
+			wantAfters: []string{uuid.Nil.String(), "user-199", "user-399"},
+			wantStatus: domain.AnnouncementSent,
+			wantStats:  [3]int{400, 400, 0},
+		},
+		{
+			name:       "no learners",
+			status:     domain.AnnouncementSending,
+			wantAfters: []string{uuid.Nil.String()},
+			wantStatus: domain.AnnouncementSent,
+		},
+		{
+			name:       "failed notifications are counted, not retried",
+			learners:   3,
+			status:     domain.AnnouncementSending,
+			failing:    []string{"user-001"},
+			wantAfters: []string{uuid.Nil.String()},
+			wantStatus: domain.AnnouncementSent,
+			wantStats:  [3]int{3, 2, 1},
+		},
+		{
+			name:         "first page failing requeues",
+			learners:     3,
+			status:       domain.AnnouncementSending,
+			pageErrs:     map[string]error{uuid.Nil.String(): errListFailed},
+			wantErr:      errListFailed,
+			wantAfters:   []string{uuid.Nil.String()},
+			wantStatus:   domain.AnnouncementScheduled,
+			wantRequeued: true,
+		},
+		{
+			name:       "later page failing records who was told",
+			learners:   450,
+			status:     domain.AnnouncementSending,
+			pageErrs:   map[string]error{"user-199": errListFailed},
+			wantAfters: []string{uuid.Nil.String(), "user-199"},
+			wantStatus: domain.AnnouncementSent,
+			wantStats:  [3]int{200, 200, 0},
+		},
+		{
+			name:       "redelivered after sending",
+			learners:   3,
+			status:     domain.AnnouncementSent,
+			wantStatus: domain.AnnouncementSent,
+		},
+		{
+			name:       "not claimed",
+			learners:   3,
+			status:     domain.AnnouncementScheduled,
+			wantStatus: domain.AnnouncementScheduled,
+		},
+		{
+			name:           "unknown announcement",
+			learners:       3,
+			announcementID: "announcement-9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			announcement := &domain.Announcement{ID: "announcement-1", CourseID: "course-1", Status: tt.status}
+			announcements := &fakeAnnouncementRepo{announcements: map[string]*domain.Announcement{announcement.ID: announcement}}
+			learners := &fakeLearnerRepo{learners: map[string][]string{"course-1": learnerIDs(tt.learners)}, pageErrs: tt.pageErrs}
+			notifications := &fakeNotificationClient{failing: map[string]bool{}}
+			for _, userID := range tt.failing {
+				notifications.failing[userID] = true
+			}
+			svc := newTestAnnouncementService(t, announcements, learners, notifications)
+
+			if tt.announcementID == "" {
+				tt.announcementID = announcement.ID
+			}
+			value, err := json.Marshal(kafka.AnnouncementPublishedEvent{
+				AnnouncementID: tt.announcementID,
+				CourseID:       "course-1",
+				CourseTitle:    "Go",
+				Title:          "Module 3",
+				Body:           "Is out",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = svc.HandleAnnouncementPublished(context.Background(), []byte("course-1"), value)
+			if err != tt.wantErr {
+				t.Fatalf("HandleAnnouncementPublished() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !slices.Equal(learners.afters, tt.wantAfters) {
+				t.Errorf("pages after = %v, want %v", learners.afters, tt.wantAfters)
+			}
+			if tt.wantStatus != "" && announcement.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", announcement.Status, tt.wantStatus)
+			}
+			if got := [3]int{announcement.Recipients, announcement.Delivered, announcement.Failed}; got != tt.wantStats {
+				t.Errorf("recipients/delivered/failed = %v, want %v", got, tt.wantStats)
+			}
+			if got := len(announcements.requeued) > 0; got != tt.wantRequeued {
+				t.Errorf("requeued = %v, want requeued %v", announcements.requeued, tt.wantRequeued)
+			}
+			if len(notifications.sent) != tt.wantStats[1] {
+				t.Errorf("sent %d notifications, want %d", len(notifications.sent), tt.wantStats[1])
+			}
+		})
+	}
+}
+
+func TestHandleAnnouncementPublishedMessage(t *testing.T) {
+	announcements := &fakeAnnouncementRepo{announcements: map[string]*domain.Announcement{
+		"announcement-1": {ID: "announcement-1", CourseID: "course-1", Status: domain.AnnouncementSending},
+	}}
+	learners := &fakeLearnerRepo{learners: map[string][]string{"course-1": {"student-1"}}}
+	notifications := &fakeNotificationClient{}
+	svc := newTestAnnouncementService(t, announcements, learners, notifications)
+
+	value, err := json.Marshal(kafka.AnnouncementPublishedEvent{
+		AnnouncementID: "announcement-1",
+		CourseID:       "course-1",
+		CourseTitle:    "Go",
+		Title:          "Module 3",
+		Body:           "Is out",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.HandleAnnouncementPublished(context.Background(), nil, value); err != nil {
+		t.Fatalf("HandleAnnouncementPublished() error = %v", err)
+	}
+
+	if len(notifications.sent) != 1 {
+		t.Fatalf("sent %d notifications, want 1", len(notifications.sent))
+	}
+	msg := notifications.sent[0]
+	if msg.UserID != "student-1" || msg.Subject != "Go: Module 3" || msg.Body != "Is out" || !msg.Email {
+		t.Errorf("message = %+v, want an email to student-1 titled %q", msg, "Go: Module 3")
+	}
+	wantData := map[string]string{"type": "course_announcement", "course_id": "course-1", "announcement_id": "announcement-1"}
+	if !maps.Equal(msg.Data, wantData) {
+		t.Errorf("Data = %v, want %v", msg.Data, wantData)
+	}
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/storage"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Upload is a file streamed in by a client.
+type Upload struct {
+	FileName    string
+	ContentType string
+	Body        io.Reader
+}
+
+// cleanName strips any directories a client left in the file name and
+// rejects names and content types that don't fit the database.
+func (u Upload) cleanName() (string, error) {
+	fileName := path.Base(strings.ReplaceAll(u.FileName, "\\", "/"))
+	if fileName == "." || fileName == "/" || len(fileName) > 255 || len(u.ContentType) > 255 {
+		return "", domain.ErrInvalidInput
+	}
+	return fileName, nil
+}
+
+// AssignmentProducers holds one producer per topic the assignment service
+// publishes to.
+type AssignmentProducers struct {
+	Submitted *kafka.Producer
+	Graded    *kafka.Producer
+}
+
+// AssignmentService manages ASSIGNMENT lessons: their instructions and
+// rubric, learners' uploads, and the instructor's grading queue.
+type AssignmentService interface {
+	SetLessonAssignment(ctx context.Context, lessonID, courseID, instructorID string, assignment *domain.Assignment) (*domain.Assignment, error)
+	GetLessonAssignment(ctx context.Context, lessonID, userID string) (*domain.Assignment, error)
+	SubmitAssignment(ctx context.Context, lessonID, userID string, upload Upload) (*domain.AssignmentSubmission, error)
+	GetMySubmission(ctx context.Context, lessonID, userID string) (*domain.AssignmentSubmission, error)
+	OpenSubmissionFile(ctx context.Context, submissionID, userID string) (*domain.AssignmentSubmission, io.ReadCloser, error)
+	ListGradingQueue(ctx context.Context, courseID, lessonID, instructorID string, page, pageSize int) ([]*domain.AssignmentSubmission, int, error)
+	GradeSubmission(ctx context.Context, submissionID, graderID string, scores []domain.CriterionScore, feedback string) (*domain.AssignmentSubmission, error)
+}
+
+type assignmentService struct {
+	courseService  CourseService
+	releaseService ReleaseService
+	assignmentRepo repository.AssignmentRepository
+	files          storage.FileStore
+	producers      AssignmentProducers
+	logger         *zap.Logger
+}
+
+func NewAssignmentService(
+	courseService CourseService,
+	releaseService ReleaseService,
+	assignmentRepo repository.AssignmentRepository,
+	files storage.FileStore,
+	producers AssignmentProducers,
+	logger *zap.Logger,
+) AssignmentService {
+	return &assignmentService{
+		courseService:  courseService,
+		releaseService: releaseService,
+		assignmentRepo: assignmentRepo,
+		files:          files,
+		producers:      producers,
+		logger:         logger,
+	}
+}
+
+// SetLessonAssignment configures an ASSIGNMENT lesson of the draft or live
+// content. Like quiz settings, changes apply straight away.
+func (s *assignmentService) SetLessonAssignment(ctx context.Context, lessonID, courseID, instructorID string, assignment *domain.Assignment) (*domain.Assignment, error) {
+	lesson, err := instructorLesson(ctx, s.courseService, courseID, instructorID, lessonID)
+	if err != nil {
+		return nil, err
+	}
+	if lesson.LessonType() != domain.LessonAssignment {
+		return nil, domain.ErrNotAssignmentLesson
+	}
+
+	assignment.LessonID = lessonID
+	assignment.CourseID = courseID
+	assignment.UpdatedAt = time.Now()
+	if err := assignment.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentRepo.UpsertAssignment(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("lesson assignment set", zap.String("lesson_id", lessonID))
+	return assignment, nil
+}
+
+func (s *assignmentService) GetLessonAssignment(ctx context.Context, lessonID, userID string) (*domain.Assignment, error) {
+	assignment, err := s.assignmentRepo.GetAssignment(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.releaseService.AuthorizeLesson(ctx, assignment.CourseID, lessonID, userID); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// SubmitAssignment stores the upload and hands it in, replacing the
+// learner's earlier upload if it hasn't been graded yet. Work handed in
+// after the due date is marked late.
+func (s *assignmentService) SubmitAssignment(ctx context.Context, lessonID, userID string, upload Upload) (*domain.AssignmentSubmission, error) {
+	assignment, err := s.assignmentRepo.GetAssignment(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.releaseService.AuthorizeLesson(ctx, assignment.CourseID, lessonID, userID); err != nil {
+		return nil, err
+	}
+
+	fileName, err := upload.cleanName()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	submission := &domain.AssignmentSubmission{
+		ID:          uuid.New().String(),
+		LessonID:    lessonID,
+		CourseID:    assignment.CourseID,
+		UserID:      userID,
+		FileKey:     path.Join("assignments", lessonID, uuid.New().String()),
+		FileName:    fileName,
+		ContentType: upload.ContentType,
+		Status:      domain.AssignmentSubmitted,
+		Late:        assignment.DueAt != nil && now.After(*assignment.DueAt),
+		SubmittedAt: now,
+	}
+
+	// Read one byte past the limit to tell a file of exactly the maximum
+	// size from a larger one
+	size, err := s.files.Put(ctx, submission.FileKey, io.LimitReader(upload.Body, domain.MaxAssignmentFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 || size > domain.MaxAssignmentFileSize {
+		s.deleteFile(ctx, submission.FileKey)
+		if size == 0 {
+			return nil, domain.ErrInvalidInput
+		}
+		return nil, domain.ErrFileTooLarge
+	}
+	submission.SizeBytes = size
+
+	previous, err := s.assignmentRepo.UpsertSubmission(ctx, submission)
+	if err != nil {
+		s.deleteFile(ctx, submission.FileKey)
+		return nil, err
+	}
+	if previous != "" {
+		s.deleteFile(ctx, previous)
+	}
+
+	s.logger.Info("assignment submitted",
+		zap.String("submission_id", submission.ID),
+		zap.String("lesson_id", lessonID),
+		zap.String("user_id", userID),
+		zap.Bool("late", submission.Late),
+	)
+
+	s.notifySubmitted(ctx, submission)
+	return submission, nil
+}
+
+func (s *assignmentService) GetMySubmission(ctx context.Context, lessonID, userID string) (*domain.AssignmentSubmission, error) {
+	return s.assignmentRepo.GetSubmissionFor(ctx, lessonID, userID)
+}
+
+// OpenSubmissionFile opens a submission's file for the learner who handed it
+// in or anyone who grades the course. The caller closes it.
+func (s *assignmentService) OpenSubmissionFile(ctx context.Context, submissionID, userID string) (*domain.AssignmentSubmission, io.ReadCloser, error) {
+	submission, err := s.assignmentRepo.GetSubmission(ctx, submissionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if submission.UserID != userID {
+		if _, err := s.courseService.Authorize(ctx, submission.CourseID, userID, domain.PermGrade); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	file, err := s.files.Open(ctx, submission.FileKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return submission, file, nil
+}
+
+// ListGradingQueue returns the course's ungraded submissions, oldest first.
+// An empty lessonID covers every assignment of the course.
+func (s *assignmentService) ListGradingQueue(ctx context.Context, courseID, lessonID, instructorID string, page, pageSize int) ([]*domain.AssignmentSubmission, int, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermGrade); err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.assignmentRepo.ListUngraded(ctx, courseID, lessonID, page, pageSize)
+}
+
+func (s *assignmentService) GradeSubmission(ctx context.Context, submissionID, graderID string, scores []domain.CriterionScore, feedback string) (*domain.AssignmentSubmission, error) {
+	submission, err := s.assignmentRepo.GetSubmission(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.courseService.Authorize(ctx, submission.CourseID, graderID, domain.PermGrade); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.assignmentRepo.GetAssignment(ctx, submission.LessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := submission.Grade(assignment, scores, feedback, graderID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentRepo.SaveGrade(ctx, submission); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("assignment graded",
+		zap.String("submission_id", submissionID),
+		zap.Int("score", submission.Score),
+		zap.Int("max_score", submission.MaxScore),
+	)
+
+	event := kafka.AssignmentGradedEvent{
+		SubmissionID: submission.ID,
+		LessonID:     submission.LessonID,
+		CourseID:     submission.CourseID,
+		UserID:       submission.UserID,
+		GraderID:     graderID,
+		Score:        submission.Score,
+		MaxScore:     submission.MaxScore,
+		Timestamp:    *submission.GradedAt,
+	}
+	if err := s.producers.Graded.PublishMessage(ctx, submission.UserID, event); err != nil {
+		s.logger.Error("failed to publish assignment graded event", zap.String("submission_id", submission.ID), zap.Error(err))
+	}
+
+	return submission, nil
+}
+
+func (s *assignmentService) notifySubmitted(ctx context.Context, submission *domain.AssignmentSubmission) {
+	course, err := s.courseService.GetCourse(ctx, submission.CourseID)
+	if err != nil {
+		s.logger.Error("failed to load course for assignment event", zap.String("submission_id", submission.ID), zap.Error(err))
+		return
+	}
+
+	event := kafka.AssignmentSubmittedEvent{
+		SubmissionID: submission.ID,
+		LessonID:     submission.LessonID,
+		CourseID:     submission.CourseID,
+		UserID:       submission.UserID,
+		InstructorID: course.InstructorID,
+		Late:         submission.Late,
+		Timestamp:    submission.SubmittedAt,
+	}
+	if err := s.producers.Submitted.PublishMessage(ctx, course.InstructorID, event); err != nil {
+		s.logger.Error("failed to publish assignment submitted event", zap.String("submission_id", submission.ID), zap.Error(err))
+	}
+}
+
+func (s *assignmentService) deleteFile(ctx context.Context, key string) {
+	if err := s.files.Delete(ctx, key); err != nil {
+		s.logger.Warn("failed to delete assignment file", zap.String("file_key", key), zap.Error(err))
+	}
+}
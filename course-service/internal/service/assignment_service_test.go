@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/storage"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+func (r *fakeAssignmentRepo) GetAssignment(ctx context.Context, lessonID string) (*domain.Assignment, error) {
+	assignment, ok := r.assignments[lessonID]
+	if !ok {
+		return nil, domain.ErrAssignmentNotFound
+	}
+	return assignment, nil
+}
+
+func (r *fakeAssignmentRepo) GetSubmission(ctx context.Context, id string) (*domain.AssignmentSubmission, error) {
+	submission, ok := r.submissions[id]
+	if !ok {
+		return nil, domain.ErrAssignmentSubmissionNotFound
+	}
+	copied := *submission
+	return &copied, nil
+}
+
+func (r *fakeAssignmentRepo) SaveGrade(ctx context.Context, submission *domain.AssignmentSubmission) error {
+	r.graded = append(r.graded, submission)
+	return nil
+}
+
+func (r *fakeAssignmentRepo) UpsertSubmission(ctx context.Context, submission *domain.AssignmentSubmission) (string, error) {
+	if r.upsertErr != nil {
+		return "", r.upsertErr
+	}
+	r.stored = append(r.stored, submission)
+	return r.previous, nil
+}
+
+type fakeReleaseService struct {
+	ReleaseService
+	enrolled map[string]bool
+}
+
+func (s *fakeReleaseService) AuthorizeLesson(ctx context.Context, courseID, lessonID, userID string) error {
+	if !s.enrolled[userID] {
+		return domain.ErrUnauthorized
+	}
+	return nil
+}
+
+// fakeFileStore counts the bytes put under each key without keeping them.
+// Opening a file reads back its key.
+type fakeFileStore struct {
+	storage.FileStore
+	sizes      map[string]int64
+	deleteErrs map[string]error
+	deleted    []string
+}
+
+func (f *fakeFileStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	n, err := io.Copy(io.Discard, r)
+	if err != nil {
+		return 0, err
+	}
+	f.sizes[key] = n
+	return n, nil
+}
+
+func (f *fakeFileStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(key)), nil
+}
+
+func (f *fakeFileStore) Delete(ctx context.Context, key string) error {
+	if err := f.deleteErrs[key]; err != nil {
+		return err
+	}
+	f.deleted = append(f.deleted, key)
+	return nil
+}
+
+// zeroReader yields n zero bytes.
+func zeroReader(n int64) io.Reader {
+	return io.LimitReader(zeros{}, n)
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestSubmitAssignment(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		userID      string
+		dueAt       *time.Time
+		fileName    string
+		size        int64
+		previous    string
+		upsertErr   error
+		wantErr     error
+		wantLate    bool
+		wantName    string
+		wantDeleted int
+	}{
+		{name: "on time", userID: "learner-1", dueAt: &future, fileName: "report.pdf", size: 1024, wantName: "report.pdf"},
+		{name: "no due date", userID: "learner-1", fileName: "report.pdf", size: 1024, wantName: "report.pdf"},
+		{name: "after the due date is late", userID: "learner-1", dueAt: &past, fileName: "report.pdf", size: 1024, wantLate: true, wantName: "report.pdf"},
+		{name: "directories are stripped from the name", userID: "learner-1", fileName: `C:\work\..\report.pdf`, size: 1024, wantName: "report.pdf"},
+		{name: "exactly the size limit", userID: "learner-1", fileName: "video.mp4", size: domain.MaxAssignmentFileSize, wantName: "video.mp4"},
+		{name: "replacing an upload deletes the old file", userID: "learner-1", fileName: "v2.pdf", size: 10, previous: "assignments/lesson-1/old", wantName: "v2.pdf", wantDeleted: 1},
+		{name: "over the size limit", userID: "learner-1", fileName: "video.mp4", size: domain.MaxAssignmentFileSize + 1, wantErr: domain.ErrFileTooLarge, wantDeleted: 1},
+		{name: "empty file", userID: "learner-1", fileName: "empty.txt", wantErr: domain.ErrInvalidInput, wantDeleted: 1},
+		{name: "no file name", userID: "learner-1", fileName: "", size: 10, wantErr: domain.ErrInvalidInput},
+		{name: "graded already", userID: "learner-1", fileName: "late.pdf", size: 10, upsertErr: domain.ErrAssignmentGraded, wantErr: domain.ErrAssignmentGraded, wantDeleted: 1},
+		{name: "not enrolled", userID: "stranger", fileName: "report.pdf", size: 10, wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignments := &fakeAssignmentRepo{
+				assignments: map[string]*domain.Assignment{"lesson-1": {LessonID: "lesson-1", CourseID: "course-1", DueAt: tt.dueAt}},
+				previous:    tt.previous,
+				upsertErr:   tt.upsertErr,
+			}
+			files := &fakeFileStore{sizes: map[string]int64{}}
+
+			producer := kafka.NewProducer(nil, "assignment.submitted", zap.NewNop())
+			defer producer.Close()
+
+			s := NewAssignmentService(
+				&fakeCourseService{courses: map[string]*domain.Course{"course-1": {ID: "course-1", InstructorID: "instructor-1"}}},
+				&fakeReleaseService{enrolled: map[string]bool{"learner-1": true}},
+				assignments,
+				files,
+				AssignmentProducers{Submitted: producer},
+				zap.NewNop(),
+			)
+
+			upload := Upload{FileName: tt.fileName, ContentType: "application/pdf", Body: zeroReader(tt.size)}
+			submission, err := s.SubmitAssignment(context.Background(), "lesson-1", tt.userID, upload)
+			if err != tt.wantErr {
+				t.Fatalf("SubmitAssignment() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(files.deleted) != tt.wantDeleted {
+				t.Errorf("deleted %v, want %d files", files.deleted, tt.wantDeleted)
+			}
+			if tt.wantErr != nil {
+				if len(assignments.stored) != 0 {
+					t.Errorf("stored %d submissions, want none", len(assignments.stored))
+				}
+				// Anything put for a refused upload is cleaned up again
+				for key := range files.sizes {
+					if !slices.Contains(files.deleted, key) {
+						t.Errorf("file %s was kept", key)
+					}
+				}
+				return
+			}
+
+			if submission.Late != tt.wantLate || submission.FileName != tt.wantName || submission.SizeBytes != tt.size {
+				t.Errorf("Late, FileName, SizeBytes = %v, %q, %d, want %v, %q, %d",
+					submission.Late, submission.FileName, submission.SizeBytes, tt.wantLate, tt.wantName, tt.size)
+			}
+			if !strings.HasPrefix(submission.FileKey, "assignments/lesson-1/") || files.sizes[submission.FileKey] != tt.size {
+				t.Errorf("FileKey = %q, want a stored file under assignments/lesson-1/", submission.FileKey)
+			}
+			if tt.previous != "" && !slices.Equal(files.deleted, []string{tt.previous}) {
+				t.Errorf("deleted %v, want the replaced %s", files.deleted, tt.previous)
+			}
+		})
+	}
+}
+
+func TestGradeSubmission(t *testing.T) {
+	assignment := &domain.Assignment{LessonID: "lesson-1", CourseID: "course-1", Rubric: []domain.RubricCriterion{{Title: "Works", MaxPoints: 5}}}
+
+	tests := []struct {
+		name    string
+		grader  string
+		wantErr error
+	}{
+		{name: "course grader", grader: "instructor-1"},
+		{name: "someone else", grader: "learner-2", wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignments := &fakeAssignmentRepo{
+				assignments: map[string]*domain.Assignment{"lesson-1": assignment},
+				submissions: map[string]*domain.AssignmentSubmission{
+					"submission-1": {ID: "submission-1", LessonID: "lesson-1", CourseID: "course-1", UserID: "learner-1", Status: domain.AssignmentSubmitted},
+				},
+			}
+
+			producer := kafka.NewProducer(nil, "assignment.graded", zap.NewNop())
+			defer producer.Close()
+
+			s := NewAssignmentService(&fakeCourseService{ownerID: "instructor-1"}, nil, assignments, nil, AssignmentProducers{Graded: producer}, zap.NewNop())
+
+			submission, err := s.GradeSubmission(context.Background(), "submission-1", tt.grader, []domain.CriterionScore{{Criterion: 0, Points: 4}}, "Good")
+			if err != tt.wantErr {
+				t.Fatalf("GradeSubmission() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(assignments.graded) != 0 {
+					t.Errorf("saved %d grades, want none", len(assignments.graded))
+				}
+				return
+			}
+
+			if submission.Score != 4 || submission.MaxScore != 5 || len(assignments.graded) != 1 {
+				t.Errorf("Score, MaxScore = %d, %d, want a saved 4 of 5", submission.Score, submission.MaxScore)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/storage"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// orphanBatchSize bounds how many attachments one cleanup run removes.
+const orphanBatchSize = 100
+
+// AttachmentService manages the downloadable resources attached to lessons.
+// Files are served over signed links rather than through gRPC, so a browser
+// can fetch them directly once the caller has been authorized.
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, lessonID, courseID, instructorID string, upload Upload) (*domain.Attachment, error)
+	ListAttachments(ctx context.Context, lessonID, courseID, userID string) ([]*domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, attachmentID, instructorID string) error
+	GetDownloadURL(ctx context.Context, attachmentID, userID string) (*domain.Attachment, string, time.Time, error)
+	OpenDownload(ctx context.Context, attachmentID string, query url.Values) (*domain.Attachment, io.ReadCloser, error)
+	DeleteOrphanedAttachments(ctx context.Context) (int, error)
+}
+
+type attachmentService struct {
+	courseService  CourseService
+	releaseService ReleaseService
+	attachmentRepo repository.AttachmentRepository
+	files          storage.FileStore
+	signer         *storage.URLSigner
+	logger         *zap.Logger
+}
+
+func NewAttachmentService(
+	courseService CourseService,
+	releaseService ReleaseService,
+	attachmentRepo repository.AttachmentRepository,
+	files storage.FileStore,
+	signer *storage.URLSigner,
+	logger *zap.Logger,
+) AttachmentService {
+	return &attachmentService{
+		courseService:  courseService,
+		releaseService: releaseService,
+		attachmentRepo: attachmentRepo,
+		files:          files,
+		signer:         signer,
+		logger:         logger,
+	}
+}
+
+// UploadAttachment stores a file against a lesson of the draft or live
+// content. Attachments aren't versioned with the content, so learners see a
+// new one straight away.
+func (s *attachmentService) UploadAttachment(ctx context.Context, lessonID, courseID, instructorID string, upload Upload) (*domain.Attachment, error) {
+	if _, err := instructorLesson(ctx, s.courseService, courseID, instructorID, lessonID); err != nil {
+		return nil, err
+	}
+
+	name, err := upload.cleanName()
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &domain.Attachment{
+		ID:        uuid.New().String(),
+		LessonID:  lessonID,
+		CourseID:  courseID,
+		Name:      name,
+		MimeType:  upload.ContentType,
+		CreatedBy: instructorID,
+		CreatedAt: time.Now(),
+	}
+	attachment.StorageKey = path.Join("attachments", lessonID, attachment.ID)
+
+	// Read one byte past the limit to tell a file of exactly the maximum
+	// size from a larger one
+	hash := sha256.New()
+	size, err := s.files.Put(ctx, attachment.StorageKey, io.TeeReader(io.LimitReader(upload.Body, domain.MaxAttachmentFileSize+1), hash))
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 || size > domain.MaxAttachmentFileSize {
+		s.deleteFile(ctx, attachment.StorageKey)
+		if size == 0 {
+			return nil, domain.ErrInvalidInput
+		}
+		return nil, domain.ErrFileTooLarge
+	}
+	attachment.SizeBytes = size
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		s.deleteFile(ctx, attachment.StorageKey)
+		return nil, err
+	}
+
+	s.logger.Info("lesson attachment uploaded",
+		zap.String("attachment_id", attachment.ID),
+		zap.String("lesson_id", lessonID),
+		zap.Int64("size_bytes", size),
+	)
+	return attachment, nil
+}
+
+// ListAttachments lists a lesson's attachments to anyone who may open the
+// lesson.
+func (s *attachmentService) ListAttachments(ctx context.Context, lessonID, courseID, userID string) ([]*domain.Attachment, error) {
+	if err := s.releaseService.AuthorizeLesson(ctx, courseID, lessonID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.attachmentRepo.ListByLesson(ctx, lessonID)
+}
+
+func (s *attachmentService) DeleteAttachment(ctx context.Context, attachmentID, instructorID string) error {
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.courseService.Authorize(ctx, attachment.CourseID, instructorID, domain.PermEditContent); err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, attachmentID); err != nil {
+		return err
+	}
+	s.deleteFile(ctx, attachment.StorageKey)
+
+	s.logger.Info("lesson attachment deleted", zap.String("attachment_id", attachmentID))
+	return nil
+}
+
+// GetDownloadURL authorizes the caller for the attachment's lesson, on the
+// same terms as the lesson itself, and returns a link to the file that
+// expires at the returned time.
+func (s *attachmentService) GetDownloadURL(ctx context.Context, attachmentID, userID string) (*domain.Attachment, string, time.Time, error) {
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+
+	if err := s.releaseService.AuthorizeLesson(ctx, attachment.CourseID, attachment.LessonID, userID); err != nil {
+		return nil, "", time.Time{}, err
+	}
+
+	link, expiresAt := s.signer.Sign(AttachmentDownloadPath(attachmentID), time.Now())
+	return attachment, link, expiresAt, nil
+}
+
+// OpenDownload opens the file behind a signed link issued by GetDownloadURL.
+// The caller closes it.
+func (s *attachmentService) OpenDownload(ctx context.Context, attachmentID string, query url.Values) (*domain.Attachment, io.ReadCloser, error) {
+	if err := s.signer.Verify(AttachmentDownloadPath(attachmentID), query, time.Now()); err != nil {
+		return nil, nil, domain.ErrInvalidDownloadURL
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := s.files.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, file, nil
+}
+
+// DeleteOrphanedAttachments removes the attachments, files included, of
+// lessons that no longer exist, live or in any revision of their course, and
+// returns how many it removed. Lessons disappear through several paths
+// (deleting them, their module or their course, or discarding drafts), so
+// rather than hook each of them the scheduler calls this periodically.
+func (s *attachmentService) DeleteOrphanedAttachments(ctx context.Context) (int, error) {
+	attachments, err := s.attachmentRepo.ListOrphaned(ctx, orphanBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, attachment := range attachments {
+		// Keep the row while the file remains so the next run retries it
+		if err := s.files.Delete(ctx, attachment.StorageKey); err != nil {
+			s.logger.Error("failed to delete orphaned attachment file", zap.String("attachment_id", attachment.ID), zap.Error(err))
+			continue
+		}
+		if err := s.attachmentRepo.Delete(ctx, attachment.ID); err != nil && err != domain.ErrAttachmentNotFound {
+			s.logger.Error("failed to delete orphaned attachment", zap.String("attachment_id", attachment.ID), zap.Error(err))
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (s *attachmentService) deleteFile(ctx context.Context, key string) {
+	if err := s.files.Delete(ctx, key); err != nil {
+		s.logger.Warn("failed to delete attachment file", zap.String("file_key", key), zap.Error(err))
+	}
+}
+
+// AttachmentDownloadPath is where the download server serves an attachment,
+// relative to the configured download base URL.
+func AttachmentDownloadPath(attachmentID string) string {
+	return "/attachments/" + url.PathEscape(attachmentID)
+}
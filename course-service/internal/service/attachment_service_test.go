@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/storage"
+	"go.uber.org/zap"
+)
+
+type fakeAttachmentRepo struct {
+	repository.AttachmentRepository
+	attachments map[string]*domain.Attachment
+	orphaned    []*domain.Attachment
+	created     []*domain.Attachment
+	deleted     []string
+}
+
+func (r *fakeAttachmentRepo) Create(ctx context.Context, attachment *domain.Attachment) error {
+	r.created = append(r.created, attachment)
+	return nil
+}
+
+func (r *fakeAttachmentRepo) GetByID(ctx context.Context, id string) (*domain.Attachment, error) {
+	attachment, ok := r.attachments[id]
+	if !ok {
+		return nil, domain.ErrAttachmentNotFound
+	}
+	return attachment, nil
+}
+
+func (r *fakeAttachmentRepo) ListOrphaned(ctx context.Context, limit int) ([]*domain.Attachment, error) {
+	return r.orphaned, nil
+}
+
+func (r *fakeAttachmentRepo) Delete(ctx context.Context, id string) error {
+	if _, ok := r.attachments[id]; !ok {
+		return domain.ErrAttachmentNotFound
+	}
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func newTestAttachmentService(attachments *fakeAttachmentRepo, files *fakeFileStore, signer *storage.URLSigner) AttachmentService {
+	courses := &fakeCourseService{
+		ownerID: "instructor-1",
+		courses: map[string]*domain.Course{"course-1": {ID: "course-1", InstructorID: "instructor-1"}},
+		contents: map[string]*domain.CourseContent{"course-1": {Modules: []*domain.ModuleContent{
+			{ID: "module-1", Lessons: []*domain.LessonContent{{ID: "lesson-1", Title: "Intro"}}},
+		}}},
+	}
+	releases := &fakeReleaseService{enrolled: map[string]bool{"learner-1": true}}
+
+	return NewAttachmentService(courses, releases, attachments, files, signer, zap.NewNop())
+}
+
+func TestUploadAttachment(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructorID string
+		lessonID     string
+		size         int64
+		wantErr      error
+	}{
+		{name: "small file", instructorID: "instructor-1", lessonID: "lesson-1", size: 2048},
+		{name: "exactly the size limit", instructorID: "instructor-1", lessonID: "lesson-1", size: domain.MaxAttachmentFileSize},
+		{name: "over the size limit", instructorID: "instructor-1", lessonID: "lesson-1", size: domain.MaxAttachmentFileSize + 1, wantErr: domain.ErrFileTooLarge},
+		{name: "empty file", instructorID: "instructor-1", lessonID: "lesson-1", wantErr: domain.ErrInvalidInput},
+		{name: "lesson outside the course", instructorID: "instructor-1", lessonID: "lesson-9", size: 10, wantErr: domain.ErrCourseNotFound},
+		{name: "not the course's instructor", instructorID: "learner-1", lessonID: "lesson-1", size: 10, wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachments := &fakeAttachmentRepo{}
+			files := &fakeFileStore{sizes: map[string]int64{}}
+			s := newTestAttachmentService(attachments, files, nil)
+
+			upload := Upload{FileName: "slides.pdf", ContentType: "application/pdf", Body: zeroReader(tt.size)}
+			attachment, err := s.UploadAttachment(context.Background(), tt.lessonID, "course-1", tt.instructorID, upload)
+			if err != tt.wantErr {
+				t.Fatalf("UploadAttachment() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(attachments.created) != 0 {
+					t.Errorf("created %d attachments, want none", len(attachments.created))
+				}
+				// A refused upload's file is deleted again
+				for key := range files.sizes {
+					if !slices.Contains(files.deleted, key) {
+						t.Errorf("file %s was kept", key)
+					}
+				}
+				return
+			}
+
+			hash := sha256.New()
+			if _, err := io.Copy(hash, zeroReader(tt.size)); err != nil {
+				t.Fatal(err)
+			}
+			if attachment.SizeBytes != tt.size || attachment.Checksum != hex.EncodeToString(hash.Sum(nil)) {
+				t.Errorf("SizeBytes, Checksum = %d, %s, want %d and the file's SHA-256", attachment.SizeBytes, attachment.Checksum, tt.size)
+			}
+			if attachment.StorageKey != "attachments/lesson-1/"+attachment.ID || len(files.deleted) != 0 {
+				t.Errorf("StorageKey = %q, deleted %v, want a kept file under attachments/lesson-1/", attachment.StorageKey, files.deleted)
+			}
+		})
+	}
+}
+
+func TestAttachmentDownload(t *testing.T) {
+	attachment := &domain.Attachment{ID: "attachment-1", LessonID: "lesson-1", CourseID: "course-1", StorageKey: "attachments/lesson-1/attachment-1"}
+	attachments := &fakeAttachmentRepo{attachments: map[string]*domain.Attachment{attachment.ID: attachment}}
+	signer := storage.NewURLSigner("https://files.example.com", "secret", time.Minute)
+	s := newTestAttachmentService(attachments, &fakeFileStore{}, signer)
+	ctx := context.Background()
+
+	if _, _, _, err := s.GetDownloadURL(ctx, attachment.ID, "stranger"); err != domain.ErrUnauthorized {
+		t.Errorf("GetDownloadURL() for someone without access error = %v, want %v", err, domain.ErrUnauthorized)
+	}
+
+	_, link, _, err := s.GetDownloadURL(ctx, attachment.ID, "learner-1")
+	if err != nil {
+		t.Fatalf("GetDownloadURL() error = %v", err)
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Path != AttachmentDownloadPath(attachment.ID) {
+		t.Errorf("link path = %q, want %q", parsed.Path, AttachmentDownloadPath(attachment.ID))
+	}
+
+	_, file, err := s.OpenDownload(ctx, attachment.ID, parsed.Query())
+	if err != nil {
+		t.Fatalf("OpenDownload() error = %v", err)
+	}
+	defer file.Close()
+	if data, _ := io.ReadAll(file); string(data) != attachment.StorageKey {
+		t.Errorf("opened %q, want the file under %s", data, attachment.StorageKey)
+	}
+
+	// The link only opens the attachment it was issued for
+	if _, _, err := s.OpenDownload(ctx, "attachment-2", parsed.Query()); err != domain.ErrInvalidDownloadURL {
+		t.Errorf("OpenDownload() of another attachment error = %v, want %v", err, domain.ErrInvalidDownloadURL)
+	}
+
+	expired := url.Values{}
+	expired.Set("expires", "1")
+	expired.Set("signature", parsed.Query().Get("signature"))
+	if _, _, err := s.OpenDownload(ctx, attachment.ID, expired); err != domain.ErrInvalidDownloadURL {
+		t.Errorf("OpenDownload() with an expired link error = %v, want %v", err, domain.ErrInvalidDownloadURL)
+	}
+}
+
+func TestDeleteOrphanedAttachments(t *testing.T) {
+	orphaned := []*domain.Attachment{
+		{ID: "attachment-1", StorageKey: "attachments/lesson-1/attachment-1"},
+		{ID: "attachment-2", StorageKey: "attachments/lesson-2/attachment-2"},
+		{ID: "attachment-3", StorageKey: "attachments/lesson-3/attachment-3"},
+	}
+	attachments := &fakeAttachmentRepo{
+		// attachment-3 was removed by an earlier run that failed after
+		// deleting the row
+		attachments: map[string]*domain.Attachment{"attachment-1": orphaned[0], "attachment-2": orphaned[1]},
+		orphaned:    orphaned,
+	}
+	files := &fakeFileStore{deleteErrs: map[string]error{"attachments/lesson-2/attachment-2": errors.New("disk unavailable")}}
+	s := newTestAttachmentService(attachments, files, nil)
+
+	deleted, err := s.DeleteOrphanedAttachments(context.Background())
+	if err != nil {
+		t.Fatalf("DeleteOrphanedAttachments() error = %v", err)
+	}
+
+	// A file that can't be deleted keeps its row, so the next run retries it
+	if deleted != 2 || !slices.Equal(attachments.deleted, []string{"attachment-1"}) {
+		t.Errorf("DeleteOrphanedAttachments() = %d, deleted rows %v, want 2, [attachment-1]", deleted, attachments.deleted)
+	}
+	if want := []string{"attachments/lesson-1/attachment-1", "attachments/lesson-3/attachment-3"}; !slices.Equal(files.deleted, want) {
+		t.Errorf("deleted files %v, want %v", files.deleted, want)
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type BundleRequest struct {
+	Title       string
+	Description string
+	CourseIDs   []string
+	Price       float64
+}
+
+type BundleService interface {
+	CreateBundle(ctx context.Context, instructorID string, req BundleRequest) (*domain.Bundle, error)
+	UpdateBundle(ctx context.Context, bundleID, instructorID string, req BundleRequest) (*domain.Bundle, error)
+	DeleteBundle(ctx context.Context, bundleID, instructorID string) error
+	GetBundle(ctx context.Context, bundleID string) (*domain.Bundle, error)
+	ListBundles(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Bundle, int, error)
+	QuoteBundle(ctx context.Context, bundleID string) (*domain.BundleQuote, error)
+}
+
+type bundleService struct {
+	courseRepo repository.CourseRepository
+	bundleRepo repository.BundleRepository
+	logger     *zap.Logger
+}
+
+func NewBundleService(courseRepo repository.CourseRepository, bundleRepo repository.BundleRepository, logger *zap.Logger) BundleService {
+	return &bundleService{
+		courseRepo: courseRepo,
+		bundleRepo: bundleRepo,
+		logger:     logger,
+	}
+}
+
+func (s *bundleService) CreateBundle(ctx context.Context, instructorID string, req BundleRequest) (*domain.Bundle, error) {
+	bundle := &domain.Bundle{
+		ID:           uuid.New().String(),
+		Title:        req.Title,
+		Description:  req.Description,
+		InstructorID: instructorID,
+		CourseIDs:    req.CourseIDs,
+		Price:        req.Price,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := s.checkBundle(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	if err := s.bundleRepo.Create(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("bundle created", zap.String("bundle_id", bundle.ID), zap.Int("courses", len(bundle.CourseIDs)))
+	return bundle, nil
+}
+
+func (s *bundleService) UpdateBundle(ctx context.Context, bundleID, instructorID string, req BundleRequest) (*domain.Bundle, error) {
+	bundle, err := s.ownedBundle(ctx, bundleID, instructorID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.Title = req.Title
+	bundle.Description = req.Description
+	bundle.CourseIDs = req.CourseIDs
+	bundle.Price = req.Price
+	bundle.UpdatedAt = time.Now()
+
+	if err := s.checkBundle(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	if err := s.bundleRepo.Update(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("bundle updated", zap.String("bundle_id", bundle.ID))
+	return bundle, nil
+}
+
+func (s *bundleService) DeleteBundle(ctx context.Context, bundleID, instructorID string) error {
+	if _, err := s.ownedBundle(ctx, bundleID, instructorID); err != nil {
+		return err
+	}
+
+	if err := s.bundleRepo.Delete(ctx, bundleID); err != nil {
+		return err
+	}
+
+	s.logger.Info("bundle deleted", zap.String("bundle_id", bundleID))
+	return nil
+}
+
+func (s *bundleService) GetBundle(ctx context.Context, bundleID string) (*domain.Bundle, error) {
+	return s.bundleRepo.GetByID(ctx, bundleID)
+}
+
+func (s *bundleService) ListBundles(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Bundle, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.bundleRepo.List(ctx, instructorID, page, pageSize)
+}
+
+// QuoteBundle prices the bundle for checkout and returns its member courses
+// so the caller can split the price across them.
+func (s *bundleService) QuoteBundle(ctx context.Context, bundleID string) (*domain.BundleQuote, error) {
+	bundle, err := s.bundleRepo.GetByID(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	courses := make([]*domain.Course, len(bundle.CourseIDs))
+	for i, courseID := range bundle.CourseIDs {
+		if courses[i], err = s.courseRepo.GetByID(ctx, courseID); err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.QuoteBundle(bundle, courses)
+}
+
+func (s *bundleService) ownedBundle(ctx context.Context, bundleID, instructorID string) (*domain.Bundle, error) {
+	bundle, err := s.bundleRepo.GetByID(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if bundle.InstructorID != instructorID {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return bundle, nil
+}
+
+// checkBundle requires every member course to be the instructor's own.
+func (s *bundleService) checkBundle(ctx context.Context, bundle *domain.Bundle) error {
+	if err := bundle.Validate(); err != nil {
+		return err
+	}
+
+	for _, courseID := range bundle.CourseIDs {
+		course, err := s.courseRepo.GetByID(ctx, courseID)
+		if err != nil {
+			return err
+		}
+		if course.InstructorID != bundle.InstructorID {
+			return domain.ErrUnauthorized
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+type CreateCategoryRequest struct {
+	Slug       string
+	ParentSlug string
+	Name       string
+	SortOrder  int
+}
+
+// UpdateCategoryRequest changes the fields that are set. An empty ParentSlug
+// moves the category to the top level.
+type UpdateCategoryRequest struct {
+	ParentSlug *string
+	Name       *string
+	SortOrder  *int
+}
+
+// CategoryService manages the category tree courses are filed under. Changes
+// are admin only; the handler checks that.
+type CategoryService interface {
+	ListCategories(ctx context.Context) ([]*domain.Category, error)
+	CreateCategory(ctx context.Context, req CreateCategoryRequest) (*domain.Category, error)
+	UpdateCategory(ctx context.Context, slug string, req UpdateCategoryRequest) (*domain.Category, error)
+	DeleteCategory(ctx context.Context, slug, reassignTo string) error
+}
+
+type categoryService struct {
+	categoryRepo repository.CategoryRepository
+	logger       *zap.Logger
+}
+
+func NewCategoryService(categoryRepo repository.CategoryRepository, logger *zap.Logger) CategoryService {
+	return &categoryService{
+		categoryRepo: categoryRepo,
+		logger:       logger,
+	}
+}
+
+func (s *categoryService) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	return s.categoryRepo.List(ctx)
+}
+
+func (s *categoryService) CreateCategory(ctx context.Context, req CreateCategoryRequest) (*domain.Category, error) {
+	now := time.Now()
+	category := &domain.Category{
+		Slug:       req.Slug,
+		ParentSlug: req.ParentSlug,
+		Name:       strings.TrimSpace(req.Name),
+		SortOrder:  req.SortOrder,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("category created", zap.String("slug", category.Slug), zap.String("parent_slug", category.ParentSlug))
+	return category, nil
+}
+
+func (s *categoryService) UpdateCategory(ctx context.Context, slug string, req UpdateCategoryRequest) (*domain.Category, error) {
+	category, err := s.categoryRepo.Get(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ParentSlug != nil {
+		category.ParentSlug = *req.ParentSlug
+	}
+	if req.Name != nil {
+		category.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.SortOrder != nil {
+		category.SortOrder = *req.SortOrder
+	}
+	category.UpdatedAt = time.Now()
+
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("category updated", zap.String("slug", slug))
+	return category, nil
+}
+
+// DeleteCategory removes a category that has no subcategories. Courses in it
+// are moved to reassignTo, which is also how duplicate categories are merged;
+// without one, only an unused category can be deleted.
+func (s *categoryService) DeleteCategory(ctx context.Context, slug, reassignTo string) error {
+	if reassignTo == slug {
+		return domain.ErrInvalidInput
+	}
+
+	if err := s.categoryRepo.Delete(ctx, slug, reassignTo); err != nil {
+		return err
+	}
+
+	s.logger.Info("category deleted", zap.String("slug", slug), zap.String("reassigned_to", reassignTo))
+	return nil
+}
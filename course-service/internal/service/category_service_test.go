@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+func (r *fakeCategoryRepo) Create(ctx context.Context, category *domain.Category) error {
+	if _, ok := r.categories[category.Slug]; ok {
+		return domain.ErrCategoryExists
+	}
+	r.created = append(r.created, category)
+	return nil
+}
+
+func (r *fakeCategoryRepo) Update(ctx context.Context, category *domain.Category) error {
+	r.updated = append(r.updated, category)
+	return nil
+}
+
+func (r *fakeCategoryRepo) Delete(ctx context.Context, slug, reassignTo string) error {
+	r.deleted = append(r.deleted, slug)
+	return nil
+}
+
+func TestCreateCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      CreateCategoryRequest
+		wantErr  error
+		wantName string
+	}{
+		{name: "subcategory", req: CreateCategoryRequest{Slug: "rust", ParentSlug: "programming", Name: " Rust "}, wantName: "Rust"},
+		{name: "slug taken", req: CreateCategoryRequest{Slug: "go", Name: "Golang"}, wantErr: domain.ErrCategoryExists},
+		{name: "free-text slug", req: CreateCategoryRequest{Slug: "Go Lang", Name: "Go"}, wantErr: domain.ErrInvalidInput},
+		{name: "blank name", req: CreateCategoryRequest{Slug: "rust", Name: " "}, wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories := testCategories()
+			s := NewCategoryService(categories, zap.NewNop())
+
+			category, err := s.CreateCategory(context.Background(), tt.req)
+			if err != tt.wantErr {
+				t.Fatalf("CreateCategory() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(categories.created) != 0 {
+					t.Errorf("created %d categories, want none", len(categories.created))
+				}
+				return
+			}
+			if category.Name != tt.wantName || category.ParentSlug != tt.req.ParentSlug {
+				t.Errorf("Name, ParentSlug = %q, %q, want %q, %q", category.Name, category.ParentSlug, tt.wantName, tt.req.ParentSlug)
+			}
+		})
+	}
+}
+
+func TestUpdateCategory(t *testing.T) {
+	topLevel := ""
+	itself := "go"
+	renamed := " Golang "
+
+	tests := []struct {
+		name       string
+		slug       string
+		req        UpdateCategoryRequest
+		wantErr    error
+		wantParent string
+		wantName   string
+	}{
+		{name: "rename keeps the parent", slug: "go", req: UpdateCategoryRequest{Name: &renamed}, wantParent: "programming", wantName: "Golang"},
+		{name: "move to the top level", slug: "go", req: UpdateCategoryRequest{ParentSlug: &topLevel}, wantName: "Go"},
+		{name: "move under itself", slug: "go", req: UpdateCategoryRequest{ParentSlug: &itself}, wantErr: domain.ErrCategoryCycle},
+		{name: "unknown category", slug: "rust", req: UpdateCategoryRequest{Name: &renamed}, wantErr: domain.ErrCategoryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories := testCategories()
+			s := NewCategoryService(categories, zap.NewNop())
+
+			category, err := s.UpdateCategory(context.Background(), tt.slug, tt.req)
+			if err != tt.wantErr {
+				t.Fatalf("UpdateCategory() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(categories.updated) != 0 {
+					t.Errorf("updated %d categories, want none", len(categories.updated))
+				}
+				return
+			}
+			if category.ParentSlug != tt.wantParent || category.Name != tt.wantName {
+				t.Errorf("ParentSlug, Name = %q, %q, want %q, %q", category.ParentSlug, category.Name, tt.wantParent, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestDeleteCategory(t *testing.T) {
+	categories := testCategories()
+	s := NewCategoryService(categories, zap.NewNop())
+
+	if err := s.DeleteCategory(context.Background(), "go", "go"); err != domain.ErrInvalidInput {
+		t.Errorf("DeleteCategory() into itself error = %v, want %v", err, domain.ErrInvalidInput)
+	}
+	if err := s.DeleteCategory(context.Background(), "go", "programming"); err != nil {
+		t.Fatalf("DeleteCategory() error = %v", err)
+	}
+	if !slices.Equal(categories.deleted, []string{"go"}) {
+		t.Errorf("deleted %v, want [go]", categories.deleted)
+	}
+}
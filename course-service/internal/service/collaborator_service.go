@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+// CollaboratorService manages who besides the owner works on a course:
+// invitations, roles and handing the course over to someone else.
+type CollaboratorService interface {
+	InviteCollaborator(ctx context.Context, courseID, ownerID, userID string, role domain.CourseRole) (*domain.Collaborator, error)
+	AcceptInvitation(ctx context.Context, courseID, userID string) (*domain.Collaborator, error)
+	ListCollaborators(ctx context.Context, courseID, userID string) ([]*domain.Collaborator, error)
+	ListMyCollaborations(ctx context.Context, userID string) ([]*domain.Collaborator, error)
+	UpdateCollaboratorRole(ctx context.Context, courseID, ownerID, userID string, role domain.CourseRole) (*domain.Collaborator, error)
+	RemoveCollaborator(ctx context.Context, courseID, callerID, userID string) error
+	TransferOwnership(ctx context.Context, courseID, ownerID, newOwnerID string) (*domain.Course, error)
+}
+
+type collaboratorService struct {
+	courseService    CourseService
+	collaboratorRepo repository.CollaboratorRepository
+	producer         *kafka.Producer
+	logger           *zap.Logger
+}
+
+func NewCollaboratorService(
+	courseService CourseService,
+	collaboratorRepo repository.CollaboratorRepository,
+	producer *kafka.Producer,
+	logger *zap.Logger,
+) CollaboratorService {
+	return &collaboratorService{
+		courseService:    courseService,
+		collaboratorRepo: collaboratorRepo,
+		producer:         producer,
+		logger:           logger,
+	}
+}
+
+// InviteCollaborator invites userID to the course. The role takes effect
+// once they accept.
+func (s *collaboratorService) InviteCollaborator(ctx context.Context, courseID, ownerID, userID string, role domain.CourseRole) (*domain.Collaborator, error) {
+	course, err := s.courseService.Authorize(ctx, courseID, ownerID, domain.PermManageCourse)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.Invitable() || userID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if userID == course.InstructorID {
+		return nil, domain.ErrAlreadyCollaborator
+	}
+
+	collaborator := &domain.Collaborator{
+		CourseID:  courseID,
+		UserID:    userID,
+		Role:      role,
+		Status:    domain.CollaboratorInvited,
+		InvitedBy: ownerID,
+		InvitedAt: time.Now(),
+	}
+
+	if err := s.collaboratorRepo.Invite(ctx, collaborator); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("collaborator invited",
+		zap.String("course_id", courseID),
+		zap.String("user_id", userID),
+		zap.String("role", string(role)),
+	)
+
+	event := kafka.CollaboratorInvitedEvent{
+		CourseID:  courseID,
+		Title:     course.Title,
+		UserID:    userID,
+		Role:      string(role),
+		InvitedBy: ownerID,
+		Timestamp: collaborator.InvitedAt,
+	}
+	if err := s.producer.PublishMessage(ctx, userID, event); err != nil {
+		s.logger.Error("failed to publish collaborator invited event", zap.String("course_id", courseID), zap.Error(err))
+	}
+
+	return collaborator, nil
+}
+
+func (s *collaboratorService) AcceptInvitation(ctx context.Context, courseID, userID string) (*domain.Collaborator, error) {
+	if err := s.collaboratorRepo.Accept(ctx, courseID, userID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("collaborator invitation accepted", zap.String("course_id", courseID), zap.String("user_id", userID))
+	return s.collaboratorRepo.Get(ctx, courseID, userID)
+}
+
+// ListCollaborators returns the course's team, owner first, to anyone on it.
+// Pending invitations are included.
+func (s *collaboratorService) ListCollaborators(ctx context.Context, courseID, userID string) ([]*domain.Collaborator, error) {
+	course, err := s.courseService.Authorize(ctx, courseID, userID, domain.PermViewContent)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators, err := s.collaboratorRepo.ListByCourse(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := &domain.Collaborator{
+		CourseID:   courseID,
+		UserID:     course.InstructorID,
+		Role:       domain.RoleOwner,
+		Status:     domain.CollaboratorAccepted,
+		InvitedAt:  course.CreatedAt,
+		AcceptedAt: &course.CreatedAt,
+	}
+	return append([]*domain.Collaborator{owner}, collaborators...), nil
+}
+
+// ListMyCollaborations returns the caller's invitations and the courses they
+// collaborate on, newest first.
+func (s *collaboratorService) ListMyCollaborations(ctx context.Context, userID string) ([]*domain.Collaborator, error) {
+	return s.collaboratorRepo.ListByUser(ctx, userID)
+}
+
+func (s *collaboratorService) UpdateCollaboratorRole(ctx context.Context, courseID, ownerID, userID string, role domain.CourseRole) (*domain.Collaborator, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, ownerID, domain.PermManageCourse); err != nil {
+		return nil, err
+	}
+
+	if !role.Invitable() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := s.collaboratorRepo.UpdateRole(ctx, courseID, userID, role); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("collaborator role updated",
+		zap.String("course_id", courseID),
+		zap.String("user_id", userID),
+		zap.String("role", string(role)),
+	)
+	return s.collaboratorRepo.Get(ctx, courseID, userID)
+}
+
+// RemoveCollaborator takes userID off the course. The owner can remove
+// anyone; collaborators can remove themselves, which is also how an
+// invitation is declined.
+func (s *collaboratorService) RemoveCollaborator(ctx context.Context, courseID, callerID, userID string) error {
+	if callerID != userID {
+		if _, err := s.courseService.Authorize(ctx, courseID, callerID, domain.PermManageCourse); err != nil {
+			return err
+		}
+	}
+
+	if err := s.collaboratorRepo.Remove(ctx, courseID, userID); err != nil {
+		return err
+	}
+
+	s.logger.Info("collaborator removed", zap.String("course_id", courseID), zap.String("user_id", userID))
+	return nil
+}
+
+// TransferOwnership hands the course to one of its active collaborators. The
+// previous owner stays on as an editor.
+func (s *collaboratorService) TransferOwnership(ctx context.Context, courseID, ownerID, newOwnerID string) (*domain.Course, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, ownerID, domain.PermManageCourse); err != nil {
+		return nil, err
+	}
+
+	if err := s.collaboratorRepo.TransferOwnership(ctx, courseID, ownerID, newOwnerID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course ownership transferred",
+		zap.String("course_id", courseID),
+		zap.String("from_user_id", ownerID),
+		zap.String("to_user_id", newOwnerID),
+	)
+	return s.courseService.GetCourse(ctx, courseID)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+func (r *fakeCollaboratorRepo) Invite(ctx context.Context, collaborator *domain.Collaborator) error {
+	if _, ok := r.collaborators[collaborator.UserID]; ok {
+		return domain.ErrAlreadyCollaborator
+	}
+	r.invited = append(r.invited, collaborator)
+	return nil
+}
+
+func (r *fakeCollaboratorRepo) Remove(ctx context.Context, courseID, userID string) error {
+	if _, ok := r.collaborators[userID]; !ok {
+		return domain.ErrCollaboratorNotFound
+	}
+	r.removed = append(r.removed, userID)
+	return nil
+}
+
+func newTestCollaboratorService(t *testing.T, collaborators *fakeCollaboratorRepo) CollaboratorService {
+	t.Helper()
+
+	producer := kafka.NewProducer(nil, "course.collaborator_invited", zap.NewNop())
+	t.Cleanup(func() { producer.Close() })
+
+	courses := &fakeCourseService{ownerID: "instructor-1"}
+	return NewCollaboratorService(courses, collaborators, producer, zap.NewNop())
+}
+
+func TestInviteCollaborator(t *testing.T) {
+	tests := []struct {
+		name     string
+		callerID string
+		userID   string
+		role     domain.CourseRole
+		wantErr  error
+	}{
+		{name: "editor", callerID: "instructor-1", userID: "user-2", role: domain.RoleEditor},
+		{name: "teaching assistant", callerID: "instructor-1", userID: "user-2", role: domain.RoleTeachingAssistant},
+		{name: "ownership isn't invited", callerID: "instructor-1", userID: "user-2", role: domain.RoleOwner, wantErr: domain.ErrInvalidInput},
+		{name: "unknown role", callerID: "instructor-1", userID: "user-2", role: "ADMIN", wantErr: domain.ErrInvalidInput},
+		{name: "no user", callerID: "instructor-1", role: domain.RoleEditor, wantErr: domain.ErrInvalidInput},
+		{name: "the owner", callerID: "instructor-1", userID: "instructor-1", role: domain.RoleEditor, wantErr: domain.ErrAlreadyCollaborator},
+		{name: "already on the course", callerID: "instructor-1", userID: "editor", role: domain.RoleEditor, wantErr: domain.ErrAlreadyCollaborator},
+		{name: "not the owner", callerID: "editor", userID: "user-2", role: domain.RoleEditor, wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collaborators := &fakeCollaboratorRepo{collaborators: map[string]*domain.Collaborator{
+				"editor": {UserID: "editor", Role: domain.RoleEditor, Status: domain.CollaboratorAccepted},
+			}}
+			s := newTestCollaboratorService(t, collaborators)
+
+			collaborator, err := s.InviteCollaborator(context.Background(), "course-1", tt.callerID, tt.userID, tt.role)
+			if err != tt.wantErr {
+				t.Fatalf("InviteCollaborator() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(collaborators.invited) != 0 {
+					t.Errorf("invited %d users, want none", len(collaborators.invited))
+				}
+				return
+			}
+
+			// The role only takes effect once the invitation is accepted
+			if collaborator.Status != domain.CollaboratorInvited || collaborator.Active() || collaborator.InvitedBy != tt.callerID {
+				t.Errorf("collaborator = %+v, want a pending invitation from %s", collaborator, tt.callerID)
+			}
+		})
+	}
+}
+
+func TestRemoveCollaborator(t *testing.T) {
+	tests := []struct {
+		name        string
+		callerID    string
+		userID      string
+		wantErr     error
+		wantRemoved []string
+	}{
+		{name: "owner removes an editor", callerID: "instructor-1", userID: "editor", wantRemoved: []string{"editor"}},
+		{name: "collaborator leaves", callerID: "ta", userID: "ta", wantRemoved: []string{"ta"}},
+		{name: "collaborator removes someone else", callerID: "ta", userID: "editor", wantErr: domain.ErrUnauthorized},
+		{name: "not on the course", callerID: "instructor-1", userID: "stranger", wantErr: domain.ErrCollaboratorNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collaborators := &fakeCollaboratorRepo{collaborators: map[string]*domain.Collaborator{
+				"editor": {UserID: "editor", Role: domain.RoleEditor, Status: domain.CollaboratorAccepted},
+				"ta":     {UserID: "ta", Role: domain.RoleTeachingAssistant, Status: domain.CollaboratorAccepted},
+			}}
+			s := newTestCollaboratorService(t, collaborators)
+
+			err := s.RemoveCollaborator(context.Background(), "course-1", tt.callerID, tt.userID)
+			if err != tt.wantErr {
+				t.Fatalf("RemoveCollaborator() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(collaborators.removed, tt.wantRemoved) {
+				t.Errorf("removed %v, want %v", collaborators.removed, tt.wantRemoved)
+			}
+		})
+	}
+}
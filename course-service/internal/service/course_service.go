@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/user"
 	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -20,6 +22,9 @@ type CreateCourseRequest struct {
 	Price        float64
 	Category     string
 	Tags         []string
+	// DefaultLocale is the locale the course's text is written in; empty
+	// means domain.DefaultLocale.
+	DefaultLocale string
 }
 
 type UpdateCourseRequest struct {
@@ -33,12 +38,14 @@ type UpdateCourseRequest struct {
 }
 
 type CourseFilter struct {
-	Page     int
-	PageSize int
-	Category *string
-	Status   *domain.CourseStatus
-	Level    *domain.CourseLevel
-	Search   *string
+	Page      int
+	PageSize  int
+	Category  *string
+	Status    *domain.CourseStatus
+	Level     *domain.CourseLevel
+	PriceBand *domain.PriceBand
+	Search    *string
+	Language  *string
 }
 
 type AddModuleRequest struct {
@@ -47,6 +54,7 @@ type AddModuleRequest struct {
 }
 
 type AddLessonRequest struct {
+	Type            domain.LessonType
 	Title           string
 	Description     string
 	VideoID         string
@@ -58,47 +66,90 @@ type UpdateLessonRequest struct {
 	Title           *string
 	Description     *string
 	IsPreview       *bool
+	DurationSeconds *int
 }
 
 type CourseService interface {
 	CreateCourse(ctx context.Context, instructorID string, req CreateCourseRequest) (*domain.Course, error)
-	PublishCourse(ctx context.Context, courseID, instructorID string) (*domain.Course, error)
+	PublishApproved(ctx context.Context, submission *domain.CourseSubmission, from domain.SubmissionStatus) (*domain.Course, error)
 	GetCourse(ctx context.Context, courseID string) (*domain.Course, error)
 	UpdateCourse(ctx context.Context, courseID, instructorID string, req UpdateCourseRequest) (*domain.Course, error)
 	DeleteCourse(ctx context.Context, courseID, instructorID string) error
-	ListCourses(ctx context.Context, filter CourseFilter) ([]*domain.Course, int, error)
+	ListCourses(ctx context.Context, filter CourseFilter) (*domain.SearchResult, error)
 	GetInstructorCourses(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Course, int, error)
 	AddModule(ctx context.Context, courseID, instructorID string, req AddModuleRequest) (*domain.Module, error)
 	UpdateModule(ctx context.Context, moduleID, courseID, instructorID string, title, description string) (*domain.Module, error)
 	DeleteModule(ctx context.Context, moduleID, courseID, instructorID string) error
 	GetModules(ctx context.Context, courseID string) ([]*domain.Module, error)
+	ReorderModules(ctx context.Context, courseID, instructorID string, moduleIDs []string) ([]*domain.Module, error)
 	AddLesson(ctx context.Context, moduleID, courseID, instructorID string, req AddLessonRequest) (*domain.Lesson, error)
 	UpdateLesson(ctx context.Context, lessonID, moduleID, courseID, instructorID string, req UpdateLessonRequest) (*domain.Lesson, error)
 	DeleteLesson(ctx context.Context, lessonID, moduleID, courseID, instructorID string) error
 	GetLessons(ctx context.Context, moduleID string) ([]*domain.Lesson, error)
+	MoveLesson(ctx context.Context, lessonID, targetModuleID, courseID, instructorID string, position int) (*domain.Lesson, error)
+	GetCourseDraft(ctx context.Context, courseID, instructorID string) (*domain.Course, *domain.CourseContent, error)
+	DiscardCourseDraft(ctx context.Context, courseID, instructorID string) error
+	ListCourseRevisions(ctx context.Context, courseID, instructorID string) ([]*domain.CourseRevision, error)
+	DiffCourseRevisions(ctx context.Context, courseID, instructorID string, from, to *int) ([]domain.ContentChange, error)
+	GetRevision(ctx context.Context, courseID string, number int) (*domain.CourseRevision, error)
+	PublishRollback(ctx context.Context, target *domain.CourseRevision, publisherID string) (*domain.Course, error)
+	ScheduleCourse(ctx context.Context, courseID, instructorID string, publishAt, archiveAt *time.Time) (*domain.Course, error)
+	ArchiveCourse(ctx context.Context, courseID, instructorID string) (*domain.Course, error)
+	ArchiveDueCourses(ctx context.Context, now time.Time) (int, error)
+	CloneCourse(ctx context.Context, courseID, instructorID, title string) (*domain.Course, error)
+	CreateCourseFromContent(ctx context.Context, instructorID, title string, content *domain.CourseContent, withActivities bool) (*domain.Course, error)
+	LiveContent(ctx context.Context, courseID string) (*domain.CourseContent, error)
+	HandleUserRegistered(ctx context.Context, key, value []byte) error
+	HandleUserProfileUpdated(ctx context.Context, key, value []byte) error
+	BackfillInstructorNames(ctx context.Context) (int, error)
+	SetModuleRelease(ctx context.Context, moduleID, courseID, instructorID string, rule *domain.ReleaseRule) (*domain.Module, error)
+	SetLessonRelease(ctx context.Context, lessonID, moduleID, courseID, instructorID string, rule *domain.ReleaseRule) (*domain.Lesson, error)
+	Authorize(ctx context.Context, courseID, userID string, permission domain.Permission) (*domain.Course, error)
+	Role(ctx context.Context, course *domain.Course, userID string) (domain.CourseRole, error)
+}
+
+// Producers holds one producer per topic the course service publishes to.
+type Producers struct {
+	CourseCreated   *kafka.Producer
+	CoursePublished *kafka.Producer
 }
 
 type courseService struct {
-	courseRepo    repository.CourseRepository
-	moduleRepo    repository.ModuleRepository
-	lessonRepo    repository.LessonRepository
-	kafkaProducer *kafka.Producer
-	logger        *zap.Logger
+	courseRepo       repository.CourseRepository
+	moduleRepo       repository.ModuleRepository
+	lessonRepo       repository.LessonRepository
+	revisionRepo     repository.RevisionRepository
+	submissionRepo   repository.SubmissionRepository
+	collaboratorRepo repository.CollaboratorRepository
+	categoryRepo     repository.CategoryRepository
+	users            user.Client
+	producers        Producers
+	logger           *zap.Logger
 }
 
 func NewCourseService(
 	courseRepo repository.CourseRepository,
 	moduleRepo repository.ModuleRepository,
 	lessonRepo repository.LessonRepository,
-	producer *kafka.Producer,
+	revisionRepo repository.RevisionRepository,
+	submissionRepo repository.SubmissionRepository,
+	collaboratorRepo repository.CollaboratorRepository,
+	categoryRepo repository.CategoryRepository,
+	users user.Client,
+	producers Producers,
 	logger *zap.Logger,
 ) CourseService {
 	return &courseService{
-		courseRepo:    courseRepo,
-		moduleRepo:    moduleRepo,
-		lessonRepo:    lessonRepo,
-		kafkaProducer: producer,
-		logger:        logger,
+		courseRepo:       courseRepo,
+		moduleRepo:       moduleRepo,
+		lessonRepo:       lessonRepo,
+		revisionRepo:     revisionRepo,
+		submissionRepo:   submissionRepo,
+		collaboratorRepo: collaboratorRepo,
+		categoryRepo:     categoryRepo,
+		users:            users,
+		producers:        producers,
+		logger:           logger,
 	}
 }
 
@@ -106,68 +157,94 @@ func (s *courseService) CreateCourse(ctx context.Context, instructorID string, r
 	if err := validateCreateCourseRequest(req); err != nil {
 		return nil, err
 	}
+	if err := s.checkCategory(ctx, req.Category); err != nil {
+		return nil, err
+	}
+
+	locale := domain.DefaultLocale
+	if req.DefaultLocale != "" {
+		var err error
+		if locale, err = domain.NormalizeLocale(req.DefaultLocale); err != nil {
+			return nil, err
+		}
+	}
 
 	course := &domain.Course{
-		ID:           uuid.New().String(),
-		Title:        req.Title,
-		Description:  req.Description,
-		InstructorID: instructorID,
-		ThumbnailURL: req.ThumbnailURL,
-		Status:       domain.StatusDraft,
-		Level:        req.Level,
-		Price:        req.Price,
-		Category:     req.Category,
-		Tags:         req.Tags,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		ID:            uuid.New().String(),
+		Title:         req.Title,
+		Description:   req.Description,
+		InstructorID:  instructorID,
+		ThumbnailURL:  req.ThumbnailURL,
+		Status:        domain.StatusDraft,
+		Level:         req.Level,
+		Price:         req.Price,
+		Category:      req.Category,
+		Tags:          req.Tags,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		DefaultLocale: locale,
+		Locale:        locale,
 	}
 
 	if err := s.courseRepo.Create(ctx, course); err != nil {
 		return nil, err
 	}
 
-	event := kafka.CourseCreatedEvent{
-		CourseID:     course.ID,
-		Title:        course.Title,
-		InstructorID: course.InstructorID,
-		Timestamp:    time.Now(),
-	}
-
-	_ = s.kafkaProducer.PublishMessage(ctx, course.ID, event)
+	s.afterCreate(ctx, course)
 
 	s.logger.Info("course created", zap.String("course_id", course.ID), zap.String("instructor_id", instructorID))
 
 	return course, nil
 }
 
-func (s *courseService) PublishCourse(ctx context.Context, courseID, instructorID string) (*domain.Course, error) {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+// PublishApproved publishes the course an admin approved through submission
+// and moves the submission on from its from status in the same transaction.
+// It publishes as the course's current owner, who may not be the instructor
+// who submitted it.
+func (s *courseService) PublishApproved(ctx context.Context, submission *domain.CourseSubmission, from domain.SubmissionStatus) (*domain.Course, error) {
+	course, err := s.courseRepo.GetByID(ctx, submission.CourseID)
 	if err != nil {
 		return nil, err
 	}
 
-	if course.InstructorID != instructorID {
-		return nil, domain.ErrUnauthorized
+	revision, err := s.revisionToPublish(ctx, course, course.InstructorID)
+	if err != nil {
+		return nil, err
 	}
 
-	course.Status = domain.StatusPublished
-	course.UpdatedAt = time.Now()
-
-	if err := s.courseRepo.Update(ctx, course); err != nil {
+	if err := s.revisionRepo.PublishApproved(ctx, revision, submission, from); err != nil {
 		return nil, err
 	}
 
-	event := kafka.CoursePublishedEvent{
-		CourseID:  course.ID,
-		Title:     course.Title,
-		Timestamp: time.Now(),
-	}
+	s.logger.Info("course published",
+		zap.String("course_id", course.ID),
+		zap.Int("revision", revision.Number),
+		zap.String("submission_id", submission.ID),
+	)
 
-	_ = s.kafkaProducer.PublishMessage(ctx, course.ID, event)
+	return s.afterPublish(ctx, course.ID, revision)
+}
 
-	s.logger.Info("course published", zap.String("course_id", courseID))
+// revisionToPublish returns what publishing the course makes live. A live
+// course only changes through its draft. Otherwise the course has been
+// edited in place and its current content becomes the first revision.
+func (s *courseService) revisionToPublish(ctx context.Context, course *domain.Course, publisherID string) (*domain.CourseRevision, error) {
+	if course.EditsThroughDraft() {
+		return s.revisionRepo.GetDraft(ctx, course.ID)
+	}
 
-	return course, nil
+	content, err := s.liveContent(ctx, course)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CourseRevision{
+		ID:        uuid.New().String(),
+		CourseID:  course.ID,
+		Status:    domain.RevisionPublished,
+		Content:   *content,
+		CreatedBy: publisherID,
+	}, nil
 }
 
 func (s *courseService) GetCourse(ctx context.Context, courseID string) (*domain.Course, error) {
@@ -175,37 +252,40 @@ func (s *courseService) GetCourse(ctx context.Context, courseID string) (*domain
 }
 
 func (s *courseService) UpdateCourse(ctx context.Context, courseID, instructorID string, req UpdateCourseRequest) (*domain.Course, error) {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return nil, err
 	}
 
-	if course.InstructorID != instructorID {
-		return nil, domain.ErrUnauthorized
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
 	}
 
-	if req.Title != nil {
-		course.Title = *req.Title
-	}
-	if req.Description != nil {
-		course.Description = *req.Description
-	}
-	if req.ThumbnailURL != nil {
-		course.ThumbnailURL = *req.ThumbnailURL
-	}
-	if req.Level != nil {
-		course.Level = *req.Level
-	}
-	if req.Price != nil {
-		course.Price = *req.Price
-	}
 	if req.Category != nil {
-		course.Category = *req.Category
-	}
-	if len(req.Tags) > 0 {
-		course.Tags = req.Tags
-	}
-
+		if err := s.checkCategory(ctx, *req.Category); err != nil {
+			return nil, err
+		}
+	}
+
+	if course.EditsThroughDraft() {
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			content.Details.ApplyTo(course)
+			applyCourseUpdate(course, req)
+			if err := course.Validate(); err != nil {
+				return err
+			}
+			content.Details = domain.DetailsOf(course)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("course draft updated", zap.String("course_id", courseID))
+		return course, nil
+	}
+
+	applyCourseUpdate(course, req)
 	course.UpdatedAt = time.Now()
 
 	if err := s.courseRepo.Update(ctx, course); err != nil {
@@ -217,15 +297,10 @@ func (s *courseService) UpdateCourse(ctx context.Context, courseID, instructorID
 }
 
 func (s *courseService) DeleteCourse(ctx context.Context, courseID, instructorID string) error {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
-	if err != nil {
+	if _, err := s.Authorize(ctx, courseID, instructorID, domain.PermManageCourse); err != nil {
 		return err
 	}
 
-	if course.InstructorID != instructorID {
-		return domain.ErrUnauthorized
-	}
-
 	if err := s.courseRepo.Delete(ctx, courseID); err != nil {
 		return err
 	}
@@ -234,7 +309,8 @@ func (s *courseService) DeleteCourse(ctx context.Context, courseID, instructorID
 	return nil
 }
 
-func (s *courseService) ListCourses(ctx context.Context, filter CourseFilter) ([]*domain.Course, int, error) {
+// ListCourses searches the catalogue and counts the matches per facet.
+func (s *courseService) ListCourses(ctx context.Context, filter CourseFilter) (*domain.SearchResult, error) {
 	if filter.Page < 1 {
 		filter.Page = 1
 	}
@@ -242,7 +318,36 @@ func (s *courseService) ListCourses(ctx context.Context, filter CourseFilter) ([
 		filter.PageSize = 10
 	}
 
-	return s.courseRepo.List(ctx, filter.Page, filter.PageSize, filter.Category, filter.Status, filter.Search, filter.Level)
+	query := domain.CourseQuery{
+		Category:  filter.Category,
+		Status:    filter.Status,
+		Level:     filter.Level,
+		PriceBand: filter.PriceBand,
+		Page:      filter.Page,
+		PageSize:  filter.PageSize,
+	}
+	if filter.Search != nil {
+		query.Text = strings.TrimSpace(*filter.Search)
+	}
+	if filter.Language != nil {
+		language, err := domain.NormalizeLocale(*filter.Language)
+		if err != nil {
+			return nil, err
+		}
+		query.Language = &language
+	}
+
+	hits, total, err := s.courseRepo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := s.courseRepo.Facets(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SearchResult{Hits: hits, Total: total, Facets: *facets}, nil
 }
 
 func (s *courseService) GetInstructorCourses(ctx context.Context, instructorID string, page, pageSize int) ([]*domain.Course, int, error) {
@@ -257,26 +362,46 @@ func (s *courseService) GetInstructorCourses(ctx context.Context, instructorID s
 }
 
 func (s *courseService) AddModule(ctx context.Context, courseID, instructorID string, req AddModuleRequest) (*domain.Module, error) {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return nil, err
 	}
 
-	if course.InstructorID != instructorID {
-		return nil, domain.ErrUnauthorized
-	}
-
-	maxIndex, err := s.moduleRepo.GetMaxOrderIndex(ctx, courseID)
-	if err != nil {
+	if err := s.ensureEditable(ctx, courseID); err != nil {
 		return nil, err
 	}
 
+	if course.EditsThroughDraft() {
+		var module *domain.Module
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			module = &domain.Module{
+				ID:          uuid.New().String(),
+				CourseID:    courseID,
+				Title:       req.Title,
+				Description: req.Description,
+				OrderIndex:  content.NextModuleIndex(),
+				CreatedAt:   time.Now(),
+			}
+			if err := module.Validate(); err != nil {
+				return err
+			}
+			content.Modules = append(content.Modules, domain.NewModuleContent(module))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft module created", zap.String("module_id", module.ID), zap.String("course_id", courseID))
+		return module, nil
+	}
+
+	// The repository appends the module and sets its OrderIndex
 	module := &domain.Module{
 		ID:          uuid.New().String(),
 		CourseID:    courseID,
 		Title:       req.Title,
 		Description: req.Description,
-		OrderIndex:  maxIndex + 1,
 		CreatedAt:   time.Now(),
 	}
 
@@ -294,13 +419,37 @@ func (s *courseService) AddModule(ctx context.Context, courseID, instructorID st
 }
 
 func (s *courseService) UpdateModule(ctx context.Context, moduleID, courseID, instructorID string, title, description string) (*domain.Module, error) {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return nil, err
 	}
 
-	if instructorID != course.InstructorID {
-		return nil, domain.ErrUnauthorized
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if course.EditsThroughDraft() {
+		var module *domain.Module
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			draftModule := content.Module(moduleID)
+			if draftModule == nil {
+				return domain.ErrCourseNotFound
+			}
+			if title != "" {
+				draftModule.Title = title
+			}
+			if description != "" {
+				draftModule.Description = description
+			}
+			module = draftModule.ToModule(courseID)
+			return module.Validate()
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft module updated", zap.String("module_id", moduleID))
+		return module, nil
 	}
 
 	module, err := s.moduleRepo.GetByID(ctx, moduleID)
@@ -328,13 +477,28 @@ func (s *courseService) UpdateModule(ctx context.Context, moduleID, courseID, in
 }
 
 func (s *courseService) DeleteModule(ctx context.Context, moduleID, courseID, instructorID string) error {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return err
 	}
 
-	if course.InstructorID != instructorID {
-		return domain.ErrUnauthorized
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return err
+	}
+
+	if course.EditsThroughDraft() {
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			if !content.RemoveModule(moduleID) {
+				return domain.ErrCourseNotFound
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		s.logger.Info("draft module deleted", zap.String("module_id", moduleID))
+		return nil
 	}
 
 	module, err := s.moduleRepo.GetByID(ctx, moduleID)
@@ -358,14 +522,86 @@ func (s *courseService) GetModules(ctx context.Context, courseID string) ([]*dom
 	return s.moduleRepo.GetByCourseID(ctx, courseID)
 }
 
+func (s *courseService) ReorderModules(ctx context.Context, courseID, instructorID string, moduleIDs []string) ([]*domain.Module, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if course.EditsThroughDraft() {
+		draft, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			return content.ReorderModules(moduleIDs)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		modules := make([]*domain.Module, len(draft.Content.Modules))
+		for i, module := range draft.Content.Modules {
+			modules[i] = module.ToModule(courseID)
+		}
+
+		s.logger.Info("draft modules reordered", zap.String("course_id", courseID))
+		return modules, nil
+	}
+
+	if err := s.moduleRepo.Reorder(ctx, courseID, moduleIDs); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("modules reordered", zap.String("course_id", courseID))
+	return s.moduleRepo.GetByCourseID(ctx, courseID)
+}
+
 func (s *courseService) AddLesson(ctx context.Context, moduleID, courseID, instructorID string, req AddLessonRequest) (*domain.Lesson, error) {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return nil, err
 	}
 
-	if course.InstructorID != instructorID {
-		return nil, domain.ErrUnauthorized
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if req.Type == "" {
+		req.Type = domain.LessonVideo
+	}
+
+	if course.EditsThroughDraft() {
+		var lesson *domain.Lesson
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			draftModule := content.Module(moduleID)
+			if draftModule == nil {
+				return domain.ErrCourseNotFound
+			}
+			lesson = &domain.Lesson{
+				ID:              uuid.New().String(),
+				ModuleID:        moduleID,
+				Type:            req.Type,
+				Title:           req.Title,
+				Description:     req.Description,
+				VideoID:         req.VideoID,
+				DurationSeconds: req.DurationSeconds,
+				OrderIndex:      draftModule.NextLessonIndex(),
+				IsPreview:       req.IsPreview,
+				CreatedAt:       time.Now(),
+			}
+			if err := lesson.Validate(); err != nil {
+				return err
+			}
+			draftModule.Lessons = append(draftModule.Lessons, domain.NewLessonContent(lesson))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft lesson created", zap.String("lesson_id", lesson.ID), zap.String("module_id", moduleID))
+		return lesson, nil
 	}
 
 	// Verify module exists and belongs to course
@@ -378,20 +614,15 @@ func (s *courseService) AddLesson(ctx context.Context, moduleID, courseID, instr
 		return nil, fmt.Errorf("module does not belong to course")
 	}
 
-	// Get next order index
-	maxIndex, err := s.lessonRepo.GetMaxOrderIndex(ctx, moduleID)
-	if err != nil {
-		return nil, err
-	}
-
+	// The repository appends the lesson and sets its OrderIndex
 	lesson := &domain.Lesson{
 		ID:              uuid.New().String(),
 		ModuleID:        moduleID,
+		Type:            req.Type,
 		Title:           req.Title,
 		Description:     req.Description,
 		VideoID:         req.VideoID,
 		DurationSeconds: req.DurationSeconds,
-		OrderIndex:      maxIndex + 1,
 		IsPreview:       req.IsPreview,
 		CreatedAt:       time.Now(),
 	}
@@ -409,13 +640,40 @@ func (s *courseService) AddLesson(ctx context.Context, moduleID, courseID, instr
 }
 
 func (s *courseService) UpdateLesson(ctx context.Context, lessonID, moduleID, courseID, instructorID string, req UpdateLessonRequest) (*domain.Lesson, error) {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return nil, err
 	}
 
-	if course.InstructorID != instructorID {
-		return nil, domain.ErrUnauthorized
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if course.EditsThroughDraft() {
+		var lesson *domain.Lesson
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			draftModule := content.Module(moduleID)
+			if draftModule == nil {
+				return domain.ErrCourseNotFound
+			}
+			draftLesson := draftModule.Lesson(lessonID)
+			if draftLesson == nil {
+				return domain.ErrCourseNotFound
+			}
+			lesson = draftLesson.ToLesson(moduleID)
+			applyLessonUpdate(lesson, req)
+			if err := lesson.Validate(); err != nil {
+				return err
+			}
+			*draftLesson = *domain.NewLessonContent(lesson)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft lesson updated", zap.String("lesson_id", lessonID))
+		return lesson, nil
 	}
 
 	// Verify module exists and belongs to course
@@ -437,15 +695,7 @@ func (s *courseService) UpdateLesson(ctx context.Context, lessonID, moduleID, co
 		return nil, fmt.Errorf("lesson does not belong to module")
 	}
 
-	if req.Title != nil {
-		lesson.Title = *req.Title
-	}
-	if req.Description != nil {
-		lesson.Description = *req.Description
-	}
-	if req.IsPreview != nil {
-		lesson.IsPreview = *req.IsPreview
-	}
+	applyLessonUpdate(lesson, req)
 
 	if err := lesson.Validate(); err != nil {
 		return nil, err
@@ -460,13 +710,29 @@ func (s *courseService) UpdateLesson(ctx context.Context, lessonID, moduleID, co
 }
 
 func (s *courseService) DeleteLesson(ctx context.Context, lessonID, moduleID, courseID, instructorID string) error {
-	course, err := s.courseRepo.GetByID(ctx, courseID)
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
 	if err != nil {
 		return err
 	}
 
-	if course.InstructorID != instructorID {
-		return domain.ErrUnauthorized
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return err
+	}
+
+	if course.EditsThroughDraft() {
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			draftModule := content.Module(moduleID)
+			if draftModule == nil || !draftModule.RemoveLesson(lessonID) {
+				return domain.ErrCourseNotFound
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		s.logger.Info("draft lesson deleted", zap.String("lesson_id", lessonID))
+		return nil
 	}
 
 	module, err := s.moduleRepo.GetByID(ctx, moduleID)
@@ -479,6 +745,9 @@ func (s *courseService) DeleteLesson(ctx context.Context, lessonID, moduleID, co
 	}
 
 	lesson, err := s.lessonRepo.GetByID(ctx, lessonID)
+	if err != nil {
+		return err
+	}
 	if lesson.ModuleID != moduleID {
 		return fmt.Errorf("lesson does not belong to module")
 	}
@@ -494,6 +763,743 @@ func (s *courseService) GetLessons(ctx context.Context, moduleID string) ([]*dom
 	return s.lessonRepo.GetByModuleID(ctx, moduleID)
 }
 
+// MoveLesson places a lesson at position within targetModuleID, which can be
+// its current module to reorder it or another module of the same course.
+func (s *courseService) MoveLesson(ctx context.Context, lessonID, targetModuleID, courseID, instructorID string, position int) (*domain.Lesson, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
+	if err != nil {
+		return nil, err
+	}
+
+	if position < 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if course.EditsThroughDraft() {
+		var lesson *domain.Lesson
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			if err := content.MoveLesson(lessonID, targetModuleID, position); err != nil {
+				return err
+			}
+			lesson = content.Module(targetModuleID).Lesson(lessonID).ToLesson(targetModuleID)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft lesson moved", zap.String("lesson_id", lessonID), zap.String("module_id", targetModuleID))
+		return lesson, nil
+	}
+
+	// The repository checks both modules belong to the course under its locks
+	if err := s.lessonRepo.Move(ctx, courseID, lessonID, targetModuleID, position); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("lesson moved", zap.String("lesson_id", lessonID), zap.String("module_id", targetModuleID))
+	return s.lessonRepo.GetByID(ctx, lessonID)
+}
+
+func (s *courseService) GetCourseDraft(ctx context.Context, courseID, instructorID string) (*domain.Course, *domain.CourseContent, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermViewContent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Until the first publish the course is edited in place, so the live
+	// content is the draft
+	if !course.EditsThroughDraft() {
+		content, err := s.liveContent(ctx, course)
+		if err != nil {
+			return nil, nil, err
+		}
+		return course, content, nil
+	}
+
+	draft, err := s.revisionRepo.GetDraft(ctx, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draft.Content.Details.ApplyTo(course)
+	return course, &draft.Content, nil
+}
+
+func (s *courseService) DiscardCourseDraft(ctx context.Context, courseID, instructorID string) error {
+	if _, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent); err != nil {
+		return err
+	}
+
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return err
+	}
+
+	if err := s.revisionRepo.DeleteDraft(ctx, courseID); err != nil {
+		return err
+	}
+
+	s.logger.Info("course draft discarded", zap.String("course_id", courseID))
+	return nil
+}
+
+func (s *courseService) ListCourseRevisions(ctx context.Context, courseID, instructorID string) ([]*domain.CourseRevision, error) {
+	if _, err := s.Authorize(ctx, courseID, instructorID, domain.PermViewContent); err != nil {
+		return nil, err
+	}
+
+	return s.revisionRepo.List(ctx, courseID)
+}
+
+// DiffCourseRevisions compares two published revisions by number. A nil from
+// stands for the live content and a nil to for the draft, so the default is
+// "what would publishing change".
+func (s *courseService) DiffCourseRevisions(ctx context.Context, courseID, instructorID string, from, to *int) ([]domain.ContentChange, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermViewContent)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromContent *domain.CourseContent
+	if from == nil {
+		fromContent, err = s.liveContent(ctx, course)
+	} else {
+		fromContent, err = s.revisionContent(ctx, courseID, *from)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var toContent *domain.CourseContent
+	if to == nil {
+		var draft *domain.CourseRevision
+		draft, err = s.revisionRepo.GetDraft(ctx, courseID)
+		if draft != nil {
+			toContent = &draft.Content
+		}
+	} else {
+		toContent, err = s.revisionContent(ctx, courseID, *to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffContent(courseID, fromContent, toContent), nil
+}
+
+func (s *courseService) GetRevision(ctx context.Context, courseID string, number int) (*domain.CourseRevision, error) {
+	return s.revisionRepo.GetByNumber(ctx, courseID, number)
+}
+
+// PublishRollback republishes the content of target, an earlier revision the
+// review service has checked. History is append-only, so the rollback is
+// recorded as a new revision and any open draft is left as it is.
+func (s *courseService) PublishRollback(ctx context.Context, target *domain.CourseRevision, publisherID string) (*domain.Course, error) {
+	revision := &domain.CourseRevision{
+		ID:             uuid.New().String(),
+		CourseID:       target.CourseID,
+		Status:         domain.RevisionPublished,
+		Content:        target.Content,
+		SourceRevision: target.Number,
+		CreatedBy:      publisherID,
+	}
+
+	if err := s.revisionRepo.PublishRollback(ctx, revision); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course rolled back",
+		zap.String("course_id", target.CourseID),
+		zap.Int("source_revision", target.Number),
+		zap.Int("revision", revision.Number),
+	)
+
+	return s.afterPublish(ctx, target.CourseID, revision)
+}
+
+// ScheduleCourse sets when the course goes live after approval and when it
+// comes off sale. Nil clears the respective time.
+func (s *courseService) ScheduleCourse(ctx context.Context, courseID, instructorID string, publishAt, archiveAt *time.Time) (*domain.Course, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermManageCourse)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := domain.ValidateSchedule(publishAt, archiveAt, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.courseRepo.SetSchedule(ctx, courseID, publishAt, archiveAt, now); err != nil {
+		return nil, err
+	}
+
+	course.PublishAt = publishAt
+	course.ArchiveAt = archiveAt
+	course.UpdatedAt = now
+
+	s.logger.Info("course schedule set", zap.String("course_id", courseID))
+	return course, nil
+}
+
+// ArchiveCourse takes a published course off sale. Enrolled students keep
+// access; publishing again brings it back.
+func (s *courseService) ArchiveCourse(ctx context.Context, courseID, instructorID string) (*domain.Course, error) {
+	if _, err := s.Authorize(ctx, courseID, instructorID, domain.PermManageCourse); err != nil {
+		return nil, err
+	}
+
+	if err := s.courseRepo.Archive(ctx, courseID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course archived", zap.String("course_id", courseID))
+	return s.courseRepo.GetByID(ctx, courseID)
+}
+
+// ArchiveDueCourses archives every published course whose archive time has
+// passed and returns how many it archived.
+func (s *courseService) ArchiveDueCourses(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.courseRepo.ListDueForArchive(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	archived := 0
+	for _, id := range ids {
+		if err := s.courseRepo.Archive(ctx, id, now); err != nil {
+			// Republished or archived by someone else since the listing
+			if err == domain.ErrNotPublished {
+				continue
+			}
+			s.logger.Error("failed to archive course", zap.Error(err), zap.String("course_id", id))
+			continue
+		}
+		s.logger.Info("course archived on schedule", zap.String("course_id", id))
+		archived++
+	}
+
+	return archived, nil
+}
+
+// CloneCourse copies one of the instructor's courses, as students currently
+// see it, into a new DRAFT. An empty title keeps the source's title.
+func (s *courseService) CloneCourse(ctx context.Context, courseID, instructorID, title string) (*domain.Course, error) {
+	source, err := s.Authorize(ctx, courseID, instructorID, domain.PermManageCourse)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.liveContent(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := s.createFromContent(ctx, instructorID, title, content, source.DefaultLocale, true)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course cloned", zap.String("source_course_id", courseID), zap.String("course_id", course.ID))
+	return course, nil
+}
+
+// CreateCourseFromContent creates a DRAFT course for instructorID holding a
+// copy of content with fresh module and lesson IDs. An empty title keeps the
+// title from content. With withActivities, the quizzes and assignments of
+// content's lessons are copied too; leave it off for content from outside,
+// whose lesson IDs mean nothing here.
+func (s *courseService) CreateCourseFromContent(ctx context.Context, instructorID, title string, content *domain.CourseContent, withActivities bool) (*domain.Course, error) {
+	return s.createFromContent(ctx, instructorID, title, content, domain.DefaultLocale, withActivities)
+}
+
+// createFromContent is CreateCourseFromContent for content written in
+// locale. Translations aren't copied, since the content gets new IDs.
+func (s *courseService) createFromContent(ctx context.Context, instructorID, title string, content *domain.CourseContent, locale string, withActivities bool) (*domain.Course, error) {
+	now := time.Now()
+	newID := func() string { return uuid.New().String() }
+	fresh, lessonIDs := content.Copy(newID, now)
+	if title != "" {
+		fresh.Details.Title = title
+	}
+
+	course := &domain.Course{
+		ID:            uuid.New().String(),
+		InstructorID:  instructorID,
+		Status:        domain.StatusDraft,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DefaultLocale: locale,
+		Locale:        locale,
+	}
+	fresh.Details.ApplyTo(course)
+	course.DurationMinutes = fresh.DurationMinutes()
+
+	if err := course.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategory(ctx, course.Category); err != nil {
+		return nil, err
+	}
+
+	var activities *domain.LessonActivities
+	if withActivities {
+		sourceIDs := make([]string, 0, len(lessonIDs))
+		for id := range lessonIDs {
+			sourceIDs = append(sourceIDs, id)
+		}
+		source, err := s.courseRepo.GetLessonActivities(ctx, sourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		activities = source.Copy(course.ID, lessonIDs, newID, now)
+	}
+
+	if err := s.courseRepo.CreateWithContent(ctx, course, fresh, activities); err != nil {
+		return nil, err
+	}
+
+	s.afterCreate(ctx, course)
+
+	s.logger.Info("course created from content",
+		zap.String("course_id", course.ID),
+		zap.String("instructor_id", instructorID),
+		zap.Int("modules", len(fresh.Modules)),
+		zap.Int("lessons", fresh.LessonCount()),
+	)
+
+	return course, nil
+}
+
+func (s *courseService) LiveContent(ctx context.Context, courseID string) (*domain.CourseContent, error) {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.liveContent(ctx, course)
+}
+
+// SetModuleRelease sets when enrolled students get the module. A nil rule
+// releases it on enrollment. On a live course the change goes through the
+// draft like any other edit.
+func (s *courseService) SetModuleRelease(ctx context.Context, moduleID, courseID, instructorID string, rule *domain.ReleaseRule) (*domain.Module, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if course.EditsThroughDraft() {
+		var module *domain.Module
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			draftModule := content.Module(moduleID)
+			if draftModule == nil {
+				return domain.ErrCourseNotFound
+			}
+			module = draftModule.ToModule(courseID)
+			module.Release = rule
+			if err := module.Validate(); err != nil {
+				return err
+			}
+			draftModule.Release = rule.Clone()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft module release set", zap.String("module_id", moduleID), zap.String("release", rule.String()))
+		return module, nil
+	}
+
+	module, err := s.moduleRepo.GetByID(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if module.CourseID != courseID {
+		return nil, domain.ErrCourseNotFound
+	}
+
+	module.Release = rule
+	if err := module.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.moduleRepo.Update(ctx, module); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("module release set", zap.String("module_id", moduleID), zap.String("release", rule.String()))
+	return module, nil
+}
+
+// SetLessonRelease sets when enrolled students get the lesson, on top of its
+// module's release. A nil rule releases it with the module.
+func (s *courseService) SetLessonRelease(ctx context.Context, lessonID, moduleID, courseID, instructorID string, rule *domain.ReleaseRule) (*domain.Lesson, error) {
+	course, err := s.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureEditable(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if course.EditsThroughDraft() {
+		var lesson *domain.Lesson
+		_, err := s.editDraft(ctx, course, instructorID, func(content *domain.CourseContent) error {
+			draftModule := content.Module(moduleID)
+			if draftModule == nil {
+				return domain.ErrCourseNotFound
+			}
+			draftLesson := draftModule.Lesson(lessonID)
+			if draftLesson == nil {
+				return domain.ErrCourseNotFound
+			}
+			lesson = draftLesson.ToLesson(moduleID)
+			lesson.Release = rule
+			if err := lesson.Validate(); err != nil {
+				return err
+			}
+			draftLesson.Release = rule.Clone()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("draft lesson release set", zap.String("lesson_id", lessonID), zap.String("release", rule.String()))
+		return lesson, nil
+	}
+
+	module, err := s.moduleRepo.GetByID(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if module.CourseID != courseID {
+		return nil, domain.ErrCourseNotFound
+	}
+
+	lesson, err := s.lessonRepo.GetByID(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if lesson.ModuleID != moduleID {
+		return nil, domain.ErrCourseNotFound
+	}
+
+	lesson.Release = rule
+	if err := lesson.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.lessonRepo.Update(ctx, lesson); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("lesson release set", zap.String("lesson_id", lessonID), zap.String("release", rule.String()))
+	return lesson, nil
+}
+
+// HandleUserRegistered is a kafka.MessageHandler for the user.registered
+// topic. It keeps the instructor names searched alongside courses.
+func (s *courseService) HandleUserRegistered(ctx context.Context, key, value []byte) error {
+	var event kafka.UserRegisteredEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(event.FirstName + " " + event.LastName)
+	if name == "" {
+		return nil
+	}
+
+	return s.courseRepo.SetInstructorName(ctx, event.UserID, name, event.Timestamp)
+}
+
+// HandleUserProfileUpdated is a kafka.MessageHandler for the
+// user.profile_updated topic, so renamed instructors are searched by their
+// new name.
+func (s *courseService) HandleUserProfileUpdated(ctx context.Context, key, value []byte) error {
+	var event kafka.UserProfileUpdatedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(event.FirstName + " " + event.LastName)
+	if name == "" {
+		return nil
+	}
+
+	return s.courseRepo.SetInstructorName(ctx, event.UserID, name, event.Timestamp)
+}
+
+// instructorNamePageSize is how many instructors are looked up in
+// user-service at a time while backfilling names.
+const instructorNamePageSize = 100
+
+// BackfillInstructorNames looks up the names of instructors whose courses
+// have none, such as those who registered before course-service listened
+// for it, and returns how many it found.
+func (s *courseService) BackfillInstructorNames(ctx context.Context) (int, error) {
+	found := 0
+	after := uuid.Nil.String()
+	for {
+		instructorIDs, err := s.courseRepo.ListUnnamedInstructors(ctx, after, instructorNamePageSize)
+		if err != nil {
+			return found, err
+		}
+		if len(instructorIDs) == 0 {
+			return found, nil
+		}
+
+		names, err := s.users.GetNames(ctx, instructorIDs)
+		if err != nil {
+			return found, err
+		}
+
+		for id, name := range names {
+			if err := s.courseRepo.SetInstructorName(ctx, id, name.Name, name.UpdatedAt); err != nil {
+				return found, err
+			}
+			found++
+		}
+
+		if len(instructorIDs) < instructorNamePageSize {
+			return found, nil
+		}
+		after = instructorIDs[len(instructorIDs)-1]
+	}
+}
+
+// Authorize is the one permission check for working on a course: it loads
+// the course and fails with ErrUnauthorized unless userID's role on it grants
+// permission.
+func (s *courseService) Authorize(ctx context.Context, courseID, userID string, permission domain.Permission) (*domain.Course, error) {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.Role(ctx, course, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Can(permission) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return course, nil
+}
+
+// Role returns userID's role on the course, or an empty role if they have
+// none. Pending invitations grant nothing.
+func (s *courseService) Role(ctx context.Context, course *domain.Course, userID string) (domain.CourseRole, error) {
+	if course.InstructorID == userID {
+		return domain.RoleOwner, nil
+	}
+
+	collaborator, err := s.collaboratorRepo.Get(ctx, course.ID, userID)
+	if err == domain.ErrCollaboratorNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !collaborator.Active() {
+		return "", nil
+	}
+
+	return collaborator.Role, nil
+}
+
+// ensureEditable rejects edits while the course is waiting for review, so
+// admins approve exactly what they looked at.
+func (s *courseService) ensureEditable(ctx context.Context, courseID string) error {
+	_, err := s.submissionRepo.GetOpenByCourse(ctx, courseID)
+	if err == nil {
+		return domain.ErrCourseUnderReview
+	}
+	if err == domain.ErrSubmissionNotFound {
+		return nil
+	}
+	return err
+}
+
+// editDraft applies edit to the course's draft revision, starting the draft
+// from the live content if there isn't one yet. The live course is untouched
+// until the draft is published.
+func (s *courseService) editDraft(ctx context.Context, course *domain.Course, instructorID string, edit func(*domain.CourseContent) error) (*domain.CourseRevision, error) {
+	draft, err := s.revisionRepo.GetDraft(ctx, course.ID)
+	if err == domain.ErrDraftNotFound {
+		draft, err = s.startDraft(ctx, course, instructorID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := edit(&draft.Content); err != nil {
+		return nil, err
+	}
+
+	draft.UpdatedAt = time.Now()
+	if err := s.revisionRepo.SaveDraft(ctx, draft); err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+func (s *courseService) startDraft(ctx context.Context, course *domain.Course, instructorID string) (*domain.CourseRevision, error) {
+	content, err := s.liveContent(ctx, course)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &domain.CourseRevision{
+		ID:        uuid.New().String(),
+		CourseID:  course.ID,
+		Status:    domain.RevisionDraft,
+		Content:   *content,
+		CreatedBy: instructorID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := s.revisionRepo.CreateDraft(ctx, draft); err != nil {
+		// Another request started the draft first; edit that one instead
+		if err == domain.ErrRevisionConflict {
+			return s.revisionRepo.GetDraft(ctx, course.ID)
+		}
+		return nil, err
+	}
+
+	s.logger.Info("course draft started", zap.String("course_id", course.ID), zap.String("draft_id", draft.ID))
+	return draft, nil
+}
+
+// liveContent snapshots what students currently see.
+func (s *courseService) liveContent(ctx context.Context, course *domain.Course) (*domain.CourseContent, error) {
+	modules, err := s.moduleRepo.GetByCourseID(ctx, course.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &domain.CourseContent{Details: domain.DetailsOf(course)}
+	for _, module := range modules {
+		lessons, err := s.lessonRepo.GetByModuleID(ctx, module.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleContent := domain.NewModuleContent(module)
+		for _, lesson := range lessons {
+			moduleContent.Lessons = append(moduleContent.Lessons, domain.NewLessonContent(lesson))
+		}
+		content.Modules = append(content.Modules, moduleContent)
+	}
+
+	return content, nil
+}
+
+func (s *courseService) revisionContent(ctx context.Context, courseID string, number int) (*domain.CourseContent, error) {
+	revision, err := s.revisionRepo.GetByNumber(ctx, courseID, number)
+	if err != nil {
+		return nil, err
+	}
+	return &revision.Content, nil
+}
+
+func (s *courseService) afterCreate(ctx context.Context, course *domain.Course) {
+	event := kafka.CourseCreatedEvent{
+		CourseID:     course.ID,
+		Title:        course.Title,
+		InstructorID: course.InstructorID,
+		Timestamp:    time.Now(),
+	}
+
+	_ = s.producers.CourseCreated.PublishMessage(ctx, course.ID, event)
+}
+
+// afterPublish reloads the course once revision is live and announces it.
+func (s *courseService) afterPublish(ctx context.Context, courseID string, revision *domain.CourseRevision) (*domain.Course, error) {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := kafka.CoursePublishedEvent{
+		CourseID:     course.ID,
+		Title:        course.Title,
+		InstructorID: course.InstructorID,
+		Revision:     revision.Number,
+		Timestamp:    time.Now(),
+	}
+
+	_ = s.producers.CoursePublished.PublishMessage(ctx, course.ID, event)
+
+	return course, nil
+}
+
+func applyCourseUpdate(course *domain.Course, req UpdateCourseRequest) {
+	if req.Title != nil {
+		course.Title = *req.Title
+	}
+	if req.Description != nil {
+		course.Description = *req.Description
+	}
+	if req.ThumbnailURL != nil {
+		course.ThumbnailURL = *req.ThumbnailURL
+	}
+	if req.Level != nil {
+		course.Level = *req.Level
+	}
+	if req.Price != nil {
+		course.Price = *req.Price
+	}
+	if req.Category != nil {
+		course.Category = *req.Category
+	}
+	if len(req.Tags) > 0 {
+		course.Tags = req.Tags
+	}
+}
+
+// checkCategory makes sure a course is filed under a managed category.
+func (s *courseService) checkCategory(ctx context.Context, slug string) error {
+	_, err := s.categoryRepo.Get(ctx, slug)
+	if err == domain.ErrCategoryNotFound {
+		return domain.ErrUnknownCategory
+	}
+	return err
+}
+
+func applyLessonUpdate(lesson *domain.Lesson, req UpdateLessonRequest) {
+	if req.Title != nil {
+		lesson.Title = *req.Title
+	}
+	if req.Description != nil {
+		lesson.Description = *req.Description
+	}
+	if req.IsPreview != nil {
+		lesson.IsPreview = *req.IsPreview
+	}
+	if req.DurationSeconds != nil {
+		lesson.DurationSeconds = *req.DurationSeconds
+	}
+}
+
 func validateCreateCourseRequest(req CreateCourseRequest) error {
 	if req.Title == "" {
 		return fmt.Errorf("title is required")
@@ -0,0 +1,670 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/user"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+// The fakes embed the repository interfaces so they only need the methods
+// publishing uses; anything else panics.
+
+type fakeCourseRepo struct {
+	repository.CourseRepository
+	courses     map[string]*domain.Course
+	due         []string
+	archiveErrs map[string]error
+	archived    []string
+	created     []*domain.Course
+	contents    []*domain.CourseContent
+	unnamed     []string
+	names       map[string]string
+	enrollments []*domain.CourseEnrollment
+}
+
+func (r *fakeCourseRepo) GetByID(ctx context.Context, id string) (*domain.Course, error) {
+	course, ok := r.courses[id]
+	if !ok {
+		return nil, domain.ErrCourseNotFound
+	}
+	copied := *course
+	return &copied, nil
+}
+
+func (r *fakeCourseRepo) Create(ctx context.Context, course *domain.Course) error {
+	r.created = append(r.created, course)
+	return nil
+}
+
+func (r *fakeCourseRepo) CreateWithContent(ctx context.Context, course *domain.Course, content *domain.CourseContent, activities *domain.LessonActivities) error {
+	r.created = append(r.created, course)
+	r.contents = append(r.contents, content)
+	return nil
+}
+
+func (r *fakeCourseRepo) GetLessonActivities(ctx context.Context, lessonIDs []string) (*domain.LessonActivities, error) {
+	return &domain.LessonActivities{}, nil
+}
+
+func (r *fakeCourseRepo) ListUnnamedInstructors(ctx context.Context, afterID string, limit int) ([]string, error) {
+	var ids []string
+	for _, id := range r.unnamed {
+		if id > afterID && len(ids) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeCourseRepo) SetInstructorName(ctx context.Context, instructorID, name string, at time.Time) error {
+	r.names[instructorID] = name
+	return nil
+}
+
+func (r *fakeCourseRepo) ListDueForArchive(ctx context.Context, now time.Time) ([]string, error) {
+	return r.due, nil
+}
+
+func (r *fakeCourseRepo) Archive(ctx context.Context, courseID string, now time.Time) error {
+	if err := r.archiveErrs[courseID]; err != nil {
+		return err
+	}
+	r.archived = append(r.archived, courseID)
+	return nil
+}
+
+// fakeCollaboratorRepo keys collaborators by user ID, whatever the course.
+type fakeCollaboratorRepo struct {
+	repository.CollaboratorRepository
+	collaborators map[string]*domain.Collaborator
+	invited       []*domain.Collaborator
+	removed       []string
+}
+
+func (r *fakeCollaboratorRepo) Get(ctx context.Context, courseID, userID string) (*domain.Collaborator, error) {
+	collaborator, ok := r.collaborators[userID]
+	if !ok {
+		return nil, domain.ErrCollaboratorNotFound
+	}
+	return collaborator, nil
+}
+
+type fakeCategoryRepo struct {
+	repository.CategoryRepository
+	categories map[string]*domain.Category
+	created    []*domain.Category
+	updated    []*domain.Category
+	deleted    []string
+}
+
+func (r *fakeCategoryRepo) Get(ctx context.Context, slug string) (*domain.Category, error) {
+	category, ok := r.categories[slug]
+	if !ok {
+		return nil, domain.ErrCategoryNotFound
+	}
+	copied := *category
+	return &copied, nil
+}
+
+// testCategories is a small tree: programming with go beneath it.
+func testCategories() *fakeCategoryRepo {
+	return &fakeCategoryRepo{categories: map[string]*domain.Category{
+		"programming": {Slug: "programming", Name: "Programming"},
+		"go":          {Slug: "go", ParentSlug: "programming", Name: "Go"},
+	}}
+}
+
+// fakeUserClient knows the names of users whose ID doesn't end in "-nameless",
+// and records each batch it was asked for.
+type fakeUserClient struct {
+	batches [][]string
+}
+
+func (c *fakeUserClient) GetNames(ctx context.Context, userIDs []string) (map[string]user.Name, error) {
+	c.batches = append(c.batches, userIDs)
+	names := make(map[string]user.Name)
+	for _, id := range userIDs {
+		if !strings.HasSuffix(id, "-nameless") {
+			names[id] = user.Name{Name: "Name of " + id}
+		}
+	}
+	return names, nil
+}
+
+type fakeRevisionRepo struct {
+	repository.RevisionRepository
+	drafts    map[string]*domain.CourseRevision
+	published []*domain.CourseRevision
+}
+
+func (r *fakeRevisionRepo) GetDraft(ctx context.Context, courseID string) (*domain.CourseRevision, error) {
+	draft, ok := r.drafts[courseID]
+	if !ok {
+		return nil, domain.ErrDraftNotFound
+	}
+	return draft, nil
+}
+
+func (r *fakeRevisionRepo) PublishApproved(ctx context.Context, revision *domain.CourseRevision, submission *domain.CourseSubmission, from domain.SubmissionStatus) error {
+	r.published = append(r.published, revision)
+	return nil
+}
+
+type fakeModuleRepo struct {
+	repository.ModuleRepository
+	modules map[string][]*domain.Module
+}
+
+func (r *fakeModuleRepo) GetByCourseID(ctx context.Context, courseID string) ([]*domain.Module, error) {
+	return r.modules[courseID], nil
+}
+
+type fakeLessonRepo struct {
+	repository.LessonRepository
+	lessons map[string][]*domain.Lesson
+	moveErr error
+	moved   []string
+}
+
+func (r *fakeLessonRepo) GetByModuleID(ctx context.Context, moduleID string) ([]*domain.Lesson, error) {
+	return r.lessons[moduleID], nil
+}
+
+func (r *fakeLessonRepo) GetByID(ctx context.Context, id string) (*domain.Lesson, error) {
+	for _, lessons := range r.lessons {
+		for _, lesson := range lessons {
+			if lesson.ID == id {
+				return lesson, nil
+			}
+		}
+	}
+	return nil, domain.ErrCourseNotFound
+}
+
+func (r *fakeLessonRepo) Move(ctx context.Context, courseID, lessonID, targetModuleID string, position int) error {
+	if r.moveErr != nil {
+		return r.moveErr
+	}
+	r.moved = append(r.moved, courseID+"/"+lessonID+"->"+targetModuleID)
+	return nil
+}
+
+type fakeSubmissionRepo struct {
+	repository.SubmissionRepository
+	open        map[string]*domain.CourseSubmission
+	submissions map[string]*domain.CourseSubmission
+	due         []*domain.CourseSubmission
+	created     []*domain.CourseSubmission
+	// updates records each stored submission as "id:from->to"
+	updates []string
+}
+
+func (r *fakeSubmissionRepo) GetOpenByCourse(ctx context.Context, courseID string) (*domain.CourseSubmission, error) {
+	submission, ok := r.open[courseID]
+	if !ok {
+		return nil, domain.ErrSubmissionNotFound
+	}
+	return submission, nil
+}
+
+func (r *fakeSubmissionRepo) Create(ctx context.Context, submission *domain.CourseSubmission) error {
+	if _, ok := r.open[submission.CourseID]; ok {
+		return domain.ErrAlreadySubmitted
+	}
+	r.created = append(r.created, submission)
+	return nil
+}
+
+func (r *fakeSubmissionRepo) GetByID(ctx context.Context, id string) (*domain.CourseSubmission, error) {
+	submission, ok := r.submissions[id]
+	if !ok {
+		return nil, domain.ErrSubmissionNotFound
+	}
+	copied := *submission
+	return &copied, nil
+}
+
+func (r *fakeSubmissionRepo) ListDueScheduled(ctx context.Context, now time.Time) ([]*domain.CourseSubmission, error) {
+	return r.due, nil
+}
+
+func (r *fakeSubmissionRepo) Update(ctx context.Context, submission *domain.CourseSubmission, from domain.SubmissionStatus) error {
+	r.updates = append(r.updates, submission.ID+":"+string(from)+"->"+string(submission.Status))
+	return nil
+}
+
+func TestPublishApproved(t *testing.T) {
+	const ownerID = "instructor-1"
+
+	draftContent := domain.CourseContent{
+		Details: domain.CourseDetails{Title: "Edited title"},
+		Modules: []*domain.ModuleContent{{ID: "module-draft", Title: "Draft module"}},
+	}
+
+	tests := []struct {
+		name        string
+		status      domain.CourseStatus
+		hasDraft    bool
+		submittedBy string
+		wantErr     error
+		wantModule  string
+		wantCreator string
+	}{
+		{
+			name:        "first publish takes the content edited in place",
+			status:      domain.StatusDraft,
+			submittedBy: ownerID,
+			wantModule:  "module-live",
+			wantCreator: ownerID,
+		},
+		{
+			name:        "published as the current owner after a transfer",
+			status:      domain.StatusDraft,
+			submittedBy: "previous-owner",
+			wantModule:  "module-live",
+			wantCreator: ownerID,
+		},
+		{
+			name:        "published course publishes its draft",
+			status:      domain.StatusPublished,
+			hasDraft:    true,
+			submittedBy: ownerID,
+			wantModule:  "module-draft",
+		},
+		{
+			name:        "archived course publishes its draft",
+			status:      domain.StatusArchived,
+			hasDraft:    true,
+			submittedBy: ownerID,
+			wantModule:  "module-draft",
+		},
+		{
+			name:        "published course without a draft",
+			status:      domain.StatusPublished,
+			submittedBy: ownerID,
+			wantErr:     domain.ErrDraftNotFound,
+		},
+		{
+			name:        "archived course without a draft",
+			status:      domain.StatusArchived,
+			submittedBy: ownerID,
+			wantErr:     domain.ErrDraftNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			course := &domain.Course{ID: "course-1", Title: "Live title", InstructorID: ownerID, Status: tt.status}
+
+			revisions := &fakeRevisionRepo{drafts: map[string]*domain.CourseRevision{}}
+			if tt.hasDraft {
+				revisions.drafts[course.ID] = &domain.CourseRevision{
+					ID:       "draft-1",
+					CourseID: course.ID,
+					Status:   domain.RevisionDraft,
+					Content:  draftContent,
+				}
+			}
+
+			producer := kafka.NewProducer(nil, "course.published", zap.NewNop())
+			defer producer.Close()
+
+			s := NewCourseService(
+				&fakeCourseRepo{courses: map[string]*domain.Course{course.ID: course}},
+				&fakeModuleRepo{modules: map[string][]*domain.Module{course.ID: {{ID: "module-live", CourseID: course.ID, Title: "Live module"}}}},
+				&fakeLessonRepo{},
+				revisions,
+				nil,
+				&fakeCollaboratorRepo{},
+				nil,
+				nil,
+				Producers{CoursePublished: producer},
+				zap.NewNop(),
+			)
+
+			// Cancelled so the published event fails at once instead of
+			// waiting on a broker
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			submission := &domain.CourseSubmission{ID: "submission-1", CourseID: course.ID, InstructorID: tt.submittedBy, Status: domain.SubmissionInReview}
+
+			_, err := s.PublishApproved(ctx, submission, domain.SubmissionInReview)
+			if err != tt.wantErr {
+				t.Fatalf("PublishApproved() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(revisions.published) != 0 {
+					t.Errorf("published %d revisions, want none", len(revisions.published))
+				}
+				return
+			}
+
+			if len(revisions.published) != 1 {
+				t.Fatalf("published %d revisions, want 1", len(revisions.published))
+			}
+			published := revisions.published[0]
+			if len(published.Content.Modules) != 1 || published.Content.Modules[0].ID != tt.wantModule {
+				t.Errorf("published modules = %+v, want %s", published.Content.Modules, tt.wantModule)
+			}
+			if tt.wantCreator != "" && published.CreatedBy != tt.wantCreator {
+				t.Errorf("CreatedBy = %q, want %q", published.CreatedBy, tt.wantCreator)
+			}
+		})
+	}
+}
+
+func TestMoveLesson(t *testing.T) {
+	const ownerID = "instructor-1"
+
+	tests := []struct {
+		name      string
+		position  int
+		inReview  bool
+		moveErr   error
+		wantErr   error
+		wantMoved []string
+	}{
+		{
+			name:      "moves within the caller's course",
+			position:  0,
+			wantMoved: []string{"course-1/lesson-1->module-2"},
+		},
+		{
+			name:     "negative position",
+			position: -1,
+			wantErr:  domain.ErrInvalidInput,
+		},
+		{
+			name:     "course under review",
+			inReview: true,
+			wantErr:  domain.ErrCourseUnderReview,
+		},
+		{
+			name:    "lesson or module outside the course",
+			moveErr: domain.ErrCourseNotFound,
+			wantErr: domain.ErrCourseNotFound,
+		},
+		{
+			name:    "concurrent reorder",
+			moveErr: domain.ErrOrderConflict,
+			wantErr: domain.ErrOrderConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			course := &domain.Course{ID: "course-1", InstructorID: ownerID, Status: domain.StatusDraft}
+
+			submissions := &fakeSubmissionRepo{open: map[string]*domain.CourseSubmission{}}
+			if tt.inReview {
+				submissions.open[course.ID] = &domain.CourseSubmission{ID: "submission-1", CourseID: course.ID}
+			}
+			lessons := &fakeLessonRepo{
+				lessons: map[string][]*domain.Lesson{"module-1": {{ID: "lesson-1", ModuleID: "module-1"}}},
+				moveErr: tt.moveErr,
+			}
+
+			s := NewCourseService(
+				&fakeCourseRepo{courses: map[string]*domain.Course{course.ID: course}},
+				&fakeModuleRepo{},
+				lessons,
+				&fakeRevisionRepo{},
+				submissions,
+				&fakeCollaboratorRepo{},
+				nil,
+				nil,
+				Producers{},
+				zap.NewNop(),
+			)
+
+			_, err := s.MoveLesson(context.Background(), "lesson-1", "module-2", course.ID, ownerID, tt.position)
+			if err != tt.wantErr {
+				t.Fatalf("MoveLesson() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(lessons.moved, tt.wantMoved) {
+				t.Errorf("moved = %v, want %v", lessons.moved, tt.wantMoved)
+			}
+		})
+	}
+}
+
+func TestArchiveDueCourses(t *testing.T) {
+	courses := &fakeCourseRepo{
+		due:         []string{"course-1", "course-2", "course-3"},
+		archiveErrs: map[string]error{"course-2": domain.ErrNotPublished, "course-3": errors.New("connection reset")},
+	}
+	s := NewCourseService(courses, nil, nil, nil, nil, nil, nil, nil, Producers{}, zap.NewNop())
+
+	archived, err := s.ArchiveDueCourses(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveDueCourses() error = %v", err)
+	}
+
+	// A course republished or archived since the listing is skipped, and one
+	// failure doesn't stop the rest
+	if archived != 1 || !slices.Equal(courses.archived, []string{"course-1"}) {
+		t.Errorf("ArchiveDueCourses() = %d, archived %v, want 1, [course-1]", archived, courses.archived)
+	}
+}
+
+func TestCloneCourse(t *testing.T) {
+	const ownerID = "instructor-1"
+
+	tests := []struct {
+		name      string
+		userID    string
+		title     string
+		category  string
+		wantErr   error
+		wantTitle string
+	}{
+		{name: "keeps the source title", userID: ownerID, category: "programming", wantTitle: "Go basics"},
+		{name: "takes a new title", userID: ownerID, title: "Go basics, spring term", category: "programming", wantTitle: "Go basics, spring term"},
+		{name: "someone else's course", userID: "someone-else", category: "programming", wantErr: domain.ErrUnauthorized},
+		{name: "category since removed", userID: ownerID, category: "retired", wantErr: domain.ErrUnknownCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &domain.Course{
+				ID: "course-1", Title: "Go basics", Description: "Learn Go", Category: tt.category,
+				InstructorID: ownerID, Status: domain.StatusPublished, DefaultLocale: domain.DefaultLocale,
+			}
+			courses := &fakeCourseRepo{courses: map[string]*domain.Course{source.ID: source}}
+
+			producer := kafka.NewProducer(nil, "course.created", zap.NewNop())
+			defer producer.Close()
+
+			s := NewCourseService(
+				courses,
+				&fakeModuleRepo{modules: map[string][]*domain.Module{source.ID: {{ID: "module-1", CourseID: source.ID}}}},
+				&fakeLessonRepo{lessons: map[string][]*domain.Lesson{"module-1": {{ID: "lesson-1", ModuleID: "module-1", VideoID: "video-1", DurationSeconds: 90}}}},
+				&fakeRevisionRepo{},
+				nil,
+				&fakeCollaboratorRepo{},
+				testCategories(),
+				nil,
+				Producers{CourseCreated: producer},
+				zap.NewNop(),
+			)
+
+			course, err := s.CloneCourse(context.Background(), source.ID, tt.userID, tt.title)
+			if err != tt.wantErr {
+				t.Fatalf("CloneCourse() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(courses.created) != 0 {
+					t.Errorf("created %d courses, want none", len(courses.created))
+				}
+				return
+			}
+
+			if course.ID == source.ID || course.InstructorID != ownerID || course.Status != domain.StatusDraft {
+				t.Errorf("course = %+v, want a new DRAFT owned by %s", course, ownerID)
+			}
+			if course.Title != tt.wantTitle || course.DurationMinutes != 2 {
+				t.Errorf("Title, DurationMinutes = %q, %d, want %q, 2", course.Title, course.DurationMinutes, tt.wantTitle)
+			}
+
+			content := courses.contents[0]
+			lesson := content.Modules[0].Lessons[0]
+			if content.Modules[0].ID == "module-1" || lesson.ID == "lesson-1" {
+				t.Errorf("copied module %s and lesson %s kept their source IDs", content.Modules[0].ID, lesson.ID)
+			}
+			if lesson.VideoID != "video-1" {
+				t.Errorf("VideoID = %q, want video-1", lesson.VideoID)
+			}
+		})
+	}
+}
+
+func TestBackfillInstructorNames(t *testing.T) {
+	// One more than a page, so the backfill has to page past the first
+	var unnamed []string
+	for i := 0; i <= instructorNamePageSize; i++ {
+		unnamed = append(unnamed, fmt.Sprintf("instructor-%03d", i))
+	}
+	unnamed[1] += "-nameless"
+
+	courses := &fakeCourseRepo{unnamed: unnamed, names: map[string]string{}}
+	users := &fakeUserClient{}
+	s := NewCourseService(courses, nil, nil, nil, nil, nil, nil, users, Producers{}, zap.NewNop())
+
+	found, err := s.BackfillInstructorNames(context.Background())
+	if err != nil {
+		t.Fatalf("BackfillInstructorNames() error = %v", err)
+	}
+
+	if found != len(unnamed)-1 || len(courses.names) != len(unnamed)-1 {
+		t.Errorf("found %d, stored %d names, want %d", found, len(courses.names), len(unnamed)-1)
+	}
+	if _, ok := courses.names[unnamed[1]]; ok {
+		t.Errorf("stored a name for %s, which user-service has none for", unnamed[1])
+	}
+	if len(users.batches) != 2 || len(users.batches[0]) != instructorNamePageSize || len(users.batches[1]) != 1 {
+		t.Errorf("looked up %d batches, want a full page and then 1", len(users.batches))
+	}
+}
+
+func TestHandleUserProfileUpdated(t *testing.T) {
+	tests := []struct {
+		name      string
+		first     string
+		last      string
+		wantNames map[string]string
+	}{
+		{name: "renamed", first: "Ada", last: "Lovelace", wantNames: map[string]string{"user-1": "Ada Lovelace"}},
+		{name: "first name only", first: "Ada", wantNames: map[string]string{"user-1": "Ada"}},
+		{name: "blank name is ignored", first: " ", wantNames: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseRepo{names: map[string]string{}}
+			s := NewCourseService(courses, nil, nil, nil, nil, nil, nil, nil, Producers{}, zap.NewNop())
+
+			value, err := json.Marshal(kafka.UserProfileUpdatedEvent{UserID: "user-1", FirstName: tt.first, LastName: tt.last, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := s.HandleUserProfileUpdated(context.Background(), []byte("user-1"), value); err != nil {
+				t.Fatalf("HandleUserProfileUpdated() error = %v", err)
+			}
+			if !maps.Equal(courses.names, tt.wantNames) {
+				t.Errorf("names = %v, want %v", courses.names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	const ownerID = "instructor-1"
+
+	collaborators := &fakeCollaboratorRepo{collaborators: map[string]*domain.Collaborator{
+		"editor":  {UserID: "editor", Role: domain.RoleEditor, Status: domain.CollaboratorAccepted},
+		"ta":      {UserID: "ta", Role: domain.RoleTeachingAssistant, Status: domain.CollaboratorAccepted},
+		"invited": {UserID: "invited", Role: domain.RoleEditor, Status: domain.CollaboratorInvited},
+	}}
+
+	tests := []struct {
+		name       string
+		userID     string
+		permission domain.Permission
+		wantErr    error
+	}{
+		{name: "owner manages", userID: ownerID, permission: domain.PermManageCourse},
+		{name: "editor edits", userID: "editor", permission: domain.PermEditContent},
+		{name: "editor can't manage", userID: "editor", permission: domain.PermManageCourse, wantErr: domain.ErrUnauthorized},
+		{name: "teaching assistant grades", userID: "ta", permission: domain.PermGrade},
+		{name: "teaching assistant can't edit", userID: "ta", permission: domain.PermEditContent, wantErr: domain.ErrUnauthorized},
+		{name: "pending invitation grants nothing", userID: "invited", permission: domain.PermViewContent, wantErr: domain.ErrUnauthorized},
+		{name: "stranger", userID: "stranger", permission: domain.PermViewContent, wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseRepo{courses: map[string]*domain.Course{"course-1": {ID: "course-1", InstructorID: ownerID}}}
+			s := NewCourseService(courses, nil, nil, nil, nil, collaborators, nil, nil, Producers{}, zap.NewNop())
+
+			course, err := s.Authorize(context.Background(), "course-1", tt.userID, tt.permission)
+			if err != tt.wantErr {
+				t.Fatalf("Authorize() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && course.ID != "course-1" {
+				t.Errorf("Authorize() = %+v, want course-1", course)
+			}
+		})
+	}
+}
+
+func TestCreateCourseChecksCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		wantErr  error
+	}{
+		{name: "top-level category", category: "programming"},
+		{name: "subcategory", category: "go"},
+		{name: "free text", category: "Go Lang", wantErr: domain.ErrUnknownCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseRepo{}
+
+			producer := kafka.NewProducer(nil, "course.created", zap.NewNop())
+			defer producer.Close()
+
+			s := NewCourseService(courses, nil, nil, nil, nil, nil, testCategories(), nil, Producers{CourseCreated: producer}, zap.NewNop())
+
+			req := CreateCourseRequest{Title: "Go basics", Description: "Learn Go", Category: tt.category}
+			course, err := s.CreateCourse(context.Background(), "instructor-1", req)
+			if err != tt.wantErr {
+				t.Fatalf("CreateCourse() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(courses.created) != 0 {
+					t.Errorf("created %d courses, want none", len(courses.created))
+				}
+				return
+			}
+			if course.Category != tt.category {
+				t.Errorf("Category = %q, want %q", course.Category, tt.category)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/notification"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DiscussionService runs the Q&A discussions of a course and its lessons.
+// They're open to the course's staff and to enrolled students; a lesson's
+// discussion is only open to students who can open the lesson.
+type DiscussionService interface {
+	CreateThread(ctx context.Context, courseID, lessonID, userID, title, body string) (*domain.Thread, error)
+	ListThreads(ctx context.Context, courseID, lessonID, userID string, page, pageSize int) ([]*domain.Thread, int, error)
+	GetThread(ctx context.Context, threadID, userID string) (*domain.Thread, []*domain.Reply, error)
+	Reply(ctx context.Context, threadID, parentID, userID, body string) (*domain.Reply, error)
+	Vote(ctx context.Context, threadID, replyID, userID string, upvote bool) (int, error)
+	MarkAnswer(ctx context.Context, threadID, replyID, userID string) (*domain.Thread, error)
+	Moderate(ctx context.Context, threadID, replyID, userID string, action domain.ModerationAction) error
+}
+
+type discussionService struct {
+	courseService  CourseService
+	releaseService ReleaseService
+	discussionRepo repository.DiscussionRepository
+	learnerRepo    repository.LearnerRepository
+	moduleRepo     repository.ModuleRepository
+	lessonRepo     repository.LessonRepository
+	notifications  notification.Client
+	logger         *zap.Logger
+}
+
+func NewDiscussionService(
+	courseService CourseService,
+	releaseService ReleaseService,
+	discussionRepo repository.DiscussionRepository,
+	learnerRepo repository.LearnerRepository,
+	moduleRepo repository.ModuleRepository,
+	lessonRepo repository.LessonRepository,
+	notifications notification.Client,
+	logger *zap.Logger,
+) DiscussionService {
+	return &discussionService{
+		courseService:  courseService,
+		releaseService: releaseService,
+		discussionRepo: discussionRepo,
+		learnerRepo:    learnerRepo,
+		moduleRepo:     moduleRepo,
+		lessonRepo:     lessonRepo,
+		notifications:  notifications,
+		logger:         logger,
+	}
+}
+
+// CreateThread starts a thread about the lesson, or about the course as a
+// whole if lessonID is empty.
+func (s *discussionService) CreateThread(ctx context.Context, courseID, lessonID, userID, title, body string) (*domain.Thread, error) {
+	if _, err := s.access(ctx, courseID, lessonID, userID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	thread := &domain.Thread{
+		ID:             uuid.New().String(),
+		CourseID:       courseID,
+		LessonID:       lessonID,
+		AuthorID:       userID,
+		Title:          strings.TrimSpace(title),
+		Body:           strings.TrimSpace(body),
+		LastActivityAt: now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := thread.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.discussionRepo.CreateThread(ctx, thread); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("discussion thread created",
+		zap.String("thread_id", thread.ID),
+		zap.String("course_id", courseID),
+		zap.String("lesson_id", lessonID),
+	)
+	return thread, nil
+}
+
+// ListThreads returns the course's threads, or the lesson's if lessonID is
+// set. Hidden threads are only listed for moderators.
+func (s *discussionService) ListThreads(ctx context.Context, courseID, lessonID, userID string, page, pageSize int) ([]*domain.Thread, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	role, err := s.access(ctx, courseID, lessonID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.discussionRepo.ListThreads(ctx, courseID, lessonID, role.Can(domain.PermModerate), userID, page, pageSize)
+}
+
+// GetThread returns the thread and all of its replies. Hidden replies are
+// kept so nested ones still have a parent, but only moderators see what
+// they said.
+func (s *discussionService) GetThread(ctx context.Context, threadID, userID string) (*domain.Thread, []*domain.Reply, error) {
+	thread, role, err := s.thread(ctx, threadID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replies, err := s.discussionRepo.ListReplies(ctx, threadID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !role.Can(domain.PermModerate) {
+		for _, reply := range replies {
+			if reply.Hidden {
+				reply.Body = ""
+			}
+		}
+	}
+
+	return thread, replies, nil
+}
+
+// Reply answers the thread, or parentID's reply in it, and lets the authors
+// of both know.
+func (s *discussionService) Reply(ctx context.Context, threadID, parentID, userID, body string) (*domain.Reply, error) {
+	thread, role, err := s.thread(ctx, threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent *domain.Reply
+	if parentID != "" {
+		parent, err = s.reply(ctx, thread, parentID, userID, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reply := &domain.Reply{
+		ID:        uuid.New().String(),
+		ThreadID:  threadID,
+		ParentID:  parentID,
+		AuthorID:  userID,
+		Body:      strings.TrimSpace(body),
+		CreatedAt: time.Now(),
+	}
+
+	if err := reply.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.discussionRepo.CreateReply(ctx, reply); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("discussion reply created", zap.String("reply_id", reply.ID), zap.String("thread_id", threadID))
+
+	recipients := []string{thread.AuthorID}
+	if parent != nil && parent.AuthorID != thread.AuthorID {
+		recipients = append(recipients, parent.AuthorID)
+	}
+	for _, recipient := range recipients {
+		if recipient != userID {
+			s.notify(ctx, thread, reply, recipient)
+		}
+	}
+
+	return reply, nil
+}
+
+// Vote upvotes the thread, or replyID's reply in it, or withdraws the
+// upvote, and returns its upvotes.
+func (s *discussionService) Vote(ctx context.Context, threadID, replyID, userID string, upvote bool) (int, error) {
+	thread, role, err := s.thread(ctx, threadID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	targetID := threadID
+	if replyID != "" {
+		if _, err := s.reply(ctx, thread, replyID, userID, role); err != nil {
+			return 0, err
+		}
+		targetID = replyID
+	}
+
+	return s.discussionRepo.Vote(ctx, threadID, targetID, userID, upvote)
+}
+
+// MarkAnswer marks replyID's reply as answering the thread, or clears the
+// answer if replyID is empty. Only moderators can mark answers.
+func (s *discussionService) MarkAnswer(ctx context.Context, threadID, replyID, userID string) (*domain.Thread, error) {
+	thread, role, err := s.thread(ctx, threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Can(domain.PermModerate) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if replyID != "" {
+		if _, err := s.reply(ctx, thread, replyID, userID, role); err != nil {
+			return nil, err
+		}
+	}
+
+	thread.AnswerReplyID = replyID
+	thread.UpdatedAt = time.Now()
+	if err := s.discussionRepo.UpdateThread(ctx, thread); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("discussion answer marked", zap.String("thread_id", threadID), zap.String("reply_id", replyID))
+	return thread, nil
+}
+
+// Moderate pins, locks or hides the thread, or hides replyID's reply in it.
+func (s *discussionService) Moderate(ctx context.Context, threadID, replyID, userID string, action domain.ModerationAction) error {
+	thread, role, err := s.thread(ctx, threadID, userID)
+	if err != nil {
+		return err
+	}
+	if !role.Can(domain.PermModerate) {
+		return domain.ErrUnauthorized
+	}
+
+	if replyID != "" {
+		if action != domain.ModerationHide && action != domain.ModerationUnhide {
+			return domain.ErrInvalidInput
+		}
+		if _, err := s.reply(ctx, thread, replyID, userID, role); err != nil {
+			return err
+		}
+		if err := s.discussionRepo.SetReplyHidden(ctx, replyID, action == domain.ModerationHide); err != nil {
+			return err
+		}
+	} else {
+		if err := action.Apply(thread); err != nil {
+			return err
+		}
+		thread.UpdatedAt = time.Now()
+		if err := s.discussionRepo.UpdateThread(ctx, thread); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info("discussion moderated",
+		zap.String("thread_id", threadID),
+		zap.String("reply_id", replyID),
+		zap.String("action", string(action)),
+		zap.String("moderator_id", userID),
+	)
+	return nil
+}
+
+// access returns userID's role on the course, making sure they can take part
+// in its discussion, or in the lesson's if lessonID is set. Staff can always
+// take part; students must be enrolled and able to open the lesson.
+func (s *discussionService) access(ctx context.Context, courseID, lessonID, userID string) (domain.CourseRole, error) {
+	course, err := s.courseService.GetCourse(ctx, courseID)
+	if err != nil {
+		return "", err
+	}
+
+	role, err := s.courseService.Role(ctx, course, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if role == "" {
+		if _, err := s.learnerRepo.Get(ctx, userID, courseID); err != nil {
+			return "", err
+		}
+		if lessonID != "" {
+			if err := s.releaseService.AuthorizeLesson(ctx, courseID, lessonID, userID); err != nil {
+				return "", err
+			}
+		}
+		return role, nil
+	}
+
+	// AuthorizeLesson lets staff through without looking at the lesson
+	if lessonID != "" {
+		lesson, err := s.lessonRepo.GetByID(ctx, lessonID)
+		if err != nil {
+			return "", err
+		}
+		module, err := s.moduleRepo.GetByID(ctx, lesson.ModuleID)
+		if err != nil {
+			return "", err
+		}
+		if module.CourseID != courseID {
+			return "", domain.ErrCourseNotFound
+		}
+	}
+	return role, nil
+}
+
+// thread loads the thread for userID. A hidden thread is only found by
+// moderators.
+func (s *discussionService) thread(ctx context.Context, threadID, userID string) (*domain.Thread, domain.CourseRole, error) {
+	thread, err := s.discussionRepo.GetThread(ctx, threadID, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	role, err := s.access(ctx, thread.CourseID, thread.LessonID, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if thread.Hidden && !role.Can(domain.PermModerate) {
+		return nil, "", domain.ErrThreadNotFound
+	}
+
+	return thread, role, nil
+}
+
+// reply loads one of the thread's replies for userID. A hidden reply is only
+// found by moderators.
+func (s *discussionService) reply(ctx context.Context, thread *domain.Thread, replyID, userID string, role domain.CourseRole) (*domain.Reply, error) {
+	reply, err := s.discussionRepo.GetReply(ctx, replyID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if reply.ThreadID != thread.ID || (reply.Hidden && !role.Can(domain.PermModerate)) {
+		return nil, domain.ErrReplyNotFound
+	}
+	return reply, nil
+}
+
+// notify tells userID about the reply in-app. A failure is only logged, as
+// the reply itself went through.
+func (s *discussionService) notify(ctx context.Context, thread *domain.Thread, reply *domain.Reply, userID string) {
+	err := s.notifications.Send(ctx, notification.Message{
+		UserID:  userID,
+		Subject: "New reply in " + thread.Title,
+		Body:    reply.Body,
+		Data: map[string]string{
+			"type":      "discussion_reply",
+			"course_id": thread.CourseID,
+			"lesson_id": thread.LessonID,
+			"thread_id": thread.ID,
+			"reply_id":  reply.ID,
+		},
+	})
+	if err != nil {
+		s.logger.Error("failed to notify of discussion reply",
+			zap.String("reply_id", reply.ID),
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+	}
+}
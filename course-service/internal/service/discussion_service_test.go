@@ -0,0 +1,496 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+func (r *fakeModuleRepo) GetByID(ctx context.Context, id string) (*domain.Module, error) {
+	for _, modules := range r.modules {
+		for _, module := range modules {
+			if module.ID == id {
+				return module, nil
+			}
+		}
+	}
+	return nil, domain.ErrCourseNotFound
+}
+
+// fakeDiscussionRepo keeps threads and replies by ID and hands out copies,
+// so only what's written back through it sticks. votes records each vote as
+// "threadID/targetID/userID/upvote".
+type fakeDiscussionRepo struct {
+	repository.DiscussionRepository
+	threads       map[string]*domain.Thread
+	replies       map[string]*domain.Reply
+	createdReply  *domain.Reply
+	includeHidden bool
+	updated       []*domain.Thread
+	hidden        map[string]bool
+	votes         []string
+}
+
+func (r *fakeDiscussionRepo) CreateThread(ctx context.Context, thread *domain.Thread) error {
+	r.threads[thread.ID] = thread
+	return nil
+}
+
+func (r *fakeDiscussionRepo) GetThread(ctx context.Context, id, viewerID string) (*domain.Thread, error) {
+	thread, ok := r.threads[id]
+	if !ok {
+		return nil, domain.ErrThreadNotFound
+	}
+	copied := *thread
+	return &copied, nil
+}
+
+func (r *fakeDiscussionRepo) ListThreads(ctx context.Context, courseID, lessonID string, includeHidden bool, viewerID string, page, pageSize int) ([]*domain.Thread, int, error) {
+	r.includeHidden = includeHidden
+	return nil, 0, nil
+}
+
+func (r *fakeDiscussionRepo) UpdateThread(ctx context.Context, thread *domain.Thread) error {
+	r.updated = append(r.updated, thread)
+	return nil
+}
+
+func (r *fakeDiscussionRepo) CreateReply(ctx context.Context, reply *domain.Reply) error {
+	if r.threads[reply.ThreadID].Locked {
+		return domain.ErrThreadLocked
+	}
+	r.createdReply = reply
+	return nil
+}
+
+func (r *fakeDiscussionRepo) GetReply(ctx context.Context, id, viewerID string) (*domain.Reply, error) {
+	reply, ok := r.replies[id]
+	if !ok {
+		return nil, domain.ErrReplyNotFound
+	}
+	copied := *reply
+	return &copied, nil
+}
+
+func (r *fakeDiscussionRepo) ListReplies(ctx context.Context, threadID, viewerID string) ([]*domain.Reply, error) {
+	var replies []*domain.Reply
+	for _, id := range slices.Sorted(maps.Keys(r.replies)) {
+		if reply := r.replies[id]; reply.ThreadID == threadID {
+			copied := *reply
+			replies = append(replies, &copied)
+		}
+	}
+	return replies, nil
+}
+
+func (r *fakeDiscussionRepo) SetReplyHidden(ctx context.Context, id string, hidden bool) error {
+	r.hidden[id] = hidden
+	return nil
+}
+
+func (r *fakeDiscussionRepo) Vote(ctx context.Context, threadID, targetID, userID string, upvote bool) (int, error) {
+	r.votes = append(r.votes, fmt.Sprintf("%s/%s/%s/%v", threadID, targetID, userID, upvote))
+	return len(r.votes), nil
+}
+
+// testDiscussions has a course-wide thread, one about lesson-1 and a locked
+// and a hidden one, all asked by student-1 on course-1. student-2 has
+// replied to the course-wide thread and a hidden reply sits beside it.
+func testDiscussions() *fakeDiscussionRepo {
+	return &fakeDiscussionRepo{
+		threads: map[string]*domain.Thread{
+			"thread-course": {ID: "thread-course", CourseID: "course-1", AuthorID: "student-1", Title: "Course question"},
+			"thread-lesson": {ID: "thread-lesson", CourseID: "course-1", LessonID: "lesson-1", AuthorID: "student-1", Title: "Lesson question"},
+			"thread-locked": {ID: "thread-locked", CourseID: "course-1", AuthorID: "student-1", Title: "Locked", Locked: true},
+			"thread-hidden": {ID: "thread-hidden", CourseID: "course-1", AuthorID: "student-1", Title: "Hidden", Hidden: true},
+		},
+		replies: map[string]*domain.Reply{
+			"reply-1":      {ID: "reply-1", ThreadID: "thread-course", AuthorID: "student-2", Body: "Same here"},
+			"reply-hidden": {ID: "reply-hidden", ThreadID: "thread-course", AuthorID: "student-2", Body: "Spam", Hidden: true},
+			"reply-own":    {ID: "reply-own", ThreadID: "thread-course", AuthorID: "student-1", Body: "Anyone?"},
+			"reply-lesson": {ID: "reply-lesson", ThreadID: "thread-lesson", AuthorID: "ta-1", Body: "See the notes"},
+		},
+		hidden: map[string]bool{},
+	}
+}
+
+// newTestDiscussionService runs course-1, owned by instructor-1 with ta-1 as
+// a teaching assistant, and course-2. student-1 to student-3 are enrolled in
+// course-1 but student-3 can't open lesson-1 yet.
+func newTestDiscussionService(discussions *fakeDiscussionRepo, notifications *fakeNotificationClient) DiscussionService {
+	courses := &fakeCourseService{
+		ownerID: "instructor-1",
+		roles:   map[string]domain.CourseRole{"ta-1": domain.RoleTeachingAssistant},
+		courses: map[string]*domain.Course{
+			"course-1": {ID: "course-1", InstructorID: "instructor-1"},
+			"course-2": {ID: "course-2", InstructorID: "instructor-1"},
+		},
+	}
+	releases := &fakeReleaseService{enrolled: map[string]bool{"student-1": true, "student-2": true}}
+	learners := &fakeLearnerRepo{learners: map[string][]string{"course-1": {"student-1", "student-2", "student-3"}}}
+	modules := &fakeModuleRepo{modules: map[string][]*domain.Module{
+		"course-1": {{ID: "module-1", CourseID: "course-1"}},
+		"course-2": {{ID: "module-2", CourseID: "course-2"}},
+	}}
+	lessons := &fakeLessonRepo{lessons: map[string][]*domain.Lesson{
+		"module-1": {{ID: "lesson-1", ModuleID: "module-1"}},
+		"module-2": {{ID: "lesson-2", ModuleID: "module-2"}},
+	}}
+
+	return NewDiscussionService(courses, releases, discussions, learners, modules, lessons, notifications, zap.NewNop())
+}
+
+func TestCreateThread(t *testing.T) {
+	tests := []struct {
+		name     string
+		courseID string
+		lessonID string
+		userID   string
+		title    string
+		wantErr  error
+	}{
+		{name: "student about the course", courseID: "course-1", userID: "student-3", title: "Question"},
+		{name: "student about a lesson", courseID: "course-1", lessonID: "lesson-1", userID: "student-1", title: "Question"},
+		{name: "student who can't open the lesson", courseID: "course-1", lessonID: "lesson-1", userID: "student-3", title: "Question", wantErr: domain.ErrUnauthorized},
+		{name: "not enrolled", courseID: "course-1", userID: "student-9", title: "Question", wantErr: domain.ErrNotEnrolled},
+		{name: "enrolled in another course", courseID: "course-2", userID: "student-1", title: "Question", wantErr: domain.ErrNotEnrolled},
+		{name: "staff about any lesson", courseID: "course-1", lessonID: "lesson-1", userID: "ta-1", title: "Question"},
+		{name: "staff about another course's lesson", courseID: "course-1", lessonID: "lesson-2", userID: "ta-1", title: "Question", wantErr: domain.ErrCourseNotFound},
+		{name: "unknown course", courseID: "course-9", userID: "instructor-1", title: "Question", wantErr: domain.ErrCourseNotFound},
+		{name: "blank title", courseID: "course-1", userID: "student-1", title: " ", wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discussions := testDiscussions()
+			svc := newTestDiscussionService(discussions, &fakeNotificationClient{})
+
+			thread, err := svc.CreateThread(context.Background(), tt.courseID, tt.lessonID, tt.userID, tt.title, " Why? ")
+			if err != tt.wantErr {
+				t.Fatalf("CreateThread() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if discussions.threads[thread.ID] != thread {
+				t.Error("thread wasn't stored")
+			}
+			if thread.CourseID != tt.courseID || thread.LessonID != tt.lessonID || thread.AuthorID != tt.userID || thread.Body != "Why?" {
+				t.Errorf("thread = %+v", thread)
+			}
+		})
+	}
+}
+
+func TestListThreadsShowsHiddenToModerators(t *testing.T) {
+	tests := []struct {
+		userID string
+		want   bool
+	}{
+		{userID: "student-1", want: false},
+		{userID: "ta-1", want: true},
+		{userID: "instructor-1", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			discussions := testDiscussions()
+			svc := newTestDiscussionService(discussions, &fakeNotificationClient{})
+
+			if _, _, err := svc.ListThreads(context.Background(), "course-1", "", tt.userID, 1, 20); err != nil {
+				t.Fatalf("ListThreads() error = %v", err)
+			}
+			if discussions.includeHidden != tt.want {
+				t.Errorf("includeHidden = %v, want %v", discussions.includeHidden, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetThread(t *testing.T) {
+	tests := []struct {
+		name        string
+		threadID    string
+		userID      string
+		wantErr     error
+		wantHidden  string
+		wantReplies int
+	}{
+		{name: "students don't see hidden replies' bodies", threadID: "thread-course", userID: "student-2", wantReplies: 3},
+		{name: "moderators see hidden replies' bodies", threadID: "thread-course", userID: "ta-1", wantHidden: "Spam", wantReplies: 3},
+		{name: "students can't find hidden threads", threadID: "thread-hidden", userID: "student-1", wantErr: domain.ErrThreadNotFound},
+		{name: "moderators find hidden threads", threadID: "thread-hidden", userID: "ta-1"},
+		{name: "students who can't open the lesson", threadID: "thread-lesson", userID: "student-3", wantErr: domain.ErrUnauthorized},
+		{name: "not enrolled", threadID: "thread-course", userID: "student-9", wantErr: domain.ErrNotEnrolled},
+		{name: "unknown thread", threadID: "thread-9", userID: "student-1", wantErr: domain.ErrThreadNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestDiscussionService(testDiscussions(), &fakeNotificationClient{})
+
+			thread, replies, err := svc.GetThread(context.Background(), tt.threadID, tt.userID)
+			if err != tt.wantErr {
+				t.Fatalf("GetThread() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if thread.ID != tt.threadID {
+				t.Errorf("thread = %s, want %s", thread.ID, tt.threadID)
+			}
+			if len(replies) != tt.wantReplies {
+				t.Fatalf("got %d replies, want %d", len(replies), tt.wantReplies)
+			}
+			for _, reply := range replies {
+				if reply.ID == "reply-hidden" && reply.Body != tt.wantHidden {
+					t.Errorf("hidden reply body = %q, want %q", reply.Body, tt.wantHidden)
+				}
+				if reply.ID == "reply-1" && reply.Body != "Same here" {
+					t.Errorf("visible reply body = %q, want it kept", reply.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestReply(t *testing.T) {
+	tests := []struct {
+		name         string
+		threadID     string
+		parentID     string
+		userID       string
+		body         string
+		wantErr      error
+		wantNotified []string
+	}{
+		{name: "tells the thread's author", threadID: "thread-course", userID: "student-2", body: "Me too", wantNotified: []string{"student-1"}},
+		{name: "tells the parent reply's author too", threadID: "thread-course", parentID: "reply-1", userID: "instructor-1", body: "Fixed", wantNotified: []string{"student-1", "student-2"}},
+		{name: "doesn't tell the replier", threadID: "thread-course", parentID: "reply-1", userID: "student-1", body: "Thanks", wantNotified: []string{"student-2"}},
+		{name: "tells an author of both once", threadID: "thread-course", parentID: "reply-own", userID: "ta-1", body: "Yes", wantNotified: []string{"student-1"}},
+		{name: "own thread", threadID: "thread-course", userID: "student-1", body: "Bump"},
+		{name: "locked thread", threadID: "thread-locked", userID: "student-2", body: "Me too", wantErr: domain.ErrThreadLocked},
+		{name: "hidden thread", threadID: "thread-hidden", userID: "student-2", body: "Me too", wantErr: domain.ErrThreadNotFound},
+		{name: "students can't reply to hidden replies", threadID: "thread-course", parentID: "reply-hidden", userID: "student-1", body: "Me too", wantErr: domain.ErrReplyNotFound},
+		{name: "parent in another thread", threadID: "thread-course", parentID: "reply-lesson", userID: "student-1", body: "Me too", wantErr: domain.ErrReplyNotFound},
+		{name: "lesson not open yet", threadID: "thread-lesson", userID: "student-3", body: "Me too", wantErr: domain.ErrUnauthorized},
+		{name: "blank body", threadID: "thread-course", userID: "student-2", body: " ", wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discussions := testDiscussions()
+			notifications := &fakeNotificationClient{}
+			svc := newTestDiscussionService(discussions, notifications)
+
+			reply, err := svc.Reply(context.Background(), tt.threadID, tt.parentID, tt.userID, tt.body)
+			if err != tt.wantErr {
+				t.Fatalf("Reply() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var notified []string
+			for _, msg := range notifications.sent {
+				notified = append(notified, msg.UserID)
+			}
+			if !slices.Equal(notified, tt.wantNotified) {
+				t.Errorf("notified %v, want %v", notified, tt.wantNotified)
+			}
+			if tt.wantErr != nil {
+				if discussions.createdReply != nil {
+					t.Error("reply was stored")
+				}
+				return
+			}
+
+			if discussions.createdReply != reply || reply.ParentID != tt.parentID || reply.AuthorID != tt.userID {
+				t.Errorf("stored reply = %+v", discussions.createdReply)
+			}
+			for _, msg := range notifications.sent {
+				if msg.Data["thread_id"] != tt.threadID || msg.Data["reply_id"] != reply.ID || msg.Data["type"] != "discussion_reply" {
+					t.Errorf("notification Data = %v", msg.Data)
+				}
+			}
+		})
+	}
+}
+
+func TestVote(t *testing.T) {
+	tests := []struct {
+		name     string
+		replyID  string
+		userID   string
+		upvote   bool
+		wantErr  error
+		wantVote string
+	}{
+		{name: "upvote the thread", userID: "student-2", upvote: true, wantVote: "thread-course/thread-course/student-2/true"},
+		{name: "withdraw a thread upvote", userID: "student-2", wantVote: "thread-course/thread-course/student-2/false"},
+		{name: "upvote a reply", replyID: "reply-1", userID: "student-1", upvote: true, wantVote: "thread-course/reply-1/student-1/true"},
+		{name: "reply in another thread", replyID: "reply-lesson", userID: "student-1", upvote: true, wantErr: domain.ErrReplyNotFound},
+		{name: "hidden reply", replyID: "reply-hidden", userID: "student-1", upvote: true, wantErr: domain.ErrReplyNotFound},
+		{name: "not enrolled", userID: "student-9", upvote: true, wantErr: domain.ErrNotEnrolled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discussions := testDiscussions()
+			svc := newTestDiscussionService(discussions, &fakeNotificationClient{})
+
+			_, err := svc.Vote(context.Background(), "thread-course", tt.replyID, tt.userID, tt.upvote)
+			if err != tt.wantErr {
+				t.Fatalf("Vote() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var want []string
+			if tt.wantVote != "" {
+				want = []string{tt.wantVote}
+			}
+			if !slices.Equal(discussions.votes, want) {
+				t.Errorf("votes = %v, want %v", discussions.votes, want)
+			}
+		})
+	}
+}
+
+func TestMarkAnswer(t *testing.T) {
+	tests := []struct {
+		name     string
+		replyID  string
+		userID   string
+		answered string
+		wantErr  error
+	}{
+		{name: "staff mark an answer", replyID: "reply-1", userID: "ta-1"},
+		{name: "staff clear the answer", userID: "instructor-1", answered: "reply-1"},
+		{name: "students can't", replyID: "reply-1", userID: "student-1", wantErr: domain.ErrUnauthorized},
+		{name: "reply in another thread", replyID: "reply-lesson", userID: "ta-1", wantErr: domain.ErrReplyNotFound},
+		{name: "unknown reply", replyID: "reply-9", userID: "ta-1", wantErr: domain.ErrReplyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discussions := testDiscussions()
+			discussions.threads["thread-course"].AnswerReplyID = tt.answered
+			svc := newTestDiscussionService(discussions, &fakeNotificationClient{})
+
+			thread, err := svc.MarkAnswer(context.Background(), "thread-course", tt.replyID, tt.userID)
+			if err != tt.wantErr {
+				t.Fatalf("MarkAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(discussions.updated) != 0 {
+					t.Error("thread was updated")
+				}
+				return
+			}
+
+			if len(discussions.updated) != 1 || discussions.updated[0] != thread {
+				t.Fatalf("updated = %v, want the marked thread", discussions.updated)
+			}
+			if thread.AnswerReplyID != tt.replyID {
+				t.Errorf("AnswerReplyID = %q, want %q", thread.AnswerReplyID, tt.replyID)
+			}
+		})
+	}
+}
+
+func TestModerate(t *testing.T) {
+	tests := []struct {
+		name       string
+		threadID   string
+		replyID    string
+		userID     string
+		action     domain.ModerationAction
+		wantErr    error
+		wantThread *domain.Thread
+		wantHidden map[string]bool
+	}{
+		{
+			name:     "pin a thread",
+			threadID: "thread-course", userID: "ta-1", action: domain.ModerationPin,
+			wantThread: &domain.Thread{Pinned: true},
+		},
+		{
+			name:     "unlock a thread",
+			threadID: "thread-locked", userID: "instructor-1", action: domain.ModerationUnlock,
+			wantThread: &domain.Thread{},
+		},
+		{
+			name:     "unhide a thread",
+			threadID: "thread-hidden", userID: "ta-1", action: domain.ModerationUnhide,
+			wantThread: &domain.Thread{},
+		},
+		{
+			name:     "hide a reply",
+			threadID: "thread-course", replyID: "reply-1", userID: "ta-1", action: domain.ModerationHide,
+			wantHidden: map[string]bool{"reply-1": true},
+		},
+		{
+			name:     "unhide a reply",
+			threadID: "thread-course", replyID: "reply-hidden", userID: "ta-1", action: domain.ModerationUnhide,
+			wantHidden: map[string]bool{"reply-hidden": false},
+		},
+		{
+			name:     "replies aren't pinned",
+			threadID: "thread-course", replyID: "reply-1", userID: "ta-1", action: domain.ModerationPin,
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:     "unknown action",
+			threadID: "thread-course", userID: "ta-1", action: "DELETE",
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:     "students can't",
+			threadID: "thread-course", userID: "student-1", action: domain.ModerationLock,
+			wantErr: domain.ErrUnauthorized,
+		},
+		{
+			name:     "reply in another thread",
+			threadID: "thread-course", replyID: "reply-lesson", userID: "ta-1", action: domain.ModerationHide,
+			wantErr: domain.ErrReplyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discussions := testDiscussions()
+			svc := newTestDiscussionService(discussions, &fakeNotificationClient{})
+
+			err := svc.Moderate(context.Background(), tt.threadID, tt.replyID, tt.userID, tt.action)
+			if err != tt.wantErr {
+				t.Fatalf("Moderate() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantThread == nil {
+				if len(discussions.updated) != 0 {
+					t.Errorf("updated = %v, want no thread updates", discussions.updated)
+				}
+			} else {
+				if len(discussions.updated) != 1 {
+					t.Fatalf("updated %d threads, want 1", len(discussions.updated))
+				}
+				got := discussions.updated[0]
+				if got.Pinned != tt.wantThread.Pinned || got.Locked != tt.wantThread.Locked || got.Hidden != tt.wantThread.Hidden {
+					t.Errorf("thread pinned/locked/hidden = %v/%v/%v, want %v/%v/%v",
+						got.Pinned, got.Locked, got.Hidden, tt.wantThread.Pinned, tt.wantThread.Locked, tt.wantThread.Hidden)
+				}
+			}
+
+			if tt.wantHidden == nil {
+				tt.wantHidden = map[string]bool{}
+			}
+			if !maps.Equal(discussions.hidden, tt.wantHidden) {
+				t.Errorf("hidden replies = %v, want %v", discussions.hidden, tt.wantHidden)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type LearningPathRequest struct {
+	Title       string
+	Description string
+	CourseIDs   []string
+}
+
+type PathService interface {
+	AddPrerequisite(ctx context.Context, courseID, requiredCourseID, instructorID string) ([]*domain.Prerequisite, error)
+	RemovePrerequisite(ctx context.Context, courseID, requiredCourseID, instructorID string) error
+	ListPrerequisites(ctx context.Context, courseID string) ([]*domain.Prerequisite, error)
+	CreateLearningPath(ctx context.Context, creatorID string, req LearningPathRequest) (*domain.LearningPath, error)
+	UpdateLearningPath(ctx context.Context, pathID string, req LearningPathRequest) (*domain.LearningPath, error)
+	GetLearningPath(ctx context.Context, pathID string) (*domain.LearningPath, error)
+	DeleteLearningPath(ctx context.Context, pathID string) error
+	ListLearningPaths(ctx context.Context, page, pageSize int) ([]*domain.LearningPath, int, error)
+}
+
+type pathService struct {
+	courseRepo       repository.CourseRepository
+	prerequisiteRepo repository.PrerequisiteRepository
+	pathRepo         repository.LearningPathRepository
+	logger           *zap.Logger
+}
+
+func NewPathService(
+	courseRepo repository.CourseRepository,
+	prerequisiteRepo repository.PrerequisiteRepository,
+	pathRepo repository.LearningPathRepository,
+	logger *zap.Logger,
+) PathService {
+	return &pathService{
+		courseRepo:       courseRepo,
+		prerequisiteRepo: prerequisiteRepo,
+		pathRepo:         pathRepo,
+		logger:           logger,
+	}
+}
+
+// AddPrerequisite gates courseID behind requiredCourseID and returns the
+// course's full prerequisite list. Only the gated course's instructor can add
+// edges; the required course may belong to anyone.
+func (s *pathService) AddPrerequisite(ctx context.Context, courseID, requiredCourseID, instructorID string) ([]*domain.Prerequisite, error) {
+	if courseID == requiredCourseID {
+		return nil, domain.ErrPrerequisiteCycle
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if course.InstructorID != instructorID {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if _, err := s.courseRepo.GetByID(ctx, requiredCourseID); err != nil {
+		return nil, err
+	}
+
+	prerequisite := &domain.Prerequisite{
+		CourseID:         courseID,
+		RequiredCourseID: requiredCourseID,
+		CreatedAt:        time.Now(),
+	}
+
+	if err := s.prerequisiteRepo.Add(ctx, prerequisite); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("prerequisite added", zap.String("course_id", courseID), zap.String("required_course_id", requiredCourseID))
+
+	return s.prerequisiteRepo.ListByCourse(ctx, courseID)
+}
+
+func (s *pathService) RemovePrerequisite(ctx context.Context, courseID, requiredCourseID, instructorID string) error {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return err
+	}
+
+	if course.InstructorID != instructorID {
+		return domain.ErrUnauthorized
+	}
+
+	if err := s.prerequisiteRepo.Remove(ctx, courseID, requiredCourseID); err != nil {
+		return err
+	}
+
+	s.logger.Info("prerequisite removed", zap.String("course_id", courseID), zap.String("required_course_id", requiredCourseID))
+	return nil
+}
+
+func (s *pathService) ListPrerequisites(ctx context.Context, courseID string) ([]*domain.Prerequisite, error) {
+	if _, err := s.courseRepo.GetByID(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	return s.prerequisiteRepo.ListByCourse(ctx, courseID)
+}
+
+func (s *pathService) CreateLearningPath(ctx context.Context, creatorID string, req LearningPathRequest) (*domain.LearningPath, error) {
+	path := &domain.LearningPath{
+		ID:          uuid.New().String(),
+		Title:       req.Title,
+		Description: req.Description,
+		CreatedBy:   creatorID,
+		CourseIDs:   req.CourseIDs,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := s.checkPath(ctx, path); err != nil {
+		return nil, err
+	}
+
+	if err := s.pathRepo.Create(ctx, path); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("learning path created", zap.String("path_id", path.ID), zap.Int("courses", len(path.CourseIDs)))
+	return path, nil
+}
+
+func (s *pathService) UpdateLearningPath(ctx context.Context, pathID string, req LearningPathRequest) (*domain.LearningPath, error) {
+	path, err := s.pathRepo.GetByID(ctx, pathID)
+	if err != nil {
+		return nil, err
+	}
+
+	path.Title = req.Title
+	path.Description = req.Description
+	path.CourseIDs = req.CourseIDs
+	path.UpdatedAt = time.Now()
+
+	if err := s.checkPath(ctx, path); err != nil {
+		return nil, err
+	}
+
+	if err := s.pathRepo.Update(ctx, path); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("learning path updated", zap.String("path_id", path.ID))
+	return path, nil
+}
+
+func (s *pathService) GetLearningPath(ctx context.Context, pathID string) (*domain.LearningPath, error) {
+	return s.pathRepo.GetByID(ctx, pathID)
+}
+
+func (s *pathService) DeleteLearningPath(ctx context.Context, pathID string) error {
+	if err := s.pathRepo.Delete(ctx, pathID); err != nil {
+		return err
+	}
+
+	s.logger.Info("learning path deleted", zap.String("path_id", pathID))
+	return nil
+}
+
+func (s *pathService) ListLearningPaths(ctx context.Context, page, pageSize int) ([]*domain.LearningPath, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.pathRepo.List(ctx, page, pageSize)
+}
+
+// checkPath validates a path before it is saved. Paths are sold, so every
+// course in one has to be live, and a learner following the path in order
+// must never reach a course before its prerequisites.
+func (s *pathService) checkPath(ctx context.Context, path *domain.LearningPath) error {
+	if err := path.Validate(); err != nil {
+		return err
+	}
+
+	for _, courseID := range path.CourseIDs {
+		course, err := s.courseRepo.GetByID(ctx, courseID)
+		if err != nil {
+			return err
+		}
+		if course.Status != domain.StatusPublished {
+			return domain.ErrPathCourseUnavailable
+		}
+	}
+
+	prerequisites, err := s.prerequisiteRepo.ListAmong(ctx, path.CourseIDs)
+	if err != nil {
+		return err
+	}
+
+	return path.CheckOrder(prerequisites)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+type fakePrerequisiteRepo struct {
+	repository.PrerequisiteRepository
+	edges  []*domain.Prerequisite
+	addErr error
+}
+
+func (r *fakePrerequisiteRepo) Add(ctx context.Context, prerequisite *domain.Prerequisite) error {
+	if r.addErr != nil {
+		return r.addErr
+	}
+	r.edges = append(r.edges, prerequisite)
+	return nil
+}
+
+func (r *fakePrerequisiteRepo) ListByCourse(ctx context.Context, courseID string) ([]*domain.Prerequisite, error) {
+	var list []*domain.Prerequisite
+	for _, p := range r.edges {
+		if p.CourseID == courseID {
+			list = append(list, p)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakePrerequisiteRepo) ListAmong(ctx context.Context, courseIDs []string) ([]*domain.Prerequisite, error) {
+	return r.edges, nil
+}
+
+type fakePathRepo struct {
+	repository.LearningPathRepository
+	created []*domain.LearningPath
+}
+
+func (r *fakePathRepo) Create(ctx context.Context, path *domain.LearningPath) error {
+	r.created = append(r.created, path)
+	return nil
+}
+
+func testCourses() map[string]*domain.Course {
+	return map[string]*domain.Course{
+		"c1": {ID: "c1", InstructorID: "instructor-1", Status: domain.StatusPublished},
+		"c2": {ID: "c2", InstructorID: "instructor-1", Status: domain.StatusPublished},
+		"c3": {ID: "c3", InstructorID: "instructor-2", Status: domain.StatusDraft},
+	}
+}
+
+func TestAddPrerequisite(t *testing.T) {
+	tests := []struct {
+		name         string
+		courseID     string
+		requiredID   string
+		instructorID string
+		addErr       error
+		wantErr      error
+		wantEdges    int
+	}{
+		{name: "owner gates a course", courseID: "c2", requiredID: "c1", instructorID: "instructor-1", wantEdges: 1},
+		{name: "required course of another instructor", courseID: "c2", requiredID: "c3", instructorID: "instructor-1", wantEdges: 1},
+		{name: "course requiring itself", courseID: "c1", requiredID: "c1", instructorID: "instructor-1", wantErr: domain.ErrPrerequisiteCycle},
+		{name: "edge closing a cycle", courseID: "c1", requiredID: "c2", instructorID: "instructor-1", addErr: domain.ErrPrerequisiteCycle, wantErr: domain.ErrPrerequisiteCycle},
+		{name: "not the course's instructor", courseID: "c3", requiredID: "c1", instructorID: "instructor-1", wantErr: domain.ErrUnauthorized},
+		{name: "unknown required course", courseID: "c2", requiredID: "c9", instructorID: "instructor-1", wantErr: domain.ErrCourseNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prerequisites := &fakePrerequisiteRepo{addErr: tt.addErr}
+			s := NewPathService(&fakeCourseRepo{courses: testCourses()}, prerequisites, &fakePathRepo{}, zap.NewNop())
+
+			list, err := s.AddPrerequisite(context.Background(), tt.courseID, tt.requiredID, tt.instructorID)
+			if err != tt.wantErr {
+				t.Fatalf("AddPrerequisite() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(prerequisites.edges) != tt.wantEdges {
+				t.Errorf("added %d edges, want %d", len(prerequisites.edges), tt.wantEdges)
+			}
+			if tt.wantErr == nil && (len(list) != 1 || list[0].RequiredCourseID != tt.requiredID) {
+				t.Errorf("AddPrerequisite() = %+v, want the new edge", list)
+			}
+		})
+	}
+}
+
+func TestCreateLearningPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		courseIDs []string
+		wantErr   error
+	}{
+		{name: "published courses in prerequisite order", courseIDs: []string{"c1", "c2"}},
+		{name: "course before its prerequisite", courseIDs: []string{"c2", "c1"}, wantErr: domain.ErrPathOrder},
+		{name: "unpublished course", courseIDs: []string{"c1", "c3"}, wantErr: domain.ErrPathCourseUnavailable},
+		{name: "unknown course", courseIDs: []string{"c1", "c9"}, wantErr: domain.ErrCourseNotFound},
+		{name: "course listed twice", courseIDs: []string{"c1", "c1"}, wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prerequisites := &fakePrerequisiteRepo{edges: []*domain.Prerequisite{{CourseID: "c2", RequiredCourseID: "c1"}}}
+			paths := &fakePathRepo{}
+			s := NewPathService(&fakeCourseRepo{courses: testCourses()}, prerequisites, paths, zap.NewNop())
+
+			_, err := s.CreateLearningPath(context.Background(), "admin-1", LearningPathRequest{Title: "Track", CourseIDs: tt.courseIDs})
+			if err != tt.wantErr {
+				t.Fatalf("CreateLearningPath() error = %v, want %v", err, tt.wantErr)
+			}
+
+			wantCreated := 0
+			if tt.wantErr == nil {
+				wantCreated = 1
+			}
+			if len(paths.created) != wantCreated {
+				t.Errorf("created %d paths, want %d", len(paths.created), wantCreated)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CouponRequest struct {
+	Code      string
+	Discount  domain.Discount
+	CourseID  string
+	MaxUses   int
+	StartsAt  *time.Time
+	ExpiresAt *time.Time
+}
+
+type SaleRequest struct {
+	Name     string
+	CourseID string
+	Discount domain.Discount
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// Caller identifies who is managing coupons and sales. Instructors manage
+// those scoped to their own courses; only admins manage global ones.
+type Caller struct {
+	UserID  string
+	IsAdmin bool
+}
+
+type PricingService interface {
+	CreateCoupon(ctx context.Context, caller Caller, req CouponRequest) (*domain.Coupon, error)
+	ListCoupons(ctx context.Context, caller Caller, courseID string) ([]*domain.Coupon, error)
+	DeleteCoupon(ctx context.Context, caller Caller, couponID string) error
+	CreateSale(ctx context.Context, caller Caller, req SaleRequest) (*domain.Sale, error)
+	ListSales(ctx context.Context, caller Caller, courseID string) ([]*domain.Sale, error)
+	DeleteSale(ctx context.Context, caller Caller, saleID string) error
+	QuotePrice(ctx context.Context, courseID, couponCode string) (*domain.PriceQuote, error)
+	RedeemCoupon(ctx context.Context, courseID, couponCode, userID, enrollmentID string) (*domain.PriceQuote, error)
+	ReleaseCoupon(ctx context.Context, enrollmentID, userID string) error
+}
+
+type pricingService struct {
+	courseRepo repository.CourseRepository
+	couponRepo repository.CouponRepository
+	saleRepo   repository.SaleRepository
+	logger     *zap.Logger
+}
+
+func NewPricingService(
+	courseRepo repository.CourseRepository,
+	couponRepo repository.CouponRepository,
+	saleRepo repository.SaleRepository,
+	logger *zap.Logger,
+) PricingService {
+	return &pricingService{
+		courseRepo: courseRepo,
+		couponRepo: couponRepo,
+		saleRepo:   saleRepo,
+		logger:     logger,
+	}
+}
+
+func (s *pricingService) CreateCoupon(ctx context.Context, caller Caller, req CouponRequest) (*domain.Coupon, error) {
+	if err := s.authorize(ctx, caller, req.CourseID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	coupon := &domain.Coupon{
+		ID:        uuid.New().String(),
+		Code:      domain.NormalizeCouponCode(req.Code),
+		Discount:  req.Discount,
+		CourseID:  req.CourseID,
+		MaxUses:   req.MaxUses,
+		StartsAt:  now,
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: caller.UserID,
+		CreatedAt: now,
+	}
+	if req.StartsAt != nil {
+		coupon.StartsAt = *req.StartsAt
+	}
+
+	if err := coupon.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.couponRepo.Create(ctx, coupon); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("coupon created", zap.String("coupon_id", coupon.ID), zap.String("course_id", coupon.CourseID))
+	return coupon, nil
+}
+
+func (s *pricingService) ListCoupons(ctx context.Context, caller Caller, courseID string) ([]*domain.Coupon, error) {
+	if err := s.authorize(ctx, caller, courseID); err != nil {
+		return nil, err
+	}
+
+	return s.couponRepo.ListByCourse(ctx, courseID)
+}
+
+func (s *pricingService) DeleteCoupon(ctx context.Context, caller Caller, couponID string) error {
+	coupon, err := s.couponRepo.GetByID(ctx, couponID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(ctx, caller, coupon.CourseID); err != nil {
+		return err
+	}
+
+	if err := s.couponRepo.Delete(ctx, couponID); err != nil {
+		return err
+	}
+
+	s.logger.Info("coupon deleted", zap.String("coupon_id", couponID))
+	return nil
+}
+
+func (s *pricingService) CreateSale(ctx context.Context, caller Caller, req SaleRequest) (*domain.Sale, error) {
+	if err := s.authorize(ctx, caller, req.CourseID); err != nil {
+		return nil, err
+	}
+
+	sale := &domain.Sale{
+		ID:        uuid.New().String(),
+		Name:      req.Name,
+		CourseID:  req.CourseID,
+		Discount:  req.Discount,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		CreatedBy: caller.UserID,
+		CreatedAt: time.Now(),
+	}
+
+	if err := sale.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.saleRepo.Create(ctx, sale); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("sale created", zap.String("sale_id", sale.ID), zap.String("course_id", sale.CourseID))
+	return sale, nil
+}
+
+func (s *pricingService) ListSales(ctx context.Context, caller Caller, courseID string) ([]*domain.Sale, error) {
+	if err := s.authorize(ctx, caller, courseID); err != nil {
+		return nil, err
+	}
+
+	return s.saleRepo.ListByCourse(ctx, courseID)
+}
+
+func (s *pricingService) DeleteSale(ctx context.Context, caller Caller, saleID string) error {
+	sale, err := s.saleRepo.GetByID(ctx, saleID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(ctx, caller, sale.CourseID); err != nil {
+		return err
+	}
+
+	if err := s.saleRepo.Delete(ctx, saleID); err != nil {
+		return err
+	}
+
+	s.logger.Info("sale deleted", zap.String("sale_id", saleID))
+	return nil
+}
+
+// QuotePrice prices a course for display without using up the coupon.
+func (s *pricingService) QuotePrice(ctx context.Context, courseID, couponCode string) (*domain.PriceQuote, error) {
+	now := time.Now()
+
+	var coupon *domain.Coupon
+	if code := domain.NormalizeCouponCode(couponCode); code != "" {
+		var err error
+		coupon, err = s.couponRepo.GetByCode(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		if err := coupon.CheckUsable(courseID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.quote(ctx, courseID, coupon, now)
+}
+
+// RedeemCoupon prices a course for checkout and holds one use of the coupon
+// for the enrollment until it is released.
+func (s *pricingService) RedeemCoupon(ctx context.Context, courseID, couponCode, userID, enrollmentID string) (*domain.PriceQuote, error) {
+	code := domain.NormalizeCouponCode(couponCode)
+	if code == "" || enrollmentID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	now := time.Now()
+
+	// Price first so a course that isn't for sale never burns a use
+	if _, err := s.quote(ctx, courseID, nil, now); err != nil {
+		return nil, err
+	}
+
+	coupon, err := s.couponRepo.Redeem(ctx, code, courseID, enrollmentID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("coupon redeemed", zap.String("coupon_id", coupon.ID), zap.String("enrollment_id", enrollmentID))
+	return s.quote(ctx, courseID, coupon, now)
+}
+
+func (s *pricingService) ReleaseCoupon(ctx context.Context, enrollmentID, userID string) error {
+	if err := s.couponRepo.Release(ctx, enrollmentID, userID); err != nil {
+		return err
+	}
+
+	s.logger.Info("coupon released", zap.String("enrollment_id", enrollmentID))
+	return nil
+}
+
+func (s *pricingService) quote(ctx context.Context, courseID string, coupon *domain.Coupon, now time.Time) (*domain.PriceQuote, error) {
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if course.Status != domain.StatusPublished {
+		return nil, domain.ErrCourseNotForSale
+	}
+
+	sales, err := s.saleRepo.ListActive(ctx, courseID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.QuotePrice(course, sales, coupon, now), nil
+}
+
+func (s *pricingService) authorize(ctx context.Context, caller Caller, courseID string) error {
+	if courseID == "" {
+		if caller.IsAdmin {
+			return nil
+		}
+		return domain.ErrUnauthorized
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		return err
+	}
+
+	if !caller.IsAdmin && course.InstructorID != caller.UserID {
+		return domain.ErrUnauthorized
+	}
+
+	return nil
+}
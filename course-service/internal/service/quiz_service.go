@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// QuizService manages question banks, the quiz settings of QUIZ lessons and
+// learners' attempts. Attempts are graded here; answer keys never leave the
+// service for anyone but the instructor.
+type QuizService interface {
+	CreateQuestionBank(ctx context.Context, courseID, instructorID, title string) (*domain.QuestionBank, error)
+	ListQuestionBanks(ctx context.Context, courseID, instructorID string) ([]*domain.QuestionBank, error)
+	DeleteQuestionBank(ctx context.Context, bankID, instructorID string) error
+	AddQuestion(ctx context.Context, bankID, instructorID string, question *domain.Question) (*domain.Question, error)
+	DeleteQuestion(ctx context.Context, questionID, instructorID string) error
+	ListQuestions(ctx context.Context, bankID, instructorID string) ([]*domain.Question, error)
+	SetLessonQuiz(ctx context.Context, lessonID, courseID, instructorID string, quiz *domain.Quiz) (*domain.Quiz, error)
+	GetLessonQuiz(ctx context.Context, lessonID string) (*domain.Quiz, error)
+	StartQuizAttempt(ctx context.Context, lessonID, userID string) (*domain.QuizAttempt, []*domain.Question, error)
+	SubmitQuizAttempt(ctx context.Context, attemptID, userID string, answers []domain.QuizAnswer) (*domain.QuizAttempt, error)
+	ListQuizAttempts(ctx context.Context, lessonID, userID string) ([]*domain.QuizAttempt, error)
+}
+
+type quizService struct {
+	courseService  CourseService
+	releaseService ReleaseService
+	quizRepo       repository.QuizRepository
+	learnerRepo    repository.LearnerRepository
+	producer       *kafka.Producer
+	logger         *zap.Logger
+}
+
+func NewQuizService(
+	courseService CourseService,
+	releaseService ReleaseService,
+	quizRepo repository.QuizRepository,
+	learnerRepo repository.LearnerRepository,
+	producer *kafka.Producer,
+	logger *zap.Logger,
+) QuizService {
+	return &quizService{
+		courseService:  courseService,
+		releaseService: releaseService,
+		quizRepo:       quizRepo,
+		learnerRepo:    learnerRepo,
+		producer:       producer,
+		logger:         logger,
+	}
+}
+
+func (s *quizService) CreateQuestionBank(ctx context.Context, courseID, instructorID, title string) (*domain.QuestionBank, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermEditContent); err != nil {
+		return nil, err
+	}
+
+	bank := &domain.QuestionBank{
+		ID:        uuid.New().String(),
+		CourseID:  courseID,
+		Title:     title,
+		CreatedAt: time.Now(),
+	}
+	if err := bank.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.quizRepo.CreateBank(ctx, bank); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("question bank created", zap.String("bank_id", bank.ID), zap.String("course_id", courseID))
+	return bank, nil
+}
+
+func (s *quizService) ListQuestionBanks(ctx context.Context, courseID, instructorID string) ([]*domain.QuestionBank, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermViewContent); err != nil {
+		return nil, err
+	}
+
+	return s.quizRepo.ListBanks(ctx, courseID)
+}
+
+func (s *quizService) DeleteQuestionBank(ctx context.Context, bankID, instructorID string) error {
+	if _, err := s.ownedBank(ctx, bankID, instructorID); err != nil {
+		return err
+	}
+
+	if err := s.quizRepo.DeleteBank(ctx, bankID); err != nil {
+		return err
+	}
+
+	s.logger.Info("question bank deleted", zap.String("bank_id", bankID))
+	return nil
+}
+
+func (s *quizService) AddQuestion(ctx context.Context, bankID, instructorID string, question *domain.Question) (*domain.Question, error) {
+	if _, err := s.ownedBank(ctx, bankID, instructorID); err != nil {
+		return nil, err
+	}
+
+	question.ID = uuid.New().String()
+	question.BankID = bankID
+	question.CreatedAt = time.Now()
+	if err := question.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.quizRepo.CreateQuestion(ctx, question); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("question added", zap.String("question_id", question.ID), zap.String("bank_id", bankID))
+	return question, nil
+}
+
+func (s *quizService) DeleteQuestion(ctx context.Context, questionID, instructorID string) error {
+	question, err := s.quizRepo.GetQuestion(ctx, questionID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.ownedBank(ctx, question.BankID, instructorID); err != nil {
+		return err
+	}
+
+	return s.quizRepo.DeleteQuestion(ctx, questionID)
+}
+
+func (s *quizService) ListQuestions(ctx context.Context, bankID, instructorID string) ([]*domain.Question, error) {
+	if _, err := s.ownedBank(ctx, bankID, instructorID); err != nil {
+		return nil, err
+	}
+
+	return s.quizRepo.ListQuestions(ctx, bankID)
+}
+
+// SetLessonQuiz configures a QUIZ lesson of the draft or live content. The
+// settings apply to the next attempt straight away; they aren't part of the
+// reviewed revision.
+func (s *quizService) SetLessonQuiz(ctx context.Context, lessonID, courseID, instructorID string, quiz *domain.Quiz) (*domain.Quiz, error) {
+	lesson, err := instructorLesson(ctx, s.courseService, courseID, instructorID, lessonID)
+	if err != nil {
+		return nil, err
+	}
+	if lesson.LessonType() != domain.LessonQuiz {
+		return nil, domain.ErrNotQuizLesson
+	}
+
+	quiz.LessonID = lessonID
+	quiz.CourseID = courseID
+	quiz.UpdatedAt = time.Now()
+	if err := quiz.Validate(); err != nil {
+		return nil, err
+	}
+
+	bank, err := s.quizRepo.GetBank(ctx, quiz.BankID)
+	if err != nil {
+		return nil, err
+	}
+	if bank.CourseID != courseID {
+		return nil, domain.ErrQuestionBankNotFound
+	}
+	if bank.QuestionCount < max(quiz.QuestionCount, 1) {
+		return nil, domain.ErrNotEnoughQuestions
+	}
+
+	if err := s.quizRepo.UpsertQuiz(ctx, quiz); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("lesson quiz set", zap.String("lesson_id", lessonID), zap.String("bank_id", quiz.BankID))
+	return quiz, nil
+}
+
+func (s *quizService) GetLessonQuiz(ctx context.Context, lessonID string) (*domain.Quiz, error) {
+	return s.quizRepo.GetQuiz(ctx, lessonID)
+}
+
+// StartQuizAttempt resumes the learner's open attempt, or draws a new set of
+// questions if they have attempts left. An open attempt past its time limit
+// is closed with a score of zero first.
+func (s *quizService) StartQuizAttempt(ctx context.Context, lessonID, userID string) (*domain.QuizAttempt, []*domain.Question, error) {
+	quiz, err := s.quizRepo.GetQuiz(ctx, lessonID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.releaseService.AuthorizeLesson(ctx, quiz.CourseID, lessonID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	open, err := s.quizRepo.GetOpenAttempt(ctx, lessonID, userID)
+	switch {
+	case err == nil && !open.IsExpired(now):
+		return s.withQuestions(ctx, open)
+	case err == nil:
+		if err := s.close(ctx, open, quiz, nil, now); err != nil && err != domain.ErrAttemptSubmitted {
+			return nil, nil, err
+		}
+	case err != domain.ErrAttemptNotFound:
+		return nil, nil, err
+	}
+
+	if quiz.MaxAttempts > 0 {
+		count, err := s.quizRepo.CountAttempts(ctx, lessonID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if count >= quiz.MaxAttempts {
+			return nil, nil, domain.ErrNoAttemptsLeft
+		}
+	}
+
+	questions, err := s.quizRepo.ListQuestions(ctx, quiz.BankID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(questions) == 0 {
+		return nil, nil, domain.ErrNotEnoughQuestions
+	}
+
+	count := quiz.QuestionCount
+	if count == 0 || count > len(questions) {
+		count = len(questions)
+	}
+
+	attempt := &domain.QuizAttempt{
+		ID:        uuid.New().String(),
+		LessonID:  lessonID,
+		CourseID:  quiz.CourseID,
+		UserID:    userID,
+		StartedAt: now,
+	}
+	drawn := make([]*domain.Question, 0, count)
+	for _, i := range rand.Perm(len(questions))[:count] {
+		drawn = append(drawn, questions[i])
+		attempt.QuestionIDs = append(attempt.QuestionIDs, questions[i].ID)
+	}
+	if quiz.TimeLimitSeconds > 0 {
+		deadline := now.Add(time.Duration(quiz.TimeLimitSeconds) * time.Second)
+		attempt.Deadline = &deadline
+	}
+
+	if err := s.quizRepo.CreateAttempt(ctx, attempt); err != nil {
+		// A concurrent request started one first; resume that instead
+		if err == domain.ErrAttemptInProgress {
+			open, err := s.quizRepo.GetOpenAttempt(ctx, lessonID, userID)
+			if err != nil {
+				return nil, nil, err
+			}
+			return s.withQuestions(ctx, open)
+		}
+		return nil, nil, err
+	}
+
+	s.logger.Info("quiz attempt started", zap.String("attempt_id", attempt.ID), zap.String("lesson_id", lessonID), zap.String("user_id", userID))
+	return attempt, drawn, nil
+}
+
+// SubmitQuizAttempt grades the attempt against the answer keys. A passing
+// attempt completes the lesson if the quiz counts toward completion.
+func (s *quizService) SubmitQuizAttempt(ctx context.Context, attemptID, userID string, answers []domain.QuizAnswer) (*domain.QuizAttempt, error) {
+	attempt, err := s.quizRepo.GetAttempt(ctx, attemptID)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.UserID != userID {
+		return nil, domain.ErrAttemptNotFound
+	}
+
+	quiz, err := s.quizRepo.GetQuiz(ctx, attempt.LessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.close(ctx, attempt, quiz, answers, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("quiz attempt submitted",
+		zap.String("attempt_id", attempt.ID),
+		zap.Int("score", attempt.Score),
+		zap.Int("max_score", attempt.MaxScore),
+		zap.Bool("passed", attempt.Passed),
+	)
+
+	if attempt.Passed && quiz.CountsTowardCompletion {
+		if err := s.complete(ctx, attempt); err != nil {
+			return nil, err
+		}
+	}
+
+	return attempt, nil
+}
+
+func (s *quizService) ListQuizAttempts(ctx context.Context, lessonID, userID string) ([]*domain.QuizAttempt, error) {
+	return s.quizRepo.ListAttempts(ctx, lessonID, userID)
+}
+
+// close grades and stores attempt. Questions deleted from the bank since
+// the attempt started no longer count.
+func (s *quizService) close(ctx context.Context, attempt *domain.QuizAttempt, quiz *domain.Quiz, answers []domain.QuizAnswer, now time.Time) error {
+	questions, err := s.quizRepo.GetQuestions(ctx, attempt.QuestionIDs)
+	if err != nil {
+		return err
+	}
+
+	if err := attempt.Grade(questions, answers, quiz.PassPercentage, now); err != nil {
+		return err
+	}
+
+	return s.quizRepo.SubmitAttempt(ctx, attempt)
+}
+
+// complete records the lesson as completed and lets the progress service
+// know.
+func (s *quizService) complete(ctx context.Context, attempt *domain.QuizAttempt) error {
+	if err := s.learnerRepo.RecordLessonCompleted(ctx, attempt.UserID, attempt.CourseID, attempt.LessonID, *attempt.SubmittedAt); err != nil {
+		return err
+	}
+
+	event := kafka.LessonCompletedEvent{
+		UserID:    attempt.UserID,
+		CourseID:  attempt.CourseID,
+		LessonID:  attempt.LessonID,
+		Timestamp: *attempt.SubmittedAt,
+	}
+	if err := s.producer.PublishMessage(ctx, attempt.UserID, event); err != nil {
+		s.logger.Error("failed to publish lesson completed event", zap.String("attempt_id", attempt.ID), zap.Error(err))
+	}
+
+	return nil
+}
+
+// withQuestions loads the questions of an attempt in the order they were
+// drawn.
+func (s *quizService) withQuestions(ctx context.Context, attempt *domain.QuizAttempt) (*domain.QuizAttempt, []*domain.Question, error) {
+	byID, err := s.quizRepo.GetQuestions(ctx, attempt.QuestionIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	questions := make([]*domain.Question, 0, len(attempt.QuestionIDs))
+	for _, id := range attempt.QuestionIDs {
+		if question := byID[id]; question != nil {
+			questions = append(questions, question)
+		}
+	}
+
+	return attempt, questions, nil
+}
+
+func (s *quizService) ownedBank(ctx context.Context, bankID, instructorID string) (*domain.QuestionBank, error) {
+	bank, err := s.quizRepo.GetBank(ctx, bankID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.courseService.Authorize(ctx, bank.CourseID, instructorID, domain.PermEditContent); err != nil {
+		return nil, err
+	}
+
+	return bank, nil
+}
+
+// instructorLesson finds a lesson of a course instructorID may edit in the
+// draft, or in the live content when there's no draft.
+func instructorLesson(ctx context.Context, courseService CourseService, courseID, instructorID, lessonID string) (*domain.LessonContent, error) {
+	if _, err := courseService.Authorize(ctx, courseID, instructorID, domain.PermEditContent); err != nil {
+		return nil, err
+	}
+
+	_, content, err := courseService.GetCourseDraft(ctx, courseID, instructorID)
+	if err == domain.ErrDraftNotFound {
+		content, err = courseService.LiveContent(ctx, courseID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range content.Modules {
+		if lesson := m.Lesson(lessonID); lesson != nil {
+			return lesson, nil
+		}
+	}
+	return nil, domain.ErrCourseNotFound
+}
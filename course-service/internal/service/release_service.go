@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/video"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+// ReleaseService evaluates drip release rules for enrolled students. Lock
+// state is only computed for them; the instructor and anyone browsing the
+// syllabus see content unlocked.
+type ReleaseService interface {
+	GetCourseContent(ctx context.Context, courseID, userID string) (*domain.Course, *domain.CourseContent, map[string]domain.Availability, error)
+	CourseAvailability(ctx context.Context, courseID, userID string) (map[string]domain.Availability, error)
+	GetModuleLessons(ctx context.Context, moduleID, userID string) ([]*domain.Lesson, map[string]domain.Availability, error)
+	AuthorizeVideo(ctx context.Context, videoID, userID string) (*domain.VideoLesson, error)
+	AuthorizeLesson(ctx context.Context, courseID, lessonID, userID string) error
+	HandleEnrollmentSuccess(ctx context.Context, key, value []byte) error
+	HandleEnrollmentFailed(ctx context.Context, key, value []byte) error
+	HandleEnrollmentCancelled(ctx context.Context, key, value []byte) error
+	HandleLessonCompleted(ctx context.Context, key, value []byte) error
+}
+
+type releaseService struct {
+	courseService CourseService
+	moduleRepo    repository.ModuleRepository
+	lessonRepo    repository.LessonRepository
+	learnerRepo   repository.LearnerRepository
+	videos        video.Client
+	logger        *zap.Logger
+}
+
+func NewReleaseService(
+	courseService CourseService,
+	moduleRepo repository.ModuleRepository,
+	lessonRepo repository.LessonRepository,
+	learnerRepo repository.LearnerRepository,
+	videos video.Client,
+	logger *zap.Logger,
+) ReleaseService {
+	return &releaseService{
+		courseService: courseService,
+		moduleRepo:    moduleRepo,
+		lessonRepo:    lessonRepo,
+		learnerRepo:   learnerRepo,
+		videos:        videos,
+		logger:        logger,
+	}
+}
+
+// GetCourseContent returns the live modules and lessons of a course with the
+// caller's lock state. Unpublished courses are only visible to their
+// instructor and collaborators. Students only see a lesson's video once they
+// can stream it.
+func (s *releaseService) GetCourseContent(ctx context.Context, courseID, userID string) (*domain.Course, *domain.CourseContent, map[string]domain.Availability, error) {
+	course, err := s.courseService.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	staff, err := s.isStaff(ctx, course, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if course.Status == domain.StatusDraft && !staff {
+		return nil, nil, nil, domain.ErrCourseNotFound
+	}
+
+	content, err := s.courseService.LiveContent(ctx, courseID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	learner, err := s.learner(ctx, course, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var availability map[string]domain.Availability
+	if learner != nil {
+		availability = content.Availability(learner, time.Now())
+	}
+
+	for _, module := range content.Modules {
+		for _, lesson := range module.Lessons {
+			if !canWatch(staff, learner != nil, lesson.IsPreview, availability[lesson.ID]) {
+				lesson.VideoID = ""
+			}
+		}
+	}
+
+	return course, content, availability, nil
+}
+
+// CourseAvailability evaluates the course's live content for userID, or
+// returns nil when the caller isn't an enrolled student.
+func (s *releaseService) CourseAvailability(ctx context.Context, courseID, userID string) (map[string]domain.Availability, error) {
+	course, err := s.courseService.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.availability(ctx, course, userID)
+}
+
+// GetModuleLessons returns the module's lessons with the caller's lock
+// state, on the same terms as GetCourseContent.
+func (s *releaseService) GetModuleLessons(ctx context.Context, moduleID, userID string) ([]*domain.Lesson, map[string]domain.Availability, error) {
+	module, err := s.moduleRepo.GetByID(ctx, moduleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	course, err := s.courseService.GetCourse(ctx, module.CourseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	staff, err := s.isStaff(ctx, course, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if course.Status == domain.StatusDraft && !staff {
+		return nil, nil, domain.ErrCourseNotFound
+	}
+
+	lessons, err := s.lessonRepo.GetByModuleID(ctx, moduleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	availability, err := s.availability(ctx, course, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, lesson := range lessons {
+		if !canWatch(staff, availability != nil, lesson.IsPreview, availability[lesson.ID]) {
+			lesson.VideoID = ""
+		}
+	}
+
+	return lessons, availability, nil
+}
+
+// AuthorizeVideo decides whether userID may stream videoID and returns the
+// lesson that grants access. Staff can stream it through a published course,
+// or through any course the video was uploaded for; preview lessons are open
+// to everyone, and otherwise the caller must be enrolled in a course where
+// the lesson is released. When every lesson using the video is locked, the
+// error carries the earliest unlock time.
+func (s *releaseService) AuthorizeVideo(ctx context.Context, videoID, userID string) (*domain.VideoLesson, error) {
+	lessons, err := s.lessonRepo.ListByVideoID(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lessons) == 0 {
+		return nil, domain.ErrCourseNotFound
+	}
+
+	var uploaded *domain.Video
+	var locked *domain.LockedError
+	for _, videoLesson := range lessons {
+		course, err := s.courseService.GetCourse(ctx, videoLesson.CourseID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Any lesson can point at any video, so staff of a course that isn't
+		// out yet only get the videos uploaded for it
+		staff, err := s.isStaff(ctx, course, userID)
+		if err != nil {
+			return nil, err
+		}
+		if staff {
+			if course.Status == domain.StatusPublished {
+				return videoLesson, nil
+			}
+			if uploaded == nil {
+				if uploaded, err = s.video(ctx, videoID); err != nil {
+					return nil, err
+				}
+			}
+			if uploaded.UploaderID == "" {
+				continue
+			}
+			owns, err := s.isStaff(ctx, course, uploaded.UploaderID)
+			if err != nil {
+				return nil, err
+			}
+			if owns {
+				return videoLesson, nil
+			}
+			continue
+		}
+
+		err = s.access(ctx, course, videoLesson.Lesson, userID)
+		if err == nil {
+			return videoLesson, nil
+		}
+
+		var lockedErr *domain.LockedError
+		switch {
+		case errors.As(err, &lockedErr):
+			if locked == nil || unlocksSooner(lockedErr.UnlockAt, locked.UnlockAt) {
+				locked = lockedErr
+			}
+		case err != domain.ErrCourseNotFound && err != domain.ErrNotEnrolled:
+			return nil, err
+		}
+	}
+
+	if locked != nil {
+		s.logger.Info("locked video stream denied", zap.String("video_id", videoID), zap.String("user_id", userID))
+		return nil, locked
+	}
+	return nil, domain.ErrNotEnrolled
+}
+
+// AuthorizeLesson decides whether userID may work through a live lesson of
+// courseID, on the same terms as AuthorizeVideo.
+func (s *releaseService) AuthorizeLesson(ctx context.Context, courseID, lessonID, userID string) error {
+	course, err := s.courseService.GetCourse(ctx, courseID)
+	if err != nil {
+		return err
+	}
+	if staff, err := s.isStaff(ctx, course, userID); err != nil || staff {
+		return err
+	}
+
+	lesson, err := s.lessonRepo.GetByID(ctx, lessonID)
+	if err != nil {
+		return err
+	}
+	module, err := s.moduleRepo.GetByID(ctx, lesson.ModuleID)
+	if err != nil {
+		return err
+	}
+	if module.CourseID != courseID {
+		return domain.ErrCourseNotFound
+	}
+
+	return s.access(ctx, course, lesson, userID)
+}
+
+// HandleEnrollmentSuccess is a kafka.MessageHandler for the
+// enrollment.success topic.
+func (s *releaseService) HandleEnrollmentSuccess(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentSuccessEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	enrolledAt := event.EnrolledAt
+	if enrolledAt.IsZero() {
+		enrolledAt = event.Timestamp
+	}
+
+	return s.learnerRepo.RecordEnrollment(ctx, event.EnrollmentID, event.UserID, event.CourseID, enrolledAt)
+}
+
+// HandleEnrollmentFailed is a kafka.MessageHandler for the enrollment.failed
+// topic.
+func (s *releaseService) HandleEnrollmentFailed(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentFailedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.removeLearner(ctx, event.EnrollmentID, event.UserID, event.CourseID)
+}
+
+// HandleEnrollmentCancelled is a kafka.MessageHandler for the
+// enrollment.cancelled topic, which covers refunds.
+func (s *releaseService) HandleEnrollmentCancelled(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentCancelledEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.removeLearner(ctx, event.EnrollmentID, event.UserID, event.CourseID)
+}
+
+// removeLearner takes a student's access to the course away once their
+// enrollment has ended; streaming, quizzes, attachments, announcements and
+// discussions all go by it.
+func (s *releaseService) removeLearner(ctx context.Context, enrollmentID, userID, courseID string) error {
+	if enrollmentID == "" || userID == "" || courseID == "" {
+		s.logger.Warn("skipping enrollment event without IDs", zap.String("enrollment_id", enrollmentID))
+		return nil
+	}
+
+	if err := s.learnerRepo.RemoveEnrollment(ctx, enrollmentID, userID, courseID); err != nil {
+		return err
+	}
+
+	s.logger.Info("learner removed",
+		zap.String("enrollment_id", enrollmentID),
+		zap.String("user_id", userID),
+		zap.String("course_id", courseID),
+	)
+	return nil
+}
+
+// HandleLessonCompleted is a kafka.MessageHandler for the lesson.completed
+// topic.
+func (s *releaseService) HandleLessonCompleted(ctx context.Context, key, value []byte) error {
+	var event kafka.LessonCompletedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.learnerRepo.RecordLessonCompleted(ctx, event.UserID, event.CourseID, event.LessonID, event.Timestamp)
+}
+
+// access checks one live lesson: the course's staff always have access, preview
+// lessons are open to everyone, and otherwise the caller must be enrolled and
+// the lesson released.
+func (s *releaseService) access(ctx context.Context, course *domain.Course, lesson *domain.Lesson, userID string) error {
+	if staff, err := s.isStaff(ctx, course, userID); err != nil || staff {
+		return err
+	}
+	if course.Status == domain.StatusDraft {
+		return domain.ErrCourseNotFound
+	}
+	if lesson.IsPreview {
+		return nil
+	}
+
+	availability, err := s.availability(ctx, course, userID)
+	if err != nil {
+		return err
+	}
+	if availability == nil {
+		return domain.ErrNotEnrolled
+	}
+
+	if state := availability[lesson.ID]; state.Locked {
+		return &domain.LockedError{UnlockAt: state.UnlockAt}
+	}
+	return nil
+}
+
+func (s *releaseService) availability(ctx context.Context, course *domain.Course, userID string) (map[string]domain.Availability, error) {
+	learner, err := s.learner(ctx, course, userID)
+	if err != nil || learner == nil {
+		return nil, err
+	}
+
+	content, err := s.courseService.LiveContent(ctx, course.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return content.Availability(learner, time.Now()), nil
+}
+
+// video looks videoID up in video-service. One it doesn't know of has no
+// uploader.
+func (s *releaseService) video(ctx context.Context, videoID string) (*domain.Video, error) {
+	videos, err := s.videos.GetVideos(ctx, []string{videoID})
+	if err != nil {
+		return nil, err
+	}
+	if video, ok := videos[videoID]; ok {
+		return video, nil
+	}
+	return &domain.Video{ID: videoID}, nil
+}
+
+// canWatch reports whether a lesson's video is shown to the caller. Staff
+// always see it; everyone else once they can stream it.
+func canWatch(staff, enrolled, preview bool, state domain.Availability) bool {
+	return staff || preview || (enrolled && !state.Locked)
+}
+
+// learner returns userID's enrollment state, or nil when they aren't an
+// enrolled student of the course.
+func (s *releaseService) learner(ctx context.Context, course *domain.Course, userID string) (*domain.Learner, error) {
+	if staff, err := s.isStaff(ctx, course, userID); err != nil || staff {
+		return nil, err
+	}
+
+	learner, err := s.learnerRepo.Get(ctx, userID, course.ID)
+	if err == domain.ErrNotEnrolled {
+		return nil, nil
+	}
+	return learner, err
+}
+
+// isStaff reports whether userID works on the course, as its owner or an
+// active collaborator in any role.
+func (s *releaseService) isStaff(ctx context.Context, course *domain.Course, userID string) (bool, error) {
+	role, err := s.courseService.Role(ctx, course, userID)
+	if err != nil {
+		return false, err
+	}
+	return role != "", nil
+}
+
+// unlocksSooner reports whether a opens before b. A known time beats one that
+// depends on progress.
+func unlocksSooner(a, b *time.Time) bool {
+	switch {
+	case a == nil:
+		return false
+	case b == nil:
+		return true
+	default:
+		return a.Before(*b)
+	}
+}
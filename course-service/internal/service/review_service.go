@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/course-service/internal/video"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ReviewService interface {
+	SubmitCourse(ctx context.Context, courseID, instructorID string) (*domain.Course, *domain.CourseSubmission, error)
+	ListCourseSubmissions(ctx context.Context, courseID, instructorID string) ([]*domain.CourseSubmission, error)
+	ListReviewQueue(ctx context.Context, page, pageSize int) ([]*domain.CourseSubmission, int, error)
+	StartReview(ctx context.Context, submissionID, reviewerID string) (*domain.CourseSubmission, error)
+	ApproveCourse(ctx context.Context, submissionID, reviewerID, comment string) (*domain.CourseSubmission, error)
+	RejectCourse(ctx context.Context, submissionID, reviewerID, comment string) (*domain.CourseSubmission, error)
+	PublishDueCourses(ctx context.Context, now time.Time) (int, error)
+	RollbackCourse(ctx context.Context, courseID, instructorID string, number int) (*domain.Course, error)
+}
+
+type reviewService struct {
+	courseService  CourseService
+	submissionRepo repository.SubmissionRepository
+	videos         video.Client
+	quizRepo       repository.QuizRepository
+	assignmentRepo repository.AssignmentRepository
+	producer       *kafka.Producer
+	logger         *zap.Logger
+}
+
+func NewReviewService(
+	courseService CourseService,
+	submissionRepo repository.SubmissionRepository,
+	videos video.Client,
+	quizRepo repository.QuizRepository,
+	assignmentRepo repository.AssignmentRepository,
+	producer *kafka.Producer,
+	logger *zap.Logger,
+) ReviewService {
+	return &reviewService{
+		courseService:  courseService,
+		submissionRepo: submissionRepo,
+		videos:         videos,
+		quizRepo:       quizRepo,
+		assignmentRepo: assignmentRepo,
+		producer:       producer,
+		logger:         logger,
+	}
+}
+
+// SubmitCourse queues the course for admin review once it passes the
+// automated checks. For a live course the draft is what gets reviewed.
+func (s *reviewService) SubmitCourse(ctx context.Context, courseID, instructorID string) (*domain.Course, *domain.CourseSubmission, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermManageCourse); err != nil {
+		return nil, nil, err
+	}
+
+	course, err := s.checkedContent(ctx, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	submission := &domain.CourseSubmission{
+		ID:           uuid.New().String(),
+		CourseID:     courseID,
+		CourseTitle:  course.Title,
+		InstructorID: instructorID,
+		Status:       domain.SubmissionSubmitted,
+		SubmittedAt:  time.Now(),
+	}
+
+	if err := s.submissionRepo.Create(ctx, submission); err != nil {
+		return nil, nil, err
+	}
+
+	s.logger.Info("course submitted for review", zap.String("course_id", courseID), zap.String("submission_id", submission.ID))
+
+	return course, submission, nil
+}
+
+func (s *reviewService) ListCourseSubmissions(ctx context.Context, courseID, instructorID string) ([]*domain.CourseSubmission, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermViewContent); err != nil {
+		return nil, err
+	}
+
+	return s.submissionRepo.ListByCourse(ctx, courseID)
+}
+
+func (s *reviewService) ListReviewQueue(ctx context.Context, page, pageSize int) ([]*domain.CourseSubmission, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.submissionRepo.ListOpen(ctx, page, pageSize)
+}
+
+func (s *reviewService) StartReview(ctx context.Context, submissionID, reviewerID string) (*domain.CourseSubmission, error) {
+	submission, err := s.submissionRepo.GetByID(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := submission.Status
+	if err := submission.StartReview(reviewerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.submissionRepo.Update(ctx, submission, from); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course review started", zap.String("submission_id", submissionID), zap.String("reviewer_id", reviewerID))
+	return submission, nil
+}
+
+// ApproveCourse re-runs the checks, since videos can fail processing while a
+// course waits in the queue, and then publishes on the owner's behalf.
+// A course with a future publish time is scheduled instead and goes live
+// through PublishDueCourses.
+func (s *reviewService) ApproveCourse(ctx context.Context, submissionID, reviewerID, comment string) (*domain.CourseSubmission, error) {
+	submission, err := s.submissionRepo.GetByID(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := submission.Status
+	if err := submission.Approve(reviewerID, comment); err != nil {
+		return nil, err
+	}
+
+	course, err := s.checkedContent(ctx, submission.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if course.PublishAt != nil && course.PublishAt.After(time.Now()) {
+		if err := submission.Schedule(); err != nil {
+			return nil, err
+		}
+		if err := s.submissionRepo.Update(ctx, submission, from); err != nil {
+			return nil, err
+		}
+	} else if _, err := s.courseService.PublishApproved(ctx, submission, from); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course approved",
+		zap.String("submission_id", submissionID),
+		zap.String("reviewer_id", reviewerID),
+		zap.String("status", string(submission.Status)),
+	)
+	s.notifyInstructor(ctx, submission)
+
+	return submission, nil
+}
+
+func (s *reviewService) RejectCourse(ctx context.Context, submissionID, reviewerID, comment string) (*domain.CourseSubmission, error) {
+	submission, err := s.submissionRepo.GetByID(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := submission.Status
+	if err := submission.RequestChanges(reviewerID, comment); err != nil {
+		return nil, err
+	}
+
+	if err := s.submissionRepo.Update(ctx, submission, from); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course changes requested", zap.String("submission_id", submissionID), zap.String("reviewer_id", reviewerID))
+	s.notifyInstructor(ctx, submission)
+
+	return submission, nil
+}
+
+// PublishDueCourses publishes scheduled approvals whose publish time has come
+// and returns how many went live. The checks run again first; a course that
+// no longer passes goes back to its instructor instead.
+func (s *reviewService) PublishDueCourses(ctx context.Context, now time.Time) (int, error) {
+	submissions, err := s.submissionRepo.ListDueScheduled(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, submission := range submissions {
+		ok, err := s.publishScheduled(ctx, submission)
+		if err != nil {
+			s.logger.Error("failed to publish scheduled course", zap.Error(err), zap.String("submission_id", submission.ID))
+			continue
+		}
+		if ok {
+			published++
+		}
+	}
+
+	return published, nil
+}
+
+func (s *reviewService) publishScheduled(ctx context.Context, submission *domain.CourseSubmission) (bool, error) {
+	if _, err := s.checkedContent(ctx, submission.CourseID); err != nil {
+		var checkErr *domain.CheckError
+		if !errors.As(err, &checkErr) {
+			return false, err
+		}
+		if err := submission.Withdraw(checkErr.Error()); err != nil {
+			return false, err
+		}
+		if err := s.submissionRepo.Update(ctx, submission, domain.SubmissionScheduled); err != nil {
+			return false, err
+		}
+
+		s.logger.Info("scheduled course failed checks", zap.String("submission_id", submission.ID))
+		s.notifyInstructor(ctx, submission)
+		return false, nil
+	}
+
+	// Releasing the submission with the publish means two schedulers can't
+	// both publish it, and a failed publish leaves it scheduled for the next
+	// run
+	if err := submission.Release(); err != nil {
+		return false, err
+	}
+	if _, err := s.courseService.PublishApproved(ctx, submission, domain.SubmissionScheduled); err != nil {
+		if err == domain.ErrInvalidTransition {
+			return false, nil
+		}
+		return false, err
+	}
+
+	s.logger.Info("scheduled course published", zap.String("submission_id", submission.ID), zap.String("course_id", submission.CourseID))
+	s.notifyInstructor(ctx, submission)
+	return true, nil
+}
+
+// RollbackCourse republishes an earlier revision without another review. Its
+// content has to pass the same checks as a submission, as its videos or
+// activities may have changed since, and it waits for any open submission.
+func (s *reviewService) RollbackCourse(ctx context.Context, courseID, instructorID string, number int) (*domain.Course, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermManageCourse); err != nil {
+		return nil, err
+	}
+
+	if number < 1 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	target, err := s.courseService.GetRevision(ctx, courseID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkContent(ctx, &target.Content); err != nil {
+		return nil, err
+	}
+
+	return s.courseService.PublishRollback(ctx, target, instructorID)
+}
+
+// checkedContent loads the content that would go live and runs the
+// pre-publish checks against it. The content is loaded as the course's
+// current owner, since the course may have changed hands since it was
+// submitted.
+func (s *reviewService) checkedContent(ctx context.Context, courseID string) (*domain.Course, error) {
+	owned, err := s.courseService.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	course, content, err := s.courseService.GetCourseDraft(ctx, courseID, owned.InstructorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkContent(ctx, content); err != nil {
+		return nil, err
+	}
+
+	return course, nil
+}
+
+func (s *reviewService) checkContent(ctx context.Context, content *domain.CourseContent) error {
+	var videoIDs, quizLessonIDs, assignmentLessonIDs []string
+	for _, m := range content.Modules {
+		for _, l := range m.Lessons {
+			if l.VideoID != "" {
+				videoIDs = append(videoIDs, l.VideoID)
+			}
+			switch l.LessonType() {
+			case domain.LessonQuiz:
+				quizLessonIDs = append(quizLessonIDs, l.ID)
+			case domain.LessonAssignment:
+				assignmentLessonIDs = append(assignmentLessonIDs, l.ID)
+			}
+		}
+	}
+
+	// Ask video-service each time, as videos can still fail processing
+	found, err := s.videos.GetVideos(ctx, videoIDs)
+	if err != nil {
+		return err
+	}
+	videos := make(map[string]domain.VideoStatus, len(found))
+	for id, v := range found {
+		videos[id] = v.Status
+	}
+
+	configured, err := s.quizRepo.ReadyQuizzes(ctx, quizLessonIDs)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := s.assignmentRepo.ReadyAssignments(ctx, assignmentLessonIDs)
+	if err != nil {
+		return err
+	}
+	for lessonID := range assignments {
+		configured[lessonID] = true
+	}
+
+	return domain.CheckPublishable(content, videos, configured)
+}
+
+func (s *reviewService) notifyInstructor(ctx context.Context, submission *domain.CourseSubmission) {
+	event := kafka.CourseReviewedEvent{
+		SubmissionID: submission.ID,
+		CourseID:     submission.CourseID,
+		Title:        submission.CourseTitle,
+		InstructorID: submission.InstructorID,
+		ReviewerID:   submission.ReviewerID,
+		Decision:     string(submission.Status),
+		Comment:      submission.Comment,
+		Timestamp:    time.Now(),
+	}
+
+	_ = s.producer.PublishMessage(ctx, submission.CourseID, event)
+}
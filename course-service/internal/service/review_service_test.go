@@ -0,0 +1,422 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+// fakeCourseService stands in for the course service the review service
+// drives; revisions are keyed by number and pending marks an open submission.
+// Courses and their content are keyed by course ID, and roles gives other
+// users' roles on every course.
+type fakeCourseService struct {
+	CourseService
+	ownerID     string
+	roles       map[string]domain.CourseRole
+	revisions   map[int]*domain.CourseRevision
+	pending     bool
+	rolledBack  []*domain.CourseRevision
+	courses     map[string]*domain.Course
+	contents    map[string]*domain.CourseContent
+	publishErrs map[string]error
+	published   []string
+}
+
+func (s *fakeCourseService) Authorize(ctx context.Context, courseID, userID string, permission domain.Permission) (*domain.Course, error) {
+	if userID != s.ownerID {
+		return nil, domain.ErrUnauthorized
+	}
+	return &domain.Course{ID: courseID, InstructorID: s.ownerID}, nil
+}
+
+func (s *fakeCourseService) GetRevision(ctx context.Context, courseID string, number int) (*domain.CourseRevision, error) {
+	revision, ok := s.revisions[number]
+	if !ok {
+		return nil, domain.ErrRevisionNotFound
+	}
+	return revision, nil
+}
+
+func (s *fakeCourseService) PublishRollback(ctx context.Context, target *domain.CourseRevision, publisherID string) (*domain.Course, error) {
+	if s.pending {
+		return nil, domain.ErrAlreadySubmitted
+	}
+	s.rolledBack = append(s.rolledBack, target)
+	return &domain.Course{ID: target.CourseID}, nil
+}
+
+func (s *fakeCourseService) GetCourse(ctx context.Context, courseID string) (*domain.Course, error) {
+	course, ok := s.courses[courseID]
+	if !ok {
+		return nil, domain.ErrCourseNotFound
+	}
+	return course, nil
+}
+
+func (s *fakeCourseService) GetCourseDraft(ctx context.Context, courseID, instructorID string) (*domain.Course, *domain.CourseContent, error) {
+	course, err := s.GetCourse(ctx, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return course, s.contents[courseID], nil
+}
+
+func (s *fakeCourseService) PublishApproved(ctx context.Context, submission *domain.CourseSubmission, from domain.SubmissionStatus) (*domain.Course, error) {
+	if err := s.publishErrs[submission.CourseID]; err != nil {
+		return nil, err
+	}
+	s.published = append(s.published, submission.CourseID)
+	return s.courses[submission.CourseID], nil
+}
+
+type fakeVideoClient struct {
+	statuses map[string]domain.VideoStatus
+}
+
+func (c *fakeVideoClient) GetVideos(ctx context.Context, videoIDs []string) (map[string]*domain.Video, error) {
+	videos := make(map[string]*domain.Video)
+	for _, id := range videoIDs {
+		if status, ok := c.statuses[id]; ok {
+			videos[id] = &domain.Video{ID: id, Status: status}
+		}
+	}
+	return videos, nil
+}
+
+type fakeQuizRepo struct {
+	repository.QuizRepository
+	ready map[string]bool
+}
+
+func (r *fakeQuizRepo) ReadyQuizzes(ctx context.Context, lessonIDs []string) (map[string]bool, error) {
+	return readyAmong(r.ready, lessonIDs), nil
+}
+
+type fakeAssignmentRepo struct {
+	repository.AssignmentRepository
+	ready       map[string]bool
+	assignments map[string]*domain.Assignment
+	// previous is the file key UpsertSubmission reports replacing
+	previous    string
+	upsertErr   error
+	stored      []*domain.AssignmentSubmission
+	submissions map[string]*domain.AssignmentSubmission
+	graded      []*domain.AssignmentSubmission
+}
+
+func (r *fakeAssignmentRepo) ReadyAssignments(ctx context.Context, lessonIDs []string) (map[string]bool, error) {
+	return readyAmong(r.ready, lessonIDs), nil
+}
+
+func readyAmong(ready map[string]bool, lessonIDs []string) map[string]bool {
+	found := make(map[string]bool)
+	for _, id := range lessonIDs {
+		if ready[id] {
+			found[id] = true
+		}
+	}
+	return found
+}
+
+func TestRollbackCourse(t *testing.T) {
+	const ownerID = "instructor-1"
+
+	revision := func(number int, videoID string) *domain.CourseRevision {
+		return &domain.CourseRevision{
+			CourseID: "course-1",
+			Number:   number,
+			Status:   domain.RevisionSuperseded,
+			Content: domain.CourseContent{
+				Details: domain.CourseDetails{Title: "Course", ThumbnailURL: "https://cdn.example.com/thumb.png"},
+				Modules: []*domain.ModuleContent{{ID: "module-1", Lessons: []*domain.LessonContent{{ID: "lesson-1", Title: "Intro", VideoID: videoID}}}},
+			},
+		}
+	}
+	empty := &domain.CourseRevision{CourseID: "course-1", Number: 3, Content: domain.CourseContent{Details: domain.CourseDetails{ThumbnailURL: "https://cdn.example.com/thumb.png"}}}
+
+	tests := []struct {
+		name      string
+		userID    string
+		number    int
+		pending   bool
+		wantErr   error
+		wantCheck bool
+	}{
+		{name: "valid revision", userID: ownerID, number: 1},
+		{name: "revision whose video has since failed", userID: ownerID, number: 2, wantCheck: true},
+		{name: "revision without modules", userID: ownerID, number: 3, wantCheck: true},
+		{name: "while a submission is pending", userID: ownerID, number: 1, pending: true, wantErr: domain.ErrAlreadySubmitted},
+		{name: "unknown revision", userID: ownerID, number: 9, wantErr: domain.ErrRevisionNotFound},
+		{name: "revision zero", userID: ownerID, number: 0, wantErr: domain.ErrInvalidInput},
+		{name: "someone else's course", userID: "someone-else", number: 1, wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseService{
+				ownerID:   ownerID,
+				revisions: map[int]*domain.CourseRevision{1: revision(1, "video-ok"), 2: revision(2, "video-failed"), 3: empty},
+				pending:   tt.pending,
+			}
+			s := NewReviewService(
+				courses,
+				nil,
+				&fakeVideoClient{statuses: map[string]domain.VideoStatus{"video-ok": domain.VideoReady, "video-failed": domain.VideoFailed}},
+				&fakeQuizRepo{},
+				&fakeAssignmentRepo{},
+				nil,
+				zap.NewNop(),
+			)
+
+			_, err := s.RollbackCourse(context.Background(), "course-1", tt.userID, tt.number)
+
+			var checkErr *domain.CheckError
+			if tt.wantCheck {
+				if !errors.As(err, &checkErr) {
+					t.Fatalf("RollbackCourse() error = %v, want a check failure", err)
+				}
+			} else if err != tt.wantErr {
+				t.Fatalf("RollbackCourse() error = %v, want %v", err, tt.wantErr)
+			}
+
+			wantPublished := tt.wantErr == nil && !tt.wantCheck
+			if got := len(courses.rolledBack) == 1; got != wantPublished {
+				t.Errorf("rolled back = %v, want %v", got, wantPublished)
+			}
+		})
+	}
+}
+
+// publishableContent passes the pre-publish checks when videoID is ready.
+func publishableContent(videoID string) *domain.CourseContent {
+	return &domain.CourseContent{
+		Details: domain.CourseDetails{Title: "Course", ThumbnailURL: "https://cdn.example.com/thumb.png"},
+		Modules: []*domain.ModuleContent{{ID: "module-1", Lessons: []*domain.LessonContent{{ID: "lesson-1", Title: "Intro", VideoID: videoID}}}},
+	}
+}
+
+func newTestReviewService(t *testing.T, courses *fakeCourseService, submissions *fakeSubmissionRepo) ReviewService {
+	t.Helper()
+
+	producer := kafka.NewProducer(nil, "course.reviewed", zap.NewNop())
+	t.Cleanup(func() { producer.Close() })
+
+	return NewReviewService(
+		courses,
+		submissions,
+		&fakeVideoClient{statuses: map[string]domain.VideoStatus{"video-ok": domain.VideoReady, "video-failed": domain.VideoFailed}},
+		&fakeQuizRepo{ready: map[string]bool{"quiz-ready": true}},
+		&fakeAssignmentRepo{ready: map[string]bool{"assignment-ready": true}},
+		producer,
+		zap.NewNop(),
+	)
+}
+
+func TestApproveCourse(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		status        domain.SubmissionStatus
+		publishAt     *time.Time
+		videoID       string
+		wantErr       error
+		wantCheck     bool
+		wantStatus    domain.SubmissionStatus
+		wantPublished bool
+		wantUpdates   []string
+	}{
+		{
+			name:          "no publish time goes live now",
+			status:        domain.SubmissionInReview,
+			videoID:       "video-ok",
+			wantStatus:    domain.SubmissionApproved,
+			wantPublished: true,
+		},
+		{
+			name:          "past publish time goes live now",
+			status:        domain.SubmissionSubmitted,
+			publishAt:     &past,
+			videoID:       "video-ok",
+			wantStatus:    domain.SubmissionApproved,
+			wantPublished: true,
+		},
+		{
+			name:        "future publish time is scheduled",
+			status:      domain.SubmissionInReview,
+			publishAt:   &future,
+			videoID:     "video-ok",
+			wantStatus:  domain.SubmissionScheduled,
+			wantUpdates: []string{"submission-1:IN_REVIEW->SCHEDULED"},
+		},
+		{
+			name:      "video failed while queued",
+			status:    domain.SubmissionInReview,
+			videoID:   "video-failed",
+			wantCheck: true,
+		},
+		{
+			name:    "already decided",
+			status:  domain.SubmissionChangesRequested,
+			videoID: "video-ok",
+			wantErr: domain.ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseService{
+				courses:  map[string]*domain.Course{"course-1": {ID: "course-1", InstructorID: "instructor-1", PublishAt: tt.publishAt}},
+				contents: map[string]*domain.CourseContent{"course-1": publishableContent(tt.videoID)},
+			}
+			submissions := &fakeSubmissionRepo{submissions: map[string]*domain.CourseSubmission{
+				"submission-1": {ID: "submission-1", CourseID: "course-1", InstructorID: "instructor-1", Status: tt.status},
+			}}
+			s := newTestReviewService(t, courses, submissions)
+
+			submission, err := s.ApproveCourse(context.Background(), "submission-1", "admin-1", "")
+
+			var checkErr *domain.CheckError
+			if tt.wantCheck {
+				if !errors.As(err, &checkErr) {
+					t.Fatalf("ApproveCourse() error = %v, want a check failure", err)
+				}
+			} else if err != tt.wantErr {
+				t.Fatalf("ApproveCourse() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got := len(courses.published) == 1; got != tt.wantPublished {
+				t.Errorf("published = %v, want %v", got, tt.wantPublished)
+			}
+			if !slices.Equal(submissions.updates, tt.wantUpdates) {
+				t.Errorf("updates = %v, want %v", submissions.updates, tt.wantUpdates)
+			}
+			if err == nil && submission.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", submission.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestPublishDueCourses(t *testing.T) {
+	scheduled := func(courseID string) *domain.CourseSubmission {
+		return &domain.CourseSubmission{ID: "submission-" + courseID, CourseID: courseID, InstructorID: "instructor-1", Status: domain.SubmissionScheduled}
+	}
+
+	courses := &fakeCourseService{
+		courses: map[string]*domain.Course{
+			"ready":   {ID: "ready", InstructorID: "instructor-1"},
+			"broken":  {ID: "broken", InstructorID: "instructor-1"},
+			"claimed": {ID: "claimed", InstructorID: "instructor-1"},
+			"failing": {ID: "failing", InstructorID: "instructor-1"},
+		},
+		contents: map[string]*domain.CourseContent{
+			"ready":   publishableContent("video-ok"),
+			"broken":  publishableContent("video-failed"),
+			"claimed": publishableContent("video-ok"),
+			"failing": publishableContent("video-ok"),
+		},
+		publishErrs: map[string]error{
+			// Another scheduler published it first
+			"claimed": domain.ErrInvalidTransition,
+			"failing": errors.New("connection reset"),
+		},
+	}
+	submissions := &fakeSubmissionRepo{due: []*domain.CourseSubmission{
+		scheduled("ready"), scheduled("broken"), scheduled("claimed"), scheduled("failing"),
+	}}
+	s := newTestReviewService(t, courses, submissions)
+
+	published, err := s.PublishDueCourses(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("PublishDueCourses() error = %v", err)
+	}
+
+	if published != 1 || !slices.Equal(courses.published, []string{"ready"}) {
+		t.Errorf("PublishDueCourses() = %d, published %v, want 1, [ready]", published, courses.published)
+	}
+
+	// Only the course that failed its checks is sent back; the others stay
+	// scheduled for the next run or were published elsewhere
+	wantUpdates := []string{"submission-broken:SCHEDULED->CHANGES_REQUESTED"}
+	if !slices.Equal(submissions.updates, wantUpdates) {
+		t.Errorf("updates = %v, want %v", submissions.updates, wantUpdates)
+	}
+}
+
+func TestSubmitCourse(t *testing.T) {
+	const ownerID = "instructor-1"
+
+	withActivities := func(quizID, assignmentID string) *domain.CourseContent {
+		content := publishableContent("video-ok")
+		content.Modules[0].Lessons = append(content.Modules[0].Lessons,
+			&domain.LessonContent{ID: quizID, Title: "Check", Type: domain.LessonQuiz},
+			&domain.LessonContent{ID: assignmentID, Title: "Build", Type: domain.LessonAssignment},
+		)
+		return content
+	}
+
+	tests := []struct {
+		name      string
+		userID    string
+		content   *domain.CourseContent
+		open      bool
+		wantErr   error
+		wantCheck bool
+	}{
+		{name: "passing course is queued", userID: ownerID, content: publishableContent("video-ok")},
+		{name: "quiz and assignment set up", userID: ownerID, content: withActivities("quiz-ready", "assignment-ready")},
+		{name: "assignment not set up", userID: ownerID, content: withActivities("quiz-ready", "assignment-empty"), wantCheck: true},
+		{name: "video not ready", userID: ownerID, content: publishableContent("video-failed"), wantCheck: true},
+		{name: "already awaiting review", userID: ownerID, content: publishableContent("video-ok"), open: true, wantErr: domain.ErrAlreadySubmitted},
+		{name: "someone else's course", userID: "someone-else", content: publishableContent("video-ok"), wantErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseService{
+				ownerID:  ownerID,
+				courses:  map[string]*domain.Course{"course-1": {ID: "course-1", Title: "Course", InstructorID: ownerID}},
+				contents: map[string]*domain.CourseContent{"course-1": tt.content},
+			}
+			submissions := &fakeSubmissionRepo{open: map[string]*domain.CourseSubmission{}}
+			if tt.open {
+				submissions.open["course-1"] = &domain.CourseSubmission{ID: "submission-0", CourseID: "course-1"}
+			}
+			s := newTestReviewService(t, courses, submissions)
+
+			_, submission, err := s.SubmitCourse(context.Background(), "course-1", tt.userID)
+
+			var checkErr *domain.CheckError
+			if tt.wantCheck {
+				if !errors.As(err, &checkErr) {
+					t.Fatalf("SubmitCourse() error = %v, want a check failure", err)
+				}
+			} else if err != tt.wantErr {
+				t.Fatalf("SubmitCourse() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				if len(submissions.created) != 0 {
+					t.Errorf("created %d submissions, want none", len(submissions.created))
+				}
+				return
+			}
+			if submission.Status != domain.SubmissionSubmitted || submission.CourseTitle != "Course" {
+				t.Errorf("submission = %+v, want a SUBMITTED submission for Course", submission)
+			}
+			if len(submissions.created) != 1 {
+				t.Errorf("created %d submissions, want 1", len(submissions.created))
+			}
+		})
+	}
+}
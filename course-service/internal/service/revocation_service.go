@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+// RevocationService keeps the auth interceptor's revocation list in step
+// with user suspensions, so a suspended user's tokens stop working here too.
+type RevocationService interface {
+	Load(ctx context.Context, since time.Time) error
+	HandleUserSuspended(ctx context.Context, key, value []byte) error
+}
+
+type revocationService struct {
+	revocations    *interceptor.RevocationList
+	revocationRepo repository.RevocationRepository
+	logger         *zap.Logger
+}
+
+func NewRevocationService(
+	revocations *interceptor.RevocationList,
+	revocationRepo repository.RevocationRepository,
+	logger *zap.Logger,
+) RevocationService {
+	return &revocationService{
+		revocations:    revocations,
+		revocationRepo: revocationRepo,
+		logger:         logger,
+	}
+}
+
+// Load seeds the list with the cutoffs recorded since since, i.e. those whose
+// tokens may still be live.
+func (s *revocationService) Load(ctx context.Context, since time.Time) error {
+	revoked, err := s.revocationRepo.ListSince(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	for userID, revokedAt := range revoked {
+		s.revocations.Revoke(userID, revokedAt)
+	}
+
+	s.logger.Info("token revocations loaded", zap.Int("users", len(revoked)))
+	return nil
+}
+
+// HandleUserSuspended is a kafka.MessageHandler for the user.suspended topic.
+// Every replica consumes it, so each records the cutoff for later restarts
+// and applies it to its own list.
+func (s *revocationService) HandleUserSuspended(ctx context.Context, key, value []byte) error {
+	var event kafka.UserSuspendedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	if err := s.revocationRepo.Record(ctx, event.UserID, event.TokensRevokedAt); err != nil {
+		return err
+	}
+	s.revocations.Revoke(event.UserID, event.TokensRevokedAt)
+
+	s.logger.Info("user tokens revoked", zap.String("user_id", event.UserID))
+	return nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+// StatsService keeps the figures shown on catalog cards that are derived
+// from other data. Duration follows the lessons and is kept in step by the
+// repositories; the enrolled count follows enrollment-service's events.
+type StatsService interface {
+	HandleEnrollmentSuccess(ctx context.Context, key, value []byte) error
+	HandleEnrollmentFailed(ctx context.Context, key, value []byte) error
+	HandleEnrollmentCancelled(ctx context.Context, key, value []byte) error
+	ReconcileCourse(ctx context.Context, courseID string) error
+	ReconcileAll(ctx context.Context) (int, error)
+}
+
+type statsService struct {
+	courseRepo repository.CourseRepository
+	logger     *zap.Logger
+}
+
+func NewStatsService(courseRepo repository.CourseRepository, logger *zap.Logger) StatsService {
+	return &statsService{
+		courseRepo: courseRepo,
+		logger:     logger,
+	}
+}
+
+// HandleEnrollmentSuccess is a kafka.MessageHandler for the
+// enrollment.success topic.
+func (s *statsService) HandleEnrollmentSuccess(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentSuccessEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.record(ctx, event.EnrollmentID, event.CourseID, event.UserID, domain.EnrollmentActive, event.Timestamp)
+}
+
+// HandleEnrollmentFailed is a kafka.MessageHandler for the enrollment.failed
+// topic.
+func (s *statsService) HandleEnrollmentFailed(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentFailedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.record(ctx, event.EnrollmentID, event.CourseID, event.UserID, domain.EnrollmentFailed, event.Timestamp)
+}
+
+// HandleEnrollmentCancelled is a kafka.MessageHandler for the
+// enrollment.cancelled topic, which covers refunds.
+func (s *statsService) HandleEnrollmentCancelled(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentCancelledEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.record(ctx, event.EnrollmentID, event.CourseID, event.UserID, domain.EnrollmentCancelled, event.Timestamp)
+}
+
+func (s *statsService) record(ctx context.Context, enrollmentID, courseID, userID string, status domain.EnrollmentStatus, at time.Time) error {
+	if enrollmentID == "" || courseID == "" {
+		s.logger.Warn("skipping enrollment event without IDs", zap.String("status", string(status)))
+		return nil
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return s.courseRepo.RecordEnrollment(ctx, &domain.CourseEnrollment{
+		EnrollmentID: enrollmentID,
+		CourseID:     courseID,
+		UserID:       userID,
+		Status:       status,
+		UpdatedAt:    at,
+	})
+}
+
+// ReconcileCourse recomputes the course's duration and enrolled count from
+// scratch, for when they have drifted.
+func (s *statsService) ReconcileCourse(ctx context.Context, courseID string) error {
+	if err := s.courseRepo.ReconcileStats(ctx, courseID); err != nil {
+		return err
+	}
+
+	s.logger.Info("course stats reconciled", zap.String("course_id", courseID))
+	return nil
+}
+
+// ReconcileAll reconciles every course and returns how many there were.
+func (s *statsService) ReconcileAll(ctx context.Context) (int, error) {
+	count, err := s.courseRepo.ReconcileAllStats(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info("all course stats reconciled", zap.Int("courses", count))
+	return count, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"go.uber.org/zap"
+)
+
+func (r *fakeCourseRepo) RecordEnrollment(ctx context.Context, enrollment *domain.CourseEnrollment) error {
+	r.enrollments = append(r.enrollments, enrollment)
+	return nil
+}
+
+func TestStatsServiceRecordsEnrollmentEvents(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Each case names the StatsService handler its event is consumed by
+	type handler func(s StatsService) kafka.MessageHandler
+	success := func(s StatsService) kafka.MessageHandler { return s.HandleEnrollmentSuccess }
+	failed := func(s StatsService) kafka.MessageHandler { return s.HandleEnrollmentFailed }
+	cancelled := func(s StatsService) kafka.MessageHandler { return s.HandleEnrollmentCancelled }
+
+	tests := []struct {
+		name       string
+		handle     handler
+		event      any
+		wantStatus domain.EnrollmentStatus
+		wantAt     time.Time
+	}{
+		{
+			name:       "success counts the enrollment",
+			handle:     success,
+			event:      kafka.EnrollmentSuccessEvent{EnrollmentID: "enrollment-1", CourseID: "course-1", UserID: "user-1", Timestamp: at},
+			wantStatus: domain.EnrollmentActive,
+			wantAt:     at,
+		},
+		{
+			name:       "failure",
+			handle:     failed,
+			event:      kafka.EnrollmentFailedEvent{EnrollmentID: "enrollment-1", CourseID: "course-1", UserID: "user-1", Timestamp: at},
+			wantStatus: domain.EnrollmentFailed,
+			wantAt:     at,
+		},
+		{
+			name:       "refund",
+			handle:     cancelled,
+			event:      kafka.EnrollmentCancelledEvent{EnrollmentID: "enrollment-1", CourseID: "course-1", UserID: "user-1", Refunded: true, Timestamp: at},
+			wantStatus: domain.EnrollmentCancelled,
+			wantAt:     at,
+		},
+		{
+			name:       "event without a timestamp",
+			handle:     success,
+			event:      kafka.EnrollmentSuccessEvent{EnrollmentID: "enrollment-1", CourseID: "course-1", UserID: "user-1"},
+			wantStatus: domain.EnrollmentActive,
+		},
+		{
+			name:   "event without an enrollment ID is skipped",
+			handle: success,
+			event:  kafka.EnrollmentSuccessEvent{CourseID: "course-1", UserID: "user-1", Timestamp: at},
+		},
+		{
+			name:   "event without a course is skipped",
+			handle: cancelled,
+			event:  kafka.EnrollmentCancelledEvent{EnrollmentID: "enrollment-1", UserID: "user-1", Timestamp: at},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courses := &fakeCourseRepo{}
+			s := NewStatsService(courses, zap.NewNop())
+
+			value, err := json.Marshal(tt.event)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := tt.handle(s)(context.Background(), []byte("enrollment-1"), value); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+
+			if tt.wantStatus == "" {
+				if len(courses.enrollments) != 0 {
+					t.Errorf("recorded %+v, want nothing", courses.enrollments[0])
+				}
+				return
+			}
+
+			if len(courses.enrollments) != 1 {
+				t.Fatalf("recorded %d enrollments, want 1", len(courses.enrollments))
+			}
+			got := courses.enrollments[0]
+			if got.EnrollmentID != "enrollment-1" || got.CourseID != "course-1" || got.Status != tt.wantStatus {
+				t.Errorf("recorded %+v, want enrollment-1 on course-1 as %s", got, tt.wantStatus)
+			}
+			// Without a timestamp the event is recorded as of now, so a
+			// later event for the same enrollment still applies
+			if tt.wantAt.IsZero() {
+				if got.UpdatedAt.IsZero() {
+					t.Error("UpdatedAt is zero, want the time the event was handled")
+				}
+			} else if !got.UpdatedAt.Equal(tt.wantAt) {
+				t.Errorf("UpdatedAt = %v, want %v", got.UpdatedAt, tt.wantAt)
+			}
+		})
+	}
+}
+
+func TestStatsServiceRejectsMalformedEvents(t *testing.T) {
+	s := NewStatsService(&fakeCourseRepo{}, zap.NewNop())
+
+	if err := s.HandleEnrollmentSuccess(context.Background(), nil, []byte("{")); err == nil {
+		t.Error("HandleEnrollmentSuccess() of malformed JSON succeeded")
+	}
+}
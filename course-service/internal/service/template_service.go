@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CreateTemplateRequest struct {
+	CourseID    string
+	Name        string
+	Description string
+}
+
+type TemplateService interface {
+	CreateTemplate(ctx context.Context, adminID string, req CreateTemplateRequest) (*domain.CourseTemplate, error)
+	GetTemplate(ctx context.Context, templateID string) (*domain.CourseTemplate, error)
+	ListTemplates(ctx context.Context, page, pageSize int) ([]*domain.CourseTemplate, int, error)
+	DeleteTemplate(ctx context.Context, templateID string) error
+	CreateCourseFromTemplate(ctx context.Context, templateID, instructorID, title string) (*domain.Course, error)
+}
+
+type templateService struct {
+	courseService CourseService
+	templateRepo  repository.TemplateRepository
+	logger        *zap.Logger
+}
+
+func NewTemplateService(courseService CourseService, templateRepo repository.TemplateRepository, logger *zap.Logger) TemplateService {
+	return &templateService{
+		courseService: courseService,
+		templateRepo:  templateRepo,
+		logger:        logger,
+	}
+}
+
+// CreateTemplate snapshots a course's live content as a new template.
+func (s *templateService) CreateTemplate(ctx context.Context, adminID string, req CreateTemplateRequest) (*domain.CourseTemplate, error) {
+	content, err := s.courseService.LiveContent(ctx, req.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &domain.CourseTemplate{
+		ID:          uuid.New().String(),
+		Name:        req.Name,
+		Description: req.Description,
+		Content:     *content,
+		CreatedBy:   adminID,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course template created", zap.String("template_id", template.ID), zap.String("course_id", req.CourseID))
+	return template, nil
+}
+
+func (s *templateService) GetTemplate(ctx context.Context, templateID string) (*domain.CourseTemplate, error) {
+	return s.templateRepo.GetByID(ctx, templateID)
+}
+
+func (s *templateService) ListTemplates(ctx context.Context, page, pageSize int) ([]*domain.CourseTemplate, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.templateRepo.List(ctx, page, pageSize)
+}
+
+func (s *templateService) DeleteTemplate(ctx context.Context, templateID string) error {
+	if err := s.templateRepo.Delete(ctx, templateID); err != nil {
+		return err
+	}
+
+	s.logger.Info("course template deleted", zap.String("template_id", templateID))
+	return nil
+}
+
+func (s *templateService) CreateCourseFromTemplate(ctx context.Context, templateID, instructorID, title string) (*domain.Course, error) {
+	template, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := s.courseService.CreateCourseFromContent(ctx, instructorID, title, &template.Content, true)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course created from template", zap.String("template_id", templateID), zap.String("course_id", course.ID))
+	return course, nil
+}
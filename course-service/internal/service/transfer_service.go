@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/archive"
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ImportRequest describes an uploaded archive. Set fields override the
+// course details read from it; SCORM packages carry no category, so one has
+// to be given for them.
+type ImportRequest struct {
+	Format      domain.ArchiveFormat
+	Title       string
+	Description string
+	Category    string
+	Level       *domain.CourseLevel
+}
+
+// TransferService moves courses in and out of the platform as archives.
+type TransferService interface {
+	ExportCourse(ctx context.Context, courseID, instructorID string, w io.Writer) error
+	ImportCourse(ctx context.Context, instructorID string, req ImportRequest, data []byte) (*domain.Course, error)
+}
+
+type transferService struct {
+	courseService CourseService
+	logger        *zap.Logger
+}
+
+func NewTransferService(courseService CourseService, logger *zap.Logger) TransferService {
+	return &transferService{
+		courseService: courseService,
+		logger:        logger,
+	}
+}
+
+// ExportCourse writes the live content of one of the instructor's courses
+// to w as a native archive.
+func (s *transferService) ExportCourse(ctx context.Context, courseID, instructorID string, w io.Writer) error {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermManageCourse); err != nil {
+		return err
+	}
+
+	content, err := s.courseService.LiveContent(ctx, courseID)
+	if err != nil {
+		return err
+	}
+
+	if err := archive.Write(w, courseID, content, time.Now()); err != nil {
+		return err
+	}
+
+	s.logger.Info("course exported", zap.String("course_id", courseID))
+	return nil
+}
+
+// ImportCourse recreates an archived course as a new DRAFT owned by
+// instructorID.
+func (s *transferService) ImportCourse(ctx context.Context, instructorID string, req ImportRequest, data []byte) (*domain.Course, error) {
+	if len(data) > archive.MaxSize {
+		return nil, domain.ErrArchiveTooLarge
+	}
+
+	var content *domain.CourseContent
+	var err error
+	switch req.Format {
+	case domain.ArchiveNative:
+		content, err = archive.Read(data)
+	case domain.ArchiveSCORM12:
+		content, err = archive.ReadSCORM(data)
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Description != "" {
+		content.Details.Description = req.Description
+	}
+	if req.Category != "" {
+		content.Details.Category = req.Category
+	}
+	if req.Level != nil {
+		content.Details.Level = *req.Level
+	}
+
+	course, err := s.courseService.CreateCourseFromContent(ctx, instructorID, req.Title, content, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course imported", zap.String("course_id", course.ID), zap.String("format", string(req.Format)))
+	return course, nil
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/course-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// TranslationInput sets one field of the course, or of one of its modules or
+// lessons, in the locale being translated. EntityID is ignored for the
+// course itself. An empty Value removes the translation.
+type TranslationInput struct {
+	Entity   domain.EntityType
+	EntityID string
+	Field    domain.TranslatableField
+	Value    string
+}
+
+// TranslationService manages the translations of course text and serves
+// courses in the locale that best matches what the caller prefers.
+type TranslationService interface {
+	SetDefaultLocale(ctx context.Context, courseID, instructorID, locale string) (*domain.Course, error)
+	PutTranslations(ctx context.Context, courseID, instructorID, locale string, inputs []TranslationInput) ([]*domain.Translation, error)
+	ListTranslations(ctx context.Context, courseID, instructorID, locale string) ([]*domain.Translation, error)
+	DeleteLocale(ctx context.Context, courseID, instructorID, locale string) error
+	GetTranslationStatus(ctx context.Context, courseID, instructorID string) (*domain.Course, []*domain.LocaleProgress, error)
+	LocalizeCourses(ctx context.Context, courses []*domain.Course, preferred []string) error
+	LocalizeContent(ctx context.Context, course *domain.Course, content *domain.CourseContent, preferred []string) error
+}
+
+type translationService struct {
+	courseService   CourseService
+	courseRepo      repository.CourseRepository
+	translationRepo repository.TranslationRepository
+	logger          *zap.Logger
+}
+
+func NewTranslationService(
+	courseService CourseService,
+	courseRepo repository.CourseRepository,
+	translationRepo repository.TranslationRepository,
+	logger *zap.Logger,
+) TranslationService {
+	return &translationService{
+		courseService:   courseService,
+		courseRepo:      courseRepo,
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+// SetDefaultLocale records which locale the course's own text is written in.
+// It doesn't change the text.
+func (s *translationService) SetDefaultLocale(ctx context.Context, courseID, instructorID, locale string) (*domain.Course, error) {
+	locale, err := domain.NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermManageCourse)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.translationRepo.ListByLocale(ctx, courseID, locale)
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) > 0 {
+		return nil, domain.ErrDefaultLocaleTranslation
+	}
+
+	now := time.Now()
+	if err := s.courseRepo.SetDefaultLocale(ctx, courseID, locale, now); err != nil {
+		return nil, err
+	}
+
+	course.DefaultLocale = locale
+	course.Locale = locale
+	course.UpdatedAt = now
+
+	s.logger.Info("course default locale set", zap.String("course_id", courseID), zap.String("locale", locale))
+	return course, nil
+}
+
+// PutTranslations saves translations of the course and of modules and
+// lessons in its draft or live content, and returns all of the course's
+// translations into locale. Translations aren't versioned with the content,
+// so learners see them straight away.
+func (s *translationService) PutTranslations(ctx context.Context, courseID, instructorID, locale string, inputs []TranslationInput) ([]*domain.Translation, error) {
+	locale, err := domain.NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermEditContent)
+	if err != nil {
+		return nil, err
+	}
+	if locale == course.DefaultLocale {
+		return nil, domain.ErrDefaultLocaleTranslation
+	}
+
+	entities, err := s.contentEntities(ctx, courseID, instructorID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	translations := make([]*domain.Translation, len(inputs))
+	for i, input := range inputs {
+		translation := &domain.Translation{
+			CourseID:  courseID,
+			Locale:    locale,
+			Entity:    input.Entity,
+			EntityID:  input.EntityID,
+			Field:     input.Field,
+			Value:     strings.TrimSpace(input.Value),
+			UpdatedAt: now,
+		}
+		if translation.Entity == domain.EntityCourse {
+			translation.EntityID = courseID
+		}
+
+		if err := translation.Validate(); err != nil {
+			return nil, err
+		}
+		if translation.Entity != domain.EntityCourse && entities[translation.EntityID] != translation.Entity {
+			return nil, domain.ErrUnknownTranslationTarget
+		}
+
+		translations[i] = translation
+	}
+
+	if err := s.translationRepo.Put(ctx, translations); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("course translations saved",
+		zap.String("course_id", courseID),
+		zap.String("locale", locale),
+		zap.Int("fields", len(translations)),
+	)
+
+	return s.translationRepo.ListByLocale(ctx, courseID, locale)
+}
+
+// ListTranslations returns the course's translations into locale, or into
+// every locale if it's empty.
+func (s *translationService) ListTranslations(ctx context.Context, courseID, instructorID, locale string) ([]*domain.Translation, error) {
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermViewContent); err != nil {
+		return nil, err
+	}
+
+	if locale == "" {
+		return s.translationRepo.ListByCourse(ctx, courseID)
+	}
+
+	locale, err := domain.NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return s.translationRepo.ListByLocale(ctx, courseID, locale)
+}
+
+func (s *translationService) DeleteLocale(ctx context.Context, courseID, instructorID, locale string) error {
+	locale, err := domain.NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.courseService.Authorize(ctx, courseID, instructorID, domain.PermEditContent); err != nil {
+		return err
+	}
+
+	if err := s.translationRepo.DeleteLocale(ctx, courseID, locale); err != nil {
+		return err
+	}
+
+	s.logger.Info("course locale deleted", zap.String("course_id", courseID), zap.String("locale", locale))
+	return nil
+}
+
+// GetTranslationStatus reports, per translated locale, how much of the
+// content instructors are working on has been translated: the draft if there
+// is one, otherwise what learners see.
+func (s *translationService) GetTranslationStatus(ctx context.Context, courseID, instructorID string) (*domain.Course, []*domain.LocaleProgress, error) {
+	course, content, err := s.workingContent(ctx, courseID, instructorID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	translations, err := s.translationRepo.ListByCourse(ctx, courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return course, domain.TranslationProgress(courseID, content, translations), nil
+}
+
+// LocalizeCourses translates each course's title and description into the
+// locale it has that best matches preferred. Courses without a match keep
+// their default locale.
+func (s *translationService) LocalizeCourses(ctx context.Context, courses []*domain.Course, preferred []string) error {
+	if len(preferred) == 0 || len(courses) == 0 {
+		return nil
+	}
+
+	courseIDs := make([]string, len(courses))
+	for i, course := range courses {
+		courseIDs[i] = course.ID
+	}
+
+	translations, err := s.translationRepo.ListCourseText(ctx, courseIDs)
+	if err != nil {
+		return err
+	}
+
+	byCourse := make(map[string][]*domain.Translation)
+	for _, t := range translations {
+		byCourse[t.CourseID] = append(byCourse[t.CourseID], t)
+	}
+
+	for _, course := range courses {
+		localize(course, byCourse[course.ID], preferred)
+	}
+	return nil
+}
+
+// LocalizeContent translates the course and its modules and lessons into the
+// locale that best matches preferred.
+func (s *translationService) LocalizeContent(ctx context.Context, course *domain.Course, content *domain.CourseContent, preferred []string) error {
+	if len(preferred) == 0 {
+		return nil
+	}
+
+	translations, err := s.translationRepo.ListByCourse(ctx, course.ID)
+	if err != nil {
+		return err
+	}
+
+	if text := localize(course, translations, preferred); text != nil {
+		text.ApplyToContent(content)
+	}
+	return nil
+}
+
+// localize applies the translations matching preferred to course and returns
+// them, or returns nil if the default locale is the best match. A locale is
+// available once anything in the course has been translated into it.
+func localize(course *domain.Course, translations []*domain.Translation, preferred []string) *domain.LocalizedText {
+	available := []string{course.DefaultLocale}
+	for _, t := range translations {
+		if !slices.Contains(available, t.Locale) {
+			available = append(available, t.Locale)
+		}
+	}
+	slices.Sort(available[1:])
+
+	locale := domain.MatchLocale(preferred, available)
+	if locale == course.DefaultLocale {
+		return nil
+	}
+
+	text := domain.NewLocalizedText(locale, translations)
+	text.ApplyToCourse(course)
+	return text
+}
+
+// workingContent loads the draft content, or the live content if the course
+// has no draft.
+func (s *translationService) workingContent(ctx context.Context, courseID, instructorID string) (*domain.Course, *domain.CourseContent, error) {
+	course, content, err := s.courseService.GetCourseDraft(ctx, courseID, instructorID)
+	if err == domain.ErrDraftNotFound {
+		course, err = s.courseService.Authorize(ctx, courseID, instructorID, domain.PermViewContent)
+		if err != nil {
+			return nil, nil, err
+		}
+		content, err = s.courseService.LiveContent(ctx, courseID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return course, content, nil
+}
+
+// contentEntities maps the IDs of the modules and lessons in the course's
+// draft and live content to their type. Something removed in the draft is
+// still shown to learners until the draft is published, so it can still be
+// translated.
+func (s *translationService) contentEntities(ctx context.Context, courseID, instructorID string) (map[string]domain.EntityType, error) {
+	_, draft, err := s.courseService.GetCourseDraft(ctx, courseID, instructorID)
+	if err != nil && err != domain.ErrDraftNotFound {
+		return nil, err
+	}
+
+	live, err := s.courseService.LiveContent(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make(map[string]domain.EntityType)
+	for _, content := range []*domain.CourseContent{draft, live} {
+		if content == nil {
+			continue
+		}
+		for _, m := range content.Modules {
+			entities[m.ID] = domain.EntityModule
+			for _, l := range m.Lessons {
+				entities[l.ID] = domain.EntityLesson
+			}
+		}
+	}
+	return entities, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+)
+
+func TestLocalize(t *testing.T) {
+	translations := []*domain.Translation{
+		{Locale: "fr", Entity: domain.EntityCourse, EntityID: "course-1", Field: domain.FieldTitle, Value: "Les bases de Go"},
+		{Locale: "de", Entity: domain.EntityLesson, EntityID: "lesson-1", Field: domain.FieldTitle, Value: "Einführung"},
+	}
+
+	tests := []struct {
+		name          string
+		defaultLocale string
+		preferred     []string
+		wantLocale    string
+		wantTitle     string
+	}{
+		{name: "translated locale", defaultLocale: "en", preferred: []string{"fr-CA", "en"}, wantLocale: "fr", wantTitle: "Les bases de Go"},
+		{name: "locale with only lesson translations", defaultLocale: "en", preferred: []string{"de"}, wantLocale: "de", wantTitle: "Go basics"},
+		{name: "default locale preferred", defaultLocale: "en", preferred: []string{"en-GB", "fr"}, wantLocale: "en", wantTitle: "Go basics"},
+		{name: "nothing matches", defaultLocale: "en", preferred: []string{"ja"}, wantLocale: "en", wantTitle: "Go basics"},
+		{name: "course written in French", defaultLocale: "fr", preferred: []string{"fr"}, wantLocale: "fr", wantTitle: "Go basics"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			course := &domain.Course{ID: "course-1", Title: "Go basics", DefaultLocale: tt.defaultLocale, Locale: tt.defaultLocale}
+
+			text := localize(course, translations, tt.preferred)
+
+			// The default locale needs no translating
+			if (text == nil) != (tt.wantLocale == tt.defaultLocale) {
+				t.Errorf("localize() = %v, want text only for a translated locale", text)
+			}
+			if course.Locale != tt.wantLocale || course.Title != tt.wantTitle {
+				t.Errorf("Locale, Title = %q, %q, want %q, %q", course.Locale, course.Title, tt.wantLocale, tt.wantTitle)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestURLSigner(t *testing.T) {
+	signer := NewURLSigner("https://files.example.com/", "secret", 15*time.Minute)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	link, expiresAt := signer.Sign("/attachments/a-1", now)
+	if !strings.HasPrefix(link, "https://files.example.com/attachments/a-1?") {
+		t.Fatalf("Sign() = %q, want a link under the base URL", link)
+	}
+	if want := now.Add(15 * time.Minute); !expiresAt.Equal(want) {
+		t.Errorf("expiresAt = %v, want %v", expiresAt, want)
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+
+	tamperedExpiry := url.Values{}
+	tamperedExpiry.Set("expires", "9999999999")
+	tamperedExpiry.Set("signature", query.Get("signature"))
+
+	tests := []struct {
+		name    string
+		signer  *URLSigner
+		path    string
+		query   url.Values
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid link", signer: signer, path: "/attachments/a-1", query: query, now: now},
+		{name: "at the expiry", signer: signer, path: "/attachments/a-1", query: query, now: expiresAt},
+		{name: "after the expiry", signer: signer, path: "/attachments/a-1", query: query, now: expiresAt.Add(time.Second), wantErr: ErrInvalidSignature},
+		{name: "another file", signer: signer, path: "/attachments/a-2", query: query, now: now, wantErr: ErrInvalidSignature},
+		{name: "extended expiry", signer: signer, path: "/attachments/a-1", query: tamperedExpiry, now: now, wantErr: ErrInvalidSignature},
+		{name: "no parameters", signer: signer, path: "/attachments/a-1", query: url.Values{}, now: now, wantErr: ErrInvalidSignature},
+		{
+			name:    "signed with another secret",
+			signer:  NewURLSigner("https://files.example.com", "other", 15*time.Minute),
+			path:    "/attachments/a-1",
+			query:   query,
+			now:     now,
+			wantErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.signer.Verify(tt.path, tt.query, tt.now); err != tt.wantErr {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
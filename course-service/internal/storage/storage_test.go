@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readFile(t *testing.T, store FileStore, key string) string {
+	t.Helper()
+	f, err := store.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Open(%q) error = %v", key, err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// failingReader yields some data and then fails, like a dropped upload.
+type failingReader struct {
+	sent bool
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if !r.sent {
+		r.sent = true
+		return copy(p, "partial"), nil
+	}
+	return 0, errors.New("connection reset")
+}
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+
+	const key = "assignments/lesson-1/file-1"
+
+	n, err := store.Put(ctx, key, strings.NewReader("first"))
+	if err != nil || n != 5 {
+		t.Fatalf("Put() = %d, %v, want 5, nil", n, err)
+	}
+	if got := readFile(t, store, key); got != "first" {
+		t.Errorf("stored %q, want first", got)
+	}
+
+	if _, err := store.Put(ctx, key, strings.NewReader("second")); err != nil {
+		t.Fatalf("Put() replacing error = %v", err)
+	}
+	if got := readFile(t, store, key); got != "second" {
+		t.Errorf("stored %q after replacing, want second", got)
+	}
+
+	// A failed upload keeps the stored file and leaves nothing behind
+	if _, err := store.Put(ctx, key, &failingReader{}); err == nil {
+		t.Fatal("Put() of a failing upload succeeded")
+	}
+	if got := readFile(t, store, key); got != "second" {
+		t.Errorf("stored %q after a failed upload, want second", got)
+	}
+	entries, err := os.ReadDir(filepath.Join(root, "assignments", "lesson-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d entries after a failed upload, want 1", len(entries))
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Open(ctx, key); err == nil {
+		t.Error("Open() of a deleted file succeeded")
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() of a missing file error = %v, want nil", err)
+	}
+}
+
+func TestLocalStoreRejectsKeysOutsideRoot(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+
+	for _, key := range []string{"../escape", "/etc/passwd", "a/../../escape", ""} {
+		if _, err := store.Put(ctx, key, strings.NewReader("x")); err != ErrInvalidKey {
+			t.Errorf("Put(%q) error = %v, want %v", key, err, ErrInvalidKey)
+		}
+		if _, err := store.Open(ctx, key); err != ErrInvalidKey {
+			t.Errorf("Open(%q) error = %v, want %v", key, err, ErrInvalidKey)
+		}
+		if err := store.Delete(ctx, key); err != ErrInvalidKey {
+			t.Errorf("Delete(%q) error = %v, want %v", key, err, ErrInvalidKey)
+		}
+	}
+}
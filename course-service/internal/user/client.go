@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/dmehra2102/learning-platform/shared/proto/user"
+	"google.golang.org/grpc"
+)
+
+// Name is a user's display name as of UpdatedAt.
+type Name struct {
+	Name      string
+	UpdatedAt time.Time
+}
+
+// Client looks users up in user-service.
+type Client interface {
+	// GetNames returns the names of the users user-service knows of, keyed
+	// by ID. Users without a name are left out.
+	GetNames(ctx context.Context, userIDs []string) (map[string]Name, error)
+}
+
+type client struct {
+	users pb.UserServiceClient
+}
+
+// NewClient talks to user-service over conn, which must authenticate as
+// course-service.
+func NewClient(conn *grpc.ClientConn) Client {
+	return &client{users: pb.NewUserServiceClient(conn)}
+}
+
+func (c *client) GetNames(ctx context.Context, userIDs []string) (map[string]Name, error) {
+	names := make(map[string]Name)
+	if len(userIDs) == 0 {
+		return names, nil
+	}
+
+	resp, err := c.users.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: userIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	for _, u := range resp.Users {
+		name := strings.TrimSpace(u.FirstName + " " + u.LastName)
+		if name == "" {
+			continue
+		}
+		names[u.Id] = Name{Name: name, UpdatedAt: u.UpdatedAt.AsTime()}
+	}
+
+	return names, nil
+}
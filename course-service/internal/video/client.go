@@ -0,0 +1,58 @@
+package video
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dmehra2102/learning-platform/course-service/internal/domain"
+	pb "github.com/dmehra2102/learning-platform/shared/proto/video"
+	"google.golang.org/grpc"
+)
+
+// Client looks lesson videos up in video-service.
+type Client interface {
+	// GetVideos returns the videos video-service knows of, keyed by ID.
+	GetVideos(ctx context.Context, videoIDs []string) (map[string]*domain.Video, error)
+}
+
+type client struct {
+	videos pb.VideoServiceClient
+}
+
+// NewClient talks to video-service over conn, which must authenticate as
+// course-service.
+func NewClient(conn *grpc.ClientConn) Client {
+	return &client{videos: pb.NewVideoServiceClient(conn)}
+}
+
+func (c *client) GetVideos(ctx context.Context, videoIDs []string) (map[string]*domain.Video, error) {
+	videos := make(map[string]*domain.Video)
+	if len(videoIDs) == 0 {
+		return videos, nil
+	}
+
+	resp, err := c.videos.GetVideosByIds(ctx, &pb.GetVideosByIdsRequest{Ids: videoIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get videos: %w", err)
+	}
+
+	for _, v := range resp.Videos {
+		videos[v.Id] = &domain.Video{
+			ID:         v.Id,
+			UploaderID: v.UploaderId,
+			Status:     statusFromProto(v.Status),
+		}
+	}
+
+	return videos, nil
+}
+
+func statusFromProto(status pb.VideoStatus) domain.VideoStatus {
+	switch status {
+	case pb.VideoStatus_READY:
+		return domain.VideoReady
+	case pb.VideoStatus_FAILED:
+		return domain.VideoFailed
+	}
+	return domain.VideoProcessing
+}
@@ -5,14 +5,14 @@ go 1.25.1
 require (
 	github.com/dmehra2102/learning-platform/shared v0.0.0-20251203101240-c022c42f26a4
 	github.com/google/uuid v1.6.0
+	github.com/jmoiron/sqlx v1.4.0
+	github.com/lib/pq v1.10.9
 	go.uber.org/zap v1.27.1
 	google.golang.org/grpc v1.77.0
 )
 
 require (
-	github.com/jmoiron/sqlx v1.4.0 // indirect
 	github.com/klauspost/compress v1.15.9 // indirect
-	github.com/lib/pq v1.10.9 // indirect
 	github.com/pierrec/lz4/v4 v4.1.15 // indirect
 	github.com/segmentio/kafka-go v0.4.49 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
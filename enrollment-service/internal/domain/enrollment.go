@@ -2,6 +2,9 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 )
 
@@ -12,8 +15,36 @@ var (
 	ErrInvalidEnrollmentStatus = errors.New("invalid enrollment status")
 	ErrUnauthorized            = errors.New("unauthorized")
 	ErrInvalidInput            = errors.New("invalid input")
+	ErrBundledEnrollment       = errors.New("bundled enrollments are cancelled with their whole purchase")
 )
 
+// PrerequisitePolicy decides whether unmet prerequisites block an enrollment
+// or are only reported back to the learner.
+type PrerequisitePolicy string
+
+const (
+	PrerequisitesEnforce PrerequisitePolicy = "ENFORCE"
+	PrerequisitesWarn    PrerequisitePolicy = "WARN"
+)
+
+type UnmetPrerequisite struct {
+	CourseID string
+	Title    string
+}
+
+// PrerequisiteError rejects an enrollment and lists what is missing.
+type PrerequisiteError struct {
+	Unmet []UnmetPrerequisite
+}
+
+func (e *PrerequisiteError) Error() string {
+	titles := make([]string, len(e.Unmet))
+	for i, u := range e.Unmet {
+		titles[i] = u.Title
+	}
+	return fmt.Sprintf("prerequisites not completed: %s", strings.Join(titles, ", "))
+}
+
 type EnrollmentStatus string
 
 const (
@@ -29,6 +60,11 @@ type Enrollment struct {
 	UserID             string
 	CourseID           string
 	Status             EnrollmentStatus
+	ListPrice          float64
+	DiscountAmount     float64
+	CouponCode         string
+	BundleID           string
+	BundlePurchaseID   string
 	AmountPaid         float64
 	PaymentID          string
 	EnrolledAt         time.Time
@@ -36,15 +72,6 @@ type Enrollment struct {
 	ProgressPercentage int
 }
 
-type EnrollmentEvent struct {
-	EnrollmentID string
-	UserID       string
-	CourseID     string
-	Status       EnrollmentStatus
-	Amount       float64
-	Timestamp    time.Time
-}
-
 func (e *Enrollment) Validate() error {
 	if e.UserID == "" {
 		return ErrInvalidInput
@@ -52,7 +79,7 @@ func (e *Enrollment) Validate() error {
 	if e.CourseID == "" {
 		return ErrInvalidInput
 	}
-	if e.AmountPaid < 0 {
+	if e.AmountPaid < 0 || e.DiscountAmount < 0 {
 		return ErrInvalidInput
 	}
 	if e.ProgressPercentage < 0 || e.ProgressPercentage > 100 {
@@ -77,3 +104,71 @@ func IsValidStatus(status string) bool {
 		return false
 	}
 }
+
+// PathProgress is a learner's position along a learning path, measured in
+// completed courses.
+type PathProgress struct {
+	PathID             string
+	TotalCourses       int
+	CompletedCourseIDs []string
+	NextCourseID       string
+	ProgressPercentage int
+}
+
+// NewPathProgress walks the path in order. The next course is the first one
+// not yet completed, even if later ones already are.
+func NewPathProgress(pathID string, courseIDs []string, completed map[string]bool) *PathProgress {
+	progress := &PathProgress{
+		PathID:       pathID,
+		TotalCourses: len(courseIDs),
+	}
+
+	for _, id := range courseIDs {
+		if completed[id] {
+			progress.CompletedCourseIDs = append(progress.CompletedCourseIDs, id)
+		} else if progress.NextCourseID == "" {
+			progress.NextCourseID = id
+		}
+	}
+
+	if progress.TotalCourses > 0 {
+		progress.ProgressPercentage = len(progress.CompletedCourseIDs) * 100 / progress.TotalCourses
+	}
+
+	return progress
+}
+
+// IsBundled reports whether the enrollment was bought as part of a bundle,
+// in which case it is paid, cancelled and refunded together with the rest of
+// that purchase.
+func (e *Enrollment) IsBundled() bool {
+	return e.BundlePurchaseID != ""
+}
+
+// SplitBundlePrice spreads a bundle price over its courses in proportion to
+// their list prices, so per-course revenue still adds up. Rounding leftovers
+// go to the last course. Free courses share evenly.
+func SplitBundlePrice(price float64, listPrices []float64) []float64 {
+	shares := make([]float64, len(listPrices))
+	if len(listPrices) == 0 {
+		return shares
+	}
+
+	var total float64
+	for _, p := range listPrices {
+		total += p
+	}
+
+	var allocated float64
+	for i, p := range listPrices[:len(listPrices)-1] {
+		if total > 0 {
+			shares[i] = math.Round(price*p/total*100) / 100
+		} else {
+			shares[i] = math.Round(price/float64(len(listPrices))*100) / 100
+		}
+		allocated += shares[i]
+	}
+	shares[len(shares)-1] = math.Round((price-allocated)*100) / 100
+
+	return shares
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitBundlePrice(t *testing.T) {
+	tests := []struct {
+		name       string
+		price      float64
+		listPrices []float64
+		want       []float64
+	}{
+		{
+			name:       "no courses",
+			price:      50,
+			listPrices: nil,
+			want:       []float64{},
+		},
+		{
+			name:       "single course takes the whole price",
+			price:      49.99,
+			listPrices: []float64{80},
+			want:       []float64{49.99},
+		},
+		{
+			name:       "in proportion to list prices",
+			price:      90,
+			listPrices: []float64{60, 40, 50},
+			want:       []float64{36, 24, 30},
+		},
+		{
+			name:       "rounding leftover goes to the last course",
+			price:      100,
+			listPrices: []float64{10, 10, 10},
+			want:       []float64{33.33, 33.33, 33.34},
+		},
+		{
+			name:       "free courses share evenly",
+			price:      10,
+			listPrices: []float64{0, 0},
+			want:       []float64{5, 5},
+		},
+		{
+			name:       "free bundle",
+			price:      0,
+			listPrices: []float64{20, 30},
+			want:       []float64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitBundlePrice(tt.price, tt.listPrices)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SplitBundlePrice(%v, %v) = %v, want %v", tt.price, tt.listPrices, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPathProgress(t *testing.T) {
+	courseIDs := []string{"c1", "c2", "c3", "c4"}
+
+	tests := []struct {
+		name          string
+		courseIDs     []string
+		completed     map[string]bool
+		wantCompleted []string
+		wantNext      string
+		wantPercent   int
+	}{
+		{
+			name:        "not started",
+			courseIDs:   courseIDs,
+			wantNext:    "c1",
+			wantPercent: 0,
+		},
+		{
+			name:          "next is the first course not completed",
+			courseIDs:     courseIDs,
+			completed:     map[string]bool{"c1": true, "c3": true},
+			wantCompleted: []string{"c1", "c3"},
+			wantNext:      "c2",
+			wantPercent:   50,
+		},
+		{
+			name:          "courses outside the path don't count",
+			courseIDs:     courseIDs,
+			completed:     map[string]bool{"c1": true, "c9": true},
+			wantCompleted: []string{"c1"},
+			wantNext:      "c2",
+			wantPercent:   25,
+		},
+		{
+			name:          "finished",
+			courseIDs:     courseIDs,
+			completed:     map[string]bool{"c1": true, "c2": true, "c3": true, "c4": true},
+			wantCompleted: courseIDs,
+			wantPercent:   100,
+		},
+		{
+			name:        "empty path",
+			wantPercent: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPathProgress("path-1", tt.courseIDs, tt.completed)
+
+			if got.TotalCourses != len(tt.courseIDs) {
+				t.Errorf("TotalCourses = %d, want %d", got.TotalCourses, len(tt.courseIDs))
+			}
+			if !slices.Equal(got.CompletedCourseIDs, tt.wantCompleted) {
+				t.Errorf("CompletedCourseIDs = %v, want %v", got.CompletedCourseIDs, tt.wantCompleted)
+			}
+			if got.NextCourseID != tt.wantNext {
+				t.Errorf("NextCourseID = %q, want %q", got.NextCourseID, tt.wantNext)
+			}
+			if got.ProgressPercentage != tt.wantPercent {
+				t.Errorf("ProgressPercentage = %d, want %d", got.ProgressPercentage, tt.wantPercent)
+			}
+		})
+	}
+}
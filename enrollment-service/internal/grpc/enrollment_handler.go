@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dmehra2102/learning-platform/enrollment-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/enrollment-service/internal/saga"
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
+	pb "github.com/dmehra2102/learning-platform/shared/proto/enrollment"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type EnrollmentHandler struct {
+	pb.UnimplementedEnrollmentServiceServer
+	saga *saga.EnrollmentSagaOrchestrator
+}
+
+func NewEnrollmentHandler(saga *saga.EnrollmentSagaOrchestrator) *EnrollmentHandler {
+	return &EnrollmentHandler{saga: saga}
+}
+
+// EnrollBundle buys every course of a bundle with one payment. Learners can
+// only enroll themselves.
+func (h *EnrollmentHandler) EnrollBundle(ctx context.Context, req *pb.EnrollBundleRequest) (*pb.BundleEnrollmentResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.UserId != "" && req.UserId != userID {
+		return nil, status.Error(codes.PermissionDenied, "cannot enroll another user")
+	}
+	if req.BundleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "bundle_id is required")
+	}
+
+	result, err := h.saga.ExecuteBundle(ctx, saga.BundleEnrollmentRequest{
+		UserID:             userID,
+		BundleID:           req.BundleId,
+		PaymentToken:       req.PaymentMethod,
+		PrerequisitePolicy: prerequisitePolicyFromProto(req.PrerequisitePolicy),
+	})
+	if err != nil {
+		return nil, enrollmentErrorToStatus(err)
+	}
+
+	enrollments := make([]*pb.Enrollment, len(result.Enrollments))
+	for i, enrollment := range result.Enrollments {
+		enrollments[i] = enrollmentToProto(enrollment)
+	}
+
+	return &pb.BundleEnrollmentResponse{
+		BundlePurchaseId:   result.PurchaseID,
+		Enrollments:        enrollments,
+		UnmetPrerequisites: unmetPrerequisitesToProto(result.UnmetPrerequisites),
+	}, nil
+}
+
+func enrollmentErrorToStatus(err error) error {
+	var prerequisiteErr *domain.PrerequisiteError
+	if errors.As(err, &prerequisiteErr) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// Pass on what course-service said about the bundle, e.g. NotFound
+	if st, ok := status.FromError(err); ok {
+		return status.Error(st.Code(), err.Error())
+	}
+
+	switch err {
+	case domain.ErrInvalidInput:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrAlreadyEnrolled:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case domain.ErrEnrollmentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func prerequisitePolicyFromProto(policy pb.PrerequisitePolicy) domain.PrerequisitePolicy {
+	if policy == pb.PrerequisitePolicy_PREREQUISITES_WARN {
+		return domain.PrerequisitesWarn
+	}
+	return domain.PrerequisitesEnforce
+}
+
+func enrollmentStatusToProto(s domain.EnrollmentStatus) pb.EnrollmentStatus {
+	switch s {
+	case domain.StatusActive:
+		return pb.EnrollmentStatus_ACTIVE
+	case domain.StatusCompleted:
+		return pb.EnrollmentStatus_COMPLETED
+	case domain.StatusCancelled:
+		return pb.EnrollmentStatus_CANCELLED
+	case domain.StatusRefunded:
+		return pb.EnrollmentStatus_REFUNDED
+	default:
+		return pb.EnrollmentStatus_PENDING
+	}
+}
+
+func enrollmentToProto(enrollment *domain.Enrollment) *pb.Enrollment {
+	e := &pb.Enrollment{
+		Id:                 enrollment.ID,
+		UserId:             enrollment.UserID,
+		CourseId:           enrollment.CourseID,
+		Status:             enrollmentStatusToProto(enrollment.Status),
+		AmountPaid:         enrollment.AmountPaid,
+		PaymentId:          enrollment.PaymentID,
+		EnrolledAt:         timestamppb.New(enrollment.EnrolledAt),
+		ProgressPercentage: int32(enrollment.ProgressPercentage),
+		ListPrice:          enrollment.ListPrice,
+		DiscountAmount:     enrollment.DiscountAmount,
+		CouponCode:         enrollment.CouponCode,
+		BundleId:           enrollment.BundleID,
+		BundlePurchaseId:   enrollment.BundlePurchaseID,
+	}
+	if enrollment.CompletedAt != nil {
+		e.CompletedAt = timestamppb.New(*enrollment.CompletedAt)
+	}
+	return e
+}
+
+func unmetPrerequisitesToProto(unmet []domain.UnmetPrerequisite) []*pb.UnmetPrerequisite {
+	out := make([]*pb.UnmetPrerequisite, len(unmet))
+	for i, u := range unmet {
+		out[i] = &pb.UnmetPrerequisite{CourseId: u.CourseID, Title: u.Title}
+	}
+	return out
+}
@@ -7,19 +7,26 @@ import (
 
 	"github.com/dmehra2102/learning-platform/enrollment-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type EnrollmentRepository interface {
 	Create(ctx context.Context, enrollment *domain.Enrollment) error
+	CreateBatch(ctx context.Context, enrollments []*domain.Enrollment) error
 	GetByID(ctx context.Context, id string) (*domain.Enrollment, error)
 	GetByUserAndCourse(ctx context.Context, userID, courseID string) (*domain.Enrollment, error)
 	Update(ctx context.Context, enrollment *domain.Enrollment) error
+	UpdateBatch(ctx context.Context, enrollments []*domain.Enrollment) error
 	Delete(ctx context.Context, id string) error
 	ListByUser(ctx context.Context, userID string, page, pageSize int) ([]*domain.Enrollment, int, error)
 	ListByCourse(ctx context.Context, courseID string, page, pageSize int) ([]*domain.Enrollment, int, error)
+	ListByBundlePurchase(ctx context.Context, purchaseID string) ([]*domain.Enrollment, error)
 	ListByStatus(ctx context.Context, status domain.EnrollmentStatus, page, pageSize int) ([]*domain.Enrollment, int, error)
 	CountByUser(ctx context.Context, userID string) (int, error)
 	CountByCourse(ctx context.Context, courseID string) (int, error)
+	CompletedCourseIDs(ctx context.Context, userID string) (map[string]bool, error)
+	HeldCourseIDs(ctx context.Context, userID string, courseIDs []string) (map[string]bool, error)
 }
 
 type enrollmentRepository struct {
@@ -31,13 +38,35 @@ func NewEnrollmentRepository(db *database.DB) EnrollmentRepository {
 }
 
 func (r *enrollmentRepository) Create(ctx context.Context, enrollment *domain.Enrollment) error {
+	return insertEnrollment(ctx, r.db, enrollment)
+}
+
+// CreateBatch inserts the enrollments of one bundle purchase all or nothing.
+func (r *enrollmentRepository) CreateBatch(ctx context.Context, enrollments []*domain.Enrollment) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		for _, enrollment := range enrollments {
+			if err := insertEnrollment(ctx, tx, enrollment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertEnrollment(ctx context.Context, db execer, enrollment *domain.Enrollment) error {
 	query := `
-		INSERT INTO enrollments (id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, progress_percentage) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+		INSERT INTO enrollments (id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := db.ExecContext(ctx, query,
 		enrollment.ID, enrollment.UserID, enrollment.CourseID, enrollment.Status,
 		enrollment.AmountPaid, enrollment.PaymentID, enrollment.EnrolledAt, enrollment.ProgressPercentage,
+		enrollment.ListPrice, enrollment.DiscountAmount, enrollment.CouponCode,
+		enrollment.BundleID, enrollment.BundlePurchaseID,
 	)
 
 	if err != nil {
@@ -49,7 +78,7 @@ func (r *enrollmentRepository) Create(ctx context.Context, enrollment *domain.En
 
 func (r *enrollmentRepository) GetByID(ctx context.Context, id string) (*domain.Enrollment, error) {
 	query := `
-		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage FROM enrollments WHERE id = $1
+		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id FROM enrollments WHERE id = $1
 	`
 
 	var enrollment domain.Enrollment
@@ -59,6 +88,8 @@ func (r *enrollmentRepository) GetByID(ctx context.Context, id string) (*domain.
 		&enrollment.ID, &enrollment.UserID, &enrollment.CourseID, &enrollment.Status,
 		&enrollment.AmountPaid, &enrollment.PaymentID, &enrollment.EnrolledAt,
 		&completedAt, &enrollment.ProgressPercentage,
+		&enrollment.ListPrice, &enrollment.DiscountAmount, &enrollment.CouponCode,
+		&enrollment.BundleID, &enrollment.BundlePurchaseID,
 	)
 
 	if err == sql.ErrNoRows {
@@ -77,7 +108,7 @@ func (r *enrollmentRepository) GetByID(ctx context.Context, id string) (*domain.
 
 func (r *enrollmentRepository) GetByUserAndCourse(ctx context.Context, userID, courseID string) (*domain.Enrollment, error) {
 	query := `
-		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage FROM enrollments WHERE user_id = $1 AND course_id = $2
+		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id FROM enrollments WHERE user_id = $1 AND course_id = $2
 	`
 
 	var enrollment domain.Enrollment
@@ -87,6 +118,8 @@ func (r *enrollmentRepository) GetByUserAndCourse(ctx context.Context, userID, c
 		&enrollment.ID, &enrollment.UserID, &enrollment.CourseID, &enrollment.Status,
 		&enrollment.AmountPaid, &enrollment.PaymentID, &enrollment.EnrolledAt,
 		&completedAt, &enrollment.ProgressPercentage,
+		&enrollment.ListPrice, &enrollment.DiscountAmount, &enrollment.CouponCode,
+		&enrollment.BundleID, &enrollment.BundlePurchaseID,
 	)
 
 	if err == sql.ErrNoRows {
@@ -104,6 +137,22 @@ func (r *enrollmentRepository) GetByUserAndCourse(ctx context.Context, userID, c
 }
 
 func (r *enrollmentRepository) Update(ctx context.Context, enrollment *domain.Enrollment) error {
+	return updateEnrollment(ctx, r.db, enrollment)
+}
+
+// UpdateBatch moves every enrollment of a bundle purchase together.
+func (r *enrollmentRepository) UpdateBatch(ctx context.Context, enrollments []*domain.Enrollment) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		for _, enrollment := range enrollments {
+			if err := updateEnrollment(ctx, tx, enrollment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func updateEnrollment(ctx context.Context, db execer, enrollment *domain.Enrollment) error {
 	query := `
 		UPDATE enrollments
 		SET status = $1, payment_id = $2, completed_at = $3, progress_percentage = $4, amount_paid = $5 WHERE id = $6
@@ -114,7 +163,7 @@ func (r *enrollmentRepository) Update(ctx context.Context, enrollment *domain.En
 		completedAt = *enrollment.CompletedAt
 	}
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := db.ExecContext(ctx, query,
 		enrollment.Status, enrollment.PaymentID, completedAt,
 		enrollment.ProgressPercentage, enrollment.AmountPaid, enrollment.ID,
 	)
@@ -157,7 +206,7 @@ func (r *enrollmentRepository) ListByUser(ctx context.Context, userID string, pa
 	}
 
 	query := `
-		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage FROM enrollments WHERE user_id = $1 ORDER BY enrolled_at DESC LIMIT $2 OFFSET $3
+		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id FROM enrollments WHERE user_id = $1 ORDER BY enrolled_at DESC LIMIT $2 OFFSET $3
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, userID, pageSize, offset)
@@ -175,6 +224,8 @@ func (r *enrollmentRepository) ListByUser(ctx context.Context, userID string, pa
 			&enrollment.ID, &enrollment.UserID, &enrollment.CourseID, &enrollment.Status,
 			&enrollment.AmountPaid, &enrollment.PaymentID, &enrollment.EnrolledAt,
 			&completedAt, &enrollment.ProgressPercentage,
+			&enrollment.ListPrice, &enrollment.DiscountAmount, &enrollment.CouponCode,
+			&enrollment.BundleID, &enrollment.BundlePurchaseID,
 		); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan enrollment: %w", err)
 		}
@@ -199,9 +250,9 @@ func (r *enrollmentRepository) ListByCourse(ctx context.Context, courseID string
 	}
 
 	query := `
-		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage
+		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id
 		FROM enrollments WHERE course_id = $1
-		ORDER BY enrolled_at DESC LIMIT $2 OFFSET $3
+		ORDER BY enrolled_at DESC, id DESC LIMIT $2 OFFSET $3
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, courseID, pageSize, offset)
@@ -219,6 +270,8 @@ func (r *enrollmentRepository) ListByCourse(ctx context.Context, courseID string
 			&enrollment.ID, &enrollment.UserID, &enrollment.CourseID, &enrollment.Status,
 			&enrollment.AmountPaid, &enrollment.PaymentID, &enrollment.EnrolledAt,
 			&completedAt, &enrollment.ProgressPercentage,
+			&enrollment.ListPrice, &enrollment.DiscountAmount, &enrollment.CouponCode,
+			&enrollment.BundleID, &enrollment.BundlePurchaseID,
 		); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan enrollment: %w", err)
 		}
@@ -233,6 +286,44 @@ func (r *enrollmentRepository) ListByCourse(ctx context.Context, courseID string
 	return enrollments, total, nil
 }
 
+func (r *enrollmentRepository) ListByBundlePurchase(ctx context.Context, purchaseID string) ([]*domain.Enrollment, error) {
+	query := `
+		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id
+		FROM enrollments WHERE bundle_purchase_id = $1
+		ORDER BY enrolled_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enrollments: %w", err)
+	}
+	defer rows.Close()
+
+	var enrollments []*domain.Enrollment
+	for rows.Next() {
+		var enrollment domain.Enrollment
+		var completedAt sql.NullTime
+
+		if err := rows.Scan(
+			&enrollment.ID, &enrollment.UserID, &enrollment.CourseID, &enrollment.Status,
+			&enrollment.AmountPaid, &enrollment.PaymentID, &enrollment.EnrolledAt,
+			&completedAt, &enrollment.ProgressPercentage,
+			&enrollment.ListPrice, &enrollment.DiscountAmount, &enrollment.CouponCode,
+			&enrollment.BundleID, &enrollment.BundlePurchaseID,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan enrollment: %w", err)
+		}
+
+		if completedAt.Valid {
+			enrollment.CompletedAt = &completedAt.Time
+		}
+
+		enrollments = append(enrollments, &enrollment)
+	}
+
+	return enrollments, nil
+}
+
 func (r *enrollmentRepository) ListByStatus(ctx context.Context, status domain.EnrollmentStatus, page, pageSize int) ([]*domain.Enrollment, int, error) {
 	offset := (page - 1) * pageSize
 
@@ -243,7 +334,7 @@ func (r *enrollmentRepository) ListByStatus(ctx context.Context, status domain.E
 	}
 
 	query := `
-		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage
+		SELECT id, user_id, course_id, status, amount_paid, payment_id, enrolled_at, completed_at, progress_percentage, list_price, discount_amount, coupon_code, bundle_id, bundle_purchase_id
 		FROM enrollments WHERE status = $1
 		ORDER BY enrolled_at DESC LIMIT $2 OFFSET $3
 	`
@@ -263,6 +354,8 @@ func (r *enrollmentRepository) ListByStatus(ctx context.Context, status domain.E
 			&enrollment.ID, &enrollment.UserID, &enrollment.CourseID, &enrollment.Status,
 			&enrollment.AmountPaid, &enrollment.PaymentID, &enrollment.EnrolledAt,
 			&completedAt, &enrollment.ProgressPercentage,
+			&enrollment.ListPrice, &enrollment.DiscountAmount, &enrollment.CouponCode,
+			&enrollment.BundleID, &enrollment.BundlePurchaseID,
 		); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan enrollment: %w", err)
 		}
@@ -298,3 +391,47 @@ func (r *enrollmentRepository) CountByCourse(ctx context.Context, courseID strin
 
 	return count, nil
 }
+
+func (r *enrollmentRepository) CompletedCourseIDs(ctx context.Context, userID string) (map[string]bool, error) {
+	query := `SELECT course_id FROM enrollments WHERE user_id = $1 AND status = $2`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, domain.StatusCompleted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list completed enrollments: %w", err)
+	}
+	defer rows.Close()
+
+	completed := make(map[string]bool)
+	for rows.Next() {
+		var courseID string
+		if err := rows.Scan(&courseID); err != nil {
+			return nil, fmt.Errorf("failed to scan enrollment: %w", err)
+		}
+		completed[courseID] = true
+	}
+
+	return completed, nil
+}
+
+// HeldCourseIDs returns which of courseIDs the user already has a pending,
+// active or completed enrollment in.
+func (r *enrollmentRepository) HeldCourseIDs(ctx context.Context, userID string, courseIDs []string) (map[string]bool, error) {
+	query := `SELECT course_id FROM enrollments WHERE user_id = $1 AND course_id = ANY($2) AND status IN ($3, $4, $5)`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, pq.Array(courseIDs), domain.StatusPending, domain.StatusActive, domain.StatusCompleted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list held enrollments: %w", err)
+	}
+	defer rows.Close()
+
+	held := make(map[string]bool)
+	for rows.Next() {
+		var courseID string
+		if err := rows.Scan(&courseID); err != nil {
+			return nil, fmt.Errorf("failed to scan enrollment: %w", err)
+		}
+		held[courseID] = true
+	}
+
+	return held, nil
+}
@@ -3,10 +3,13 @@ package saga
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/dmehra2102/learning-platform/enrollment-service/internal/domain"
 	"github.com/dmehra2102/learning-platform/enrollment-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
 	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
 	pb_course "github.com/dmehra2102/learning-platform/shared/proto/course"
 	pb_payment "github.com/dmehra2102/learning-platform/shared/proto/payment"
@@ -16,137 +19,399 @@ import (
 )
 
 type EnrollmentRequest struct {
-	UserID       string
-	CourseID     string
-	Amount       float64
-	PaymentToken string
+	UserID             string
+	CourseID           string
+	CouponCode         string
+	PaymentToken       string
+	PrerequisitePolicy domain.PrerequisitePolicy
+}
+
+type EnrollmentResult struct {
+	Enrollment *domain.Enrollment
+	// UnmetPrerequisites is only set under PrerequisitesWarn.
+	UnmetPrerequisites []domain.UnmetPrerequisite
+}
+
+type BundleEnrollmentRequest struct {
+	UserID             string
+	BundleID           string
+	PaymentToken       string
+	PrerequisitePolicy domain.PrerequisitePolicy
+}
+
+type BundleEnrollmentResult struct {
+	PurchaseID         string
+	Enrollments        []*domain.Enrollment
+	UnmetPrerequisites []domain.UnmetPrerequisite
+}
+
+// Producers holds one producer per topic the saga publishes to.
+type Producers struct {
+	EnrollmentSuccess   *kafka.Producer
+	EnrollmentFailed    *kafka.Producer
+	EnrollmentCancelled *kafka.Producer
 }
 
 type EnrollmentSagaOrchestrator struct {
 	enrollmentRepo repository.EnrollmentRepository
 	paymentConn    *grpcLib.ClientConn
 	courseConn     *grpcLib.ClientConn
-	kafkaProducer  *kafka.Producer
-	logger         *zap.Logger
+	// serviceCreds authenticates the calls only services may make, such as
+	// redeeming coupons
+	serviceCreds *interceptor.ServiceCredentials
+	producers    Producers
+	logger       *zap.Logger
 }
 
 func NewEnrollmentSagaOrchestrator(
 	enrollmentRepo repository.EnrollmentRepository,
 	paymentConn *grpcLib.ClientConn,
 	courseConn *grpcLib.ClientConn,
-	kafkaProducer *kafka.Producer,
+	serviceCreds *interceptor.ServiceCredentials,
+	producers Producers,
 	logger *zap.Logger,
 ) *EnrollmentSagaOrchestrator {
 	return &EnrollmentSagaOrchestrator{
 		enrollmentRepo: enrollmentRepo,
 		paymentConn:    paymentConn,
 		courseConn:     courseConn,
-		kafkaProducer:  kafkaProducer,
+		serviceCreds:   serviceCreds,
+		producers:      producers,
 		logger:         logger,
 	}
 }
 
-func (o *EnrollmentSagaOrchestrator) Execute(ctx context.Context, req EnrollmentRequest) (*domain.Enrollment, error) {
-	// Step-1 : Create enrollment_id in PENDING status
+func (o *EnrollmentSagaOrchestrator) Execute(ctx context.Context, req EnrollmentRequest) (*EnrollmentResult, error) {
+	// Step-0 : Check prerequisites before anything is charged
+	unmet, err := o.unmetPrerequisites(ctx, req.UserID, req.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(unmet) > 0 && req.PrerequisitePolicy != domain.PrerequisitesWarn {
+		return nil, &domain.PrerequisiteError{Unmet: unmet}
+	}
+
+	// Step-1 : Price the course and create enrollment_id in PENDING status.
+	// The price comes from course-service, never from the client.
+	enrollmentID := uuid.New().String()
+	quote, err := o.priceCourse(ctx, req, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+
 	enrollment := &domain.Enrollment{
-		ID:         uuid.New().String(),
-		UserID:     req.UserID,
-		CourseID:   req.CourseID,
-		Status:     domain.StatusPending,
-		AmountPaid: req.Amount,
-		EnrolledAt: time.Now(),
+		ID:             enrollmentID,
+		UserID:         req.UserID,
+		CourseID:       req.CourseID,
+		Status:         domain.StatusPending,
+		ListPrice:      quote.ListPrice,
+		DiscountAmount: quote.Discount,
+		CouponCode:     quote.CouponCode,
+		AmountPaid:     quote.FinalPrice,
+		EnrolledAt:     time.Now(),
 	}
 
 	if err := enrollment.Validate(); err != nil {
+		o.releaseCoupon(ctx, enrollment)
 		return nil, err
 	}
 
 	if err := o.enrollmentRepo.Create(ctx, enrollment); err != nil {
 		o.logger.Error("failed to create enrollment", zap.Error(err))
+		o.releaseCoupon(ctx, enrollment)
 		return nil, err
 	}
 
 	o.logger.Info("enrollment created in PENDING status", zap.String("enrollment_id", enrollment.ID))
 
-	// Step-2: Process payment
-	paymentID, err := o.processPayment(ctx, req.UserID, req.Amount, req.PaymentToken, req.CourseID)
-	if err != nil {
-		o.logger.Error("payment processing failed", zap.Error(err), zap.String("enrollment_id", enrollment.ID))
-		enrollment.Status = domain.StatusCancelled
-		_ = o.enrollmentRepo.Update(ctx, enrollment)
-		return nil, fmt.Errorf("payment failed: %w", err)
-	}
+	// Step-2: Process payment. Fully discounted enrollments have nothing to charge.
+	if enrollment.AmountPaid > 0 {
+		paymentID, err := o.processPayment(ctx, &pb_payment.ProcessPaymentRequest{
+			UserId:       req.UserID,
+			Amount:       enrollment.AmountPaid,
+			PaymentToken: req.PaymentToken,
+			CourseId:     req.CourseID,
+		})
+		if err != nil {
+			o.logger.Error("payment processing failed", zap.Error(err), zap.String("enrollment_id", enrollment.ID))
+			enrollment.Status = domain.StatusCancelled
+			_ = o.enrollmentRepo.Update(ctx, enrollment)
+			o.releaseCoupon(ctx, enrollment)
+			o.publishFailed(ctx, []*domain.Enrollment{enrollment}, "payment failed")
+			return nil, fmt.Errorf("payment failed: %w", err)
+		}
 
-	o.logger.Info("payment processed successfully", zap.String("payment_id", paymentID))
+		o.logger.Info("payment processed successfully", zap.String("payment_id", paymentID))
+		enrollment.PaymentID = paymentID
+	}
 
 	// Step-3: Update enrollment with payment info
-	enrollment.PaymentID = paymentID
 	enrollment.Status = domain.StatusActive
 	if err := o.enrollmentRepo.Update(ctx, enrollment); err != nil {
 		o.logger.Error("failed to update enrollment after payment", zap.Error(err))
 		// Try to refund
-		_ = o.refundPayment(ctx, paymentID)
+		if enrollment.PaymentID != "" {
+			_ = o.refundPayment(ctx, enrollment.PaymentID)
+		}
 		enrollment.Status = domain.StatusRefunded
 		_ = o.enrollmentRepo.Update(ctx, enrollment)
+		o.releaseCoupon(ctx, enrollment)
+		o.publishFailed(ctx, []*domain.Enrollment{enrollment}, "activation failed")
 		return nil, fmt.Errorf("failed to activate enrollment: %w", err)
 	}
 
 	o.logger.Info("enrollment activated", zap.String("enrollment_id", enrollment.ID))
 
 	// Step 4: Publish enrollment event
-	event := domain.EnrollmentEvent{
+	event := kafka.EnrollmentSuccessEvent{
 		EnrollmentID: enrollment.ID,
 		UserID:       enrollment.UserID,
 		CourseID:     enrollment.CourseID,
-		Status:       enrollment.Status,
-		Amount:       enrollment.AmountPaid,
+		EnrolledAt:   enrollment.EnrolledAt,
 		Timestamp:    time.Now(),
 	}
 
-	if err := o.kafkaProducer.PublishMessage(ctx, enrollment.ID, event); err != nil {
+	if err := o.producers.EnrollmentSuccess.PublishMessage(ctx, enrollment.ID, event); err != nil {
 		o.logger.Warn("failed to publish enrollment event", zap.Error(err))
 	}
 
 	o.logger.Info("enrollment saga completed successfully", zap.String("enrollment_id", enrollment.ID))
-	return enrollment, nil
+	return &EnrollmentResult{Enrollment: enrollment, UnmetPrerequisites: unmet}, nil
 }
 
+// ExecuteBundle enrolls the user in every course of a bundle for a single
+// payment. The enrollments are created together and compensated together.
+// A user who already holds any of the courses can't buy the bundle, so no
+// course is ever paid for twice.
+func (o *EnrollmentSagaOrchestrator) ExecuteBundle(ctx context.Context, req BundleEnrollmentRequest) (*BundleEnrollmentResult, error) {
+	client := pb_course.NewCourseServiceClient(o.courseConn)
+
+	// Step-0 : Refuse courses the user already holds before anything is priced
+	bundle, err := client.GetBundle(ctx, &pb_course.GetBundleRequest{Id: req.BundleID})
+	if err != nil {
+		return nil, fmt.Errorf("course service error: %w", err)
+	}
+	if err := o.checkNotHeld(ctx, req.UserID, bundle.Bundle.CourseIds); err != nil {
+		return nil, err
+	}
+
+	// Step-1 : Price the bundle. Course-service refuses unless every member is on sale
+	quote, err := client.QuoteBundle(ctx, &pb_course.QuoteBundleRequest{BundleId: req.BundleID})
+	if err != nil {
+		return nil, fmt.Errorf("course service error: %w", err)
+	}
+
+	// The instructor may have changed the bundle in between
+	if !slices.Equal(quote.Bundle.CourseIds, bundle.Bundle.CourseIds) {
+		if err := o.checkNotHeld(ctx, req.UserID, quote.Bundle.CourseIds); err != nil {
+			return nil, err
+		}
+	}
+
+	// Step-2 : Check prerequisites. Ones the bundle itself provides count as met
+	inBundle := make(map[string]bool, len(quote.Courses))
+	for _, course := range quote.Courses {
+		inBundle[course.Id] = true
+	}
+
+	var unmet []domain.UnmetPrerequisite
+	for _, course := range quote.Courses {
+		courseUnmet, err := o.unmetPrerequisites(ctx, req.UserID, course.Id)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range courseUnmet {
+			if !inBundle[u.CourseID] {
+				inBundle[u.CourseID] = true
+				unmet = append(unmet, u)
+			}
+		}
+	}
+	if len(unmet) > 0 && req.PrerequisitePolicy != domain.PrerequisitesWarn {
+		return nil, &domain.PrerequisiteError{Unmet: unmet}
+	}
+
+	// Step-3 : Create one PENDING enrollment per course, all or nothing
+	listPrices := make([]float64, len(quote.Courses))
+	for i, course := range quote.Courses {
+		listPrices[i] = course.Price
+	}
+	shares := domain.SplitBundlePrice(quote.Bundle.Price, listPrices)
+
+	purchaseID := uuid.New().String()
+	enrollments := make([]*domain.Enrollment, len(quote.Courses))
+	for i, course := range quote.Courses {
+		enrollments[i] = &domain.Enrollment{
+			ID:               uuid.New().String(),
+			UserID:           req.UserID,
+			CourseID:         course.Id,
+			Status:           domain.StatusPending,
+			ListPrice:        course.Price,
+			DiscountAmount:   math.Max(course.Price-shares[i], 0),
+			BundleID:         req.BundleID,
+			BundlePurchaseID: purchaseID,
+			AmountPaid:       shares[i],
+			EnrolledAt:       time.Now(),
+		}
+		if err := enrollments[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := o.enrollmentRepo.CreateBatch(ctx, enrollments); err != nil {
+		o.logger.Error("failed to create bundle enrollments", zap.Error(err))
+		return nil, err
+	}
+
+	o.logger.Info("bundle enrollments created in PENDING status", zap.String("purchase_id", purchaseID))
+
+	// Step-4 : One payment for the whole bundle
+	var paymentID string
+	if quote.Bundle.Price > 0 {
+		paymentID, err = o.processPayment(ctx, &pb_payment.ProcessPaymentRequest{
+			UserId:       req.UserID,
+			Amount:       quote.Bundle.Price,
+			PaymentToken: req.PaymentToken,
+			BundleId:     req.BundleID,
+		})
+		if err != nil {
+			o.logger.Error("bundle payment failed", zap.Error(err), zap.String("purchase_id", purchaseID))
+			setStatus(enrollments, domain.StatusCancelled)
+			_ = o.enrollmentRepo.UpdateBatch(ctx, enrollments)
+			o.publishFailed(ctx, enrollments, "payment failed")
+			return nil, fmt.Errorf("payment failed: %w", err)
+		}
+
+		o.logger.Info("bundle payment processed successfully", zap.String("payment_id", paymentID))
+	}
+
+	// Step-5 : Activate every enrollment, or refund the whole purchase
+	for _, enrollment := range enrollments {
+		enrollment.PaymentID = paymentID
+	}
+	setStatus(enrollments, domain.StatusActive)
+	if err := o.enrollmentRepo.UpdateBatch(ctx, enrollments); err != nil {
+		o.logger.Error("failed to activate bundle enrollments", zap.Error(err))
+		if paymentID != "" {
+			_ = o.refundPayment(ctx, paymentID)
+		}
+		setStatus(enrollments, domain.StatusRefunded)
+		_ = o.enrollmentRepo.UpdateBatch(ctx, enrollments)
+		o.publishFailed(ctx, enrollments, "activation failed")
+		return nil, fmt.Errorf("failed to activate enrollments: %w", err)
+	}
+
+	// Step-6 : Publish one enrollment event per course
+	for _, enrollment := range enrollments {
+		event := kafka.EnrollmentSuccessEvent{
+			EnrollmentID: enrollment.ID,
+			UserID:       enrollment.UserID,
+			CourseID:     enrollment.CourseID,
+			EnrolledAt:   enrollment.EnrolledAt,
+			Timestamp:    time.Now(),
+		}
+
+		if err := o.producers.EnrollmentSuccess.PublishMessage(ctx, enrollment.ID, event); err != nil {
+			o.logger.Warn("failed to publish enrollment event", zap.Error(err))
+		}
+	}
+
+	o.logger.Info("bundle enrollment saga completed successfully", zap.String("purchase_id", purchaseID))
+	return &BundleEnrollmentResult{PurchaseID: purchaseID, Enrollments: enrollments, UnmetPrerequisites: unmet}, nil
+}
+
+// CancelEnrollment cancels an enrollment and refunds its payment. A bundled
+// enrollment shares one payment with the rest of its purchase, so it can only
+// be cancelled through CancelBundlePurchase.
 func (o *EnrollmentSagaOrchestrator) CancelEnrollment(ctx context.Context, enrollmentID string) error {
 	enrollment, err := o.enrollmentRepo.GetByID(ctx, enrollmentID)
 	if err != nil {
 		return err
 	}
 
-	if !enrollment.CanBeCancelled() {
-		return fmt.Errorf("enrollment cannot be cancelled in status: %s", enrollment.Status)
+	if enrollment.IsBundled() {
+		return domain.ErrBundledEnrollment
 	}
 
-	if enrollment.PaymentID != "" {
-		if err := o.refundPayment(ctx, enrollment.PaymentID); err != nil {
-			o.logger.Error("failed to refund payment", zap.Error(err), zap.String("payment_id", enrollment.PaymentID))
-		}
+	return o.cancel(ctx, []*domain.Enrollment{enrollment}, enrollment.PaymentID)
+}
+
+// CancelBundlePurchase cancels every enrollment of a bundle purchase and
+// refunds its payment in full, but only while none of its courses has been
+// completed.
+func (o *EnrollmentSagaOrchestrator) CancelBundlePurchase(ctx context.Context, purchaseID string) error {
+	enrollments, err := o.enrollmentRepo.ListByBundlePurchase(ctx, purchaseID)
+	if err != nil {
 		return err
 	}
+	if len(enrollments) == 0 {
+		return domain.ErrEnrollmentNotFound
+	}
+
+	return o.cancel(ctx, enrollments, enrollments[0].PaymentID)
+}
+
+// cancel refunds paymentID, if there is one, and cancels the enrollments it
+// paid for. Nothing happens unless every enrollment can be cancelled.
+func (o *EnrollmentSagaOrchestrator) cancel(ctx context.Context, enrollments []*domain.Enrollment, paymentID string) error {
+	for _, e := range enrollments {
+		if !e.CanBeCancelled() {
+			return fmt.Errorf("enrollment cannot be cancelled in status: %s", e.Status)
+		}
+	}
+
+	status := domain.StatusCancelled
+	if paymentID != "" {
+		if err := o.refundPayment(ctx, paymentID); err != nil {
+			o.logger.Error("failed to refund payment", zap.Error(err), zap.String("payment_id", paymentID))
+			return err
+		}
+		status = domain.StatusRefunded
+	}
 
 	// Update enrollment status
-	enrollment.Status = domain.StatusCancelled
-	if err := o.enrollmentRepo.Update(ctx, enrollment); err != nil {
+	setStatus(enrollments, status)
+	if err := o.enrollmentRepo.UpdateBatch(ctx, enrollments); err != nil {
 		return err
 	}
 
-	o.logger.Info("enrollment cancelled", zap.String("enrollment_id", enrollmentID))
+	o.logger.Info("enrollments cancelled", zap.String("enrollment_id", enrollments[0].ID), zap.Int("enrollments", len(enrollments)))
+
+	for _, e := range enrollments {
+		event := kafka.EnrollmentCancelledEvent{
+			EnrollmentID: e.ID,
+			UserID:       e.UserID,
+			CourseID:     e.CourseID,
+			Refunded:     status == domain.StatusRefunded,
+			Timestamp:    time.Now(),
+		}
+		if err := o.producers.EnrollmentCancelled.PublishMessage(ctx, e.ID, event); err != nil {
+			o.logger.Warn("failed to publish enrollment cancelled event", zap.Error(err))
+		}
+	}
+
 	return nil
 }
 
-func (o *EnrollmentSagaOrchestrator) processPayment(ctx context.Context, userID string, amount float64, token string, courseID string) (string, error) {
-	client := pb_payment.NewPaymentServiceClient(o.paymentConn)
-
-	req := &pb_payment.ProcessPaymentRequest{
-		UserId:       userID,
-		Amount:       amount,
-		PaymentToken: token,
-		CourseId:     courseID,
+// publishFailed tells other services the enrollments were compensated and
+// will never become active.
+func (o *EnrollmentSagaOrchestrator) publishFailed(ctx context.Context, enrollments []*domain.Enrollment, reason string) {
+	for _, e := range enrollments {
+		event := kafka.EnrollmentFailedEvent{
+			EnrollmentID: e.ID,
+			UserID:       e.UserID,
+			CourseID:     e.CourseID,
+			Reason:       reason,
+			Timestamp:    time.Now(),
+		}
+		if err := o.producers.EnrollmentFailed.PublishMessage(ctx, e.ID, event); err != nil {
+			o.logger.Warn("failed to publish enrollment failed event", zap.Error(err))
+		}
 	}
+}
+
+func (o *EnrollmentSagaOrchestrator) processPayment(ctx context.Context, req *pb_payment.ProcessPaymentRequest) (string, error) {
+	client := pb_payment.NewPaymentServiceClient(o.paymentConn)
 
 	resp, err := client.ProcessPayment(ctx, req)
 	if err != nil {
@@ -194,3 +459,106 @@ func (o *EnrollmentSagaOrchestrator) ValidateCourse(ctx context.Context, courseI
 
 	return resp != nil && resp.Course != nil, nil
 }
+
+// LearningPathProgress measures a user's progress along a learning path by
+// their completed enrollments.
+func (o *EnrollmentSagaOrchestrator) LearningPathProgress(ctx context.Context, userID, pathID string) (*domain.PathProgress, error) {
+	client := pb_course.NewCourseServiceClient(o.courseConn)
+
+	resp, err := client.GetLearningPath(ctx, &pb_course.GetLearningPathRequest{Id: pathID})
+	if err != nil {
+		return nil, fmt.Errorf("course service error: %w", err)
+	}
+
+	completed, err := o.enrollmentRepo.CompletedCourseIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPathProgress(pathID, resp.Path.CourseIds, completed), nil
+}
+
+func (o *EnrollmentSagaOrchestrator) unmetPrerequisites(ctx context.Context, userID, courseID string) ([]domain.UnmetPrerequisite, error) {
+	client := pb_course.NewCourseServiceClient(o.courseConn)
+
+	resp, err := client.ListCoursePrerequisites(ctx, &pb_course.ListCoursePrerequisitesRequest{CourseId: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("course service error: %w", err)
+	}
+	if len(resp.Prerequisites) == 0 {
+		return nil, nil
+	}
+
+	completed, err := o.enrollmentRepo.CompletedCourseIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var unmet []domain.UnmetPrerequisite
+	for _, p := range resp.Prerequisites {
+		if !completed[p.RequiredCourseId] {
+			unmet = append(unmet, domain.UnmetPrerequisite{CourseID: p.RequiredCourseId, Title: p.RequiredCourseTitle})
+		}
+	}
+
+	return unmet, nil
+}
+
+// priceCourse asks course-service for the checkout price. With a coupon the
+// quote also holds one use of it for this enrollment, which releaseCoupon
+// hands back if the saga fails.
+func (o *EnrollmentSagaOrchestrator) priceCourse(ctx context.Context, req EnrollmentRequest, enrollmentID string) (*pb_course.PriceQuote, error) {
+	client := pb_course.NewCourseServiceClient(o.courseConn)
+
+	if req.CouponCode == "" {
+		resp, err := client.QuotePrice(ctx, &pb_course.QuotePriceRequest{CourseId: req.CourseID})
+		if err != nil {
+			return nil, fmt.Errorf("course service error: %w", err)
+		}
+		return resp.Quote, nil
+	}
+
+	resp, err := client.RedeemCoupon(interceptor.OnBehalfOf(ctx, req.UserID), &pb_course.RedeemCouponRequest{
+		CourseId:     req.CourseID,
+		CouponCode:   req.CouponCode,
+		EnrollmentId: enrollmentID,
+	}, grpcLib.PerRPCCredentials(o.serviceCreds))
+	if err != nil {
+		return nil, fmt.Errorf("course service error: %w", err)
+	}
+
+	return resp.Quote, nil
+}
+
+func (o *EnrollmentSagaOrchestrator) releaseCoupon(ctx context.Context, enrollment *domain.Enrollment) {
+	if enrollment.CouponCode == "" {
+		return
+	}
+
+	client := pb_course.NewCourseServiceClient(o.courseConn)
+
+	if _, err := client.ReleaseCoupon(interceptor.OnBehalfOf(ctx, enrollment.UserID), &pb_course.ReleaseCouponRequest{
+		EnrollmentId: enrollment.ID,
+	}, grpcLib.PerRPCCredentials(o.serviceCreds)); err != nil {
+		o.logger.Error("failed to release coupon", zap.Error(err), zap.String("enrollment_id", enrollment.ID))
+	}
+}
+
+// checkNotHeld refuses the enrollment when the user already holds any of
+// courseIDs.
+func (o *EnrollmentSagaOrchestrator) checkNotHeld(ctx context.Context, userID string, courseIDs []string) error {
+	held, err := o.enrollmentRepo.HeldCourseIDs(ctx, userID, courseIDs)
+	if err != nil {
+		return err
+	}
+	if len(held) > 0 {
+		return domain.ErrAlreadyEnrolled
+	}
+	return nil
+}
+
+func setStatus(enrollments []*domain.Enrollment, status domain.EnrollmentStatus) {
+	for _, enrollment := range enrollments {
+		enrollment.Status = status
+	}
+}
@@ -0,0 +1,569 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/enrollment-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/enrollment-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	pb_course "github.com/dmehra2102/learning-platform/shared/proto/course"
+	pb_payment "github.com/dmehra2102/learning-platform/shared/proto/payment"
+	"go.uber.org/zap"
+	grpcLib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// The saga talks to course-service and payment-service over gRPC, so the
+// tests serve fakes of both over an in-memory listener. The fake repository
+// embeds the interface and only implements what the saga calls.
+
+type fakeEnrollmentRepo struct {
+	repository.EnrollmentRepository
+	mu          sync.Mutex
+	enrollments map[string]*domain.Enrollment
+}
+
+func newFakeEnrollmentRepo(existing ...*domain.Enrollment) *fakeEnrollmentRepo {
+	r := &fakeEnrollmentRepo{enrollments: make(map[string]*domain.Enrollment)}
+	for _, e := range existing {
+		r.enrollments[e.ID] = e
+	}
+	return r
+}
+
+func (r *fakeEnrollmentRepo) Create(ctx context.Context, enrollment *domain.Enrollment) error {
+	return r.CreateBatch(ctx, []*domain.Enrollment{enrollment})
+}
+
+func (r *fakeEnrollmentRepo) CreateBatch(ctx context.Context, enrollments []*domain.Enrollment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range enrollments {
+		copied := *e
+		r.enrollments[e.ID] = &copied
+	}
+	return nil
+}
+
+func (r *fakeEnrollmentRepo) GetByID(ctx context.Context, id string) (*domain.Enrollment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.enrollments[id]
+	if !ok {
+		return nil, domain.ErrEnrollmentNotFound
+	}
+	copied := *e
+	return &copied, nil
+}
+
+func (r *fakeEnrollmentRepo) Update(ctx context.Context, enrollment *domain.Enrollment) error {
+	return r.UpdateBatch(ctx, []*domain.Enrollment{enrollment})
+}
+
+func (r *fakeEnrollmentRepo) UpdateBatch(ctx context.Context, enrollments []*domain.Enrollment) error {
+	return r.CreateBatch(ctx, enrollments)
+}
+
+func (r *fakeEnrollmentRepo) ListByBundlePurchase(ctx context.Context, purchaseID string) ([]*domain.Enrollment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*domain.Enrollment
+	for _, e := range r.enrollments {
+		if e.BundlePurchaseID == purchaseID {
+			copied := *e
+			list = append(list, &copied)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeEnrollmentRepo) CompletedCourseIDs(ctx context.Context, userID string) (map[string]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	completed := make(map[string]bool)
+	for _, e := range r.enrollments {
+		if e.UserID == userID && e.Status == domain.StatusCompleted {
+			completed[e.CourseID] = true
+		}
+	}
+	return completed, nil
+}
+
+func (r *fakeEnrollmentRepo) HeldCourseIDs(ctx context.Context, userID string, courseIDs []string) (map[string]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	held := make(map[string]bool)
+	for _, e := range r.enrollments {
+		if e.UserID == userID && slices.Contains(courseIDs, e.CourseID) &&
+			(e.Status == domain.StatusPending || e.Status == domain.StatusActive || e.Status == domain.StatusCompleted) {
+			held[e.CourseID] = true
+		}
+	}
+	return held, nil
+}
+
+// statuses maps each course the user has an enrollment for to its status.
+func (r *fakeEnrollmentRepo) statuses(userID string) map[string]domain.EnrollmentStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	statuses := make(map[string]domain.EnrollmentStatus)
+	for _, e := range r.enrollments {
+		if e.UserID == userID {
+			statuses[e.CourseID] = e.Status
+		}
+	}
+	return statuses
+}
+
+type fakeCourseServer struct {
+	pb_course.UnimplementedCourseServiceServer
+	prices        map[string]float64
+	prerequisites map[string][]string
+	bundle        *pb_course.Bundle
+	quotes        int
+}
+
+func (s *fakeCourseServer) QuotePrice(ctx context.Context, req *pb_course.QuotePriceRequest) (*pb_course.PriceQuoteResponse, error) {
+	price := s.prices[req.CourseId]
+	return &pb_course.PriceQuoteResponse{Quote: &pb_course.PriceQuote{
+		CourseId:   req.CourseId,
+		ListPrice:  price,
+		FinalPrice: price,
+	}}, nil
+}
+
+func (s *fakeCourseServer) ListCoursePrerequisites(ctx context.Context, req *pb_course.ListCoursePrerequisitesRequest) (*pb_course.ListCoursePrerequisitesResponse, error) {
+	var prerequisites []*pb_course.CoursePrerequisite
+	for _, id := range s.prerequisites[req.CourseId] {
+		prerequisites = append(prerequisites, &pb_course.CoursePrerequisite{
+			CourseId:            req.CourseId,
+			RequiredCourseId:    id,
+			RequiredCourseTitle: "Course " + id,
+		})
+	}
+	return &pb_course.ListCoursePrerequisitesResponse{Prerequisites: prerequisites}, nil
+}
+
+func (s *fakeCourseServer) GetBundle(ctx context.Context, req *pb_course.GetBundleRequest) (*pb_course.BundleResponse, error) {
+	return &pb_course.BundleResponse{Bundle: s.bundle}, nil
+}
+
+func (s *fakeCourseServer) QuoteBundle(ctx context.Context, req *pb_course.QuoteBundleRequest) (*pb_course.BundleQuoteResponse, error) {
+	s.quotes++
+	var courses []*pb_course.Course
+	for _, id := range s.bundle.CourseIds {
+		courses = append(courses, &pb_course.Course{Id: id, Price: s.prices[id]})
+	}
+	return &pb_course.BundleQuoteResponse{Bundle: s.bundle, Courses: courses}, nil
+}
+
+type fakePaymentServer struct {
+	pb_payment.UnimplementedPaymentServiceServer
+	mu      sync.Mutex
+	charges []*pb_payment.ProcessPaymentRequest
+	refunds []*pb_payment.RefundPaymentRequest
+}
+
+func (s *fakePaymentServer) ProcessPayment(ctx context.Context, req *pb_payment.ProcessPaymentRequest) (*pb_payment.PaymentResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.charges = append(s.charges, req)
+	return &pb_payment.PaymentResponse{Payment: &pb_payment.Payment{Id: "payment-1", Amount: req.Amount, Status: pb_payment.PaymentStatus_COMPLETED}}, nil
+}
+
+func (s *fakePaymentServer) RefundPayment(ctx context.Context, req *pb_payment.RefundPaymentRequest) (*pb_payment.RefundResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refunds = append(s.refunds, req)
+	return &pb_payment.RefundResponse{OriginalPaymentId: req.Id, Status: pb_payment.PaymentStatus_COMPLETED}, nil
+}
+
+// newTestSaga serves the fakes and returns a saga wired to them. Events go to
+// producers without brokers, which fail at once.
+func newTestSaga(t *testing.T, repo *fakeEnrollmentRepo, course *fakeCourseServer, payment *fakePaymentServer) *EnrollmentSagaOrchestrator {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpcLib.NewServer()
+	pb_course.RegisterCourseServiceServer(server, course)
+	pb_payment.RegisterPaymentServiceServer(server, payment)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpcLib.NewClient("passthrough:///bufnet",
+		grpcLib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpcLib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial fakes: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	producer := func(topic string) *kafka.Producer {
+		p := kafka.NewProducer(nil, topic, zap.NewNop())
+		t.Cleanup(func() { p.Close() })
+		return p
+	}
+
+	return NewEnrollmentSagaOrchestrator(repo, conn, conn, nil, Producers{
+		EnrollmentSuccess:   producer("enrollment.success"),
+		EnrollmentFailed:    producer("enrollment.failed"),
+		EnrollmentCancelled: producer("enrollment.cancelled"),
+	}, zap.NewNop())
+}
+
+func completed(userID, courseID string) *domain.Enrollment {
+	return &domain.Enrollment{ID: "done-" + courseID, UserID: userID, CourseID: courseID, Status: domain.StatusCompleted}
+}
+
+func unmetIDs(unmet []domain.UnmetPrerequisite) []string {
+	var ids []string
+	for _, u := range unmet {
+		ids = append(ids, u.CourseID)
+	}
+	return ids
+}
+
+func TestExecutePrerequisites(t *testing.T) {
+	const userID = "user-1"
+
+	tests := []struct {
+		name      string
+		policy    domain.PrerequisitePolicy
+		existing  []*domain.Enrollment
+		wantUnmet []string
+		wantErr   bool
+	}{
+		{
+			name:     "prerequisites completed",
+			policy:   domain.PrerequisitesEnforce,
+			existing: []*domain.Enrollment{completed(userID, "c1"), completed(userID, "c2")},
+		},
+		{
+			name:      "enforced prerequisites block before charging",
+			policy:    domain.PrerequisitesEnforce,
+			existing:  []*domain.Enrollment{completed(userID, "c1")},
+			wantUnmet: []string{"c2"},
+			wantErr:   true,
+		},
+		{
+			name:      "no policy enforces",
+			existing:  []*domain.Enrollment{completed(userID, "c2")},
+			wantUnmet: []string{"c1"},
+			wantErr:   true,
+		},
+		{
+			name:      "warned prerequisites are reported",
+			policy:    domain.PrerequisitesWarn,
+			wantUnmet: []string{"c1", "c2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeEnrollmentRepo(tt.existing...)
+			payment := &fakePaymentServer{}
+			s := newTestSaga(t, repo, &fakeCourseServer{
+				prices:        map[string]float64{"c3": 40},
+				prerequisites: map[string][]string{"c3": {"c1", "c2"}},
+			}, payment)
+
+			result, err := s.Execute(context.Background(), EnrollmentRequest{UserID: userID, CourseID: "c3", PrerequisitePolicy: tt.policy})
+
+			if tt.wantErr {
+				var prerequisiteErr *domain.PrerequisiteError
+				if !errors.As(err, &prerequisiteErr) {
+					t.Fatalf("Execute() error = %v, want a PrerequisiteError", err)
+				}
+				if got := unmetIDs(prerequisiteErr.Unmet); !slices.Equal(got, tt.wantUnmet) {
+					t.Errorf("unmet = %v, want %v", got, tt.wantUnmet)
+				}
+				if len(payment.charges) != 0 {
+					t.Errorf("charged %d times, want none", len(payment.charges))
+				}
+				if _, ok := repo.statuses(userID)["c3"]; ok {
+					t.Error("created an enrollment for the blocked course")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got := unmetIDs(result.UnmetPrerequisites); !slices.Equal(got, tt.wantUnmet) {
+				t.Errorf("UnmetPrerequisites = %v, want %v", got, tt.wantUnmet)
+			}
+			if got := repo.statuses(userID)["c3"]; got != domain.StatusActive {
+				t.Errorf("enrollment status = %s, want %s", got, domain.StatusActive)
+			}
+		})
+	}
+}
+
+func TestExecuteBundlePrerequisites(t *testing.T) {
+	const userID = "user-1"
+
+	// c2 needs c1, which the bundle provides; c3 needs c9, which it doesn't
+	course := func() *fakeCourseServer {
+		return &fakeCourseServer{
+			prices:        map[string]float64{"c1": 30, "c2": 30, "c3": 40},
+			prerequisites: map[string][]string{"c2": {"c1"}, "c3": {"c9"}},
+			bundle:        &pb_course.Bundle{Id: "bundle-1", CourseIds: []string{"c1", "c2", "c3"}, Price: 80},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		policy    domain.PrerequisitePolicy
+		existing  []*domain.Enrollment
+		wantUnmet []string
+		wantErr   bool
+	}{
+		{
+			name:     "bundle and history cover every prerequisite",
+			policy:   domain.PrerequisitesEnforce,
+			existing: []*domain.Enrollment{completed(userID, "c9")},
+		},
+		{
+			name:      "prerequisite outside the bundle blocks",
+			policy:    domain.PrerequisitesEnforce,
+			wantUnmet: []string{"c9"},
+			wantErr:   true,
+		},
+		{
+			name:      "prerequisite outside the bundle is reported",
+			policy:    domain.PrerequisitesWarn,
+			wantUnmet: []string{"c9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeEnrollmentRepo(tt.existing...)
+			payment := &fakePaymentServer{}
+			s := newTestSaga(t, repo, course(), payment)
+
+			result, err := s.ExecuteBundle(context.Background(), BundleEnrollmentRequest{UserID: userID, BundleID: "bundle-1", PrerequisitePolicy: tt.policy})
+
+			if tt.wantErr {
+				var prerequisiteErr *domain.PrerequisiteError
+				if !errors.As(err, &prerequisiteErr) {
+					t.Fatalf("ExecuteBundle() error = %v, want a PrerequisiteError", err)
+				}
+				if got := unmetIDs(prerequisiteErr.Unmet); !slices.Equal(got, tt.wantUnmet) {
+					t.Errorf("unmet = %v, want %v", got, tt.wantUnmet)
+				}
+				if len(payment.charges) != 0 {
+					t.Errorf("charged %d times, want none", len(payment.charges))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ExecuteBundle() error = %v", err)
+			}
+			if got := unmetIDs(result.UnmetPrerequisites); !slices.Equal(got, tt.wantUnmet) {
+				t.Errorf("UnmetPrerequisites = %v, want %v", got, tt.wantUnmet)
+			}
+			if len(result.Enrollments) != 3 {
+				t.Errorf("enrolled in %d courses, want 3", len(result.Enrollments))
+			}
+		})
+	}
+}
+
+func TestExecuteBundleHeldCourses(t *testing.T) {
+	const userID = "user-1"
+
+	held := func(courseID string, status domain.EnrollmentStatus) *domain.Enrollment {
+		return &domain.Enrollment{ID: "held-" + courseID, UserID: userID, CourseID: courseID, Status: status}
+	}
+
+	tests := []struct {
+		name     string
+		existing []*domain.Enrollment
+		wantErr  error
+	}{
+		{name: "no earlier enrollments"},
+		{name: "cancelled course can be bought again", existing: []*domain.Enrollment{held("c2", domain.StatusCancelled)}},
+		{name: "refunded course can be bought again", existing: []*domain.Enrollment{held("c2", domain.StatusRefunded)}},
+		{name: "another user's enrollment", existing: []*domain.Enrollment{{ID: "other", UserID: "user-2", CourseID: "c2", Status: domain.StatusActive}}},
+		{name: "active course", existing: []*domain.Enrollment{held("c2", domain.StatusActive)}, wantErr: domain.ErrAlreadyEnrolled},
+		{name: "pending course", existing: []*domain.Enrollment{held("c1", domain.StatusPending)}, wantErr: domain.ErrAlreadyEnrolled},
+		{name: "completed course", existing: []*domain.Enrollment{held("c1", domain.StatusCompleted)}, wantErr: domain.ErrAlreadyEnrolled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeEnrollmentRepo(tt.existing...)
+			course := &fakeCourseServer{
+				prices: map[string]float64{"c1": 30, "c2": 50},
+				bundle: &pb_course.Bundle{Id: "bundle-1", CourseIds: []string{"c1", "c2"}, Price: 60},
+			}
+			payment := &fakePaymentServer{}
+			s := newTestSaga(t, repo, course, payment)
+
+			result, err := s.ExecuteBundle(context.Background(), BundleEnrollmentRequest{UserID: userID, BundleID: "bundle-1"})
+			if err != tt.wantErr {
+				t.Fatalf("ExecuteBundle() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if course.quotes != 0 || len(payment.charges) != 0 {
+					t.Errorf("quoted %d and charged %d times, want neither", course.quotes, len(payment.charges))
+				}
+				return
+			}
+
+			if len(payment.charges) != 1 || payment.charges[0].Amount != 60 {
+				t.Errorf("charges = %v, want one of 60", payment.charges)
+			}
+			if len(result.Enrollments) != 2 {
+				t.Errorf("enrolled in %d courses, want 2", len(result.Enrollments))
+			}
+		})
+	}
+}
+
+func TestCancelEnrollment(t *testing.T) {
+	const userID = "user-1"
+
+	single := &domain.Enrollment{ID: "e1", UserID: userID, CourseID: "c1", Status: domain.StatusActive, PaymentID: "payment-single"}
+	free := &domain.Enrollment{ID: "e2", UserID: userID, CourseID: "c2", Status: domain.StatusActive}
+	bundled := &domain.Enrollment{ID: "e3", UserID: userID, CourseID: "c3", Status: domain.StatusActive, PaymentID: "payment-bundle", BundlePurchaseID: "purchase-1"}
+	sibling := &domain.Enrollment{ID: "e4", UserID: userID, CourseID: "c4", Status: domain.StatusActive, PaymentID: "payment-bundle", BundlePurchaseID: "purchase-1"}
+
+	tests := []struct {
+		name         string
+		enrollmentID string
+		wantErr      error
+		wantRefunds  []string
+		wantStatuses map[string]domain.EnrollmentStatus
+	}{
+		{
+			name:         "paid enrollment is refunded",
+			enrollmentID: "e1",
+			wantRefunds:  []string{"payment-single"},
+			wantStatuses: map[string]domain.EnrollmentStatus{"c1": domain.StatusRefunded, "c3": domain.StatusActive, "c4": domain.StatusActive},
+		},
+		{
+			name:         "free enrollment is cancelled",
+			enrollmentID: "e2",
+			wantStatuses: map[string]domain.EnrollmentStatus{"c2": domain.StatusCancelled},
+		},
+		{
+			name:         "bundled enrollment on its own",
+			enrollmentID: "e3",
+			wantErr:      domain.ErrBundledEnrollment,
+			wantStatuses: map[string]domain.EnrollmentStatus{"c3": domain.StatusActive, "c4": domain.StatusActive},
+		},
+		{
+			name:         "unknown enrollment",
+			enrollmentID: "e9",
+			wantErr:      domain.ErrEnrollmentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeEnrollmentRepo(clone(single), clone(free), clone(bundled), clone(sibling))
+			payment := &fakePaymentServer{}
+			s := newTestSaga(t, repo, &fakeCourseServer{}, payment)
+
+			if err := s.CancelEnrollment(context.Background(), tt.enrollmentID); err != tt.wantErr {
+				t.Fatalf("CancelEnrollment() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := refundedIDs(payment); !slices.Equal(got, tt.wantRefunds) {
+				t.Errorf("refunds = %v, want %v", got, tt.wantRefunds)
+			}
+			statuses := repo.statuses(userID)
+			for courseID, want := range tt.wantStatuses {
+				if statuses[courseID] != want {
+					t.Errorf("%s status = %s, want %s", courseID, statuses[courseID], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCancelBundlePurchase(t *testing.T) {
+	const userID = "user-1"
+
+	member := func(id, courseID string, status domain.EnrollmentStatus) *domain.Enrollment {
+		return &domain.Enrollment{ID: id, UserID: userID, CourseID: courseID, Status: status, PaymentID: "payment-bundle", BundlePurchaseID: "purchase-1"}
+	}
+
+	tests := []struct {
+		name        string
+		members     []*domain.Enrollment
+		purchaseID  string
+		wantErr     bool
+		wantRefunds []string
+		wantStatus  domain.EnrollmentStatus
+	}{
+		{
+			name:        "whole purchase refunded once",
+			members:     []*domain.Enrollment{member("e1", "c1", domain.StatusActive), member("e2", "c2", domain.StatusActive)},
+			purchaseID:  "purchase-1",
+			wantRefunds: []string{"payment-bundle"},
+			wantStatus:  domain.StatusRefunded,
+		},
+		{
+			name:       "completed course keeps the purchase",
+			members:    []*domain.Enrollment{member("e1", "c1", domain.StatusActive), member("e2", "c2", domain.StatusCompleted)},
+			purchaseID: "purchase-1",
+			wantErr:    true,
+		},
+		{
+			name:       "unknown purchase",
+			purchaseID: "purchase-9",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeEnrollmentRepo(tt.members...)
+			before := repo.statuses(userID)
+			payment := &fakePaymentServer{}
+			s := newTestSaga(t, repo, &fakeCourseServer{}, payment)
+
+			err := s.CancelBundlePurchase(context.Background(), tt.purchaseID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CancelBundlePurchase() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got := refundedIDs(payment); !slices.Equal(got, tt.wantRefunds) {
+				t.Errorf("refunds = %v, want %v", got, tt.wantRefunds)
+			}
+
+			for courseID, status := range repo.statuses(userID) {
+				want := tt.wantStatus
+				if tt.wantErr {
+					want = before[courseID]
+				}
+				if status != want {
+					t.Errorf("%s status = %s, want %s", courseID, status, want)
+				}
+			}
+		})
+	}
+}
+
+func clone(e *domain.Enrollment) *domain.Enrollment {
+	copied := *e
+	return &copied
+}
+
+func refundedIDs(payment *fakePaymentServer) []string {
+	var ids []string
+	for _, r := range payment.refunds {
+		ids = append(ids, r.Id)
+	}
+	return ids
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/dmehra2102/learning-platform/shared/pkg/jwt"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -17,29 +18,82 @@ const (
 	UserIDKey    contextKey = "user_id"
 	UserEmailKey contextKey = "user_email"
 	UserRoleKey  contextKey = "user_role"
+	ActorIDKey   contextKey = "actor_id"
+	ServiceKey   contextKey = "service"
 )
 
 type AuthInterceptor struct {
 	jwtManager    *jwt.Manager
 	publicMethods map[string]bool
+	// Methods and service prefixes that impersonation tokens may not call
+	impersonationDenied         map[string]bool
+	impersonationDeniedServices []string
+	revocations                 *RevocationList
+	logger                      *zap.Logger
 }
 
-func NewAuthInterceptor(jwtManager *jwt.Manager) *AuthInterceptor {
+func NewAuthInterceptor(jwtManager *jwt.Manager, logger *zap.Logger) *AuthInterceptor {
 	publicMethods := map[string]bool{
-		"/user.UserService/Register":                 true,
-		"/user.UserService/Login":                    true,
-		"/course.CourseService/GetCourse":            true,
-		"/course.CourseService/ListCourse":           true,
-		"/review.ReviewService/ListCourseReviews":    true,
-		"/review.ReviewService/GetCourseRatingStats": true,
+		"/user.UserService/Register":                    true,
+		"/user.UserService/Login":                       true,
+		"/user.UserService/RequestPasswordReset":        true,
+		"/user.UserService/ResetPassword":               true,
+		"/user.UserService/GetInstructorProfile":        true,
+		"/course.CourseService/GetCourse":               true,
+		"/course.CourseService/ListCourse":              true,
+		"/course.CourseService/ListCoursePrerequisites": true,
+		"/course.CourseService/GetLearningPath":         true,
+		"/course.CourseService/ListLearningPaths":       true,
+		"/course.CourseService/QuotePrice":              true,
+		"/course.CourseService/GetBundle":               true,
+		"/course.CourseService/ListBundles":             true,
+		"/course.CourseService/QuoteBundle":             true,
+		"/course.CourseService/ListCategories":          true,
+		"/review.ReviewService/ListCourseReviews":       true,
+		"/review.ReviewService/GetCourseRatingStats":    true,
+	}
+
+	impersonationDenied := map[string]bool{
+		"/user.UserService/ChangeUserRole":                   true,
+		"/user.UserService/DeleteUser":                       true,
+		"/user.UserService/ImpersonateUser":                  true,
+		"/user.UserService/ChangePassword":                   true,
+		"/enrollment.EnrollmentService/EnrollCourse":         true,
+		"/enrollment.EnrollmentService/EnrollBundle":         true,
+		"/enrollment.EnrollmentService/CancelEnrollment":     true,
+		"/course.CourseService/DeleteCourse":                 true,
+		"/course.CourseService/TransferCourseOwnership":      true,
+		"/course.CourseService/InviteCollaborator":           true,
+		"/course.CourseService/AcceptCollaboratorInvitation": true,
+		"/course.CourseService/UpdateCollaboratorRole":       true,
+		"/course.CourseService/RemoveCollaborator":           true,
+		"/course.CourseService/CreateCoupon":                 true,
+		"/course.CourseService/DeleteCoupon":                 true,
+		"/course.CourseService/RedeemCoupon":                 true,
+		"/course.CourseService/ReleaseCoupon":                true,
+		"/course.CourseService/CreateSale":                   true,
+		"/course.CourseService/DeleteSale":                   true,
+	}
+
+	impersonationDeniedServices := []string{
+		"/payment.PaymentService/",
 	}
 
 	return &AuthInterceptor{
-		jwtManager:    jwtManager,
-		publicMethods: publicMethods,
+		jwtManager:                  jwtManager,
+		publicMethods:               publicMethods,
+		impersonationDenied:         impersonationDenied,
+		impersonationDeniedServices: impersonationDeniedServices,
+		logger:                      logger,
 	}
 }
 
+// SetRevocationList makes the interceptor reject tokens revoked through l,
+// e.g. those belonging to a user who has since been suspended.
+func (i *AuthInterceptor) SetRevocationList(l *RevocationList) {
+	i.revocations = l
+}
+
 func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -51,7 +105,7 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		newCtx, err := i.authorize(ctx)
+		newCtx, err := i.authorize(ctx, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
@@ -71,7 +125,7 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 			return handler(srv, ss)
 		}
 
-		newCtx, err := i.authorize(ss.Context())
+		newCtx, err := i.authorize(ss.Context(), info.FullMethod)
 		if err != nil {
 			return err
 		}
@@ -85,7 +139,7 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 	}
 }
 
-func (i *AuthInterceptor) authorize(ctx context.Context) (context.Context, error) {
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "missing metadata")
@@ -108,13 +162,52 @@ func (i *AuthInterceptor) authorize(ctx context.Context) (context.Context, error
 		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
 	}
 
+	if i.revocations != nil && claims.IssuedAt != nil && i.revocations.IsRevoked(claims.UserID, claims.IssuedAt.Time) {
+		return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+
 	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
 	ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
 	ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
 
+	if claims.IsService() {
+		ctx = context.WithValue(ctx, ServiceKey, claims.Service)
+	}
+
+	if claims.IsImpersonation() {
+		if i.isImpersonationDenied(method) {
+			i.logger.Warn("impersonated call denied",
+				zap.String("method", method),
+				zap.String("actor_id", claims.Actor.Subject),
+				zap.String("user_id", claims.UserID),
+			)
+			return nil, status.Error(codes.PermissionDenied, "method not allowed while impersonating")
+		}
+
+		i.logger.Info("impersonated call",
+			zap.String("method", method),
+			zap.String("actor_id", claims.Actor.Subject),
+			zap.String("user_id", claims.UserID),
+		)
+
+		ctx = context.WithValue(ctx, ActorIDKey, claims.Actor.Subject)
+	}
+
 	return ctx, nil
 }
 
+func (i *AuthInterceptor) isImpersonationDenied(method string) bool {
+	if i.impersonationDenied[method] {
+		return true
+	}
+	for _, prefix := range i.impersonationDeniedServices {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 type wrappedServerStream struct {
 	grpc.ServerStream
 	ctx context.Context
@@ -132,6 +225,20 @@ func GetUserID(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
+// GetActorID returns the admin ID when the request was made with an
+// impersonation token.
+func GetActorID(ctx context.Context) (string, bool) {
+	actorID, ok := ctx.Value(ActorIDKey).(string)
+	return actorID, ok && actorID != ""
+}
+
+// GetService returns the calling service when the request was made with a
+// service token.
+func GetService(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(ServiceKey).(string)
+	return service, ok && service != ""
+}
+
 func GetUserRole(ctx context.Context) (string, error) {
 	role, ok := ctx.Value(UserRoleKey).(string)
 	if !ok {
@@ -0,0 +1,157 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/jwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	manager := jwt.NewManager("test-secret", time.Hour, 24*time.Hour)
+
+	userToken, _ := manager.GenerateAccessToken("user-1", "user@example.com", "student")
+	impersonationToken, _ := manager.GenerateImpersonationToken("user-1", "user@example.com", "student", "admin-1", time.Hour)
+	serviceToken, _ := manager.GenerateServiceToken("enrollment-service", "user-1")
+	foreignToken, _ := jwt.NewManager("other-secret", time.Hour, time.Hour).GenerateAccessToken("user-1", "user@example.com", "student")
+
+	tests := []struct {
+		name        string
+		method      string
+		auth        string
+		wantCode    codes.Code
+		wantActor   string
+		wantService string
+	}{
+		{
+			name:     "public method needs no token",
+			method:   "/course.CourseService/GetCourse",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "missing token",
+			method:   "/course.CourseService/UpdateCourse",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "not a bearer token",
+			method:   "/course.CourseService/UpdateCourse",
+			auth:     userToken,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token signed with another key",
+			method:   "/course.CourseService/UpdateCourse",
+			auth:     "Bearer " + foreignToken,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "user token",
+			method:   "/course.CourseService/DeleteCourse",
+			auth:     "Bearer " + userToken,
+			wantCode: codes.OK,
+		},
+		{
+			name:      "impersonation on an allowed method",
+			method:    "/course.CourseService/UpdateCourse",
+			auth:      "Bearer " + impersonationToken,
+			wantCode:  codes.OK,
+			wantActor: "admin-1",
+		},
+		{
+			name:     "impersonation on a denied method",
+			method:   "/course.CourseService/TransferCourseOwnership",
+			auth:     "Bearer " + impersonationToken,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "impersonation on a denied service",
+			method:   "/payment.PaymentService/ProcessPayment",
+			auth:     "Bearer " + impersonationToken,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:        "service token",
+			method:      "/course.CourseService/RedeemCoupon",
+			auth:        "Bearer " + serviceToken,
+			wantCode:    codes.OK,
+			wantService: "enrollment-service",
+		},
+	}
+
+	interceptor := NewAuthInterceptor(manager, zap.NewNop()).Unary()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.auth != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.auth))
+			} else {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{})
+			}
+
+			var handlerCtx context.Context
+			handler := func(ctx context.Context, req any) (any, error) {
+				handlerCtx = ctx
+				return nil, nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err: %v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				if handlerCtx != nil {
+					t.Error("handler ran for a rejected call")
+				}
+				return
+			}
+
+			actorID, _ := GetActorID(handlerCtx)
+			if actorID != tt.wantActor {
+				t.Errorf("GetActorID() = %q, want %q", actorID, tt.wantActor)
+			}
+			service, _ := GetService(handlerCtx)
+			if service != tt.wantService {
+				t.Errorf("GetService() = %q, want %q", service, tt.wantService)
+			}
+		})
+	}
+}
+
+func TestServiceCredentials(t *testing.T) {
+	manager := jwt.NewManager("test-secret", time.Hour, 24*time.Hour)
+	creds := NewServiceCredentials(manager, "enrollment-service")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantUser string
+	}{
+		{"on its own", context.Background(), ""},
+		{"on behalf of a user", OnBehalfOf(context.Background(), "user-1"), "user-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := creds.GetRequestMetadata(tt.ctx)
+			if err != nil {
+				t.Fatalf("GetRequestMetadata() error = %v", err)
+			}
+
+			claims, err := manager.ValidateToken(md["authorization"][len("Bearer "):])
+			if err != nil {
+				t.Fatalf("ValidateToken() error = %v", err)
+			}
+			if claims.Service != "enrollment-service" || claims.UserID != tt.wantUser {
+				t.Errorf("claims service, user = %q, %q, want %q, %q", claims.Service, claims.UserID, "enrollment-service", tt.wantUser)
+			}
+		})
+	}
+}
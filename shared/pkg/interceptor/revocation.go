@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+)
+
+// RevocationList tracks users whose tokens issued at or before a cutoff are
+// no longer accepted. Services keep it current from user.suspended events so
+// a suspension takes effect without waiting for tokens to expire.
+type RevocationList struct {
+	mu            sync.RWMutex
+	revokedBefore map[string]time.Time
+}
+
+func NewRevocationList() *RevocationList {
+	return &RevocationList{revokedBefore: make(map[string]time.Time)}
+}
+
+func (l *RevocationList) Revoke(userID string, before time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if current, ok := l.revokedBefore[userID]; !ok || before.After(current) {
+		l.revokedBefore[userID] = before
+	}
+}
+
+func (l *RevocationList) IsRevoked(userID string, issuedAt time.Time) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	before, ok := l.revokedBefore[userID]
+	if !ok {
+		return false
+	}
+
+	// JWT iat has second precision, so compare at that precision too
+	return !issuedAt.After(before.Truncate(time.Second))
+}
+
+// Prune drops cutoffs older than maxAge. Once every token that could predate
+// a cutoff has expired the entry no longer has any effect.
+func (l *RevocationList) Prune(maxAge time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	threshold := time.Now().Add(-maxAge)
+	for userID, before := range l.revokedBefore {
+		if before.Before(threshold) {
+			delete(l.revokedBefore, userID)
+		}
+	}
+}
+
+// HandleUserSuspended is a kafka.MessageHandler for the user.suspended topic.
+func (l *RevocationList) HandleUserSuspended(ctx context.Context, key, value []byte) error {
+	var event kafka.UserSuspendedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	l.Revoke(event.UserID, event.TokensRevokedAt)
+	return nil
+}
@@ -0,0 +1,74 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+)
+
+func TestRevocationList(t *testing.T) {
+	cutoff := time.Date(2026, 6, 1, 12, 0, 0, 500_000_000, time.UTC)
+
+	tests := []struct {
+		name     string
+		userID   string
+		issuedAt time.Time
+		want     bool
+	}{
+		{"issued before the cutoff", "user-1", cutoff.Add(-time.Hour), true},
+		{"issued in the cutoff's second", "user-1", cutoff.Truncate(time.Second), true},
+		{"issued after the cutoff", "user-1", cutoff.Truncate(time.Second).Add(time.Second), false},
+		{"another user", "user-2", cutoff.Add(-time.Hour), false},
+	}
+
+	l := NewRevocationList()
+	l.Revoke("user-1", cutoff)
+	// An older cutoff arriving late doesn't move it back
+	l.Revoke("user-1", cutoff.Add(-24*time.Hour))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.IsRevoked(tt.userID, tt.issuedAt); got != tt.want {
+				t.Errorf("IsRevoked(%s, %v) = %v, want %v", tt.userID, tt.issuedAt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRevocationListPrune(t *testing.T) {
+	l := NewRevocationList()
+	l.Revoke("old", time.Now().Add(-2*time.Hour))
+	l.Revoke("recent", time.Now().Add(-time.Minute))
+
+	l.Prune(time.Hour)
+
+	if l.IsRevoked("old", time.Now().Add(-3*time.Hour)) {
+		t.Error("cutoff older than the max age kept")
+	}
+	if !l.IsRevoked("recent", time.Now().Add(-time.Hour)) {
+		t.Error("recent cutoff pruned")
+	}
+}
+
+func TestRevocationListHandleUserSuspended(t *testing.T) {
+	revokedAt := time.Now().Truncate(time.Second)
+	value, err := json.Marshal(kafka.UserSuspendedEvent{UserID: "user-1", TokensRevokedAt: revokedAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := NewRevocationList()
+	if err := l.HandleUserSuspended(context.Background(), []byte("user-1"), value); err != nil {
+		t.Fatalf("HandleUserSuspended() error = %v", err)
+	}
+	if !l.IsRevoked("user-1", revokedAt.Add(-time.Minute)) {
+		t.Error("token issued before the suspension still accepted")
+	}
+
+	if err := l.HandleUserSuspended(context.Background(), nil, []byte("not json")); err == nil {
+		t.Error("HandleUserSuspended() accepted a malformed event")
+	}
+}
@@ -16,9 +16,31 @@ type Claims struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email"`
 	Role   string `json:"role"`
+	// Actor is set on impersonation tokens and identifies the admin acting
+	// on behalf of UserID (RFC 8693 "act" claim).
+	Actor *Actor `json:"act,omitempty"`
+	// Service is set on service tokens and names the service making the
+	// call, on behalf of UserID if that's set.
+	Service string `json:"svc,omitempty"`
 	jwt.RegisteredClaims
 }
 
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+func (c *Claims) IsImpersonation() bool {
+	return c.Actor != nil && c.Actor.Subject != ""
+}
+
+func (c *Claims) IsService() bool {
+	return c.Service != ""
+}
+
+// serviceTokenTTL is how long a service token lasts. They're minted for
+// each call, so they only need to outlive the call.
+const serviceTokenTTL = time.Minute
+
 type Manager struct {
 	secretKey       []byte
 	accessTokenTTL  time.Duration
@@ -49,6 +71,43 @@ func (m *Manager) GenerateAccessToken(userID, email, role string) (string, error
 	return token.SignedString(m.secretKey)
 }
 
+func (m *Manager) GenerateImpersonationToken(userID, email, role, actorID string, ttl time.Duration) (string, error) {
+	claims := &Claims{
+		UserID: userID,
+		Email:  email,
+		Role:   role,
+		Actor:  &Actor{Subject: actorID},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secretKey)
+}
+
+// GenerateServiceToken returns a token identifying service, for calls it
+// makes to other services on behalf of userID, or on its own if userID is
+// empty.
+func (m *Manager) GenerateServiceToken(service, userID string) (string, error) {
+	claims := &Claims{
+		UserID:  userID,
+		Service: service,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   service,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(serviceTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secretKey)
+}
+
 func (m *Manager) GenerateRefreshToken(userID string) (string, error) {
 	claims := &Claims{
 		UserID: userID,
@@ -91,5 +150,11 @@ func (m *Manager) RefreshAccessToken(refreshToken string) (string, error) {
 		return "", err
 	}
 
+	// Impersonation and service tokens are deliberately short-lived and must
+	// not be exchangeable for a regular access token.
+	if claims.IsImpersonation() || claims.IsService() {
+		return "", ErrInvalidToken
+	}
+
 	return m.GenerateAccessToken(claims.UserID, claims.Email, claims.Role)
 }
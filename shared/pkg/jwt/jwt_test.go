@@ -0,0 +1,100 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenClaims(t *testing.T) {
+	m := NewManager("test-secret", time.Hour, 24*time.Hour)
+
+	tests := []struct {
+		name              string
+		generate          func() (string, error)
+		wantUser          string
+		wantImpersonation bool
+		wantService       bool
+	}{
+		{
+			name:     "access token",
+			generate: func() (string, error) { return m.GenerateAccessToken("user-1", "user@example.com", "student") },
+			wantUser: "user-1",
+		},
+		{
+			name: "impersonation token",
+			generate: func() (string, error) {
+				return m.GenerateImpersonationToken("user-1", "user@example.com", "student", "admin-1", time.Minute)
+			},
+			wantUser:          "user-1",
+			wantImpersonation: true,
+		},
+		{
+			name:        "service token on behalf of a user",
+			generate:    func() (string, error) { return m.GenerateServiceToken("enrollment-service", "user-1") },
+			wantUser:    "user-1",
+			wantService: true,
+		},
+		{
+			name:        "service token on its own",
+			generate:    func() (string, error) { return m.GenerateServiceToken("enrollment-service", "") },
+			wantService: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.generate()
+			if err != nil {
+				t.Fatalf("generate error = %v", err)
+			}
+
+			claims, err := m.ValidateToken(token)
+			if err != nil {
+				t.Fatalf("ValidateToken() error = %v", err)
+			}
+			if claims.UserID != tt.wantUser {
+				t.Errorf("UserID = %q, want %q", claims.UserID, tt.wantUser)
+			}
+			if claims.IsImpersonation() != tt.wantImpersonation {
+				t.Errorf("IsImpersonation() = %v, want %v", claims.IsImpersonation(), tt.wantImpersonation)
+			}
+			if tt.wantImpersonation && claims.Actor.Subject != "admin-1" {
+				t.Errorf("Actor = %q, want %q", claims.Actor.Subject, "admin-1")
+			}
+			if claims.IsService() != tt.wantService {
+				t.Errorf("IsService() = %v, want %v", claims.IsService(), tt.wantService)
+			}
+
+			// Only plain user tokens may be traded for an access token
+			_, err = m.RefreshAccessToken(token)
+			if wantErr := tt.wantImpersonation || tt.wantService; (err != nil) != wantErr {
+				t.Errorf("RefreshAccessToken() error = %v, want error %v", err, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTokenRejects(t *testing.T) {
+	m := NewManager("test-secret", time.Hour, time.Hour)
+
+	expired, _ := NewManager("test-secret", -time.Minute, time.Hour).GenerateAccessToken("user-1", "user@example.com", "student")
+	foreign, _ := NewManager("other-secret", time.Hour, time.Hour).GenerateAccessToken("user-1", "user@example.com", "student")
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"expired", expired, ErrExpiredToken},
+		{"wrong key", foreign, ErrInvalidToken},
+		{"garbage", "not.a.token", ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := m.ValidateToken(tt.token); err != tt.want {
+				t.Errorf("ValidateToken() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -3,18 +3,28 @@ package kafka
 import "time"
 
 const (
-	TopicUserRegistered    = "user.registered"
-	TopicCourseCreated     = "course.created"
-	TopicCoursePublished   = "course.published"
-	TopicEnrollmentStarted = "enrollment.started"
-	TopicPaymentProcessed  = "payment.processed"
-	TopicPaymentFailed     = "payment.failed"
-	TopicEnrollmentSuccess = "enrollment.success"
-	TopicEnrollmentFailed  = "enrollment.failed"
-	TopicProgressUpdated   = "progress.updated"
-	TopicLessonCompleted   = "lesson.completed"
-	TopicCourseCompleted   = "course.completed"
-	TopicReviewCreated     = "review.created"
+	TopicUserRegistered        = "user.registered"
+	TopicPasswordReset         = "user.password_reset_requested"
+	TopicUserSuspended         = "user.suspended"
+	TopicUserReinstated        = "user.reinstated"
+	TopicUserProfileUpdated    = "user.profile_updated"
+	TopicCourseCreated         = "course.created"
+	TopicCoursePublished       = "course.published"
+	TopicCourseReviewed        = "course.reviewed"
+	TopicEnrollmentStarted     = "enrollment.started"
+	TopicPaymentProcessed      = "payment.processed"
+	TopicPaymentFailed         = "payment.failed"
+	TopicEnrollmentSuccess     = "enrollment.success"
+	TopicEnrollmentFailed      = "enrollment.failed"
+	TopicEnrollmentCancelled   = "enrollment.cancelled"
+	TopicProgressUpdated       = "progress.updated"
+	TopicLessonCompleted       = "lesson.completed"
+	TopicCourseCompleted       = "course.completed"
+	TopicReviewCreated         = "review.created"
+	TopicAssignmentSubmitted   = "assignment.submitted"
+	TopicAssignmentGraded      = "assignment.graded"
+	TopicCollaboratorInvited   = "course.collaborator_invited"
+	TopicAnnouncementPublished = "course.announcement_published"
 )
 
 type UserRegisteredEvent struct {
@@ -26,6 +36,40 @@ type UserRegisteredEvent struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+type PasswordResetRequestedEvent struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// UserProfileUpdatedEvent carries a user's name after they changed their
+// profile.
+type UserProfileUpdatedEvent struct {
+	UserID    string    `json:"user_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type UserSuspendedEvent struct {
+	UserID          string     `json:"user_id"`
+	Reason          string     `json:"reason"`
+	SuspendedBy     string     `json:"suspended_by"`
+	SuspendedUntil  *time.Time `json:"suspended_until,omitempty"`
+	TokensRevokedAt time.Time  `json:"tokens_revoked_at"`
+	Timestamp       time.Time  `json:"timestamp"`
+}
+
+type UserReinstatedEvent struct {
+	UserID       string    `json:"user_id"`
+	Reason       string    `json:"reason"`
+	ReinstatedBy string    `json:"reinstated_by"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
 type CourseCreatedEvent struct {
 	CourseID     string    `json:"course_id"`
 	Title        string    `json:"title"`
@@ -34,11 +78,67 @@ type CourseCreatedEvent struct {
 }
 
 type CoursePublishedEvent struct {
+	CourseID     string    `json:"course_id"`
+	Title        string    `json:"title"`
+	InstructorID string    `json:"instructor_id"`
+	Revision     int       `json:"revision"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+type CourseReviewedEvent struct {
+	SubmissionID string    `json:"submission_id"`
+	CourseID     string    `json:"course_id"`
+	Title        string    `json:"title"`
+	InstructorID string    `json:"instructor_id"`
+	ReviewerID   string    `json:"reviewer_id"`
+	Decision     string    `json:"decision"`
+	Comment      string    `json:"comment"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// AssignmentSubmittedEvent is published whenever a learner hands in or
+// replaces their work for an assignment.
+type AssignmentSubmittedEvent struct {
+	SubmissionID string    `json:"submission_id"`
+	LessonID     string    `json:"lesson_id"`
+	CourseID     string    `json:"course_id"`
+	UserID       string    `json:"user_id"`
+	InstructorID string    `json:"instructor_id"`
+	Late         bool      `json:"late"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+type AssignmentGradedEvent struct {
+	SubmissionID string    `json:"submission_id"`
+	LessonID     string    `json:"lesson_id"`
+	CourseID     string    `json:"course_id"`
+	UserID       string    `json:"user_id"`
+	GraderID     string    `json:"grader_id"`
+	Score        int       `json:"score"`
+	MaxScore     int       `json:"max_score"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+type CollaboratorInvitedEvent struct {
 	CourseID  string    `json:"course_id"`
 	Title     string    `json:"title"`
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	InvitedBy string    `json:"invited_by"`
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// AnnouncementPublishedEvent asks for an announcement to be sent to every
+// student enrolled in the course.
+type AnnouncementPublishedEvent struct {
+	AnnouncementID string    `json:"announcement_id"`
+	CourseID       string    `json:"course_id"`
+	CourseTitle    string    `json:"course_title"`
+	Title          string    `json:"title"`
+	Body           string    `json:"body"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
 type EnrollmentStartedEvent struct {
 	EnrollmentID string    `json:"enrollment_id"`
 	UserID       string    `json:"user_id"`
@@ -70,6 +170,7 @@ type EnrollmentSuccessEvent struct {
 	EnrollmentID string    `json:"enrollment_id"`
 	UserID       string    `json:"user_id"`
 	CourseID     string    `json:"course_id"`
+	EnrolledAt   time.Time `json:"enrolled_at"`
 	Timestamp    time.Time `json:"timestamp"`
 }
 
@@ -81,6 +182,16 @@ type EnrollmentFailedEvent struct {
 	Timestamp    time.Time `json:"timestamp"`
 }
 
+// EnrollmentCancelledEvent is published when an active enrollment is
+// cancelled. Refunded is set when its payment was returned.
+type EnrollmentCancelledEvent struct {
+	EnrollmentID string    `json:"enrollment_id"`
+	UserID       string    `json:"user_id"`
+	CourseID     string    `json:"course_id"`
+	Refunded     bool      `json:"refunded"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
 type ProgressUpdatedEvent struct {
 	UserID             string    `json:"user_id"`
 	CourseID           string    `json:"course_id"`
@@ -20,21 +20,118 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CourseService_CreateCourse_FullMethodName           = "/course.CourseService/CreateCourse"
-	CourseService_GetCourse_FullMethodName              = "/course.CourseService/GetCourse"
-	CourseService_UpdateCourse_FullMethodName           = "/course.CourseService/UpdateCourse"
-	CourseService_DeleteCourse_FullMethodName           = "/course.CourseService/DeleteCourse"
-	CourseService_ListCourses_FullMethodName            = "/course.CourseService/ListCourses"
-	CourseService_PublishCourse_FullMethodName          = "/course.CourseService/PublishCourse"
-	CourseService_GetCoursesByInstructor_FullMethodName = "/course.CourseService/GetCoursesByInstructor"
-	CourseService_AddModule_FullMethodName              = "/course.CourseService/AddModule"
-	CourseService_UpdateModule_FullMethodName           = "/course.CourseService/UpdateModule"
-	CourseService_DeleteModule_FullMethodName           = "/course.CourseService/DeleteModule"
-	CourseService_GetModules_FullMethodName             = "/course.CourseService/GetModules"
-	CourseService_AddLesson_FullMethodName              = "/course.CourseService/AddLesson"
-	CourseService_UpdateLesson_FullMethodName           = "/course.CourseService/UpdateLesson"
-	CourseService_DeleteLesson_FullMethodName           = "/course.CourseService/DeleteLesson"
-	CourseService_GetLessons_FullMethodName             = "/course.CourseService/GetLessons"
+	CourseService_CreateCourse_FullMethodName                 = "/course.CourseService/CreateCourse"
+	CourseService_GetCourse_FullMethodName                    = "/course.CourseService/GetCourse"
+	CourseService_UpdateCourse_FullMethodName                 = "/course.CourseService/UpdateCourse"
+	CourseService_DeleteCourse_FullMethodName                 = "/course.CourseService/DeleteCourse"
+	CourseService_ListCourses_FullMethodName                  = "/course.CourseService/ListCourses"
+	CourseService_PublishCourse_FullMethodName                = "/course.CourseService/PublishCourse"
+	CourseService_GetCoursesByInstructor_FullMethodName       = "/course.CourseService/GetCoursesByInstructor"
+	CourseService_AddModule_FullMethodName                    = "/course.CourseService/AddModule"
+	CourseService_UpdateModule_FullMethodName                 = "/course.CourseService/UpdateModule"
+	CourseService_DeleteModule_FullMethodName                 = "/course.CourseService/DeleteModule"
+	CourseService_GetModules_FullMethodName                   = "/course.CourseService/GetModules"
+	CourseService_ReorderModules_FullMethodName               = "/course.CourseService/ReorderModules"
+	CourseService_AddLesson_FullMethodName                    = "/course.CourseService/AddLesson"
+	CourseService_UpdateLesson_FullMethodName                 = "/course.CourseService/UpdateLesson"
+	CourseService_DeleteLesson_FullMethodName                 = "/course.CourseService/DeleteLesson"
+	CourseService_GetLessons_FullMethodName                   = "/course.CourseService/GetLessons"
+	CourseService_MoveLesson_FullMethodName                   = "/course.CourseService/MoveLesson"
+	CourseService_GetCourseDraft_FullMethodName               = "/course.CourseService/GetCourseDraft"
+	CourseService_DiscardCourseDraft_FullMethodName           = "/course.CourseService/DiscardCourseDraft"
+	CourseService_ListCourseRevisions_FullMethodName          = "/course.CourseService/ListCourseRevisions"
+	CourseService_DiffCourseRevisions_FullMethodName          = "/course.CourseService/DiffCourseRevisions"
+	CourseService_RollbackCourse_FullMethodName               = "/course.CourseService/RollbackCourse"
+	CourseService_ScheduleCourse_FullMethodName               = "/course.CourseService/ScheduleCourse"
+	CourseService_ArchiveCourse_FullMethodName                = "/course.CourseService/ArchiveCourse"
+	CourseService_ListCourseSubmissions_FullMethodName        = "/course.CourseService/ListCourseSubmissions"
+	CourseService_ListReviewQueue_FullMethodName              = "/course.CourseService/ListReviewQueue"
+	CourseService_StartCourseReview_FullMethodName            = "/course.CourseService/StartCourseReview"
+	CourseService_ApproveCourse_FullMethodName                = "/course.CourseService/ApproveCourse"
+	CourseService_RejectCourse_FullMethodName                 = "/course.CourseService/RejectCourse"
+	CourseService_AddCoursePrerequisite_FullMethodName        = "/course.CourseService/AddCoursePrerequisite"
+	CourseService_RemoveCoursePrerequisite_FullMethodName     = "/course.CourseService/RemoveCoursePrerequisite"
+	CourseService_ListCoursePrerequisites_FullMethodName      = "/course.CourseService/ListCoursePrerequisites"
+	CourseService_CreateLearningPath_FullMethodName           = "/course.CourseService/CreateLearningPath"
+	CourseService_UpdateLearningPath_FullMethodName           = "/course.CourseService/UpdateLearningPath"
+	CourseService_GetLearningPath_FullMethodName              = "/course.CourseService/GetLearningPath"
+	CourseService_DeleteLearningPath_FullMethodName           = "/course.CourseService/DeleteLearningPath"
+	CourseService_ListLearningPaths_FullMethodName            = "/course.CourseService/ListLearningPaths"
+	CourseService_CreateCoupon_FullMethodName                 = "/course.CourseService/CreateCoupon"
+	CourseService_ListCoupons_FullMethodName                  = "/course.CourseService/ListCoupons"
+	CourseService_DeleteCoupon_FullMethodName                 = "/course.CourseService/DeleteCoupon"
+	CourseService_CreateSale_FullMethodName                   = "/course.CourseService/CreateSale"
+	CourseService_ListSales_FullMethodName                    = "/course.CourseService/ListSales"
+	CourseService_DeleteSale_FullMethodName                   = "/course.CourseService/DeleteSale"
+	CourseService_QuotePrice_FullMethodName                   = "/course.CourseService/QuotePrice"
+	CourseService_RedeemCoupon_FullMethodName                 = "/course.CourseService/RedeemCoupon"
+	CourseService_ReleaseCoupon_FullMethodName                = "/course.CourseService/ReleaseCoupon"
+	CourseService_CreateBundle_FullMethodName                 = "/course.CourseService/CreateBundle"
+	CourseService_UpdateBundle_FullMethodName                 = "/course.CourseService/UpdateBundle"
+	CourseService_DeleteBundle_FullMethodName                 = "/course.CourseService/DeleteBundle"
+	CourseService_GetBundle_FullMethodName                    = "/course.CourseService/GetBundle"
+	CourseService_ListBundles_FullMethodName                  = "/course.CourseService/ListBundles"
+	CourseService_QuoteBundle_FullMethodName                  = "/course.CourseService/QuoteBundle"
+	CourseService_CloneCourse_FullMethodName                  = "/course.CourseService/CloneCourse"
+	CourseService_CreateCourseTemplate_FullMethodName         = "/course.CourseService/CreateCourseTemplate"
+	CourseService_GetCourseTemplate_FullMethodName            = "/course.CourseService/GetCourseTemplate"
+	CourseService_ListCourseTemplates_FullMethodName          = "/course.CourseService/ListCourseTemplates"
+	CourseService_DeleteCourseTemplate_FullMethodName         = "/course.CourseService/DeleteCourseTemplate"
+	CourseService_CreateCourseFromTemplate_FullMethodName     = "/course.CourseService/CreateCourseFromTemplate"
+	CourseService_ExportCourse_FullMethodName                 = "/course.CourseService/ExportCourse"
+	CourseService_ImportCourse_FullMethodName                 = "/course.CourseService/ImportCourse"
+	CourseService_SetModuleRelease_FullMethodName             = "/course.CourseService/SetModuleRelease"
+	CourseService_SetLessonRelease_FullMethodName             = "/course.CourseService/SetLessonRelease"
+	CourseService_GetCourseContent_FullMethodName             = "/course.CourseService/GetCourseContent"
+	CourseService_AuthorizeVideoStream_FullMethodName         = "/course.CourseService/AuthorizeVideoStream"
+	CourseService_CreateQuestionBank_FullMethodName           = "/course.CourseService/CreateQuestionBank"
+	CourseService_ListQuestionBanks_FullMethodName            = "/course.CourseService/ListQuestionBanks"
+	CourseService_DeleteQuestionBank_FullMethodName           = "/course.CourseService/DeleteQuestionBank"
+	CourseService_AddQuestion_FullMethodName                  = "/course.CourseService/AddQuestion"
+	CourseService_DeleteQuestion_FullMethodName               = "/course.CourseService/DeleteQuestion"
+	CourseService_ListQuestions_FullMethodName                = "/course.CourseService/ListQuestions"
+	CourseService_SetLessonQuiz_FullMethodName                = "/course.CourseService/SetLessonQuiz"
+	CourseService_GetLessonQuiz_FullMethodName                = "/course.CourseService/GetLessonQuiz"
+	CourseService_StartQuizAttempt_FullMethodName             = "/course.CourseService/StartQuizAttempt"
+	CourseService_SubmitQuizAttempt_FullMethodName            = "/course.CourseService/SubmitQuizAttempt"
+	CourseService_ListQuizAttempts_FullMethodName             = "/course.CourseService/ListQuizAttempts"
+	CourseService_SetLessonAssignment_FullMethodName          = "/course.CourseService/SetLessonAssignment"
+	CourseService_GetLessonAssignment_FullMethodName          = "/course.CourseService/GetLessonAssignment"
+	CourseService_SubmitAssignment_FullMethodName             = "/course.CourseService/SubmitAssignment"
+	CourseService_GetMyAssignmentSubmission_FullMethodName    = "/course.CourseService/GetMyAssignmentSubmission"
+	CourseService_DownloadAssignmentSubmission_FullMethodName = "/course.CourseService/DownloadAssignmentSubmission"
+	CourseService_ListGradingQueue_FullMethodName             = "/course.CourseService/ListGradingQueue"
+	CourseService_GradeAssignmentSubmission_FullMethodName    = "/course.CourseService/GradeAssignmentSubmission"
+	CourseService_UploadLessonAttachment_FullMethodName       = "/course.CourseService/UploadLessonAttachment"
+	CourseService_ListLessonAttachments_FullMethodName        = "/course.CourseService/ListLessonAttachments"
+	CourseService_DeleteLessonAttachment_FullMethodName       = "/course.CourseService/DeleteLessonAttachment"
+	CourseService_GetAttachmentDownloadURL_FullMethodName     = "/course.CourseService/GetAttachmentDownloadURL"
+	CourseService_InviteCollaborator_FullMethodName           = "/course.CourseService/InviteCollaborator"
+	CourseService_AcceptCollaboratorInvitation_FullMethodName = "/course.CourseService/AcceptCollaboratorInvitation"
+	CourseService_ListCollaborators_FullMethodName            = "/course.CourseService/ListCollaborators"
+	CourseService_ListMyCollaborations_FullMethodName         = "/course.CourseService/ListMyCollaborations"
+	CourseService_UpdateCollaboratorRole_FullMethodName       = "/course.CourseService/UpdateCollaboratorRole"
+	CourseService_RemoveCollaborator_FullMethodName           = "/course.CourseService/RemoveCollaborator"
+	CourseService_TransferCourseOwnership_FullMethodName      = "/course.CourseService/TransferCourseOwnership"
+	CourseService_ReconcileCourseStats_FullMethodName         = "/course.CourseService/ReconcileCourseStats"
+	CourseService_ListCategories_FullMethodName               = "/course.CourseService/ListCategories"
+	CourseService_CreateCategory_FullMethodName               = "/course.CourseService/CreateCategory"
+	CourseService_UpdateCategory_FullMethodName               = "/course.CourseService/UpdateCategory"
+	CourseService_DeleteCategory_FullMethodName               = "/course.CourseService/DeleteCategory"
+	CourseService_SetCourseDefaultLocale_FullMethodName       = "/course.CourseService/SetCourseDefaultLocale"
+	CourseService_PutCourseTranslations_FullMethodName        = "/course.CourseService/PutCourseTranslations"
+	CourseService_ListCourseTranslations_FullMethodName       = "/course.CourseService/ListCourseTranslations"
+	CourseService_DeleteCourseLocale_FullMethodName           = "/course.CourseService/DeleteCourseLocale"
+	CourseService_GetTranslationStatus_FullMethodName         = "/course.CourseService/GetTranslationStatus"
+	CourseService_CreateAnnouncement_FullMethodName           = "/course.CourseService/CreateAnnouncement"
+	CourseService_ListAnnouncements_FullMethodName            = "/course.CourseService/ListAnnouncements"
+	CourseService_CreateDiscussionThread_FullMethodName       = "/course.CourseService/CreateDiscussionThread"
+	CourseService_ListDiscussionThreads_FullMethodName        = "/course.CourseService/ListDiscussionThreads"
+	CourseService_GetDiscussionThread_FullMethodName          = "/course.CourseService/GetDiscussionThread"
+	CourseService_ReplyToDiscussion_FullMethodName            = "/course.CourseService/ReplyToDiscussion"
+	CourseService_VoteDiscussion_FullMethodName               = "/course.CourseService/VoteDiscussion"
+	CourseService_MarkDiscussionAnswer_FullMethodName         = "/course.CourseService/MarkDiscussionAnswer"
+	CourseService_ModerateDiscussion_FullMethodName           = "/course.CourseService/ModerateDiscussion"
 )
 
 // CourseServiceClient is the client API for CourseService service.
@@ -46,16 +143,181 @@ type CourseServiceClient interface {
 	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
+	// Submits the course, or the draft of a live course, for admin review.
+	// It goes live when an admin approves the submission.
 	PublishCourse(ctx context.Context, in *PublishCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
 	GetCoursesByInstructor(ctx context.Context, in *GetCoursesByInstructorRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
 	AddModule(ctx context.Context, in *AddModuleRequest, opts ...grpc.CallOption) (*ModuleResponse, error)
 	UpdateModule(ctx context.Context, in *UpdateModuleRequest, opts ...grpc.CallOption) (*ModuleResponse, error)
 	DeleteModule(ctx context.Context, in *DeleteModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetModules(ctx context.Context, in *GetModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error)
+	ReorderModules(ctx context.Context, in *ReorderModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error)
 	AddLesson(ctx context.Context, in *AddLessonRequest, opts ...grpc.CallOption) (*LessonResponse, error)
 	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*LessonResponse, error)
 	DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
+	MoveLesson(ctx context.Context, in *MoveLessonRequest, opts ...grpc.CallOption) (*LessonResponse, error)
+	GetCourseDraft(ctx context.Context, in *GetCourseDraftRequest, opts ...grpc.CallOption) (*CourseContentResponse, error)
+	DiscardCourseDraft(ctx context.Context, in *DiscardCourseDraftRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCourseRevisions(ctx context.Context, in *ListCourseRevisionsRequest, opts ...grpc.CallOption) (*ListCourseRevisionsResponse, error)
+	DiffCourseRevisions(ctx context.Context, in *DiffCourseRevisionsRequest, opts ...grpc.CallOption) (*DiffCourseRevisionsResponse, error)
+	RollbackCourse(ctx context.Context, in *RollbackCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	// Sets when the course goes live once approved and when it is archived.
+	// Unset times are cleared.
+	ScheduleCourse(ctx context.Context, in *ScheduleCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	// Takes a published course off sale. Enrolled students keep access.
+	ArchiveCourse(ctx context.Context, in *ArchiveCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	ListCourseSubmissions(ctx context.Context, in *ListCourseSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
+	ListReviewQueue(ctx context.Context, in *ListReviewQueueRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
+	StartCourseReview(ctx context.Context, in *StartCourseReviewRequest, opts ...grpc.CallOption) (*SubmissionResponse, error)
+	ApproveCourse(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error)
+	RejectCourse(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error)
+	AddCoursePrerequisite(ctx context.Context, in *CoursePrerequisiteRequest, opts ...grpc.CallOption) (*ListCoursePrerequisitesResponse, error)
+	RemoveCoursePrerequisite(ctx context.Context, in *CoursePrerequisiteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCoursePrerequisites(ctx context.Context, in *ListCoursePrerequisitesRequest, opts ...grpc.CallOption) (*ListCoursePrerequisitesResponse, error)
+	CreateLearningPath(ctx context.Context, in *CreateLearningPathRequest, opts ...grpc.CallOption) (*LearningPathResponse, error)
+	UpdateLearningPath(ctx context.Context, in *UpdateLearningPathRequest, opts ...grpc.CallOption) (*LearningPathResponse, error)
+	GetLearningPath(ctx context.Context, in *GetLearningPathRequest, opts ...grpc.CallOption) (*LearningPathResponse, error)
+	DeleteLearningPath(ctx context.Context, in *DeleteLearningPathRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListLearningPaths(ctx context.Context, in *ListLearningPathsRequest, opts ...grpc.CallOption) (*ListLearningPathsResponse, error)
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error)
+	DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateSale(ctx context.Context, in *CreateSaleRequest, opts ...grpc.CallOption) (*SaleResponse, error)
+	ListSales(ctx context.Context, in *ListSalesRequest, opts ...grpc.CallOption) (*ListSalesResponse, error)
+	DeleteSale(ctx context.Context, in *DeleteSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*PriceQuoteResponse, error)
+	// Prices a checkout and holds one use of the coupon for the enrollment.
+	// Services only, on behalf of the enrolling user.
+	RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*PriceQuoteResponse, error)
+	// Gives the coupon use back when the enrollment doesn't go through.
+	// Services only, on behalf of the enrolling user.
+	ReleaseCoupon(ctx context.Context, in *ReleaseCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	DeleteBundle(ctx context.Context, in *DeleteBundleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error)
+	// Prices a bundle for checkout. Fails unless every member course is on sale.
+	QuoteBundle(ctx context.Context, in *QuoteBundleRequest, opts ...grpc.CallOption) (*BundleQuoteResponse, error)
+	// Copies one of the caller's courses, with its modules and lessons, into
+	// a new draft.
+	CloneCourse(ctx context.Context, in *CloneCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	CreateCourseTemplate(ctx context.Context, in *CreateCourseTemplateRequest, opts ...grpc.CallOption) (*CourseTemplateResponse, error)
+	GetCourseTemplate(ctx context.Context, in *GetCourseTemplateRequest, opts ...grpc.CallOption) (*CourseTemplateResponse, error)
+	ListCourseTemplates(ctx context.Context, in *ListCourseTemplatesRequest, opts ...grpc.CallOption) (*ListCourseTemplatesResponse, error)
+	DeleteCourseTemplate(ctx context.Context, in *DeleteCourseTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateCourseFromTemplate(ctx context.Context, in *CreateCourseFromTemplateRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	// Streams one of the caller's courses as a native zip archive.
+	ExportCourse(ctx context.Context, in *ExportCourseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCourseChunk], error)
+	// Recreates an uploaded archive as a new draft. The first message carries
+	// the metadata and the rest the archive bytes.
+	ImportCourse(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCourseRequest, CourseResponse], error)
+	// Sets when enrolled students get a module or lesson. An immediate rule
+	// clears it.
+	SetModuleRelease(ctx context.Context, in *SetModuleReleaseRequest, opts ...grpc.CallOption) (*ModuleResponse, error)
+	SetLessonRelease(ctx context.Context, in *SetLessonReleaseRequest, opts ...grpc.CallOption) (*LessonResponse, error)
+	// Live modules and lessons, with lock state for enrolled students.
+	GetCourseContent(ctx context.Context, in *GetCourseContentRequest, opts ...grpc.CallOption) (*CourseContentResponse, error)
+	// Called by the video service before it issues a stream URL. Fails with
+	// PERMISSION_DENIED for locked lessons and callers who aren't enrolled.
+	AuthorizeVideoStream(ctx context.Context, in *AuthorizeVideoStreamRequest, opts ...grpc.CallOption) (*VideoStreamAuthorization, error)
+	CreateQuestionBank(ctx context.Context, in *CreateQuestionBankRequest, opts ...grpc.CallOption) (*QuestionBankResponse, error)
+	ListQuestionBanks(ctx context.Context, in *ListQuestionBanksRequest, opts ...grpc.CallOption) (*ListQuestionBanksResponse, error)
+	// Fails while a quiz draws from the bank.
+	DeleteQuestionBank(ctx context.Context, in *DeleteQuestionBankRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddQuestion(ctx context.Context, in *AddQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error)
+	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListQuestions(ctx context.Context, in *ListQuestionsRequest, opts ...grpc.CallOption) (*ListQuestionsResponse, error)
+	// Configures a QUIZ lesson. Changes apply to the next attempt.
+	SetLessonQuiz(ctx context.Context, in *SetLessonQuizRequest, opts ...grpc.CallOption) (*LessonQuizResponse, error)
+	GetLessonQuiz(ctx context.Context, in *GetLessonQuizRequest, opts ...grpc.CallOption) (*LessonQuizResponse, error)
+	// Resumes the caller's open attempt or starts a new one. Questions come
+	// without their answers.
+	StartQuizAttempt(ctx context.Context, in *StartQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttemptResponse, error)
+	SubmitQuizAttempt(ctx context.Context, in *SubmitQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttemptResponse, error)
+	// The caller's attempts at a quiz, newest first.
+	ListQuizAttempts(ctx context.Context, in *ListQuizAttemptsRequest, opts ...grpc.CallOption) (*ListQuizAttemptsResponse, error)
+	// Configures an ASSIGNMENT lesson. Changes apply straight away.
+	SetLessonAssignment(ctx context.Context, in *SetLessonAssignmentRequest, opts ...grpc.CallOption) (*LessonAssignmentResponse, error)
+	GetLessonAssignment(ctx context.Context, in *GetLessonAssignmentRequest, opts ...grpc.CallOption) (*LessonAssignmentResponse, error)
+	// Hands in a file for an assignment. The first message carries the
+	// metadata and the rest the file bytes. Uploading again replaces the
+	// caller's earlier file until it is graded.
+	SubmitAssignment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SubmitAssignmentRequest, AssignmentSubmissionResponse], error)
+	GetMyAssignmentSubmission(ctx context.Context, in *GetMyAssignmentSubmissionRequest, opts ...grpc.CallOption) (*AssignmentSubmissionResponse, error)
+	// Streams a submitted file to the learner who handed it in or the
+	// course's instructor.
+	DownloadAssignmentSubmission(ctx context.Context, in *DownloadAssignmentSubmissionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
+	// Ungraded submissions of the instructor's course, oldest first.
+	ListGradingQueue(ctx context.Context, in *ListGradingQueueRequest, opts ...grpc.CallOption) (*ListAssignmentSubmissionsResponse, error)
+	GradeAssignmentSubmission(ctx context.Context, in *GradeAssignmentSubmissionRequest, opts ...grpc.CallOption) (*AssignmentSubmissionResponse, error)
+	// Attaches a file to a lesson. The first message carries the metadata and
+	// the rest the file bytes.
+	UploadLessonAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadLessonAttachmentRequest, LessonAttachmentResponse], error)
+	ListLessonAttachments(ctx context.Context, in *ListLessonAttachmentsRequest, opts ...grpc.CallOption) (*ListLessonAttachmentsResponse, error)
+	DeleteLessonAttachment(ctx context.Context, in *DeleteLessonAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns a short-lived link to the file for callers enrolled in the
+	// course, or for anyone if the lesson is a preview.
+	GetAttachmentDownloadURL(ctx context.Context, in *GetAttachmentDownloadURLRequest, opts ...grpc.CallOption) (*AttachmentDownloadURLResponse, error)
+	// Invites a user to work on the caller's course. The role takes effect
+	// once they accept.
+	InviteCollaborator(ctx context.Context, in *InviteCollaboratorRequest, opts ...grpc.CallOption) (*CollaboratorResponse, error)
+	AcceptCollaboratorInvitation(ctx context.Context, in *AcceptCollaboratorInvitationRequest, opts ...grpc.CallOption) (*CollaboratorResponse, error)
+	// The course's team, owner first, including pending invitations.
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	// The caller's invitations and the courses they collaborate on.
+	ListMyCollaborations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	UpdateCollaboratorRole(ctx context.Context, in *UpdateCollaboratorRoleRequest, opts ...grpc.CallOption) (*CollaboratorResponse, error)
+	// Owners can remove anyone; collaborators can remove themselves, which
+	// also declines an invitation.
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Hands the course to an active collaborator. The previous owner stays on
+	// as an editor.
+	TransferCourseOwnership(ctx context.Context, in *TransferCourseOwnershipRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	// Admin only. Recomputes duration and enrolled count from the lessons and
+	// the recorded enrollments, for one course or, with no course_id, all.
+	ReconcileCourseStats(ctx context.Context, in *ReconcileCourseStatsRequest, opts ...grpc.CallOption) (*ReconcileCourseStatsResponse, error)
+	// The category tree as a flat list, siblings in display order.
+	ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// Admin only.
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	// Admin only. The slug can't change.
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	// Admin only. Courses in the category move to reassign_to, which is how
+	// duplicate categories are merged; without it the category must be unused.
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Owner only. Records which locale the course's own text is written in.
+	SetCourseDefaultLocale(ctx context.Context, in *SetCourseDefaultLocaleRequest, opts ...grpc.CallOption) (*CourseResponse, error)
+	// Sets translations into one locale; an empty value removes one.
+	// Returns all of the course's translations into that locale.
+	PutCourseTranslations(ctx context.Context, in *PutCourseTranslationsRequest, opts ...grpc.CallOption) (*ListCourseTranslationsResponse, error)
+	ListCourseTranslations(ctx context.Context, in *ListCourseTranslationsRequest, opts ...grpc.CallOption) (*ListCourseTranslationsResponse, error)
+	DeleteCourseLocale(ctx context.Context, in *DeleteCourseLocaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// How much of the draft, or the live content without one, is translated
+	// into each locale.
+	GetTranslationStatus(ctx context.Context, in *GetTranslationStatusRequest, opts ...grpc.CallOption) (*TranslationStatusResponse, error)
+	// Owners and editors. Sent to every actively enrolled student at
+	// send_at, or straight away without one.
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error)
+	// Staff see scheduled announcements and delivery stats; enrolled students
+	// see what has been sent.
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	// Discussions are open to staff and enrolled students; a lesson's only to
+	// students who can open the lesson. Without lesson_id a thread is about
+	// the course as a whole.
+	CreateDiscussionThread(ctx context.Context, in *CreateDiscussionThreadRequest, opts ...grpc.CallOption) (*DiscussionThreadResponse, error)
+	// Pinned threads first, then by latest activity. Hidden threads are
+	// listed for staff only.
+	ListDiscussionThreads(ctx context.Context, in *ListDiscussionThreadsRequest, opts ...grpc.CallOption) (*ListDiscussionThreadsResponse, error)
+	GetDiscussionThread(ctx context.Context, in *GetDiscussionThreadRequest, opts ...grpc.CallOption) (*GetDiscussionThreadResponse, error)
+	// Notifies the thread's author and, when replying to a reply, its author.
+	ReplyToDiscussion(ctx context.Context, in *ReplyToDiscussionRequest, opts ...grpc.CallOption) (*DiscussionReplyResponse, error)
+	VoteDiscussion(ctx context.Context, in *VoteDiscussionRequest, opts ...grpc.CallOption) (*VoteDiscussionResponse, error)
+	// Staff only. An empty reply_id clears the answer.
+	MarkDiscussionAnswer(ctx context.Context, in *MarkDiscussionAnswerRequest, opts ...grpc.CallOption) (*DiscussionThreadResponse, error)
+	// Staff only. Replies can only be hidden or unhidden.
+	ModerateDiscussion(ctx context.Context, in *ModerateDiscussionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type courseServiceClient struct {
@@ -176,6 +438,16 @@ func (c *courseServiceClient) GetModules(ctx context.Context, in *GetModulesRequ
 	return out, nil
 }
 
+func (c *courseServiceClient) ReorderModules(ctx context.Context, in *ReorderModulesRequest, opts ...grpc.CallOption) (*ListModulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModulesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ReorderModules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courseServiceClient) AddLesson(ctx context.Context, in *AddLessonRequest, opts ...grpc.CallOption) (*LessonResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LessonResponse)
@@ -216,367 +488,3509 @@ func (c *courseServiceClient) GetLessons(ctx context.Context, in *GetLessonsRequ
 	return out, nil
 }
 
-// CourseServiceServer is the server API for CourseService service.
-// All implementations must embed UnimplementedCourseServiceServer
-// for forward compatibility.
-type CourseServiceServer interface {
-	CreateCourse(context.Context, *CreateCourseRequest) (*CourseResponse, error)
-	GetCourse(context.Context, *GetCourseRequest) (*CourseResponse, error)
-	UpdateCourse(context.Context, *UpdateCourseRequest) (*CourseResponse, error)
-	DeleteCourse(context.Context, *DeleteCourseRequest) (*emptypb.Empty, error)
-	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
-	PublishCourse(context.Context, *PublishCourseRequest) (*CourseResponse, error)
-	GetCoursesByInstructor(context.Context, *GetCoursesByInstructorRequest) (*ListCoursesResponse, error)
-	AddModule(context.Context, *AddModuleRequest) (*ModuleResponse, error)
-	UpdateModule(context.Context, *UpdateModuleRequest) (*ModuleResponse, error)
-	DeleteModule(context.Context, *DeleteModuleRequest) (*emptypb.Empty, error)
-	GetModules(context.Context, *GetModulesRequest) (*ListModulesResponse, error)
-	AddLesson(context.Context, *AddLessonRequest) (*LessonResponse, error)
-	UpdateLesson(context.Context, *UpdateLessonRequest) (*LessonResponse, error)
-	DeleteLesson(context.Context, *DeleteLessonRequest) (*emptypb.Empty, error)
-	GetLessons(context.Context, *GetLessonsRequest) (*ListLessonsResponse, error)
-	mustEmbedUnimplementedCourseServiceServer()
+func (c *courseServiceClient) MoveLesson(ctx context.Context, in *MoveLessonRequest, opts ...grpc.CallOption) (*LessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonResponse)
+	err := c.cc.Invoke(ctx, CourseService_MoveLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCourseServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCourseServiceServer struct{}
-
-func (UnimplementedCourseServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*CourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
-}
-func (UnimplementedCourseServiceServer) GetCourse(context.Context, *GetCourseRequest) (*CourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+func (c *courseServiceClient) GetCourseDraft(ctx context.Context, in *GetCourseDraftRequest, opts ...grpc.CallOption) (*CourseContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseContentResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) UpdateCourse(context.Context, *UpdateCourseRequest) (*CourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+
+func (c *courseServiceClient) DiscardCourseDraft(ctx context.Context, in *DiscardCourseDraftRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DiscardCourseDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+
+func (c *courseServiceClient) ListCourseRevisions(ctx context.Context, in *ListCourseRevisionsRequest, opts ...grpc.CallOption) (*ListCourseRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourseRevisionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourseRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+
+func (c *courseServiceClient) DiffCourseRevisions(ctx context.Context, in *DiffCourseRevisionsRequest, opts ...grpc.CallOption) (*DiffCourseRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCourseRevisionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_DiffCourseRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) PublishCourse(context.Context, *PublishCourseRequest) (*CourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishCourse not implemented")
+
+func (c *courseServiceClient) RollbackCourse(ctx context.Context, in *RollbackCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_RollbackCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) GetCoursesByInstructor(context.Context, *GetCoursesByInstructorRequest) (*ListCoursesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByInstructor not implemented")
+
+func (c *courseServiceClient) ScheduleCourse(ctx context.Context, in *ScheduleCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_ScheduleCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) AddModule(context.Context, *AddModuleRequest) (*ModuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddModule not implemented")
+
+func (c *courseServiceClient) ArchiveCourse(ctx context.Context, in *ArchiveCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_ArchiveCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) UpdateModule(context.Context, *UpdateModuleRequest) (*ModuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateModule not implemented")
+
+func (c *courseServiceClient) ListCourseSubmissions(ctx context.Context, in *ListCourseSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubmissionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourseSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) DeleteModule(context.Context, *DeleteModuleRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteModule not implemented")
+
+func (c *courseServiceClient) ListReviewQueue(ctx context.Context, in *ListReviewQueueRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubmissionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListReviewQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) GetModules(context.Context, *GetModulesRequest) (*ListModulesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetModules not implemented")
+
+func (c *courseServiceClient) StartCourseReview(ctx context.Context, in *StartCourseReviewRequest, opts ...grpc.CallOption) (*SubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmissionResponse)
+	err := c.cc.Invoke(ctx, CourseService_StartCourseReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) AddLesson(context.Context, *AddLessonRequest) (*LessonResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLesson not implemented")
+
+func (c *courseServiceClient) ApproveCourse(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmissionResponse)
+	err := c.cc.Invoke(ctx, CourseService_ApproveCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*LessonResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
+
+func (c *courseServiceClient) RejectCourse(ctx context.Context, in *ReviewDecisionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmissionResponse)
+	err := c.cc.Invoke(ctx, CourseService_RejectCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
+
+func (c *courseServiceClient) AddCoursePrerequisite(ctx context.Context, in *CoursePrerequisiteRequest, opts ...grpc.CallOption) (*ListCoursePrerequisitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursePrerequisitesResponse)
+	err := c.cc.Invoke(ctx, CourseService_AddCoursePrerequisite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) GetLessons(context.Context, *GetLessonsRequest) (*ListLessonsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLessons not implemented")
+
+func (c *courseServiceClient) RemoveCoursePrerequisite(ctx context.Context, in *CoursePrerequisiteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_RemoveCoursePrerequisite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
-func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CourseServiceServer will
-// result in compilation errors.
-type UnsafeCourseServiceServer interface {
-	mustEmbedUnimplementedCourseServiceServer()
+func (c *courseServiceClient) ListCoursePrerequisites(ctx context.Context, in *ListCoursePrerequisitesRequest, opts ...grpc.CallOption) (*ListCoursePrerequisitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursePrerequisitesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCoursePrerequisites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *courseServiceClient) CreateLearningPath(ctx context.Context, in *CreateLearningPathRequest, opts ...grpc.CallOption) (*LearningPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CourseService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _CourseService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCourseRequest)
-	if err := dec(in); err != nil {
+func (c *courseServiceClient) UpdateLearningPath(ctx context.Context, in *UpdateLearningPathRequest, opts ...grpc.CallOption) (*LearningPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CourseServiceServer).CreateCourse(ctx, in)
+	return out, nil
+}
+
+func (c *courseServiceClient) GetLearningPath(ctx context.Context, in *GetLearningPathRequest, opts ...grpc.CallOption) (*LearningPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CourseService_CreateCourse_FullMethodName,
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteLearningPath(ctx context.Context, in *DeleteLearningPathRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+	return out, nil
+}
+
+func (c *courseServiceClient) ListLearningPaths(ctx context.Context, in *ListLearningPathsRequest, opts ...grpc.CallOption) (*ListLearningPathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLearningPathsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListLearningPaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CourseService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseRequest)
-	if err := dec(in); err != nil {
+func (c *courseServiceClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CourseServiceServer).GetCourse(ctx, in)
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CourseService_GetCourse_FullMethodName,
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetCourse(ctx, req.(*GetCourseRequest))
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateSale(ctx context.Context, in *CreateSaleRequest, opts ...grpc.CallOption) (*SaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaleResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CourseService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCourseRequest)
-	if err := dec(in); err != nil {
+func (c *courseServiceClient) ListSales(ctx context.Context, in *ListSalesRequest, opts ...grpc.CallOption) (*ListSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSalesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteSale(ctx context.Context, in *DeleteSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*PriceQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceQuoteResponse)
+	err := c.cc.Invoke(ctx, CourseService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*PriceQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceQuoteResponse)
+	err := c.cc.Invoke(ctx, CourseService_RedeemCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ReleaseCoupon(ctx context.Context, in *ReleaseCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_ReleaseCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteBundle(ctx context.Context, in *DeleteBundleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBundlesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) QuoteBundle(ctx context.Context, in *QuoteBundleRequest, opts ...grpc.CallOption) (*BundleQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleQuoteResponse)
+	err := c.cc.Invoke(ctx, CourseService_QuoteBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CloneCourse(ctx context.Context, in *CloneCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_CloneCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateCourseTemplate(ctx context.Context, in *CreateCourseTemplateRequest, opts ...grpc.CallOption) (*CourseTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseTemplateResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateCourseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourseTemplate(ctx context.Context, in *GetCourseTemplateRequest, opts ...grpc.CallOption) (*CourseTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseTemplateResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCourseTemplates(ctx context.Context, in *ListCourseTemplatesRequest, opts ...grpc.CallOption) (*ListCourseTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourseTemplatesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourseTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteCourseTemplate(ctx context.Context, in *DeleteCourseTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteCourseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateCourseFromTemplate(ctx context.Context, in *CreateCourseFromTemplateRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateCourseFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ExportCourse(ctx context.Context, in *ExportCourseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCourseChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[0], CourseService_ExportCourse_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportCourseRequest, ExportCourseChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ExportCourseClient = grpc.ServerStreamingClient[ExportCourseChunk]
+
+func (c *courseServiceClient) ImportCourse(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCourseRequest, CourseResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[1], CourseService_ImportCourse_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportCourseRequest, CourseResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ImportCourseClient = grpc.ClientStreamingClient[ImportCourseRequest, CourseResponse]
+
+func (c *courseServiceClient) SetModuleRelease(ctx context.Context, in *SetModuleReleaseRequest, opts ...grpc.CallOption) (*ModuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModuleResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetModuleRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetLessonRelease(ctx context.Context, in *SetLessonReleaseRequest, opts ...grpc.CallOption) (*LessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetLessonRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourseContent(ctx context.Context, in *GetCourseContentRequest, opts ...grpc.CallOption) (*CourseContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseContentResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) AuthorizeVideoStream(ctx context.Context, in *AuthorizeVideoStreamRequest, opts ...grpc.CallOption) (*VideoStreamAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoStreamAuthorization)
+	err := c.cc.Invoke(ctx, CourseService_AuthorizeVideoStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateQuestionBank(ctx context.Context, in *CreateQuestionBankRequest, opts ...grpc.CallOption) (*QuestionBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionBankResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListQuestionBanks(ctx context.Context, in *ListQuestionBanksRequest, opts ...grpc.CallOption) (*ListQuestionBanksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionBanksResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListQuestionBanks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteQuestionBank(ctx context.Context, in *DeleteQuestionBankRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) AddQuestion(ctx context.Context, in *AddQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionResponse)
+	err := c.cc.Invoke(ctx, CourseService_AddQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListQuestions(ctx context.Context, in *ListQuestionsRequest, opts ...grpc.CallOption) (*ListQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetLessonQuiz(ctx context.Context, in *SetLessonQuizRequest, opts ...grpc.CallOption) (*LessonQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonQuizResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetLessonQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetLessonQuiz(ctx context.Context, in *GetLessonQuizRequest, opts ...grpc.CallOption) (*LessonQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonQuizResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetLessonQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) StartQuizAttempt(ctx context.Context, in *StartQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizAttemptResponse)
+	err := c.cc.Invoke(ctx, CourseService_StartQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SubmitQuizAttempt(ctx context.Context, in *SubmitQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizAttemptResponse)
+	err := c.cc.Invoke(ctx, CourseService_SubmitQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListQuizAttempts(ctx context.Context, in *ListQuizAttemptsRequest, opts ...grpc.CallOption) (*ListQuizAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuizAttemptsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListQuizAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetLessonAssignment(ctx context.Context, in *SetLessonAssignmentRequest, opts ...grpc.CallOption) (*LessonAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonAssignmentResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetLessonAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetLessonAssignment(ctx context.Context, in *GetLessonAssignmentRequest, opts ...grpc.CallOption) (*LessonAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonAssignmentResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetLessonAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SubmitAssignment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SubmitAssignmentRequest, AssignmentSubmissionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[2], CourseService_SubmitAssignment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubmitAssignmentRequest, AssignmentSubmissionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_SubmitAssignmentClient = grpc.ClientStreamingClient[SubmitAssignmentRequest, AssignmentSubmissionResponse]
+
+func (c *courseServiceClient) GetMyAssignmentSubmission(ctx context.Context, in *GetMyAssignmentSubmissionRequest, opts ...grpc.CallOption) (*AssignmentSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentSubmissionResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetMyAssignmentSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DownloadAssignmentSubmission(ctx context.Context, in *DownloadAssignmentSubmissionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[3], CourseService_DownloadAssignmentSubmission_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAssignmentSubmissionRequest, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_DownloadAssignmentSubmissionClient = grpc.ServerStreamingClient[FileChunk]
+
+func (c *courseServiceClient) ListGradingQueue(ctx context.Context, in *ListGradingQueueRequest, opts ...grpc.CallOption) (*ListAssignmentSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentSubmissionsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListGradingQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GradeAssignmentSubmission(ctx context.Context, in *GradeAssignmentSubmissionRequest, opts ...grpc.CallOption) (*AssignmentSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentSubmissionResponse)
+	err := c.cc.Invoke(ctx, CourseService_GradeAssignmentSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UploadLessonAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadLessonAttachmentRequest, LessonAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[4], CourseService_UploadLessonAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadLessonAttachmentRequest, LessonAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_UploadLessonAttachmentClient = grpc.ClientStreamingClient[UploadLessonAttachmentRequest, LessonAttachmentResponse]
+
+func (c *courseServiceClient) ListLessonAttachments(ctx context.Context, in *ListLessonAttachmentsRequest, opts ...grpc.CallOption) (*ListLessonAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLessonAttachmentsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListLessonAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteLessonAttachment(ctx context.Context, in *DeleteLessonAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteLessonAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetAttachmentDownloadURL(ctx context.Context, in *GetAttachmentDownloadURLRequest, opts ...grpc.CallOption) (*AttachmentDownloadURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentDownloadURLResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetAttachmentDownloadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) InviteCollaborator(ctx context.Context, in *InviteCollaboratorRequest, opts ...grpc.CallOption) (*CollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollaboratorResponse)
+	err := c.cc.Invoke(ctx, CourseService_InviteCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) AcceptCollaboratorInvitation(ctx context.Context, in *AcceptCollaboratorInvitationRequest, opts ...grpc.CallOption) (*CollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollaboratorResponse)
+	err := c.cc.Invoke(ctx, CourseService_AcceptCollaboratorInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListMyCollaborations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListMyCollaborations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateCollaboratorRole(ctx context.Context, in *UpdateCollaboratorRoleRequest, opts ...grpc.CallOption) (*CollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollaboratorResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateCollaboratorRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_RemoveCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) TransferCourseOwnership(ctx context.Context, in *TransferCourseOwnershipRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_TransferCourseOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ReconcileCourseStats(ctx context.Context, in *ReconcileCourseStatsRequest, opts ...grpc.CallOption) (*ReconcileCourseStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileCourseStatsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ReconcileCourseStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetCourseDefaultLocale(ctx context.Context, in *SetCourseDefaultLocaleRequest, opts ...grpc.CallOption) (*CourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetCourseDefaultLocale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) PutCourseTranslations(ctx context.Context, in *PutCourseTranslationsRequest, opts ...grpc.CallOption) (*ListCourseTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourseTranslationsResponse)
+	err := c.cc.Invoke(ctx, CourseService_PutCourseTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCourseTranslations(ctx context.Context, in *ListCourseTranslationsRequest, opts ...grpc.CallOption) (*ListCourseTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourseTranslationsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourseTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteCourseLocale(ctx context.Context, in *DeleteCourseLocaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_DeleteCourseLocale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetTranslationStatus(ctx context.Context, in *GetTranslationStatusRequest, opts ...grpc.CallOption) (*TranslationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TranslationStatusResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetTranslationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*AnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnouncementResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateDiscussionThread(ctx context.Context, in *CreateDiscussionThreadRequest, opts ...grpc.CallOption) (*DiscussionThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscussionThreadResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateDiscussionThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListDiscussionThreads(ctx context.Context, in *ListDiscussionThreadsRequest, opts ...grpc.CallOption) (*ListDiscussionThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiscussionThreadsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListDiscussionThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetDiscussionThread(ctx context.Context, in *GetDiscussionThreadRequest, opts ...grpc.CallOption) (*GetDiscussionThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscussionThreadResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetDiscussionThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ReplyToDiscussion(ctx context.Context, in *ReplyToDiscussionRequest, opts ...grpc.CallOption) (*DiscussionReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscussionReplyResponse)
+	err := c.cc.Invoke(ctx, CourseService_ReplyToDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) VoteDiscussion(ctx context.Context, in *VoteDiscussionRequest, opts ...grpc.CallOption) (*VoteDiscussionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteDiscussionResponse)
+	err := c.cc.Invoke(ctx, CourseService_VoteDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) MarkDiscussionAnswer(ctx context.Context, in *MarkDiscussionAnswerRequest, opts ...grpc.CallOption) (*DiscussionThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscussionThreadResponse)
+	err := c.cc.Invoke(ctx, CourseService_MarkDiscussionAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ModerateDiscussion(ctx context.Context, in *ModerateDiscussionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_ModerateDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourseServiceServer is the server API for CourseService service.
+// All implementations must embed UnimplementedCourseServiceServer
+// for forward compatibility.
+type CourseServiceServer interface {
+	CreateCourse(context.Context, *CreateCourseRequest) (*CourseResponse, error)
+	GetCourse(context.Context, *GetCourseRequest) (*CourseResponse, error)
+	UpdateCourse(context.Context, *UpdateCourseRequest) (*CourseResponse, error)
+	DeleteCourse(context.Context, *DeleteCourseRequest) (*emptypb.Empty, error)
+	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
+	// Submits the course, or the draft of a live course, for admin review.
+	// It goes live when an admin approves the submission.
+	PublishCourse(context.Context, *PublishCourseRequest) (*CourseResponse, error)
+	GetCoursesByInstructor(context.Context, *GetCoursesByInstructorRequest) (*ListCoursesResponse, error)
+	AddModule(context.Context, *AddModuleRequest) (*ModuleResponse, error)
+	UpdateModule(context.Context, *UpdateModuleRequest) (*ModuleResponse, error)
+	DeleteModule(context.Context, *DeleteModuleRequest) (*emptypb.Empty, error)
+	GetModules(context.Context, *GetModulesRequest) (*ListModulesResponse, error)
+	ReorderModules(context.Context, *ReorderModulesRequest) (*ListModulesResponse, error)
+	AddLesson(context.Context, *AddLessonRequest) (*LessonResponse, error)
+	UpdateLesson(context.Context, *UpdateLessonRequest) (*LessonResponse, error)
+	DeleteLesson(context.Context, *DeleteLessonRequest) (*emptypb.Empty, error)
+	GetLessons(context.Context, *GetLessonsRequest) (*ListLessonsResponse, error)
+	MoveLesson(context.Context, *MoveLessonRequest) (*LessonResponse, error)
+	GetCourseDraft(context.Context, *GetCourseDraftRequest) (*CourseContentResponse, error)
+	DiscardCourseDraft(context.Context, *DiscardCourseDraftRequest) (*emptypb.Empty, error)
+	ListCourseRevisions(context.Context, *ListCourseRevisionsRequest) (*ListCourseRevisionsResponse, error)
+	DiffCourseRevisions(context.Context, *DiffCourseRevisionsRequest) (*DiffCourseRevisionsResponse, error)
+	RollbackCourse(context.Context, *RollbackCourseRequest) (*CourseResponse, error)
+	// Sets when the course goes live once approved and when it is archived.
+	// Unset times are cleared.
+	ScheduleCourse(context.Context, *ScheduleCourseRequest) (*CourseResponse, error)
+	// Takes a published course off sale. Enrolled students keep access.
+	ArchiveCourse(context.Context, *ArchiveCourseRequest) (*CourseResponse, error)
+	ListCourseSubmissions(context.Context, *ListCourseSubmissionsRequest) (*ListSubmissionsResponse, error)
+	ListReviewQueue(context.Context, *ListReviewQueueRequest) (*ListSubmissionsResponse, error)
+	StartCourseReview(context.Context, *StartCourseReviewRequest) (*SubmissionResponse, error)
+	ApproveCourse(context.Context, *ReviewDecisionRequest) (*SubmissionResponse, error)
+	RejectCourse(context.Context, *ReviewDecisionRequest) (*SubmissionResponse, error)
+	AddCoursePrerequisite(context.Context, *CoursePrerequisiteRequest) (*ListCoursePrerequisitesResponse, error)
+	RemoveCoursePrerequisite(context.Context, *CoursePrerequisiteRequest) (*emptypb.Empty, error)
+	ListCoursePrerequisites(context.Context, *ListCoursePrerequisitesRequest) (*ListCoursePrerequisitesResponse, error)
+	CreateLearningPath(context.Context, *CreateLearningPathRequest) (*LearningPathResponse, error)
+	UpdateLearningPath(context.Context, *UpdateLearningPathRequest) (*LearningPathResponse, error)
+	GetLearningPath(context.Context, *GetLearningPathRequest) (*LearningPathResponse, error)
+	DeleteLearningPath(context.Context, *DeleteLearningPathRequest) (*emptypb.Empty, error)
+	ListLearningPaths(context.Context, *ListLearningPathsRequest) (*ListLearningPathsResponse, error)
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error)
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error)
+	DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error)
+	CreateSale(context.Context, *CreateSaleRequest) (*SaleResponse, error)
+	ListSales(context.Context, *ListSalesRequest) (*ListSalesResponse, error)
+	DeleteSale(context.Context, *DeleteSaleRequest) (*emptypb.Empty, error)
+	QuotePrice(context.Context, *QuotePriceRequest) (*PriceQuoteResponse, error)
+	// Prices a checkout and holds one use of the coupon for the enrollment.
+	// Services only, on behalf of the enrolling user.
+	RedeemCoupon(context.Context, *RedeemCouponRequest) (*PriceQuoteResponse, error)
+	// Gives the coupon use back when the enrollment doesn't go through.
+	// Services only, on behalf of the enrolling user.
+	ReleaseCoupon(context.Context, *ReleaseCouponRequest) (*emptypb.Empty, error)
+	CreateBundle(context.Context, *CreateBundleRequest) (*BundleResponse, error)
+	UpdateBundle(context.Context, *UpdateBundleRequest) (*BundleResponse, error)
+	DeleteBundle(context.Context, *DeleteBundleRequest) (*emptypb.Empty, error)
+	GetBundle(context.Context, *GetBundleRequest) (*BundleResponse, error)
+	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	// Prices a bundle for checkout. Fails unless every member course is on sale.
+	QuoteBundle(context.Context, *QuoteBundleRequest) (*BundleQuoteResponse, error)
+	// Copies one of the caller's courses, with its modules and lessons, into
+	// a new draft.
+	CloneCourse(context.Context, *CloneCourseRequest) (*CourseResponse, error)
+	CreateCourseTemplate(context.Context, *CreateCourseTemplateRequest) (*CourseTemplateResponse, error)
+	GetCourseTemplate(context.Context, *GetCourseTemplateRequest) (*CourseTemplateResponse, error)
+	ListCourseTemplates(context.Context, *ListCourseTemplatesRequest) (*ListCourseTemplatesResponse, error)
+	DeleteCourseTemplate(context.Context, *DeleteCourseTemplateRequest) (*emptypb.Empty, error)
+	CreateCourseFromTemplate(context.Context, *CreateCourseFromTemplateRequest) (*CourseResponse, error)
+	// Streams one of the caller's courses as a native zip archive.
+	ExportCourse(*ExportCourseRequest, grpc.ServerStreamingServer[ExportCourseChunk]) error
+	// Recreates an uploaded archive as a new draft. The first message carries
+	// the metadata and the rest the archive bytes.
+	ImportCourse(grpc.ClientStreamingServer[ImportCourseRequest, CourseResponse]) error
+	// Sets when enrolled students get a module or lesson. An immediate rule
+	// clears it.
+	SetModuleRelease(context.Context, *SetModuleReleaseRequest) (*ModuleResponse, error)
+	SetLessonRelease(context.Context, *SetLessonReleaseRequest) (*LessonResponse, error)
+	// Live modules and lessons, with lock state for enrolled students.
+	GetCourseContent(context.Context, *GetCourseContentRequest) (*CourseContentResponse, error)
+	// Called by the video service before it issues a stream URL. Fails with
+	// PERMISSION_DENIED for locked lessons and callers who aren't enrolled.
+	AuthorizeVideoStream(context.Context, *AuthorizeVideoStreamRequest) (*VideoStreamAuthorization, error)
+	CreateQuestionBank(context.Context, *CreateQuestionBankRequest) (*QuestionBankResponse, error)
+	ListQuestionBanks(context.Context, *ListQuestionBanksRequest) (*ListQuestionBanksResponse, error)
+	// Fails while a quiz draws from the bank.
+	DeleteQuestionBank(context.Context, *DeleteQuestionBankRequest) (*emptypb.Empty, error)
+	AddQuestion(context.Context, *AddQuestionRequest) (*QuestionResponse, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*emptypb.Empty, error)
+	ListQuestions(context.Context, *ListQuestionsRequest) (*ListQuestionsResponse, error)
+	// Configures a QUIZ lesson. Changes apply to the next attempt.
+	SetLessonQuiz(context.Context, *SetLessonQuizRequest) (*LessonQuizResponse, error)
+	GetLessonQuiz(context.Context, *GetLessonQuizRequest) (*LessonQuizResponse, error)
+	// Resumes the caller's open attempt or starts a new one. Questions come
+	// without their answers.
+	StartQuizAttempt(context.Context, *StartQuizAttemptRequest) (*QuizAttemptResponse, error)
+	SubmitQuizAttempt(context.Context, *SubmitQuizAttemptRequest) (*QuizAttemptResponse, error)
+	// The caller's attempts at a quiz, newest first.
+	ListQuizAttempts(context.Context, *ListQuizAttemptsRequest) (*ListQuizAttemptsResponse, error)
+	// Configures an ASSIGNMENT lesson. Changes apply straight away.
+	SetLessonAssignment(context.Context, *SetLessonAssignmentRequest) (*LessonAssignmentResponse, error)
+	GetLessonAssignment(context.Context, *GetLessonAssignmentRequest) (*LessonAssignmentResponse, error)
+	// Hands in a file for an assignment. The first message carries the
+	// metadata and the rest the file bytes. Uploading again replaces the
+	// caller's earlier file until it is graded.
+	SubmitAssignment(grpc.ClientStreamingServer[SubmitAssignmentRequest, AssignmentSubmissionResponse]) error
+	GetMyAssignmentSubmission(context.Context, *GetMyAssignmentSubmissionRequest) (*AssignmentSubmissionResponse, error)
+	// Streams a submitted file to the learner who handed it in or the
+	// course's instructor.
+	DownloadAssignmentSubmission(*DownloadAssignmentSubmissionRequest, grpc.ServerStreamingServer[FileChunk]) error
+	// Ungraded submissions of the instructor's course, oldest first.
+	ListGradingQueue(context.Context, *ListGradingQueueRequest) (*ListAssignmentSubmissionsResponse, error)
+	GradeAssignmentSubmission(context.Context, *GradeAssignmentSubmissionRequest) (*AssignmentSubmissionResponse, error)
+	// Attaches a file to a lesson. The first message carries the metadata and
+	// the rest the file bytes.
+	UploadLessonAttachment(grpc.ClientStreamingServer[UploadLessonAttachmentRequest, LessonAttachmentResponse]) error
+	ListLessonAttachments(context.Context, *ListLessonAttachmentsRequest) (*ListLessonAttachmentsResponse, error)
+	DeleteLessonAttachment(context.Context, *DeleteLessonAttachmentRequest) (*emptypb.Empty, error)
+	// Returns a short-lived link to the file for callers enrolled in the
+	// course, or for anyone if the lesson is a preview.
+	GetAttachmentDownloadURL(context.Context, *GetAttachmentDownloadURLRequest) (*AttachmentDownloadURLResponse, error)
+	// Invites a user to work on the caller's course. The role takes effect
+	// once they accept.
+	InviteCollaborator(context.Context, *InviteCollaboratorRequest) (*CollaboratorResponse, error)
+	AcceptCollaboratorInvitation(context.Context, *AcceptCollaboratorInvitationRequest) (*CollaboratorResponse, error)
+	// The course's team, owner first, including pending invitations.
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	// The caller's invitations and the courses they collaborate on.
+	ListMyCollaborations(context.Context, *emptypb.Empty) (*ListCollaboratorsResponse, error)
+	UpdateCollaboratorRole(context.Context, *UpdateCollaboratorRoleRequest) (*CollaboratorResponse, error)
+	// Owners can remove anyone; collaborators can remove themselves, which
+	// also declines an invitation.
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*emptypb.Empty, error)
+	// Hands the course to an active collaborator. The previous owner stays on
+	// as an editor.
+	TransferCourseOwnership(context.Context, *TransferCourseOwnershipRequest) (*CourseResponse, error)
+	// Admin only. Recomputes duration and enrolled count from the lessons and
+	// the recorded enrollments, for one course or, with no course_id, all.
+	ReconcileCourseStats(context.Context, *ReconcileCourseStatsRequest) (*ReconcileCourseStatsResponse, error)
+	// The category tree as a flat list, siblings in display order.
+	ListCategories(context.Context, *emptypb.Empty) (*ListCategoriesResponse, error)
+	// Admin only.
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryResponse, error)
+	// Admin only. The slug can't change.
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error)
+	// Admin only. Courses in the category move to reassign_to, which is how
+	// duplicate categories are merged; without it the category must be unused.
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	// Owner only. Records which locale the course's own text is written in.
+	SetCourseDefaultLocale(context.Context, *SetCourseDefaultLocaleRequest) (*CourseResponse, error)
+	// Sets translations into one locale; an empty value removes one.
+	// Returns all of the course's translations into that locale.
+	PutCourseTranslations(context.Context, *PutCourseTranslationsRequest) (*ListCourseTranslationsResponse, error)
+	ListCourseTranslations(context.Context, *ListCourseTranslationsRequest) (*ListCourseTranslationsResponse, error)
+	DeleteCourseLocale(context.Context, *DeleteCourseLocaleRequest) (*emptypb.Empty, error)
+	// How much of the draft, or the live content without one, is translated
+	// into each locale.
+	GetTranslationStatus(context.Context, *GetTranslationStatusRequest) (*TranslationStatusResponse, error)
+	// Owners and editors. Sent to every actively enrolled student at
+	// send_at, or straight away without one.
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*AnnouncementResponse, error)
+	// Staff see scheduled announcements and delivery stats; enrolled students
+	// see what has been sent.
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error)
+	// Discussions are open to staff and enrolled students; a lesson's only to
+	// students who can open the lesson. Without lesson_id a thread is about
+	// the course as a whole.
+	CreateDiscussionThread(context.Context, *CreateDiscussionThreadRequest) (*DiscussionThreadResponse, error)
+	// Pinned threads first, then by latest activity. Hidden threads are
+	// listed for staff only.
+	ListDiscussionThreads(context.Context, *ListDiscussionThreadsRequest) (*ListDiscussionThreadsResponse, error)
+	GetDiscussionThread(context.Context, *GetDiscussionThreadRequest) (*GetDiscussionThreadResponse, error)
+	// Notifies the thread's author and, when replying to a reply, its author.
+	ReplyToDiscussion(context.Context, *ReplyToDiscussionRequest) (*DiscussionReplyResponse, error)
+	VoteDiscussion(context.Context, *VoteDiscussionRequest) (*VoteDiscussionResponse, error)
+	// Staff only. An empty reply_id clears the answer.
+	MarkDiscussionAnswer(context.Context, *MarkDiscussionAnswerRequest) (*DiscussionThreadResponse, error)
+	// Staff only. Replies can only be hidden or unhidden.
+	ModerateDiscussion(context.Context, *ModerateDiscussionRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+// UnimplementedCourseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourseServiceServer struct{}
+
+func (UnimplementedCourseServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourse(context.Context, *GetCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateCourse(context.Context, *UpdateCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) PublishCourse(context.Context, *PublishCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCoursesByInstructor(context.Context, *GetCoursesByInstructorRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByInstructor not implemented")
+}
+func (UnimplementedCourseServiceServer) AddModule(context.Context, *AddModuleRequest) (*ModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddModule not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateModule(context.Context, *UpdateModuleRequest) (*ModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModule not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteModule(context.Context, *DeleteModuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModule not implemented")
+}
+func (UnimplementedCourseServiceServer) GetModules(context.Context, *GetModulesRequest) (*ListModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModules not implemented")
+}
+func (UnimplementedCourseServiceServer) ReorderModules(context.Context, *ReorderModulesRequest) (*ListModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderModules not implemented")
+}
+func (UnimplementedCourseServiceServer) AddLesson(context.Context, *AddLessonRequest) (*LessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*LessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) GetLessons(context.Context, *GetLessonsRequest) (*ListLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessons not implemented")
+}
+func (UnimplementedCourseServiceServer) MoveLesson(context.Context, *MoveLessonRequest) (*LessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveLesson not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseDraft(context.Context, *GetCourseDraftRequest) (*CourseContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseDraft not implemented")
+}
+func (UnimplementedCourseServiceServer) DiscardCourseDraft(context.Context, *DiscardCourseDraftRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardCourseDraft not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourseRevisions(context.Context, *ListCourseRevisionsRequest) (*ListCourseRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseRevisions not implemented")
+}
+func (UnimplementedCourseServiceServer) DiffCourseRevisions(context.Context, *DiffCourseRevisionsRequest) (*DiffCourseRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCourseRevisions not implemented")
+}
+func (UnimplementedCourseServiceServer) RollbackCourse(context.Context, *RollbackCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ScheduleCourse(context.Context, *ScheduleCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ArchiveCourse(context.Context, *ArchiveCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourseSubmissions(context.Context, *ListCourseSubmissionsRequest) (*ListSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseSubmissions not implemented")
+}
+func (UnimplementedCourseServiceServer) ListReviewQueue(context.Context, *ListReviewQueueRequest) (*ListSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewQueue not implemented")
+}
+func (UnimplementedCourseServiceServer) StartCourseReview(context.Context, *StartCourseReviewRequest) (*SubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCourseReview not implemented")
+}
+func (UnimplementedCourseServiceServer) ApproveCourse(context.Context, *ReviewDecisionRequest) (*SubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) RejectCourse(context.Context, *ReviewDecisionRequest) (*SubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) AddCoursePrerequisite(context.Context, *CoursePrerequisiteRequest) (*ListCoursePrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCoursePrerequisite not implemented")
+}
+func (UnimplementedCourseServiceServer) RemoveCoursePrerequisite(context.Context, *CoursePrerequisiteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoursePrerequisite not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCoursePrerequisites(context.Context, *ListCoursePrerequisitesRequest) (*ListCoursePrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoursePrerequisites not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateLearningPath(context.Context, *CreateLearningPathRequest) (*LearningPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLearningPath not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateLearningPath(context.Context, *UpdateLearningPathRequest) (*LearningPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLearningPath not implemented")
+}
+func (UnimplementedCourseServiceServer) GetLearningPath(context.Context, *GetLearningPathRequest) (*LearningPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningPath not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteLearningPath(context.Context, *DeleteLearningPathRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLearningPath not implemented")
+}
+func (UnimplementedCourseServiceServer) ListLearningPaths(context.Context, *ListLearningPathsRequest) (*ListLearningPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLearningPaths not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoupon not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateSale(context.Context, *CreateSaleRequest) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSale not implemented")
+}
+func (UnimplementedCourseServiceServer) ListSales(context.Context, *ListSalesRequest) (*ListSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSales not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteSale(context.Context, *DeleteSaleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSale not implemented")
+}
+func (UnimplementedCourseServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*PriceQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrice not implemented")
+}
+func (UnimplementedCourseServiceServer) RedeemCoupon(context.Context, *RedeemCouponRequest) (*PriceQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedCourseServiceServer) ReleaseCoupon(context.Context, *ReleaseCouponRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCoupon not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateBundle(context.Context, *UpdateBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBundle not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteBundle(context.Context, *DeleteBundleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBundle not implemented")
+}
+func (UnimplementedCourseServiceServer) GetBundle(context.Context, *GetBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedCourseServiceServer) ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBundles not implemented")
+}
+func (UnimplementedCourseServiceServer) QuoteBundle(context.Context, *QuoteBundleRequest) (*BundleQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteBundle not implemented")
+}
+func (UnimplementedCourseServiceServer) CloneCourse(context.Context, *CloneCourseRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateCourseTemplate(context.Context, *CreateCourseTemplateRequest) (*CourseTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourseTemplate not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseTemplate(context.Context, *GetCourseTemplateRequest) (*CourseTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseTemplate not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourseTemplates(context.Context, *ListCourseTemplatesRequest) (*ListCourseTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseTemplates not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteCourseTemplate(context.Context, *DeleteCourseTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourseTemplate not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateCourseFromTemplate(context.Context, *CreateCourseFromTemplateRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourseFromTemplate not implemented")
+}
+func (UnimplementedCourseServiceServer) ExportCourse(*ExportCourseRequest, grpc.ServerStreamingServer[ExportCourseChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) ImportCourse(grpc.ClientStreamingServer[ImportCourseRequest, CourseResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) SetModuleRelease(context.Context, *SetModuleReleaseRequest) (*ModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModuleRelease not implemented")
+}
+func (UnimplementedCourseServiceServer) SetLessonRelease(context.Context, *SetLessonReleaseRequest) (*LessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonRelease not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseContent(context.Context, *GetCourseContentRequest) (*CourseContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseContent not implemented")
+}
+func (UnimplementedCourseServiceServer) AuthorizeVideoStream(context.Context, *AuthorizeVideoStreamRequest) (*VideoStreamAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeVideoStream not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateQuestionBank(context.Context, *CreateQuestionBankRequest) (*QuestionBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestionBank not implemented")
+}
+func (UnimplementedCourseServiceServer) ListQuestionBanks(context.Context, *ListQuestionBanksRequest) (*ListQuestionBanksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestionBanks not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteQuestionBank(context.Context, *DeleteQuestionBankRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestionBank not implemented")
+}
+func (UnimplementedCourseServiceServer) AddQuestion(context.Context, *AddQuestionRequest) (*QuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQuestion not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedCourseServiceServer) ListQuestions(context.Context, *ListQuestionsRequest) (*ListQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestions not implemented")
+}
+func (UnimplementedCourseServiceServer) SetLessonQuiz(context.Context, *SetLessonQuizRequest) (*LessonQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonQuiz not implemented")
+}
+func (UnimplementedCourseServiceServer) GetLessonQuiz(context.Context, *GetLessonQuizRequest) (*LessonQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonQuiz not implemented")
+}
+func (UnimplementedCourseServiceServer) StartQuizAttempt(context.Context, *StartQuizAttemptRequest) (*QuizAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartQuizAttempt not implemented")
+}
+func (UnimplementedCourseServiceServer) SubmitQuizAttempt(context.Context, *SubmitQuizAttemptRequest) (*QuizAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuizAttempt not implemented")
+}
+func (UnimplementedCourseServiceServer) ListQuizAttempts(context.Context, *ListQuizAttemptsRequest) (*ListQuizAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizAttempts not implemented")
+}
+func (UnimplementedCourseServiceServer) SetLessonAssignment(context.Context, *SetLessonAssignmentRequest) (*LessonAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonAssignment not implemented")
+}
+func (UnimplementedCourseServiceServer) GetLessonAssignment(context.Context, *GetLessonAssignmentRequest) (*LessonAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonAssignment not implemented")
+}
+func (UnimplementedCourseServiceServer) SubmitAssignment(grpc.ClientStreamingServer[SubmitAssignmentRequest, AssignmentSubmissionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SubmitAssignment not implemented")
+}
+func (UnimplementedCourseServiceServer) GetMyAssignmentSubmission(context.Context, *GetMyAssignmentSubmissionRequest) (*AssignmentSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyAssignmentSubmission not implemented")
+}
+func (UnimplementedCourseServiceServer) DownloadAssignmentSubmission(*DownloadAssignmentSubmissionRequest, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAssignmentSubmission not implemented")
+}
+func (UnimplementedCourseServiceServer) ListGradingQueue(context.Context, *ListGradingQueueRequest) (*ListAssignmentSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradingQueue not implemented")
+}
+func (UnimplementedCourseServiceServer) GradeAssignmentSubmission(context.Context, *GradeAssignmentSubmissionRequest) (*AssignmentSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeAssignmentSubmission not implemented")
+}
+func (UnimplementedCourseServiceServer) UploadLessonAttachment(grpc.ClientStreamingServer[UploadLessonAttachmentRequest, LessonAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadLessonAttachment not implemented")
+}
+func (UnimplementedCourseServiceServer) ListLessonAttachments(context.Context, *ListLessonAttachmentsRequest) (*ListLessonAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLessonAttachments not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteLessonAttachment(context.Context, *DeleteLessonAttachmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLessonAttachment not implemented")
+}
+func (UnimplementedCourseServiceServer) GetAttachmentDownloadURL(context.Context, *GetAttachmentDownloadURLRequest) (*AttachmentDownloadURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentDownloadURL not implemented")
+}
+func (UnimplementedCourseServiceServer) InviteCollaborator(context.Context, *InviteCollaboratorRequest) (*CollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCollaborator not implemented")
+}
+func (UnimplementedCourseServiceServer) AcceptCollaboratorInvitation(context.Context, *AcceptCollaboratorInvitationRequest) (*CollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCollaboratorInvitation not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedCourseServiceServer) ListMyCollaborations(context.Context, *emptypb.Empty) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCollaborations not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateCollaboratorRole(context.Context, *UpdateCollaboratorRoleRequest) (*CollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollaboratorRole not implemented")
+}
+func (UnimplementedCourseServiceServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedCourseServiceServer) TransferCourseOwnership(context.Context, *TransferCourseOwnershipRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCourseOwnership not implemented")
+}
+func (UnimplementedCourseServiceServer) ReconcileCourseStats(context.Context, *ReconcileCourseStatsRequest) (*ReconcileCourseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileCourseStats not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCategories(context.Context, *emptypb.Empty) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCourseServiceServer) SetCourseDefaultLocale(context.Context, *SetCourseDefaultLocaleRequest) (*CourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCourseDefaultLocale not implemented")
+}
+func (UnimplementedCourseServiceServer) PutCourseTranslations(context.Context, *PutCourseTranslationsRequest) (*ListCourseTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutCourseTranslations not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourseTranslations(context.Context, *ListCourseTranslationsRequest) (*ListCourseTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourseTranslations not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteCourseLocale(context.Context, *DeleteCourseLocaleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourseLocale not implemented")
+}
+func (UnimplementedCourseServiceServer) GetTranslationStatus(context.Context, *GetTranslationStatusRequest) (*TranslationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTranslationStatus not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*AnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedCourseServiceServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateDiscussionThread(context.Context, *CreateDiscussionThreadRequest) (*DiscussionThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscussionThread not implemented")
+}
+func (UnimplementedCourseServiceServer) ListDiscussionThreads(context.Context, *ListDiscussionThreadsRequest) (*ListDiscussionThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiscussionThreads not implemented")
+}
+func (UnimplementedCourseServiceServer) GetDiscussionThread(context.Context, *GetDiscussionThreadRequest) (*GetDiscussionThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscussionThread not implemented")
+}
+func (UnimplementedCourseServiceServer) ReplyToDiscussion(context.Context, *ReplyToDiscussionRequest) (*DiscussionReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToDiscussion not implemented")
+}
+func (UnimplementedCourseServiceServer) VoteDiscussion(context.Context, *VoteDiscussionRequest) (*VoteDiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDiscussion not implemented")
+}
+func (UnimplementedCourseServiceServer) MarkDiscussionAnswer(context.Context, *MarkDiscussionAnswerRequest) (*DiscussionThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDiscussionAnswer not implemented")
+}
+func (UnimplementedCourseServiceServer) ModerateDiscussion(context.Context, *ModerateDiscussionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateDiscussion not implemented")
+}
+func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
+func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourseServiceServer will
+// result in compilation errors.
+type UnsafeCourseServiceServer interface {
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourseService_ServiceDesc, srv)
+}
+
+func _CourseService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourse(ctx, req.(*GetCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
 		return srv.(CourseServiceServer).UpdateCourse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_UpdateCourse_FullMethodName,
+		FullMethod: CourseService_UpdateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateCourse(ctx, req.(*UpdateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteCourse(ctx, req.(*DeleteCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourses(ctx, req.(*ListCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_PublishCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).PublishCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_PublishCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).PublishCourse(ctx, req.(*PublishCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCoursesByInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoursesByInstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCoursesByInstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCoursesByInstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCoursesByInstructor(ctx, req.(*GetCoursesByInstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AddModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AddModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AddModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AddModule(ctx, req.(*AddModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateModule(ctx, req.(*UpdateModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteModule(ctx, req.(*DeleteModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetModules(ctx, req.(*GetModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ReorderModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ReorderModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ReorderModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ReorderModules(ctx, req.(*ReorderModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AddLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AddLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AddLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AddLesson(ctx, req.(*AddLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateLesson(ctx, req.(*UpdateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteLesson(ctx, req.(*DeleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetLessons(ctx, req.(*GetLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_MoveLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).MoveLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_MoveLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).MoveLesson(ctx, req.(*MoveLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourseDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseDraft(ctx, req.(*GetCourseDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DiscardCourseDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardCourseDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DiscardCourseDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DiscardCourseDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DiscardCourseDraft(ctx, req.(*DiscardCourseDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCourseRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourseRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourseRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourseRevisions(ctx, req.(*ListCourseRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DiffCourseRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCourseRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DiffCourseRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DiffCourseRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DiffCourseRevisions(ctx, req.(*DiffCourseRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RollbackCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RollbackCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RollbackCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RollbackCourse(ctx, req.(*RollbackCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ScheduleCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ScheduleCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ScheduleCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ScheduleCourse(ctx, req.(*ScheduleCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ArchiveCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ArchiveCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ArchiveCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ArchiveCourse(ctx, req.(*ArchiveCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCourseSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourseSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourseSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourseSubmissions(ctx, req.(*ListCourseSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListReviewQueue(ctx, req.(*ListReviewQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_StartCourseReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCourseReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).StartCourseReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_StartCourseReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).StartCourseReview(ctx, req.(*StartCourseReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ApproveCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ApproveCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ApproveCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ApproveCourse(ctx, req.(*ReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RejectCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RejectCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RejectCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RejectCourse(ctx, req.(*ReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AddCoursePrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoursePrerequisiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AddCoursePrerequisite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AddCoursePrerequisite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AddCoursePrerequisite(ctx, req.(*CoursePrerequisiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RemoveCoursePrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoursePrerequisiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RemoveCoursePrerequisite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RemoveCoursePrerequisite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RemoveCoursePrerequisite(ctx, req.(*CoursePrerequisiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCoursePrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursePrerequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCoursePrerequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCoursePrerequisites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCoursePrerequisites(ctx, req.(*ListCoursePrerequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateLearningPath(ctx, req.(*CreateLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateLearningPath(ctx, req.(*UpdateLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetLearningPath(ctx, req.(*GetLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteLearningPath(ctx, req.(*DeleteLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListLearningPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLearningPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListLearningPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListLearningPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListLearningPaths(ctx, req.(*ListLearningPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateSale(ctx, req.(*CreateSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListSales(ctx, req.(*ListSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteSale(ctx, req.(*DeleteSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_QuotePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RedeemCoupon(ctx, req.(*RedeemCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ReleaseCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ReleaseCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ReleaseCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ReleaseCoupon(ctx, req.(*ReleaseCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateBundle(ctx, req.(*CreateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateBundle(ctx, req.(*UpdateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteBundle(ctx, req.(*DeleteBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListBundles(ctx, req.(*ListBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_QuoteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).QuoteBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_QuoteBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).QuoteBundle(ctx, req.(*QuoteBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CloneCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CloneCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CloneCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CloneCourse(ctx, req.(*CloneCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateCourseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCourseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCourseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCourseTemplate(ctx, req.(*CreateCourseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseTemplate(ctx, req.(*GetCourseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCourseTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourseTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourseTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourseTemplates(ctx, req.(*ListCourseTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteCourseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteCourseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteCourseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteCourseTemplate(ctx, req.(*DeleteCourseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateCourseFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCourseFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCourseFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCourseFromTemplate(ctx, req.(*CreateCourseFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ExportCourse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCourseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CourseServiceServer).ExportCourse(m, &grpc.GenericServerStream[ExportCourseRequest, ExportCourseChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ExportCourseServer = grpc.ServerStreamingServer[ExportCourseChunk]
+
+func _CourseService_ImportCourse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourseServiceServer).ImportCourse(&grpc.GenericServerStream[ImportCourseRequest, CourseResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ImportCourseServer = grpc.ClientStreamingServer[ImportCourseRequest, CourseResponse]
+
+func _CourseService_SetModuleRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetModuleReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetModuleRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetModuleRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetModuleRelease(ctx, req.(*SetModuleReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetLessonRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLessonReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetLessonRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetLessonRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetLessonRelease(ctx, req.(*SetLessonReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourseContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseContent(ctx, req.(*GetCourseContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AuthorizeVideoStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeVideoStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AuthorizeVideoStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AuthorizeVideoStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AuthorizeVideoStream(ctx, req.(*AuthorizeVideoStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateQuestionBank(ctx, req.(*CreateQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListQuestionBanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionBanksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListQuestionBanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListQuestionBanks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListQuestionBanks(ctx, req.(*ListQuestionBanksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteQuestionBank(ctx, req.(*DeleteQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AddQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AddQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AddQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AddQuestion(ctx, req.(*AddQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListQuestions(ctx, req.(*ListQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetLessonQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLessonQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetLessonQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetLessonQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetLessonQuiz(ctx, req.(*SetLessonQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetLessonQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetLessonQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetLessonQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetLessonQuiz(ctx, req.(*GetLessonQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_StartQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).StartQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_StartQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).StartQuizAttempt(ctx, req.(*StartQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SubmitQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SubmitQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SubmitQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SubmitQuizAttempt(ctx, req.(*SubmitQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListQuizAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListQuizAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListQuizAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListQuizAttempts(ctx, req.(*ListQuizAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetLessonAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLessonAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetLessonAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetLessonAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetLessonAssignment(ctx, req.(*SetLessonAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetLessonAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetLessonAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetLessonAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetLessonAssignment(ctx, req.(*GetLessonAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SubmitAssignment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourseServiceServer).SubmitAssignment(&grpc.GenericServerStream[SubmitAssignmentRequest, AssignmentSubmissionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_SubmitAssignmentServer = grpc.ClientStreamingServer[SubmitAssignmentRequest, AssignmentSubmissionResponse]
+
+func _CourseService_GetMyAssignmentSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyAssignmentSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetMyAssignmentSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetMyAssignmentSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetMyAssignmentSubmission(ctx, req.(*GetMyAssignmentSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DownloadAssignmentSubmission_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAssignmentSubmissionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CourseServiceServer).DownloadAssignmentSubmission(m, &grpc.GenericServerStream[DownloadAssignmentSubmissionRequest, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_DownloadAssignmentSubmissionServer = grpc.ServerStreamingServer[FileChunk]
+
+func _CourseService_ListGradingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListGradingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListGradingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListGradingQueue(ctx, req.(*ListGradingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GradeAssignmentSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeAssignmentSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GradeAssignmentSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GradeAssignmentSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GradeAssignmentSubmission(ctx, req.(*GradeAssignmentSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UploadLessonAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourseServiceServer).UploadLessonAttachment(&grpc.GenericServerStream[UploadLessonAttachmentRequest, LessonAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_UploadLessonAttachmentServer = grpc.ClientStreamingServer[UploadLessonAttachmentRequest, LessonAttachmentResponse]
+
+func _CourseService_ListLessonAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLessonAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListLessonAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListLessonAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListLessonAttachments(ctx, req.(*ListLessonAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteLessonAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteLessonAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteLessonAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteLessonAttachment(ctx, req.(*DeleteLessonAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetAttachmentDownloadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentDownloadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetAttachmentDownloadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetAttachmentDownloadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetAttachmentDownloadURL(ctx, req.(*GetAttachmentDownloadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_InviteCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).InviteCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_InviteCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).InviteCollaborator(ctx, req.(*InviteCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AcceptCollaboratorInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCollaboratorInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AcceptCollaboratorInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AcceptCollaboratorInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AcceptCollaboratorInvitation(ctx, req.(*AcceptCollaboratorInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListMyCollaborations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListMyCollaborations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListMyCollaborations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListMyCollaborations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateCollaboratorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollaboratorRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateCollaboratorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateCollaboratorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateCollaboratorRole(ctx, req.(*UpdateCollaboratorRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RemoveCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_TransferCourseOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCourseOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).TransferCourseOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_TransferCourseOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).TransferCourseOwnership(ctx, req.(*TransferCourseOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ReconcileCourseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCourseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ReconcileCourseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ReconcileCourseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ReconcileCourseStats(ctx, req.(*ReconcileCourseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetCourseDefaultLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCourseDefaultLocaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetCourseDefaultLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetCourseDefaultLocale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetCourseDefaultLocale(ctx, req.(*SetCourseDefaultLocaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_PutCourseTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutCourseTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).PutCourseTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_PutCourseTranslations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).UpdateCourse(ctx, req.(*UpdateCourseRequest))
+		return srv.(CourseServiceServer).PutCourseTranslations(ctx, req.(*PutCourseTranslationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCourseRequest)
+func _CourseService_ListCourseTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourseTranslationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).DeleteCourse(ctx, in)
+		return srv.(CourseServiceServer).ListCourseTranslations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_DeleteCourse_FullMethodName,
+		FullMethod: CourseService_ListCourseTranslations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).DeleteCourse(ctx, req.(*DeleteCourseRequest))
+		return srv.(CourseServiceServer).ListCourseTranslations(ctx, req.(*ListCourseTranslationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCoursesRequest)
+func _CourseService_DeleteCourseLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseLocaleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).ListCourses(ctx, in)
+		return srv.(CourseServiceServer).DeleteCourseLocale(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_ListCourses_FullMethodName,
+		FullMethod: CourseService_DeleteCourseLocale_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).ListCourses(ctx, req.(*ListCoursesRequest))
+		return srv.(CourseServiceServer).DeleteCourseLocale(ctx, req.(*DeleteCourseLocaleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_PublishCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishCourseRequest)
+func _CourseService_GetTranslationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTranslationStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).PublishCourse(ctx, in)
+		return srv.(CourseServiceServer).GetTranslationStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_PublishCourse_FullMethodName,
+		FullMethod: CourseService_GetTranslationStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).PublishCourse(ctx, req.(*PublishCourseRequest))
+		return srv.(CourseServiceServer).GetTranslationStatus(ctx, req.(*GetTranslationStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_GetCoursesByInstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCoursesByInstructorRequest)
+func _CourseService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).GetCoursesByInstructor(ctx, in)
+		return srv.(CourseServiceServer).CreateAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_GetCoursesByInstructor_FullMethodName,
+		FullMethod: CourseService_CreateAnnouncement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetCoursesByInstructor(ctx, req.(*GetCoursesByInstructorRequest))
+		return srv.(CourseServiceServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_AddModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddModuleRequest)
+func _CourseService_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).AddModule(ctx, in)
+		return srv.(CourseServiceServer).ListAnnouncements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_AddModule_FullMethodName,
+		FullMethod: CourseService_ListAnnouncements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).AddModule(ctx, req.(*AddModuleRequest))
+		return srv.(CourseServiceServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_UpdateModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateModuleRequest)
+func _CourseService_CreateDiscussionThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiscussionThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).UpdateModule(ctx, in)
+		return srv.(CourseServiceServer).CreateDiscussionThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_UpdateModule_FullMethodName,
+		FullMethod: CourseService_CreateDiscussionThread_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).UpdateModule(ctx, req.(*UpdateModuleRequest))
+		return srv.(CourseServiceServer).CreateDiscussionThread(ctx, req.(*CreateDiscussionThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_DeleteModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteModuleRequest)
+func _CourseService_ListDiscussionThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiscussionThreadsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).DeleteModule(ctx, in)
+		return srv.(CourseServiceServer).ListDiscussionThreads(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_DeleteModule_FullMethodName,
+		FullMethod: CourseService_ListDiscussionThreads_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).DeleteModule(ctx, req.(*DeleteModuleRequest))
+		return srv.(CourseServiceServer).ListDiscussionThreads(ctx, req.(*ListDiscussionThreadsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_GetModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetModulesRequest)
+func _CourseService_GetDiscussionThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscussionThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).GetModules(ctx, in)
+		return srv.(CourseServiceServer).GetDiscussionThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_GetModules_FullMethodName,
+		FullMethod: CourseService_GetDiscussionThread_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetModules(ctx, req.(*GetModulesRequest))
+		return srv.(CourseServiceServer).GetDiscussionThread(ctx, req.(*GetDiscussionThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_AddLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLessonRequest)
+func _CourseService_ReplyToDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToDiscussionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).AddLesson(ctx, in)
+		return srv.(CourseServiceServer).ReplyToDiscussion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_AddLesson_FullMethodName,
+		FullMethod: CourseService_ReplyToDiscussion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).AddLesson(ctx, req.(*AddLessonRequest))
+		return srv.(CourseServiceServer).ReplyToDiscussion(ctx, req.(*ReplyToDiscussionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLessonRequest)
+func _CourseService_VoteDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteDiscussionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).UpdateLesson(ctx, in)
+		return srv.(CourseServiceServer).VoteDiscussion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_UpdateLesson_FullMethodName,
+		FullMethod: CourseService_VoteDiscussion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).UpdateLesson(ctx, req.(*UpdateLessonRequest))
+		return srv.(CourseServiceServer).VoteDiscussion(ctx, req.(*VoteDiscussionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_DeleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLessonRequest)
+func _CourseService_MarkDiscussionAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkDiscussionAnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).DeleteLesson(ctx, in)
+		return srv.(CourseServiceServer).MarkDiscussionAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_DeleteLesson_FullMethodName,
+		FullMethod: CourseService_MarkDiscussionAnswer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).DeleteLesson(ctx, req.(*DeleteLessonRequest))
+		return srv.(CourseServiceServer).MarkDiscussionAnswer(ctx, req.(*MarkDiscussionAnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLessonsRequest)
+func _CourseService_ModerateDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateDiscussionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).GetLessons(ctx, in)
+		return srv.(CourseServiceServer).ModerateDiscussion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_GetLessons_FullMethodName,
+		FullMethod: CourseService_ModerateDiscussion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetLessons(ctx, req.(*GetLessonsRequest))
+		return srv.(CourseServiceServer).ModerateDiscussion(ctx, req.(*ModerateDiscussionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -632,6 +4046,10 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetModules",
 			Handler:    _CourseService_GetModules_Handler,
 		},
+		{
+			MethodName: "ReorderModules",
+			Handler:    _CourseService_ReorderModules_Handler,
+		},
 		{
 			MethodName: "AddLesson",
 			Handler:    _CourseService_AddLesson_Handler,
@@ -648,7 +4066,397 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLessons",
 			Handler:    _CourseService_GetLessons_Handler,
 		},
+		{
+			MethodName: "MoveLesson",
+			Handler:    _CourseService_MoveLesson_Handler,
+		},
+		{
+			MethodName: "GetCourseDraft",
+			Handler:    _CourseService_GetCourseDraft_Handler,
+		},
+		{
+			MethodName: "DiscardCourseDraft",
+			Handler:    _CourseService_DiscardCourseDraft_Handler,
+		},
+		{
+			MethodName: "ListCourseRevisions",
+			Handler:    _CourseService_ListCourseRevisions_Handler,
+		},
+		{
+			MethodName: "DiffCourseRevisions",
+			Handler:    _CourseService_DiffCourseRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackCourse",
+			Handler:    _CourseService_RollbackCourse_Handler,
+		},
+		{
+			MethodName: "ScheduleCourse",
+			Handler:    _CourseService_ScheduleCourse_Handler,
+		},
+		{
+			MethodName: "ArchiveCourse",
+			Handler:    _CourseService_ArchiveCourse_Handler,
+		},
+		{
+			MethodName: "ListCourseSubmissions",
+			Handler:    _CourseService_ListCourseSubmissions_Handler,
+		},
+		{
+			MethodName: "ListReviewQueue",
+			Handler:    _CourseService_ListReviewQueue_Handler,
+		},
+		{
+			MethodName: "StartCourseReview",
+			Handler:    _CourseService_StartCourseReview_Handler,
+		},
+		{
+			MethodName: "ApproveCourse",
+			Handler:    _CourseService_ApproveCourse_Handler,
+		},
+		{
+			MethodName: "RejectCourse",
+			Handler:    _CourseService_RejectCourse_Handler,
+		},
+		{
+			MethodName: "AddCoursePrerequisite",
+			Handler:    _CourseService_AddCoursePrerequisite_Handler,
+		},
+		{
+			MethodName: "RemoveCoursePrerequisite",
+			Handler:    _CourseService_RemoveCoursePrerequisite_Handler,
+		},
+		{
+			MethodName: "ListCoursePrerequisites",
+			Handler:    _CourseService_ListCoursePrerequisites_Handler,
+		},
+		{
+			MethodName: "CreateLearningPath",
+			Handler:    _CourseService_CreateLearningPath_Handler,
+		},
+		{
+			MethodName: "UpdateLearningPath",
+			Handler:    _CourseService_UpdateLearningPath_Handler,
+		},
+		{
+			MethodName: "GetLearningPath",
+			Handler:    _CourseService_GetLearningPath_Handler,
+		},
+		{
+			MethodName: "DeleteLearningPath",
+			Handler:    _CourseService_DeleteLearningPath_Handler,
+		},
+		{
+			MethodName: "ListLearningPaths",
+			Handler:    _CourseService_ListLearningPaths_Handler,
+		},
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _CourseService_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _CourseService_ListCoupons_Handler,
+		},
+		{
+			MethodName: "DeleteCoupon",
+			Handler:    _CourseService_DeleteCoupon_Handler,
+		},
+		{
+			MethodName: "CreateSale",
+			Handler:    _CourseService_CreateSale_Handler,
+		},
+		{
+			MethodName: "ListSales",
+			Handler:    _CourseService_ListSales_Handler,
+		},
+		{
+			MethodName: "DeleteSale",
+			Handler:    _CourseService_DeleteSale_Handler,
+		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _CourseService_QuotePrice_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _CourseService_RedeemCoupon_Handler,
+		},
+		{
+			MethodName: "ReleaseCoupon",
+			Handler:    _CourseService_ReleaseCoupon_Handler,
+		},
+		{
+			MethodName: "CreateBundle",
+			Handler:    _CourseService_CreateBundle_Handler,
+		},
+		{
+			MethodName: "UpdateBundle",
+			Handler:    _CourseService_UpdateBundle_Handler,
+		},
+		{
+			MethodName: "DeleteBundle",
+			Handler:    _CourseService_DeleteBundle_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _CourseService_GetBundle_Handler,
+		},
+		{
+			MethodName: "ListBundles",
+			Handler:    _CourseService_ListBundles_Handler,
+		},
+		{
+			MethodName: "QuoteBundle",
+			Handler:    _CourseService_QuoteBundle_Handler,
+		},
+		{
+			MethodName: "CloneCourse",
+			Handler:    _CourseService_CloneCourse_Handler,
+		},
+		{
+			MethodName: "CreateCourseTemplate",
+			Handler:    _CourseService_CreateCourseTemplate_Handler,
+		},
+		{
+			MethodName: "GetCourseTemplate",
+			Handler:    _CourseService_GetCourseTemplate_Handler,
+		},
+		{
+			MethodName: "ListCourseTemplates",
+			Handler:    _CourseService_ListCourseTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteCourseTemplate",
+			Handler:    _CourseService_DeleteCourseTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCourseFromTemplate",
+			Handler:    _CourseService_CreateCourseFromTemplate_Handler,
+		},
+		{
+			MethodName: "SetModuleRelease",
+			Handler:    _CourseService_SetModuleRelease_Handler,
+		},
+		{
+			MethodName: "SetLessonRelease",
+			Handler:    _CourseService_SetLessonRelease_Handler,
+		},
+		{
+			MethodName: "GetCourseContent",
+			Handler:    _CourseService_GetCourseContent_Handler,
+		},
+		{
+			MethodName: "AuthorizeVideoStream",
+			Handler:    _CourseService_AuthorizeVideoStream_Handler,
+		},
+		{
+			MethodName: "CreateQuestionBank",
+			Handler:    _CourseService_CreateQuestionBank_Handler,
+		},
+		{
+			MethodName: "ListQuestionBanks",
+			Handler:    _CourseService_ListQuestionBanks_Handler,
+		},
+		{
+			MethodName: "DeleteQuestionBank",
+			Handler:    _CourseService_DeleteQuestionBank_Handler,
+		},
+		{
+			MethodName: "AddQuestion",
+			Handler:    _CourseService_AddQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _CourseService_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "ListQuestions",
+			Handler:    _CourseService_ListQuestions_Handler,
+		},
+		{
+			MethodName: "SetLessonQuiz",
+			Handler:    _CourseService_SetLessonQuiz_Handler,
+		},
+		{
+			MethodName: "GetLessonQuiz",
+			Handler:    _CourseService_GetLessonQuiz_Handler,
+		},
+		{
+			MethodName: "StartQuizAttempt",
+			Handler:    _CourseService_StartQuizAttempt_Handler,
+		},
+		{
+			MethodName: "SubmitQuizAttempt",
+			Handler:    _CourseService_SubmitQuizAttempt_Handler,
+		},
+		{
+			MethodName: "ListQuizAttempts",
+			Handler:    _CourseService_ListQuizAttempts_Handler,
+		},
+		{
+			MethodName: "SetLessonAssignment",
+			Handler:    _CourseService_SetLessonAssignment_Handler,
+		},
+		{
+			MethodName: "GetLessonAssignment",
+			Handler:    _CourseService_GetLessonAssignment_Handler,
+		},
+		{
+			MethodName: "GetMyAssignmentSubmission",
+			Handler:    _CourseService_GetMyAssignmentSubmission_Handler,
+		},
+		{
+			MethodName: "ListGradingQueue",
+			Handler:    _CourseService_ListGradingQueue_Handler,
+		},
+		{
+			MethodName: "GradeAssignmentSubmission",
+			Handler:    _CourseService_GradeAssignmentSubmission_Handler,
+		},
+		{
+			MethodName: "ListLessonAttachments",
+			Handler:    _CourseService_ListLessonAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteLessonAttachment",
+			Handler:    _CourseService_DeleteLessonAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachmentDownloadURL",
+			Handler:    _CourseService_GetAttachmentDownloadURL_Handler,
+		},
+		{
+			MethodName: "InviteCollaborator",
+			Handler:    _CourseService_InviteCollaborator_Handler,
+		},
+		{
+			MethodName: "AcceptCollaboratorInvitation",
+			Handler:    _CourseService_AcceptCollaboratorInvitation_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _CourseService_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "ListMyCollaborations",
+			Handler:    _CourseService_ListMyCollaborations_Handler,
+		},
+		{
+			MethodName: "UpdateCollaboratorRole",
+			Handler:    _CourseService_UpdateCollaboratorRole_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _CourseService_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "TransferCourseOwnership",
+			Handler:    _CourseService_TransferCourseOwnership_Handler,
+		},
+		{
+			MethodName: "ReconcileCourseStats",
+			Handler:    _CourseService_ReconcileCourseStats_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _CourseService_ListCategories_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _CourseService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _CourseService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _CourseService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "SetCourseDefaultLocale",
+			Handler:    _CourseService_SetCourseDefaultLocale_Handler,
+		},
+		{
+			MethodName: "PutCourseTranslations",
+			Handler:    _CourseService_PutCourseTranslations_Handler,
+		},
+		{
+			MethodName: "ListCourseTranslations",
+			Handler:    _CourseService_ListCourseTranslations_Handler,
+		},
+		{
+			MethodName: "DeleteCourseLocale",
+			Handler:    _CourseService_DeleteCourseLocale_Handler,
+		},
+		{
+			MethodName: "GetTranslationStatus",
+			Handler:    _CourseService_GetTranslationStatus_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _CourseService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _CourseService_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "CreateDiscussionThread",
+			Handler:    _CourseService_CreateDiscussionThread_Handler,
+		},
+		{
+			MethodName: "ListDiscussionThreads",
+			Handler:    _CourseService_ListDiscussionThreads_Handler,
+		},
+		{
+			MethodName: "GetDiscussionThread",
+			Handler:    _CourseService_GetDiscussionThread_Handler,
+		},
+		{
+			MethodName: "ReplyToDiscussion",
+			Handler:    _CourseService_ReplyToDiscussion_Handler,
+		},
+		{
+			MethodName: "VoteDiscussion",
+			Handler:    _CourseService_VoteDiscussion_Handler,
+		},
+		{
+			MethodName: "MarkDiscussionAnswer",
+			Handler:    _CourseService_MarkDiscussionAnswer_Handler,
+		},
+		{
+			MethodName: "ModerateDiscussion",
+			Handler:    _CourseService_ModerateDiscussion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCourse",
+			Handler:       _CourseService_ExportCourse_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCourse",
+			Handler:       _CourseService_ImportCourse_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubmitAssignment",
+			Handler:       _CourseService_SubmitAssignment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAssignmentSubmission",
+			Handler:       _CourseService_DownloadAssignmentSubmission_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadLessonAttachment",
+			Handler:       _CourseService_UploadLessonAttachment_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "course.proto",
 }
@@ -20,14 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EnrollmentService_EnrollCourse_FullMethodName          = "/enrollment.EnrollmentService/EnrollCourse"
-	EnrollmentService_GetEnrollment_FullMethodName         = "/enrollment.EnrollmentService/GetEnrollment"
-	EnrollmentService_ListEnrollments_FullMethodName       = "/enrollment.EnrollmentService/ListEnrollments"
-	EnrollmentService_GetStudentEnrollments_FullMethodName = "/enrollment.EnrollmentService/GetStudentEnrollments"
-	EnrollmentService_GetCourseEnrollments_FullMethodName  = "/enrollment.EnrollmentService/GetCourseEnrollments"
-	EnrollmentService_CancelEnrollment_FullMethodName      = "/enrollment.EnrollmentService/CancelEnrollment"
-	EnrollmentService_CompleteEnrollment_FullMethodName    = "/enrollment.EnrollmentService/CompleteEnrollment"
-	EnrollmentService_IsUserEnrolled_FullMethodName        = "/enrollment.EnrollmentService/IsUserEnrolled"
+	EnrollmentService_EnrollCourse_FullMethodName            = "/enrollment.EnrollmentService/EnrollCourse"
+	EnrollmentService_EnrollBundle_FullMethodName            = "/enrollment.EnrollmentService/EnrollBundle"
+	EnrollmentService_GetEnrollment_FullMethodName           = "/enrollment.EnrollmentService/GetEnrollment"
+	EnrollmentService_ListEnrollments_FullMethodName         = "/enrollment.EnrollmentService/ListEnrollments"
+	EnrollmentService_GetStudentEnrollments_FullMethodName   = "/enrollment.EnrollmentService/GetStudentEnrollments"
+	EnrollmentService_GetCourseEnrollments_FullMethodName    = "/enrollment.EnrollmentService/GetCourseEnrollments"
+	EnrollmentService_CancelEnrollment_FullMethodName        = "/enrollment.EnrollmentService/CancelEnrollment"
+	EnrollmentService_CompleteEnrollment_FullMethodName      = "/enrollment.EnrollmentService/CompleteEnrollment"
+	EnrollmentService_IsUserEnrolled_FullMethodName          = "/enrollment.EnrollmentService/IsUserEnrolled"
+	EnrollmentService_GetLearningPathProgress_FullMethodName = "/enrollment.EnrollmentService/GetLearningPathProgress"
 )
 
 // EnrollmentServiceClient is the client API for EnrollmentService service.
@@ -35,13 +37,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EnrollmentServiceClient interface {
 	EnrollCourse(ctx context.Context, in *EnrollCourseRequest, opts ...grpc.CallOption) (*EnrollmentResponse, error)
+	// Enrolls in every course of a bundle for one payment.
+	EnrollBundle(ctx context.Context, in *EnrollBundleRequest, opts ...grpc.CallOption) (*BundleEnrollmentResponse, error)
 	GetEnrollment(ctx context.Context, in *GetEnrollmentRequest, opts ...grpc.CallOption) (*EnrollmentResponse, error)
 	ListEnrollments(ctx context.Context, in *ListEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error)
 	GetStudentEnrollments(ctx context.Context, in *GetStudentEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error)
 	GetCourseEnrollments(ctx context.Context, in *GetCourseEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error)
+	// Cancelling a bundled enrollment cancels and refunds the whole bundle.
 	CancelEnrollment(ctx context.Context, in *CancelEnrollmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CompleteEnrollment(ctx context.Context, in *CompleteEnrollmentRequest, opts ...grpc.CallOption) (*EnrollmentResponse, error)
 	IsUserEnrolled(ctx context.Context, in *IsUserEnrolledRequest, opts ...grpc.CallOption) (*IsUserEnrolledResponse, error)
+	GetLearningPathProgress(ctx context.Context, in *GetLearningPathProgressRequest, opts ...grpc.CallOption) (*LearningPathProgress, error)
 }
 
 type enrollmentServiceClient struct {
@@ -62,6 +68,16 @@ func (c *enrollmentServiceClient) EnrollCourse(ctx context.Context, in *EnrollCo
 	return out, nil
 }
 
+func (c *enrollmentServiceClient) EnrollBundle(ctx context.Context, in *EnrollBundleRequest, opts ...grpc.CallOption) (*BundleEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleEnrollmentResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_EnrollBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enrollmentServiceClient) GetEnrollment(ctx context.Context, in *GetEnrollmentRequest, opts ...grpc.CallOption) (*EnrollmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EnrollmentResponse)
@@ -132,18 +148,32 @@ func (c *enrollmentServiceClient) IsUserEnrolled(ctx context.Context, in *IsUser
 	return out, nil
 }
 
+func (c *enrollmentServiceClient) GetLearningPathProgress(ctx context.Context, in *GetLearningPathProgressRequest, opts ...grpc.CallOption) (*LearningPathProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathProgress)
+	err := c.cc.Invoke(ctx, EnrollmentService_GetLearningPathProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnrollmentServiceServer is the server API for EnrollmentService service.
 // All implementations must embed UnimplementedEnrollmentServiceServer
 // for forward compatibility.
 type EnrollmentServiceServer interface {
 	EnrollCourse(context.Context, *EnrollCourseRequest) (*EnrollmentResponse, error)
+	// Enrolls in every course of a bundle for one payment.
+	EnrollBundle(context.Context, *EnrollBundleRequest) (*BundleEnrollmentResponse, error)
 	GetEnrollment(context.Context, *GetEnrollmentRequest) (*EnrollmentResponse, error)
 	ListEnrollments(context.Context, *ListEnrollmentsRequest) (*ListEnrollmentsResponse, error)
 	GetStudentEnrollments(context.Context, *GetStudentEnrollmentsRequest) (*ListEnrollmentsResponse, error)
 	GetCourseEnrollments(context.Context, *GetCourseEnrollmentsRequest) (*ListEnrollmentsResponse, error)
+	// Cancelling a bundled enrollment cancels and refunds the whole bundle.
 	CancelEnrollment(context.Context, *CancelEnrollmentRequest) (*emptypb.Empty, error)
 	CompleteEnrollment(context.Context, *CompleteEnrollmentRequest) (*EnrollmentResponse, error)
 	IsUserEnrolled(context.Context, *IsUserEnrolledRequest) (*IsUserEnrolledResponse, error)
+	GetLearningPathProgress(context.Context, *GetLearningPathProgressRequest) (*LearningPathProgress, error)
 	mustEmbedUnimplementedEnrollmentServiceServer()
 }
 
@@ -157,6 +187,9 @@ type UnimplementedEnrollmentServiceServer struct{}
 func (UnimplementedEnrollmentServiceServer) EnrollCourse(context.Context, *EnrollCourseRequest) (*EnrollmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnrollCourse not implemented")
 }
+func (UnimplementedEnrollmentServiceServer) EnrollBundle(context.Context, *EnrollBundleRequest) (*BundleEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollBundle not implemented")
+}
 func (UnimplementedEnrollmentServiceServer) GetEnrollment(context.Context, *GetEnrollmentRequest) (*EnrollmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollment not implemented")
 }
@@ -178,6 +211,9 @@ func (UnimplementedEnrollmentServiceServer) CompleteEnrollment(context.Context,
 func (UnimplementedEnrollmentServiceServer) IsUserEnrolled(context.Context, *IsUserEnrolledRequest) (*IsUserEnrolledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsUserEnrolled not implemented")
 }
+func (UnimplementedEnrollmentServiceServer) GetLearningPathProgress(context.Context, *GetLearningPathProgressRequest) (*LearningPathProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningPathProgress not implemented")
+}
 func (UnimplementedEnrollmentServiceServer) mustEmbedUnimplementedEnrollmentServiceServer() {}
 func (UnimplementedEnrollmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -217,6 +253,24 @@ func _EnrollmentService_EnrollCourse_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnrollmentService_EnrollBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).EnrollBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_EnrollBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).EnrollBundle(ctx, req.(*EnrollBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnrollmentService_GetEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEnrollmentRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +397,24 @@ func _EnrollmentService_IsUserEnrolled_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnrollmentService_GetLearningPathProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningPathProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).GetLearningPathProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_GetLearningPathProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).GetLearningPathProgress(ctx, req.(*GetLearningPathProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnrollmentService_ServiceDesc is the grpc.ServiceDesc for EnrollmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -354,6 +426,10 @@ var EnrollmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnrollCourse",
 			Handler:    _EnrollmentService_EnrollCourse_Handler,
 		},
+		{
+			MethodName: "EnrollBundle",
+			Handler:    _EnrollmentService_EnrollBundle_Handler,
+		},
 		{
 			MethodName: "GetEnrollment",
 			Handler:    _EnrollmentService_GetEnrollment_Handler,
@@ -382,6 +458,10 @@ var EnrollmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsUserEnrolled",
 			Handler:    _EnrollmentService_IsUserEnrolled_Handler,
 		},
+		{
+			MethodName: "GetLearningPathProgress",
+			Handler:    _EnrollmentService_GetLearningPathProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "enrollment.proto",
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/dmehra2102/learning-platform/shared/pkg/database"
 	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
@@ -14,11 +16,16 @@ import (
 	"github.com/dmehra2102/learning-platform/shared/pkg/logger"
 	pb "github.com/dmehra2102/learning-platform/shared/proto/user"
 	"github.com/dmehra2102/learning-platform/user-service/config"
+	"github.com/dmehra2102/learning-platform/user-service/internal/course"
 	"github.com/dmehra2102/learning-platform/user-service/internal/grpc"
+	"github.com/dmehra2102/learning-platform/user-service/internal/password"
 	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/user-service/internal/scheduler"
 	"github.com/dmehra2102/learning-platform/user-service/internal/service"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	grpcLib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -61,14 +68,158 @@ func main() {
 	)
 	defer kafkaProducer.Close()
 
+	passwordResetProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicPasswordReset,
+		log,
+	)
+	defer passwordResetProducer.Close()
+
+	userSuspendedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserSuspended,
+		log,
+	)
+	defer userSuspendedProducer.Close()
+
+	userReinstatedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserReinstated,
+		log,
+	)
+	defer userReinstatedProducer.Close()
+
+	userProfileUpdatedProducer := kafka.NewProducer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserProfileUpdated,
+		log,
+	)
+	defer userProfileUpdatedProducer.Close()
+
+	// Initialize password policy
+	var breachedList *password.BreachedList
+	if cfg.Password.BreachedListPath != "" {
+		breachedList, err = password.LoadBreachedList(cfg.Password.BreachedListPath)
+		if err != nil {
+			log.Fatal("failed to load breached password list", zap.Error(err))
+		}
+		log.Info("loaded breached password list", zap.Int("prefixes", breachedList.Size()))
+	}
+
+	passwordPolicy := password.NewPolicy(password.Config{
+		MinLength:     cfg.Password.MinLength,
+		MaxLength:     cfg.Password.MaxLength,
+		RequireUpper:  cfg.Password.RequireUpper,
+		RequireLower:  cfg.Password.RequireLower,
+		RequireDigit:  cfg.Password.RequireDigit,
+		RequireSymbol: cfg.Password.RequireSymbol,
+	}, breachedList)
+
 	// Initialize repository
 	userRepo := repository.NewUserRepository(db)
+	passwordResetRepo := repository.NewPasswordResetRepository(db)
+	instructorRepo := repository.NewInstructorRepository(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Seed token revocations for suspensions that may still have live tokens
+	revocations := interceptor.NewRevocationList()
+	revoked, err := userRepo.ListTokenRevocations(ctx, time.Now().Add(-cfg.JWT.RefreshTokenTTL))
+	if err != nil {
+		log.Fatal("failed to load token revocations", zap.Error(err))
+	}
+	for userID, revokedAt := range revoked {
+		revocations.Revoke(userID, revokedAt)
+	}
+
+	// Keep revocations in sync with suspensions made by other replicas
+	suspensionConsumer := kafka.NewConsumer(
+		cfg.Kafka.Brokers,
+		kafka.TopicUserSuspended,
+		fmt.Sprintf("user-service-revocations-%s", uuid.New().String()),
+		revocations.HandleUserSuspended,
+		log,
+	)
+	go func() {
+		if err := suspensionConsumer.Start(ctx); err != nil {
+			log.Error("suspension consumer stopped", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				revocations.Prune(cfg.JWT.RefreshTokenTTL)
+			}
+		}
+	}()
 
 	// Initialize Service
-	userServer := service.NewUserService(userRepo, jwtManager, kafkaProducer, log)
+	userServer := service.NewUserService(
+		userRepo,
+		passwordResetRepo,
+		jwtManager,
+		service.Producers{
+			UserRegistered: kafkaProducer,
+			PasswordReset:  passwordResetProducer,
+			UserSuspended:  userSuspendedProducer,
+			UserReinstated: userReinstatedProducer,
+			ProfileUpdated: userProfileUpdatedProducer,
+		},
+		passwordPolicy,
+		revocations,
+		log,
+		service.Config{
+			ImpersonationTokenTTL: cfg.JWT.ImpersonationTokenTTL,
+			PasswordResetTokenTTL: cfg.Password.ResetTokenTTL,
+		},
+	)
+
+	// Calls to other services authenticate as user-service
+	courseConn, err := grpcLib.NewClient(
+		fmt.Sprintf("%s:%d", cfg.Services.CourseHost, cfg.Services.CoursePort),
+		grpcLib.WithTransportCredentials(insecure.NewCredentials()),
+		grpcLib.WithPerRPCCredentials(interceptor.NewServiceCredentials(jwtManager, "user-service")),
+	)
+	if err != nil {
+		log.Fatal("failed to create course service client", zap.Error(err))
+	}
+	defer courseConn.Close()
+
+	instructorService := service.NewInstructorService(userRepo, instructorRepo, course.NewClient(courseConn), log)
+
+	// Keep instructor profile aggregates current
+	instructorStatsConsumers := []*kafka.Consumer{
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicCoursePublished, "user-service-instructor-stats", instructorService.HandleCoursePublished, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentSuccess, "user-service-instructor-stats", instructorService.HandleEnrollmentSuccess, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicEnrollmentCancelled, "user-service-instructor-stats", instructorService.HandleEnrollmentCancelled, log),
+		kafka.NewConsumer(cfg.Kafka.Brokers, kafka.TopicReviewCreated, "user-service-instructor-stats", instructorService.HandleReviewCreated, log),
+	}
+	for _, consumer := range instructorStatsConsumers {
+		go func(c *kafka.Consumer) {
+			if err := c.Start(ctx); err != nil {
+				log.Error("instructor stats consumer stopped", zap.Error(err))
+			}
+		}(consumer)
+	}
+
+	// Attribute stats for courses published before their events were consumed
+	instructorCourseScheduler := scheduler.NewInstructorCourseScheduler(instructorService, cfg.Scheduler.CourseReconcileInterval, log)
+	go instructorCourseScheduler.Start(ctx)
+
+	// Reactivate users whose suspension has ended
+	reinstatementScheduler := scheduler.NewReinstatementScheduler(userRepo, userServer, cfg.Scheduler.ReinstatementInterval, log)
+	go reinstatementScheduler.Start(ctx)
 
 	// Initialize gRPC server
-	authInterceptor := interceptor.NewAuthInterceptor(jwtManager)
+	authInterceptor := interceptor.NewAuthInterceptor(jwtManager, log)
+	authInterceptor.SetRevocationList(revocations)
 	loggingInterceptor := interceptor.NewLoggingInterceptor(log)
 	recoveryInterceptor := interceptor.NewRecoveryInterceptor(log)
 
@@ -86,7 +237,7 @@ func main() {
 	)
 
 	// Register services
-	userHandler := grpc.NewUserHandler(userServer)
+	userHandler := grpc.NewUserHandler(userServer, instructorService)
 	pb.RegisterUserServiceServer(grpcServer, userHandler)
 
 	// Register health check
@@ -113,6 +264,7 @@ func main() {
 	<-quit
 
 	log.Info("shutting down user service")
+	cancel()
 	grpcServer.GracefulStop()
 }
 
@@ -134,6 +286,51 @@ func runDBMigrations(db *database.DB, log *zap.Logger) error {
 		`CREATE INDEX IF NOT EXISTS idx_users_email ON users(email)`,
 		`CREATE INDEX IF NOT EXISTS idx_users_role ON users(role)`,
 		`CREATE INDEX IF NOT EXISTS idx_users_status ON users(status)`,
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`CREATE INDEX IF NOT EXISTS idx_users_search_trgm ON users USING gin ((first_name || ' ' || last_name || ' ' || email) gin_trgm_ops)`,
+		`CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users(created_at, id)`,
+		`CREATE INDEX IF NOT EXISTS idx_users_name_id ON users(lower(first_name || ' ' || last_name), id)`,
+		`CREATE INDEX IF NOT EXISTS idx_users_email_lower_id ON users(lower(email), id)`,
+		`CREATE TABLE IF NOT EXISTS password_reset_tokens (
+			id UUID PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			token_hash VARCHAR(64) UNIQUE NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens(user_id)`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS suspension_reason TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMP`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS tokens_revoked_at TIMESTAMP`,
+		`CREATE INDEX IF NOT EXISTS idx_users_suspended_until ON users(suspended_until) WHERE suspended_until IS NOT NULL`,
+		`CREATE TABLE IF NOT EXISTS instructor_profiles (
+			user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+			headline VARCHAR(160) NOT NULL DEFAULT '',
+			links JSONB NOT NULL DEFAULT '[]',
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS instructor_courses (
+			course_id UUID PRIMARY KEY,
+			instructor_id UUID NOT NULL,
+			published BOOLEAN NOT NULL DEFAULT FALSE,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_instructor_courses_instructor_id ON instructor_courses(instructor_id)`,
+		`CREATE TABLE IF NOT EXISTS instructor_enrollments (
+			enrollment_id UUID PRIMARY KEY,
+			course_id UUID NOT NULL,
+			active BOOLEAN NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_instructor_enrollments_course_id ON instructor_enrollments(course_id) WHERE active`,
+		`CREATE TABLE IF NOT EXISTS instructor_reviews (
+			review_id UUID PRIMARY KEY,
+			course_id UUID NOT NULL,
+			rating INTEGER NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_instructor_reviews_course_id ON instructor_reviews(course_id)`,
 	}
 
 	for i, migration := range migrations {
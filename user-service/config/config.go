@@ -12,10 +12,13 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database database.Config
-	JWT      JWTConfig
-	Kafka    KafkaConfig
+	Server    ServerConfig
+	Database  database.Config
+	JWT       JWTConfig
+	Kafka     KafkaConfig
+	Password  PasswordConfig
+	Scheduler SchedulerConfig
+	Services  ServicesConfig
 }
 
 type ServerConfig struct {
@@ -23,9 +26,31 @@ type ServerConfig struct {
 }
 
 type JWTConfig struct {
-	SecretKey       string
-	AccessTokenTTL  time.Duration
-	RefreshTokenTTL time.Duration
+	SecretKey             string
+	AccessTokenTTL        time.Duration
+	RefreshTokenTTL       time.Duration
+	ImpersonationTokenTTL time.Duration
+}
+
+type PasswordConfig struct {
+	MinLength        int
+	MaxLength        int
+	RequireUpper     bool
+	RequireLower     bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	BreachedListPath string
+	ResetTokenTTL    time.Duration
+}
+
+type SchedulerConfig struct {
+	ReinstatementInterval   time.Duration
+	CourseReconcileInterval time.Duration
+}
+
+type ServicesConfig struct {
+	CourseHost string
+	CoursePort int
 }
 
 type KafkaConfig struct {
@@ -55,13 +80,32 @@ func Load() Config {
 			ConnMaxIdleTime: 10 * time.Minute,
 		},
 		JWT: JWTConfig{
-			SecretKey:       getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-			AccessTokenTTL:  15 * time.Minute,
-			RefreshTokenTTL: 7 * 24 * time.Hour,
+			SecretKey:             getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+			AccessTokenTTL:        15 * time.Minute,
+			RefreshTokenTTL:       7 * 24 * time.Hour,
+			ImpersonationTokenTTL: 10 * time.Minute,
 		},
 		Kafka: KafkaConfig{
 			Brokers: getSliceEnv("KAFKA_BROKERS", []string{"localhost:9092"}),
 		},
+		Password: PasswordConfig{
+			MinLength:        getIntEnv("PASSWORD_MIN_LENGTH", 10),
+			MaxLength:        getIntEnv("PASSWORD_MAX_LENGTH", 72),
+			RequireUpper:     getBoolEnv("PASSWORD_REQUIRE_UPPER", true),
+			RequireLower:     getBoolEnv("PASSWORD_REQUIRE_LOWER", true),
+			RequireDigit:     getBoolEnv("PASSWORD_REQUIRE_DIGIT", true),
+			RequireSymbol:    getBoolEnv("PASSWORD_REQUIRE_SYMBOL", false),
+			BreachedListPath: getEnv("PASSWORD_BREACHED_LIST", ""),
+			ResetTokenTTL:    time.Hour,
+		},
+		Scheduler: SchedulerConfig{
+			ReinstatementInterval:   time.Minute,
+			CourseReconcileInterval: time.Hour,
+		},
+		Services: ServicesConfig{
+			CourseHost: getEnv("COURSE_SERVICE_HOST", "localhost"),
+			CoursePort: getIntEnv("COURSE_SERVICE_PORT", 50052),
+		},
 	}
 }
 
@@ -74,7 +118,16 @@ func getEnv(key, defaultValue string) string {
 
 func getIntEnv(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
-		if result, err := strconv.Atoi(key); err == nil {
+		if result, err := strconv.Atoi(value); err == nil {
+			return result
+		}
+	}
+	return defaultValue
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if result, err := strconv.ParseBool(value); err == nil {
 			return result
 		}
 	}
@@ -86,4 +139,4 @@ func getSliceEnv(key string, defaultValue []string) []string {
 		return strings.Split(value, ",")
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
@@ -0,0 +1,44 @@
+package course
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/dmehra2102/learning-platform/shared/proto/course"
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"google.golang.org/grpc"
+)
+
+// Client reads courses from course-service.
+type Client interface {
+	// ListPublished returns a page of the published courses, newest first,
+	// and how many there are.
+	ListPublished(ctx context.Context, page, pageSize int) ([]domain.PublishedCourse, int, error)
+}
+
+type client struct {
+	courses pb.CourseServiceClient
+}
+
+// NewClient talks to course-service over conn, which must authenticate as
+// user-service.
+func NewClient(conn *grpc.ClientConn) Client {
+	return &client{courses: pb.NewCourseServiceClient(conn)}
+}
+
+func (c *client) ListPublished(ctx context.Context, page, pageSize int) ([]domain.PublishedCourse, int, error) {
+	resp, err := c.courses.ListCourses(ctx, &pb.ListCoursesRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list courses: %w", err)
+	}
+
+	courses := make([]domain.PublishedCourse, len(resp.Courses))
+	for i, course := range resp.Courses {
+		courses[i] = domain.PublishedCourse{CourseID: course.Id, InstructorID: course.InstructorId}
+	}
+
+	return courses, int(resp.Total), nil
+}
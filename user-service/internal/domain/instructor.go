@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInstructorNotFound = errors.New("instructor not found")
+	ErrInvalidProfileLink = errors.New("invalid profile link")
+)
+
+type ProfileLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// InstructorProfile is the public view of an instructor. It never carries
+// contact details such as the email address.
+type InstructorProfile struct {
+	UserID    string
+	FirstName string
+	LastName  string
+	AvatarURL string
+	Bio       string
+	Headline  string
+	Links     []ProfileLink
+	Stats     InstructorStats
+	UpdatedAt time.Time
+}
+
+// InstructorStats are computed from the course, enrollment and review events
+// recorded for the courses the instructor currently owns.
+type InstructorStats struct {
+	PublishedCourseCount int
+	TotalEnrollments     int
+	ReviewCount          int
+	AverageRating        float64
+}
+
+// PublishedCourse is a live course as course-service reports it.
+type PublishedCourse struct {
+	CourseID     string
+	InstructorID string
+}
@@ -11,6 +11,13 @@ var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrInvalidEmail       = errors.New("invalid email")
 	ErrWeakPassword       = errors.New("password too weak")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrReasonRequired     = errors.New("reason is required")
+	ErrCannotImpersonate  = errors.New("user cannot be impersonated")
+	ErrInvalidResetToken  = errors.New("invalid or expired reset token")
+	ErrInvalidSuspension  = errors.New("invalid suspension end time")
+	ErrNotSuspended       = errors.New("user is not suspended")
+	ErrUnauthorized       = errors.New("unauthorized")
 )
 
 type UserRole string
@@ -41,6 +48,23 @@ type User struct {
 	Bio          string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	// Set while Status is StatusSuspended; a nil SuspendedUntil means the
+	// suspension lasts until an admin reinstates the user.
+	SuspensionReason string
+	SuspendedUntil   *time.Time
+}
+
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (t *PasswordResetToken) IsUsable() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
 }
 
 func NewUser(email, firstname, lastname string, role UserRole) (*User, error) {
@@ -78,12 +102,24 @@ func (u *User) UpdateProfile(firstName, lastName, avatarURL, bio *string) {
 
 func (u *User) Activate() {
 	u.Status = StatusActive
+	u.SuspensionReason = ""
+	u.SuspendedUntil = nil
 	u.UpdatedAt = time.Now()
 }
 
-func (u *User) Suspend() {
+func (u *User) Suspend(reason string, until *time.Time) error {
+	if reason == "" {
+		return ErrReasonRequired
+	}
+	if until != nil && !until.After(time.Now()) {
+		return ErrInvalidSuspension
+	}
+
 	u.Status = StatusSuspended
+	u.SuspensionReason = reason
+	u.SuspendedUntil = until
 	u.UpdatedAt = time.Now()
+	return nil
 }
 
 func (u *User) ChangeRole(role UserRole) {
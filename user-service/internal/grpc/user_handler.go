@@ -2,9 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
 	pb "github.com/dmehra2102/learning-platform/shared/proto/user"
 	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
 	"github.com/dmehra2102/learning-platform/user-service/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,11 +18,12 @@ import (
 
 type UserHandler struct {
 	pb.UnimplementedUserServiceServer
-	service service.UserService
+	service           service.UserService
+	instructorService service.InstructorService
 }
 
-func NewUserHandler(service service.UserService) *UserHandler {
-	return &UserHandler{service: service}
+func NewUserHandler(service service.UserService, instructorService service.InstructorService) *UserHandler {
+	return &UserHandler{service: service, instructorService: instructorService}
 }
 
 func (h *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
@@ -35,6 +40,9 @@ func (h *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		if err == domain.ErrEmailAlreadyExists {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		if errors.Is(err, domain.ErrWeakPassword) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -121,14 +129,20 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 		statusVal = &s
 	}
 
-	users, total, err := h.service.LisUsers(
-		ctx,
-		int(req.Page),
-		int(req.PageSize),
-		role,
-		statusVal,
-	)
+	users, total, nextPageToken, err := h.service.LisUsers(ctx, repository.ListFilter{
+		Role:     role,
+		Status:   statusVal,
+		Search:   req.Search,
+		SortBy:   sortFieldFromProto(req.SortBy),
+		SortDesc: req.SortOrder == pb.SortOrder_DESC,
+		Cursor:   req.PageToken,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
 	if err != nil {
+		if err == domain.ErrInvalidPageToken {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -138,10 +152,11 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 	}
 
 	return &pb.ListUsersResponse{
-		Users:    pbUsers,
-		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Users:         pbUsers,
+		Total:         int32(total),
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -186,18 +201,231 @@ func (h *UserHandler) ChangeUserRole(ctx context.Context, req *pb.ChangeUserRole
 	}, nil
 }
 
+func (h *UserHandler) ImpersonateUser(ctx context.Context, req *pb.ImpersonateUserRequest) (*pb.ImpersonateUserResponse, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, accessToken, expiresAt, err := h.service.ImpersonateUser(ctx, adminID, req.UserId, req.Reason)
+	if err != nil {
+		switch err {
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		case domain.ErrReasonRequired:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrCannotImpersonate:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ImpersonateUserResponse{
+		User:        userToProto(user),
+		AccessToken: accessToken,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (h *UserHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.service.ChangePassword(ctx, userID, req.CurrentPassword, req.NewPassword); err != nil {
+		if err == domain.ErrInvalidCredentials {
+			return nil, status.Error(codes.PermissionDenied, "current password is incorrect")
+		}
+		if err == domain.ErrUserNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		if errors.Is(err, domain.ErrWeakPassword) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := h.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := h.service.ResetPassword(ctx, req.Token, req.NewPassword); err != nil {
+		if err == domain.ErrInvalidResetToken {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrWeakPassword) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.UserResponse, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var until *time.Time
+	if req.Until != nil {
+		t := req.Until.AsTime()
+		until = &t
+	}
+
+	user, err := h.service.SuspendUser(ctx, adminID, req.UserId, req.Reason, until)
+	if err != nil {
+		switch err {
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		case domain.ErrReasonRequired, domain.ErrInvalidSuspension:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrUnauthorized:
+			return nil, status.Error(codes.PermissionDenied, "cannot suspend yourself")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.UserResponse{
+		User: userToProto(user),
+	}, nil
+}
+
+func (h *UserHandler) ReinstateUser(ctx context.Context, req *pb.ReinstateUserRequest) (*pb.UserResponse, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.ReinstateUser(ctx, adminID, req.UserId, req.Reason)
+	if err != nil {
+		switch err {
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, "user not found")
+		case domain.ErrReasonRequired:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrNotSuspended:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.UserResponse{
+		User: userToProto(user),
+	}, nil
+}
+
+func (h *UserHandler) GetInstructorProfile(ctx context.Context, req *pb.GetInstructorProfileRequest) (*pb.InstructorProfileResponse, error) {
+	profile, err := h.instructorService.GetInstructorProfile(ctx, req.InstructorId)
+	if err != nil {
+		if err == domain.ErrInstructorNotFound {
+			return nil, status.Error(codes.NotFound, "instructor not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.InstructorProfileResponse{
+		Profile: instructorProfileToProto(profile),
+	}, nil
+}
+
+func (h *UserHandler) UpdateInstructorProfile(ctx context.Context, req *pb.UpdateInstructorProfileRequest) (*pb.InstructorProfileResponse, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	links := make([]domain.ProfileLink, len(req.Links))
+	for i, link := range req.Links {
+		links[i] = domain.ProfileLink{Label: link.Label, URL: link.Url}
+	}
+
+	profile, err := h.instructorService.UpdateInstructorProfile(ctx, userID, req.Headline, links)
+	if err != nil {
+		switch err {
+		case domain.ErrUserNotFound, domain.ErrInstructorNotFound:
+			return nil, status.Error(codes.PermissionDenied, "instructor role required")
+		case domain.ErrInvalidProfileLink:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.InstructorProfileResponse{
+		Profile: instructorProfileToProto(profile),
+	}, nil
+}
+
+// requireAdmin returns the caller's ID if the request was made by an admin
+// with their own (non-impersonation) token.
+func requireAdmin(ctx context.Context) (string, error) {
+	userID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	role, err := interceptor.GetUserRole(ctx)
+	if err != nil || role != string(domain.RoleAdmin) {
+		return "", status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	if _, impersonating := interceptor.GetActorID(ctx); impersonating {
+		return "", status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	return userID, nil
+}
+
 func userToProto(user *domain.User) *pb.User {
-	return &pb.User{
-		Id:        user.ID,
-		Email:     user.Email,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Role:      roleToProto(user.Role),
-		Status:    statusToProto(user.Status),
-		AvatarUrl: user.AvatarURL,
-		Bio:       user.Bio,
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	pbUser := &pb.User{
+		Id:               user.ID,
+		Email:            user.Email,
+		FirstName:        user.FirstName,
+		LastName:         user.LastName,
+		Role:             roleToProto(user.Role),
+		Status:           statusToProto(user.Status),
+		AvatarUrl:        user.AvatarURL,
+		Bio:              user.Bio,
+		CreatedAt:        timestamppb.New(user.CreatedAt),
+		UpdatedAt:        timestamppb.New(user.UpdatedAt),
+		SuspensionReason: user.SuspensionReason,
+	}
+
+	if user.SuspendedUntil != nil {
+		pbUser.SuspendedUntil = timestamppb.New(*user.SuspendedUntil)
+	}
+
+	return pbUser
+}
+
+func instructorProfileToProto(profile *domain.InstructorProfile) *pb.InstructorProfile {
+	links := make([]*pb.ProfileLink, len(profile.Links))
+	for i, link := range profile.Links {
+		links[i] = &pb.ProfileLink{Label: link.Label, Url: link.URL}
+	}
+
+	return &pb.InstructorProfile{
+		Id:                   profile.UserID,
+		FirstName:            profile.FirstName,
+		LastName:             profile.LastName,
+		AvatarUrl:            profile.AvatarURL,
+		Bio:                  profile.Bio,
+		Headline:             profile.Headline,
+		Links:                links,
+		PublishedCourseCount: int32(profile.Stats.PublishedCourseCount),
+		TotalEnrollments:     int32(profile.Stats.TotalEnrollments),
+		AverageRating:        profile.Stats.AverageRating,
+		ReviewCount:          int32(profile.Stats.ReviewCount),
 	}
 }
 
@@ -252,3 +480,14 @@ func statusFromProto(status pb.UserStatus) domain.UserStatus {
 		return domain.StatusActive
 	}
 }
+
+func sortFieldFromProto(field pb.UserSortField) repository.SortField {
+	switch field {
+	case pb.UserSortField_NAME:
+		return repository.SortByName
+	case pb.UserSortField_EMAIL:
+		return repository.SortByEmail
+	default:
+		return repository.SortByCreatedAt
+	}
+}
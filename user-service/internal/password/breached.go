@@ -0,0 +1,92 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// minPrefixLength keeps a stray short line from matching most passwords.
+const minPrefixLength = 5
+
+// BreachedList holds upper-case hex SHA-1 prefixes of known breached
+// passwords. A password matches when its SHA-1 digest starts with any listed
+// prefix.
+type BreachedList struct {
+	prefixes map[int]map[string]struct{}
+}
+
+// LoadBreachedList reads one hex prefix per line. Blank lines and lines
+// starting with '#' are ignored, as is anything after a ':' so the downloaded
+// Pwned Passwords list ("HASH:COUNT" lines) can be used directly. Responses
+// from its range API list "SUFFIX:COUNT" for a 5-character prefix, so the
+// prefix has to be put back on each line first.
+func LoadBreachedList(path string) (*BreachedList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	defer file.Close()
+
+	list := &BreachedList{prefixes: make(map[int]map[string]struct{})}
+
+	scanner := bufio.NewScanner(file)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		prefix, _, _ := strings.Cut(line, ":")
+		prefix = strings.ToUpper(strings.TrimSpace(prefix))
+		if len(prefix) < minPrefixLength || len(prefix) > sha1.Size*2 {
+			return nil, fmt.Errorf("invalid prefix length on line %d", lineNo)
+		}
+		if _, err := hex.DecodeString(padEven(prefix)); err != nil {
+			return nil, fmt.Errorf("invalid hex prefix on line %d", lineNo)
+		}
+
+		if list.prefixes[len(prefix)] == nil {
+			list.prefixes[len(prefix)] = make(map[string]struct{})
+		}
+		list.prefixes[len(prefix)][prefix] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password list: %w", err)
+	}
+
+	return list, nil
+}
+
+func (l *BreachedList) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	for length, set := range l.prefixes {
+		if _, ok := set[digest[:length]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *BreachedList) Size() int {
+	total := 0
+	for _, set := range l.prefixes {
+		total += len(set)
+	}
+	return total
+}
+
+func padEven(s string) string {
+	if len(s)%2 == 1 {
+		return s + "0"
+	}
+	return s
+}
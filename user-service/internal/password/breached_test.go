@@ -0,0 +1,85 @@
+package password
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+)
+
+// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8 and of
+// "letmein" is B7A875FC1EA228B9061041B7CEC4BD3C52AB3CE3.
+
+func writeList(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBreachedListContains(t *testing.T) {
+	list, err := LoadBreachedList(writeList(t, `# comment
+
+5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8:10434004
+B7A87
+`))
+	if err != nil {
+		t.Fatalf("LoadBreachedList() error = %v", err)
+	}
+	if list.Size() != 2 {
+		t.Errorf("Size() = %d, want 2", list.Size())
+	}
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{"password", true},
+		{"letmein", true},
+		{"Password", false},
+		{"correct horse battery staple", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			if got := list.Contains(tt.password); got != tt.want {
+				t.Errorf("Contains(%q) = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadBreachedListRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{"prefix too short", "5BAA\n"},
+		{"longer than a digest", "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD800\n"},
+		{"not hex", "5BAXY\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadBreachedList(writeList(t, tt.contents)); err == nil {
+				t.Errorf("LoadBreachedList(%q) succeeded, want error", tt.contents)
+			}
+		})
+	}
+}
+
+func TestPolicyRejectsBreachedPassword(t *testing.T) {
+	list, err := LoadBreachedList(writeList(t, "5BAA61\n"))
+	if err != nil {
+		t.Fatalf("LoadBreachedList() error = %v", err)
+	}
+
+	err = NewPolicy(Config{MinLength: 8}, list).Validate("password", "ada@example.com", "Ada", "Lovelace")
+	if !errors.Is(err, domain.ErrWeakPassword) {
+		t.Errorf("Validate() error = %v, want %v", err, domain.ErrWeakPassword)
+	}
+}
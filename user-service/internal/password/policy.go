@@ -0,0 +1,109 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+)
+
+// MaxBytes is the most bcrypt hashes; longer passwords are rejected rather
+// than silently truncated.
+const MaxBytes = 72
+
+// Config holds the password rules. MaxLength is in bytes and is capped at
+// MaxBytes; zero means MaxBytes.
+type Config struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// Policy validates candidate passwords. Every violation is returned wrapped
+// in domain.ErrWeakPassword so callers can match on a single error.
+type Policy struct {
+	cfg      Config
+	breached *BreachedList
+}
+
+func NewPolicy(cfg Config, breached *BreachedList) *Policy {
+	if cfg.MaxLength <= 0 || cfg.MaxLength > MaxBytes {
+		cfg.MaxLength = MaxBytes
+	}
+	return &Policy{cfg: cfg, breached: breached}
+}
+
+// Validate checks password against the configured rules. email, firstName and
+// lastName are the account's own details, which must not appear in the
+// password.
+func (p *Policy) Validate(password, email, firstName, lastName string) error {
+	if len([]rune(password)) < p.cfg.MinLength {
+		return fmt.Errorf("%w: must be at least %d characters", domain.ErrWeakPassword, p.cfg.MinLength)
+	}
+	// Measured in bytes, as that's what bcrypt limits
+	if len(password) > p.cfg.MaxLength {
+		return fmt.Errorf("%w: must be at most %d bytes", domain.ErrWeakPassword, p.cfg.MaxLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.cfg.RequireUpper && !hasUpper {
+		return fmt.Errorf("%w: must contain an uppercase letter", domain.ErrWeakPassword)
+	}
+	if p.cfg.RequireLower && !hasLower {
+		return fmt.Errorf("%w: must contain a lowercase letter", domain.ErrWeakPassword)
+	}
+	if p.cfg.RequireDigit && !hasDigit {
+		return fmt.Errorf("%w: must contain a digit", domain.ErrWeakPassword)
+	}
+	if p.cfg.RequireSymbol && !hasSymbol {
+		return fmt.Errorf("%w: must contain a symbol", domain.ErrWeakPassword)
+	}
+
+	lowered := strings.ToLower(password)
+	for _, word := range contextWords(email, firstName, lastName) {
+		if strings.Contains(lowered, word) {
+			return fmt.Errorf("%w: must not contain your name or email", domain.ErrWeakPassword)
+		}
+	}
+
+	if p.breached != nil && p.breached.Contains(password) {
+		return fmt.Errorf("%w: has appeared in a known data breach", domain.ErrWeakPassword)
+	}
+
+	return nil
+}
+
+// contextWords returns the lower-cased account details a password must not
+// contain. Very short fragments are skipped to avoid rejecting on e.g. "al".
+func contextWords(email, firstName, lastName string) []string {
+	candidates := []string{firstName, lastName}
+	if local, _, ok := strings.Cut(email, "@"); ok {
+		candidates = append(candidates, local)
+	}
+
+	var words []string
+	for _, c := range candidates {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if len(c) >= 3 {
+			words = append(words, c)
+		}
+	}
+	return words
+}
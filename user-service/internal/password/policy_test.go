@@ -0,0 +1,69 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	strict := Config{MinLength: 10, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		password string
+		wantErr  bool
+	}{
+		{"meets every rule", strict, "Tr4ck-Lantern", false},
+		{"too short", strict, "Tr4ck-La", true},
+		{"length counts characters, not bytes", Config{MinLength: 4}, "ñéüö", false},
+		{"over the bcrypt limit", Config{MinLength: 1}, strings.Repeat("a", MaxBytes+1), true},
+		{"at the bcrypt limit", Config{MinLength: 1}, strings.Repeat("a", MaxBytes), false},
+		{"configured maximum", Config{MinLength: 1, MaxLength: 12}, "thirteen-chr!", true},
+		{"missing uppercase", strict, "tr4ck-lantern", true},
+		{"missing lowercase", strict, "TR4CK-LANTERN", true},
+		{"missing digit", strict, "Track-Lantern", true},
+		{"missing symbol", strict, "Tr4ckLantern9", true},
+		{"space counts as a symbol", strict, "Tr4ck Lantern", false},
+		{"contains first name", strict, "Ada-Lovelace-9", true},
+		{"contains email local part", strict, "Xx-adal0ve-9", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewPolicy(tt.cfg, nil).Validate(tt.password, "adal0ve@example.com", "Ada", "Lovelace")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate(%q) error = %v, want error %v", tt.password, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrWeakPassword) {
+				t.Errorf("Validate(%q) error = %v, want it to wrap %v", tt.password, err, domain.ErrWeakPassword)
+			}
+		})
+	}
+}
+
+func TestContextWords(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		firstName string
+		lastName  string
+		want      []string
+	}{
+		{"names and email", "ada.l@example.com", "Ada", "Lovelace", []string{"ada", "lovelace", "ada.l"}},
+		{"short fragments are skipped", "al@example.com", "Bo", " Li ", nil},
+		{"email without a local part separator", "not-an-email", "Grace", "", []string{"grace"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := contextWords(tt.email, tt.firstName, tt.lastName)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("contextWords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+// cursor is the decoded form of the opaque page token handed to clients.
+// It records the sort key of the last row returned so the next page can
+// continue with a keyset condition instead of an OFFSET.
+type cursor struct {
+	SortBy   SortField `json:"s"`
+	SortDesc bool      `json:"d"`
+	Value    string    `json:"v"`
+	ID       string    `json:"id"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string) (cursor, error) {
+	var c cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, domain.ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, domain.ErrInvalidPageToken
+	}
+
+	// The values end up in SQL comparisons, where a bad one would fail the
+	// query instead of the request
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return c, domain.ErrInvalidPageToken
+	}
+	if _, ok := sortKeys[c.SortBy]; !ok {
+		return c, domain.ErrInvalidPageToken
+	}
+	if c.SortBy == SortByCreatedAt {
+		if _, err := time.Parse(time.RFC3339Nano, c.Value); err != nil {
+			return c, domain.ErrInvalidPageToken
+		}
+	}
+
+	return c, nil
+}
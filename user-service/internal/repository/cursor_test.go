@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+)
+
+const cursorUserID = "3f1c2b7e-8d4a-4c1e-9b2f-6a5d4e3c2b1a"
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor cursor
+	}{
+		{"created at ascending", cursor{SortBy: SortByCreatedAt, Value: "2026-06-01T12:00:00.123456Z", ID: cursorUserID}},
+		{"name descending", cursor{SortBy: SortByName, SortDesc: true, Value: "ada lovelace", ID: cursorUserID}},
+		{"email with symbols", cursor{SortBy: SortByEmail, Value: "a+b/c=d@example.com", ID: cursorUserID}},
+		{"empty name", cursor{SortBy: SortByName, ID: cursorUserID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := encodeCursor(tt.cursor)
+
+			got, err := decodeCursor(token)
+			if err != nil {
+				t.Fatalf("decodeCursor(%q) error = %v", token, err)
+			}
+			if got != tt.cursor {
+				t.Errorf("decodeCursor(encodeCursor(%+v)) = %+v", tt.cursor, got)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsBadTokens(t *testing.T) {
+	raw := func(json string) string { return base64.RawURLEncoding.EncodeToString([]byte(json)) }
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"not base64", "not a token!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"s":"name","v":"x","id":"` + cursorUserID + `"}`))},
+		{"not json", raw(cursorUserID)},
+		{"missing id", raw(`{"s":"name","v":"x"}`)},
+		{"id is not a uuid", raw(`{"s":"name","v":"x","id":"1 OR 1=1"}`)},
+		{"unknown sort field", raw(`{"s":"password_hash","v":"x","id":"` + cursorUserID + `"}`)},
+		{"created at is not a time", raw(`{"s":"created_at","v":"yesterday","id":"` + cursorUserID + `"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.token); err != domain.ErrInvalidPageToken {
+				t.Errorf("decodeCursor(%q) error = %v, want %v", tt.token, err, domain.ErrInvalidPageToken)
+			}
+		})
+	}
+}
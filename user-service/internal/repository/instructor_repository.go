@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+)
+
+type InstructorRepository interface {
+	GetProfile(ctx context.Context, userID string) (*domain.InstructorProfile, error)
+	UpdateProfile(ctx context.Context, userID, headline string, links []domain.ProfileLink) error
+	RecordCoursePublished(ctx context.Context, courseID, instructorID string) error
+	UnpublishStale(ctx context.Context, before time.Time) error
+	RecordEnrollment(ctx context.Context, enrollmentID, courseID string) error
+	RecordEnrollmentCancelled(ctx context.Context, enrollmentID, courseID string) error
+	RecordReview(ctx context.Context, reviewID, courseID string, rating int) error
+}
+
+type instructorRepository struct {
+	db *database.DB
+}
+
+func NewInstructorRepository(db *database.DB) InstructorRepository {
+	return &instructorRepository{db: db}
+}
+
+func (r *instructorRepository) GetProfile(ctx context.Context, userID string) (*domain.InstructorProfile, error) {
+	query := `
+		SELECT u.id, u.first_name, u.last_name, u.avatar_url, u.bio,
+			COALESCE(p.headline, ''), COALESCE(p.links, '[]'::jsonb),
+			(SELECT COUNT(*) FROM instructor_courses c WHERE c.instructor_id = u.id AND c.published),
+			(SELECT COUNT(*) FROM instructor_enrollments e JOIN instructor_courses c ON c.course_id = e.course_id
+				WHERE c.instructor_id = u.id AND e.active),
+			(SELECT COUNT(*) FROM instructor_reviews rv JOIN instructor_courses c ON c.course_id = rv.course_id
+				WHERE c.instructor_id = u.id),
+			(SELECT COALESCE(SUM(rv.rating), 0) FROM instructor_reviews rv JOIN instructor_courses c ON c.course_id = rv.course_id
+				WHERE c.instructor_id = u.id),
+			COALESCE(p.updated_at, u.updated_at)
+		FROM users u
+		LEFT JOIN instructor_profiles p ON p.user_id = u.id
+		WHERE u.id = $1 AND u.role = $2 AND u.status = $3
+	`
+
+	var profile domain.InstructorProfile
+	var links []byte
+	var ratingSum int
+
+	err := r.db.QueryRowContext(ctx, query, userID, domain.RoleInstructor, domain.StatusActive).Scan(
+		&profile.UserID,
+		&profile.FirstName,
+		&profile.LastName,
+		&profile.AvatarURL,
+		&profile.Bio,
+		&profile.Headline,
+		&links,
+		&profile.Stats.PublishedCourseCount,
+		&profile.Stats.TotalEnrollments,
+		&profile.Stats.ReviewCount,
+		&ratingSum,
+		&profile.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrInstructorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instructor profile: %w", err)
+	}
+
+	if err := json.Unmarshal(links, &profile.Links); err != nil {
+		return nil, fmt.Errorf("failed to decode profile links: %w", err)
+	}
+
+	if profile.Stats.ReviewCount > 0 {
+		profile.Stats.AverageRating = float64(ratingSum) / float64(profile.Stats.ReviewCount)
+	}
+
+	return &profile, nil
+}
+
+func (r *instructorRepository) UpdateProfile(ctx context.Context, userID, headline string, links []domain.ProfileLink) error {
+	if links == nil {
+		links = []domain.ProfileLink{}
+	}
+
+	linksJSON, err := json.Marshal(links)
+	if err != nil {
+		return fmt.Errorf("failed to encode profile links: %w", err)
+	}
+
+	query := `
+		INSERT INTO instructor_profiles (user_id, headline, links, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE
+		SET headline = EXCLUDED.headline, links = EXCLUDED.links, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, headline, linksJSON, time.Now()); err != nil {
+		return fmt.Errorf("failed to update instructor profile: %w", err)
+	}
+
+	return nil
+}
+
+func (r *instructorRepository) RecordCoursePublished(ctx context.Context, courseID, instructorID string) error {
+	query := `
+		INSERT INTO instructor_courses (course_id, instructor_id, published, updated_at)
+		VALUES ($1, $2, TRUE, $3)
+		ON CONFLICT (course_id) DO UPDATE
+		SET instructor_id = EXCLUDED.instructor_id, published = TRUE, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, courseID, instructorID, time.Now()); err != nil {
+		return fmt.Errorf("failed to record published course: %w", err)
+	}
+
+	return nil
+}
+
+// UnpublishStale marks the published courses that haven't been recorded
+// since before as no longer published.
+func (r *instructorRepository) UnpublishStale(ctx context.Context, before time.Time) error {
+	query := `UPDATE instructor_courses SET published = FALSE, updated_at = $1 WHERE published AND updated_at < $2`
+
+	if _, err := r.db.ExecContext(ctx, query, time.Now(), before); err != nil {
+		return fmt.Errorf("failed to unpublish stale courses: %w", err)
+	}
+
+	return nil
+}
+
+// RecordEnrollment records an enrollment in the course whether or not its
+// instructor is known yet; profiles count them by the course's current
+// instructor. One already cancelled stays cancelled, as its events can
+// arrive out of order.
+func (r *instructorRepository) RecordEnrollment(ctx context.Context, enrollmentID, courseID string) error {
+	query := `
+		INSERT INTO instructor_enrollments (enrollment_id, course_id, active, updated_at)
+		VALUES ($1, $2, TRUE, $3)
+		ON CONFLICT (enrollment_id) DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, enrollmentID, courseID, time.Now()); err != nil {
+		return fmt.Errorf("failed to record enrollment: %w", err)
+	}
+
+	return nil
+}
+
+// RecordEnrollmentCancelled stops the enrollment counting towards its
+// instructor's total.
+func (r *instructorRepository) RecordEnrollmentCancelled(ctx context.Context, enrollmentID, courseID string) error {
+	query := `
+		INSERT INTO instructor_enrollments (enrollment_id, course_id, active, updated_at)
+		VALUES ($1, $2, FALSE, $3)
+		ON CONFLICT (enrollment_id) DO UPDATE
+		SET active = FALSE, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, enrollmentID, courseID, time.Now()); err != nil {
+		return fmt.Errorf("failed to record cancelled enrollment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *instructorRepository) RecordReview(ctx context.Context, reviewID, courseID string, rating int) error {
+	query := `
+		INSERT INTO instructor_reviews (review_id, course_id, rating, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (review_id) DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, reviewID, courseID, rating, time.Now()); err != nil {
+		return fmt.Errorf("failed to record review: %w", err)
+	}
+
+	return nil
+}
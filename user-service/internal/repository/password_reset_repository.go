@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/database"
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token *domain.PasswordResetToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)
+	Redeem(ctx context.Context, token *domain.PasswordResetToken, passwordHash string, revokedAt time.Time) error
+}
+
+type passwordResetRepository struct {
+	db *database.DB
+}
+
+func NewPasswordResetRepository(db *database.DB) PasswordResetRepository {
+	return &passwordResetRepository{db: db}
+}
+
+func (r *passwordResetRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	query := `
+		INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		token.ID,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *passwordResetRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at FROM password_reset_tokens WHERE token_hash = $1
+	`
+
+	var token domain.PasswordResetToken
+	var usedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&usedAt,
+		&token.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrInvalidResetToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+
+	return &token, nil
+}
+
+// Redeem marks the token used, sets its user's password and revokes the
+// user's tokens issued up to revokedAt together, so a failed update leaves
+// the token usable.
+func (r *passwordResetRepository) Redeem(ctx context.Context, token *domain.PasswordResetToken, passwordHash string, revokedAt time.Time) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		now := time.Now()
+
+		result, err := tx.ExecContext(ctx,
+			`UPDATE password_reset_tokens SET used_at = $1 WHERE id = $2 AND used_at IS NULL`,
+			now, token.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to mark password reset token used: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		// Someone else redeemed the token first
+		if rowsAffected == 0 {
+			return domain.ErrInvalidResetToken
+		}
+
+		result, err = tx.ExecContext(ctx,
+			`UPDATE users SET password_hash = $1, tokens_revoked_at = $2, updated_at = $3 WHERE id = $4`,
+			passwordHash, revokedAt, now, token.UserID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return domain.ErrUserNotFound
+		}
+
+		return nil
+	})
+}
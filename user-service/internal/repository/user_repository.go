@@ -4,19 +4,56 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/dmehra2102/learning-platform/shared/pkg/database"
 	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/jmoiron/sqlx"
 )
 
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByName      SortField = "name"
+	SortByEmail     SortField = "email"
+)
+
+var sortKeys = map[SortField]string{
+	SortByCreatedAt: "created_at",
+	SortByName:      "lower(first_name || ' ' || last_name)",
+	SortByEmail:     "lower(email)",
+}
+
+// ListFilter describes a ListUsers query. When Cursor is set, or Page is
+// zero, results are paged by keyset on the sort key; otherwise the legacy
+// Page/PageSize OFFSET paging is used.
+type ListFilter struct {
+	Role     *domain.UserRole
+	Status   *domain.UserStatus
+	Search   *string
+	SortBy   SortField
+	SortDesc bool
+	Cursor   string
+	Page     int
+	PageSize int
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *domain.User) error
 	GetByID(ctx context.Context, id string) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetByIDs(ctx context.Context, ids []string) ([]*domain.User, error)
 	Update(ctx context.Context, user *domain.User) error
+	UpdatePassword(ctx context.Context, id, passwordHash string, revokedAt time.Time) error
+	Suspend(ctx context.Context, user *domain.User, revokedAt time.Time) error
+	Reinstate(ctx context.Context, user *domain.User) error
+	ReinstateExpired(ctx context.Context, id string, now time.Time) error
+	ListTokenRevocations(ctx context.Context, since time.Time) (map[string]time.Time, error)
+	ListExpiredSuspensionIDs(ctx context.Context, now time.Time) ([]string, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, page, pageSize int, role *domain.UserRole, status *domain.UserStatus) ([]*domain.User, int, error)
+	List(ctx context.Context, filter ListFilter) ([]*domain.User, int, string, error)
 }
 
 type userRepository struct {
@@ -56,10 +93,12 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 
 func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at FROM users WHERE id = $1
+		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at, suspension_reason, suspended_until FROM users WHERE id = $1
 	`
 
 	var user domain.User
+	var suspendedUntil sql.NullTime
+
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
 		&user.Email,
@@ -72,6 +111,8 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User,
 		&user.Bio,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.SuspensionReason,
+		&suspendedUntil,
 	)
 
 	if err == sql.ErrNoRows {
@@ -81,15 +122,21 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User,
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
+	if suspendedUntil.Valid {
+		user.SuspendedUntil = &suspendedUntil.Time
+	}
+
 	return &user, nil
 }
 
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at FROM users WHERE email = $1
+		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at, suspension_reason, suspended_until FROM users WHERE email = $1
 	`
 
 	var user domain.User
+	var suspendedUntil sql.NullTime
+
 	err := r.db.QueryRowContext(ctx, query, email).Scan(
 		&user.ID,
 		&user.Email,
@@ -102,6 +149,8 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 		&user.Bio,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.SuspensionReason,
+		&suspendedUntil,
 	)
 
 	if err == sql.ErrNoRows {
@@ -112,6 +161,10 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
+	if suspendedUntil.Valid {
+		user.SuspendedUntil = &suspendedUntil.Time
+	}
+
 	return &user, nil
 }
 
@@ -121,7 +174,7 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.
 	}
 
 	query := `
-		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at FROM users WHERE id = ANY($1)
+		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at, suspension_reason, suspended_until FROM users WHERE id = ANY($1)
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, ids)
@@ -133,6 +186,8 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.
 	var users []*domain.User
 	for rows.Next() {
 		var user domain.User
+		var suspendedUntil sql.NullTime
+
 		if err := rows.Scan(
 			&user.ID,
 			&user.Email,
@@ -145,10 +200,16 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.
 			&user.Bio,
 			&user.CreatedAt,
 			&user.UpdatedAt,
+			&user.SuspensionReason,
+			&suspendedUntil,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan user: %w", err)
 		}
 
+		if suspendedUntil.Valid {
+			user.SuspendedUntil = &suspendedUntil.Time
+		}
+
 		users = append(users, &user)
 	}
 
@@ -156,13 +217,27 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.
 }
 
 func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
+	return updateUser(ctx, r.db, user)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func updateUser(ctx context.Context, db execer, user *domain.User) error {
 	query := `
 		UPDATE users
-		SET first_name = $1, last_name = $2, role = $3, status = $4, avatar_url = $5, bio = $6, updated_at = $7
-		WHERE id = $8
+		SET first_name = $1, last_name = $2, role = $3, status = $4, avatar_url = $5, bio = $6, updated_at = $7,
+			suspension_reason = $8, suspended_until = $9
+		WHERE id = $10
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	var suspendedUntil any
+	if user.SuspendedUntil != nil {
+		suspendedUntil = *user.SuspendedUntil
+	}
+
+	result, err := db.ExecContext(ctx, query,
 		user.FirstName,
 		user.LastName,
 		user.Role,
@@ -170,6 +245,8 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 		user.AvatarURL,
 		user.Bio,
 		user.UpdatedAt,
+		user.SuspensionReason,
+		suspendedUntil,
 		user.ID,
 	)
 
@@ -189,6 +266,137 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
+// UpdatePassword sets a new password hash and revokes every token issued to
+// the user up to revokedAt in the same statement.
+func (r *userRepository) UpdatePassword(ctx context.Context, id, passwordHash string, revokedAt time.Time) error {
+	query := `UPDATE users SET password_hash = $1, tokens_revoked_at = $2, updated_at = $3 WHERE id = $4`
+
+	result, err := r.db.ExecContext(ctx, query, passwordHash, revokedAt, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// Suspend saves the suspended user and revokes every token issued to them
+// up to revokedAt, all or nothing.
+func (r *userRepository) Suspend(ctx context.Context, user *domain.User, revokedAt time.Time) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		if err := updateUser(ctx, tx, user); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE users SET tokens_revoked_at = $1 WHERE id = $2`, revokedAt, user.ID,
+		); err != nil {
+			return fmt.Errorf("failed to revoke tokens: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Reinstate saves the reactivated user if they are still suspended, locking
+// the row so a concurrent suspension isn't overwritten.
+func (r *userRepository) Reinstate(ctx context.Context, user *domain.User) error {
+	return r.db.WithTransaction(ctx, func(tx *sqlx.Tx) error {
+		var status domain.UserStatus
+		err := tx.QueryRowContext(ctx, `SELECT status FROM users WHERE id = $1 FOR UPDATE`, user.ID).Scan(&status)
+		if err == sql.ErrNoRows {
+			return domain.ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock user: %w", err)
+		}
+
+		if status != domain.StatusSuspended {
+			return domain.ErrNotSuspended
+		}
+
+		return updateUser(ctx, tx, user)
+	})
+}
+
+// ReinstateExpired reactivates the user only if their suspension has run out
+// by now. A suspension extended or made indefinite since it was listed as
+// expired is left alone and reported as domain.ErrNotSuspended.
+func (r *userRepository) ReinstateExpired(ctx context.Context, id string, now time.Time) error {
+	query := `
+		UPDATE users
+		SET status = $1, suspension_reason = '', suspended_until = NULL, updated_at = $2
+		WHERE id = $3 AND status = $4 AND suspended_until IS NOT NULL AND suspended_until <= $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, domain.StatusActive, now, id, domain.StatusSuspended)
+	if err != nil {
+		return fmt.Errorf("failed to reinstate user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotSuspended
+	}
+
+	return nil
+}
+
+func (r *userRepository) ListTokenRevocations(ctx context.Context, since time.Time) (map[string]time.Time, error) {
+	query := `SELECT id, tokens_revoked_at FROM users WHERE tokens_revoked_at > $1`
+
+	rows, err := r.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list token revocations: %w", err)
+	}
+	defer rows.Close()
+
+	revocations := make(map[string]time.Time)
+	for rows.Next() {
+		var id string
+		var revokedAt time.Time
+		if err := rows.Scan(&id, &revokedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan token revocation: %w", err)
+		}
+		revocations[id] = revokedAt
+	}
+
+	return revocations, nil
+}
+
+func (r *userRepository) ListExpiredSuspensionIDs(ctx context.Context, now time.Time) ([]string, error) {
+	query := `SELECT id FROM users WHERE status = $1 AND suspended_until IS NOT NULL AND suspended_until <= $2`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.StatusSuspended, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired suspensions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 func (r *userRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
 
@@ -209,48 +417,86 @@ func (r *userRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *userRepository) List(ctx context.Context, page, pageSize int, role *domain.UserRole, status *domain.UserStatus) ([]*domain.User, int, error) {
-	offset := (page - 1) * pageSize
+func (r *userRepository) List(ctx context.Context, filter ListFilter) ([]*domain.User, int, string, error) {
+	sortKey, ok := sortKeys[filter.SortBy]
+	if !ok {
+		sortKey = sortKeys[SortByCreatedAt]
+		filter.SortBy = SortByCreatedAt
+	}
 
-	query := `
-		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at FROM users WHERE 1=1
-	`
-	countQuery := `SELECT COUNT(*) FROM users WHERE 1=1`
+	direction, comparator := "ASC", ">"
+	if filter.SortDesc {
+		direction, comparator = "DESC", "<"
+	}
+
+	where := " WHERE 1=1"
 	args := []any{}
 	argCount := 1
 
-	if role != nil {
-		query += fmt.Sprintf("AND role = $%d", argCount)
-		countQuery += fmt.Sprintf("AND role = $%d", argCount)
-		args = append(args, *role)
+	if filter.Role != nil {
+		where += fmt.Sprintf(" AND role = $%d", argCount)
+		args = append(args, *filter.Role)
 		argCount++
 	}
-	if status != nil {
-		query += fmt.Sprintf("AND status = $%d", argCount)
-		countQuery += fmt.Sprintf("AND status = $%d", argCount)
-		args = append(args, *status)
+	if filter.Status != nil {
+		where += fmt.Sprintf(" AND status = $%d", argCount)
+		args = append(args, *filter.Status)
+		argCount++
+	}
+	if filter.Search != nil && strings.TrimSpace(*filter.Search) != "" {
+		// Matches the trigram index on the same expression
+		where += fmt.Sprintf(" AND (first_name || ' ' || last_name || ' ' || email) ILIKE $%d", argCount)
+		args = append(args, "%"+escapeLike(strings.TrimSpace(*filter.Search))+"%")
 		argCount++
 	}
 
 	// Getting total count of records
 	var total int
-	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
-		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users`+where, args...).Scan(&total); err != nil {
+		return nil, 0, "", fmt.Errorf("failed to count users: %w", err)
+	}
+
+	query := `
+		SELECT id, email, password_hash, first_name, last_name, role, status, avatar_url, bio, created_at, updated_at, suspension_reason, suspended_until FROM users
+	` + where
+
+	useCursor := filter.Cursor != "" || filter.Page < 1
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		if c.SortBy != filter.SortBy || c.SortDesc != filter.SortDesc {
+			return nil, 0, "", domain.ErrInvalidPageToken
+		}
+
+		query += fmt.Sprintf(" AND (%s, id) %s ($%d, $%d)", sortKey, comparator, argCount, argCount+1)
+		args = append(args, c.Value, c.ID)
+		argCount += 2
 	}
 
-	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, pageSize, offset)
+	// One extra row tells us whether another page exists
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT $%d", sortKey, direction, direction, argCount)
+	args = append(args, filter.PageSize+1)
+	argCount++
+
+	if !useCursor {
+		query += fmt.Sprintf(" OFFSET $%d", argCount)
+		args = append(args, (filter.Page-1)*filter.PageSize)
+	}
 
 	// Getting records out of the databse
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+		return nil, 0, "", fmt.Errorf("failed to list users: %w", err)
 	}
 	defer rows.Close()
 
 	var users []*domain.User
 	for rows.Next() {
 		var user domain.User
+		var suspendedUntil sql.NullTime
+
 		if err := rows.Scan(
 			&user.ID,
 			&user.Email,
@@ -263,11 +509,50 @@ func (r *userRepository) List(ctx context.Context, page, pageSize int, role *dom
 			&user.Bio,
 			&user.CreatedAt,
 			&user.UpdatedAt,
+			&user.SuspensionReason,
+			&suspendedUntil,
 		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan user: %w", err)
+			return nil, 0, "", fmt.Errorf("failed to scan user: %w", err)
 		}
+
+		if suspendedUntil.Valid {
+			user.SuspendedUntil = &suspendedUntil.Time
+		}
+
 		users = append(users, &user)
 	}
 
-	return users, total, nil
+	// A token only makes sense for keyset paging; OFFSET callers keep
+	// asking for pages by number
+	var nextCursor string
+	if len(users) > filter.PageSize {
+		users = users[:filter.PageSize]
+		if useCursor {
+			last := users[len(users)-1]
+			nextCursor = encodeCursor(cursor{
+				SortBy:   filter.SortBy,
+				SortDesc: filter.SortDesc,
+				Value:    sortValue(last, filter.SortBy),
+				ID:       last.ID,
+			})
+		}
+	}
+
+	return users, total, nextCursor, nil
+}
+
+// sortValue mirrors the SQL expressions in sortKeys for a scanned row.
+func sortValue(user *domain.User, sortBy SortField) string {
+	switch sortBy {
+	case SortByName:
+		return strings.ToLower(user.FirstName + " " + user.LastName)
+	case SortByEmail:
+		return strings.ToLower(user.Email)
+	default:
+		return user.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/service"
+	"go.uber.org/zap"
+)
+
+// InstructorCourseScheduler reconciles the courses instructor stats are
+// attributed by with course-service, on start and then periodically.
+type InstructorCourseScheduler struct {
+	service  service.InstructorService
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func NewInstructorCourseScheduler(
+	service service.InstructorService,
+	interval time.Duration,
+	logger *zap.Logger,
+) *InstructorCourseScheduler {
+	return &InstructorCourseScheduler{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Start runs until ctx is cancelled.
+func (s *InstructorCourseScheduler) Start(ctx context.Context) {
+	s.logger.Info("starting instructor course scheduler", zap.Duration("interval", s.interval))
+
+	s.runOnce(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping instructor course scheduler")
+			return
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+func (s *InstructorCourseScheduler) runOnce(ctx context.Context) {
+	if err := s.service.ReconcileCourses(ctx); err != nil {
+		s.logger.Error("failed to reconcile instructor courses", zap.Error(err))
+	}
+}
@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/user-service/internal/service"
+	"go.uber.org/zap"
+)
+
+// ReinstatementScheduler periodically reactivates users whose time-bound
+// suspension has ended.
+type ReinstatementScheduler struct {
+	repo     repository.UserRepository
+	service  service.UserService
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func NewReinstatementScheduler(
+	repo repository.UserRepository,
+	service service.UserService,
+	interval time.Duration,
+	logger *zap.Logger,
+) *ReinstatementScheduler {
+	return &ReinstatementScheduler{
+		repo:     repo,
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Start runs until ctx is cancelled.
+func (s *ReinstatementScheduler) Start(ctx context.Context) {
+	s.logger.Info("starting reinstatement scheduler", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("stopping reinstatement scheduler")
+			return
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+func (s *ReinstatementScheduler) runOnce(ctx context.Context) {
+	ids, err := s.repo.ListExpiredSuspensionIDs(ctx, time.Now())
+	if err != nil {
+		s.logger.Error("failed to list expired suspensions", zap.Error(err))
+		return
+	}
+
+	for _, id := range ids {
+		err := s.service.ReinstateExpired(ctx, id)
+		// Lifted, extended or made indefinite since it was listed
+		if err == domain.ErrNotSuspended {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("failed to reinstate user", zap.Error(err), zap.String("user_id", id))
+		}
+	}
+}
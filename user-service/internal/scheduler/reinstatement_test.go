@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
+	"github.com/dmehra2102/learning-platform/user-service/internal/service"
+	"go.uber.org/zap"
+)
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	expired []string
+}
+
+func (r *fakeUserRepo) ListExpiredSuspensionIDs(ctx context.Context, now time.Time) ([]string, error) {
+	return r.expired, nil
+}
+
+type fakeUserService struct {
+	service.UserService
+	errs       map[string]error
+	reinstated []string
+}
+
+func (s *fakeUserService) ReinstateExpired(ctx context.Context, userID string) error {
+	s.reinstated = append(s.reinstated, userID)
+	return s.errs[userID]
+}
+
+func TestReinstatementSchedulerRunOnce(t *testing.T) {
+	users := &fakeUserService{errs: map[string]error{
+		"extended": domain.ErrNotSuspended,
+		"broken":   context.DeadlineExceeded,
+	}}
+	s := NewReinstatementScheduler(
+		&fakeUserRepo{expired: []string{"extended", "broken", "ended"}},
+		users,
+		time.Minute,
+		zap.NewNop(),
+	)
+
+	s.runOnce(context.Background())
+
+	// One failure mustn't hold up the rest
+	if len(users.reinstated) != 3 || users.reinstated[2] != "ended" {
+		t.Errorf("reinstated %v, want every expired user tried", users.reinstated)
+	}
+}
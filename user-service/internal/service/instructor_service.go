@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/dmehra2102/learning-platform/user-service/internal/course"
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	maxHeadlineLength = 160
+	maxProfileLinks   = 10
+)
+
+type InstructorService interface {
+	GetInstructorProfile(ctx context.Context, instructorID string) (*domain.InstructorProfile, error)
+	UpdateInstructorProfile(ctx context.Context, instructorID, headline string, links []domain.ProfileLink) (*domain.InstructorProfile, error)
+	HandleCoursePublished(ctx context.Context, key, value []byte) error
+	HandleEnrollmentSuccess(ctx context.Context, key, value []byte) error
+	HandleEnrollmentCancelled(ctx context.Context, key, value []byte) error
+	HandleReviewCreated(ctx context.Context, key, value []byte) error
+	ReconcileCourses(ctx context.Context) error
+}
+
+type instructorService struct {
+	userRepo       repository.UserRepository
+	instructorRepo repository.InstructorRepository
+	courses        course.Client
+	logger         *zap.Logger
+}
+
+func NewInstructorService(
+	userRepo repository.UserRepository,
+	instructorRepo repository.InstructorRepository,
+	courses course.Client,
+	logger *zap.Logger,
+) InstructorService {
+	return &instructorService{
+		userRepo:       userRepo,
+		instructorRepo: instructorRepo,
+		courses:        courses,
+		logger:         logger,
+	}
+}
+
+func (s *instructorService) GetInstructorProfile(ctx context.Context, instructorID string) (*domain.InstructorProfile, error) {
+	return s.instructorRepo.GetProfile(ctx, instructorID)
+}
+
+func (s *instructorService) UpdateInstructorProfile(ctx context.Context, instructorID, headline string, links []domain.ProfileLink) (*domain.InstructorProfile, error) {
+	user, err := s.userRepo.GetByID(ctx, instructorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != domain.RoleInstructor {
+		return nil, domain.ErrInstructorNotFound
+	}
+
+	if len([]rune(headline)) > maxHeadlineLength || len(links) > maxProfileLinks {
+		return nil, domain.ErrInvalidProfileLink
+	}
+
+	for _, link := range links {
+		u, err := url.Parse(link.URL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || link.Label == "" {
+			return nil, domain.ErrInvalidProfileLink
+		}
+	}
+
+	if err := s.instructorRepo.UpdateProfile(ctx, instructorID, headline, links); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("instructor profile updated", zap.String("user_id", instructorID))
+
+	return s.instructorRepo.GetProfile(ctx, instructorID)
+}
+
+func (s *instructorService) HandleCoursePublished(ctx context.Context, key, value []byte) error {
+	var event kafka.CoursePublishedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	if event.InstructorID == "" {
+		s.logger.Warn("course published event without instructor", zap.String("course_id", event.CourseID))
+		return nil
+	}
+
+	return s.instructorRepo.RecordCoursePublished(ctx, event.CourseID, event.InstructorID)
+}
+
+func (s *instructorService) HandleEnrollmentSuccess(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentSuccessEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.instructorRepo.RecordEnrollment(ctx, event.EnrollmentID, event.CourseID)
+}
+
+// HandleEnrollmentCancelled is a kafka.MessageHandler for the
+// enrollment.cancelled topic, which covers refunds.
+func (s *instructorService) HandleEnrollmentCancelled(ctx context.Context, key, value []byte) error {
+	var event kafka.EnrollmentCancelledEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.instructorRepo.RecordEnrollmentCancelled(ctx, event.EnrollmentID, event.CourseID)
+}
+
+func (s *instructorService) HandleReviewCreated(ctx context.Context, key, value []byte) error {
+	var event kafka.ReviewCreatedEvent
+	if err := kafka.UnmarshalMessage(value, &event); err != nil {
+		return err
+	}
+
+	return s.instructorRepo.RecordReview(ctx, event.ReviewID, event.CourseID, event.Rating)
+}
+
+// ReconcileCourses brings the recorded courses in line with what
+// course-service has published, filling in courses published before their
+// events were consumed and picking up changes of owner. Courses it no longer
+// lists are unpublished, unless the list changed while it was read.
+func (s *instructorService) ReconcileCourses(ctx context.Context) error {
+	const pageSize = 100
+
+	started := time.Now()
+	total := -1
+	complete := true
+
+	for page := 1; ; page++ {
+		courses, count, err := s.courses.ListPublished(ctx, page, pageSize)
+		if err != nil {
+			return err
+		}
+		if total >= 0 && count != total {
+			complete = false
+		}
+		total = count
+
+		for _, c := range courses {
+			if c.InstructorID == "" {
+				continue
+			}
+			if err := s.instructorRepo.RecordCoursePublished(ctx, c.CourseID, c.InstructorID); err != nil {
+				return err
+			}
+		}
+
+		if len(courses) < pageSize || page*pageSize >= total {
+			break
+		}
+	}
+
+	if complete {
+		if err := s.instructorRepo.UnpublishStale(ctx, started); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info("instructor courses reconciled", zap.Int("courses", total), zap.Bool("complete", complete))
+	return nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+type fakeInstructorRepo struct {
+	repository.InstructorRepository
+	published   map[string]string
+	unpublished bool
+	cancelled   []string
+	updated     bool
+}
+
+func (r *fakeInstructorRepo) GetProfile(ctx context.Context, userID string) (*domain.InstructorProfile, error) {
+	return &domain.InstructorProfile{UserID: userID}, nil
+}
+
+func (r *fakeInstructorRepo) UpdateProfile(ctx context.Context, userID, headline string, links []domain.ProfileLink) error {
+	r.updated = true
+	return nil
+}
+
+func (r *fakeInstructorRepo) RecordCoursePublished(ctx context.Context, courseID, instructorID string) error {
+	r.published[courseID] = instructorID
+	return nil
+}
+
+func (r *fakeInstructorRepo) UnpublishStale(ctx context.Context, before time.Time) error {
+	r.unpublished = true
+	return nil
+}
+
+func (r *fakeInstructorRepo) RecordEnrollmentCancelled(ctx context.Context, enrollmentID, courseID string) error {
+	r.cancelled = append(r.cancelled, enrollmentID)
+	return nil
+}
+
+// fakeCourseClient serves pages out of courses, reporting totals[i] as the
+// count on page i+1, or len(courses) past the end of totals.
+type fakeCourseClient struct {
+	courses []domain.PublishedCourse
+	totals  []int
+	err     error
+}
+
+func (c *fakeCourseClient) ListPublished(ctx context.Context, page, pageSize int) ([]domain.PublishedCourse, int, error) {
+	if c.err != nil {
+		return nil, 0, c.err
+	}
+
+	total := len(c.courses)
+	if page <= len(c.totals) {
+		total = c.totals[page-1]
+	}
+
+	start := min((page-1)*pageSize, len(c.courses))
+	end := min(start+pageSize, len(c.courses))
+	return c.courses[start:end], total, nil
+}
+
+func publishedCourses(n int) []domain.PublishedCourse {
+	courses := make([]domain.PublishedCourse, n)
+	for i := range courses {
+		courses[i] = domain.PublishedCourse{CourseID: fmt.Sprintf("course-%d", i), InstructorID: "instructor-1"}
+	}
+	return courses
+}
+
+func TestReconcileCourses(t *testing.T) {
+	tests := []struct {
+		name            string
+		client          *fakeCourseClient
+		wantErr         bool
+		wantRecorded    int
+		wantUnpublished bool
+	}{
+		{
+			name:            "single page",
+			client:          &fakeCourseClient{courses: publishedCourses(3)},
+			wantRecorded:    3,
+			wantUnpublished: true,
+		},
+		{
+			name:            "several pages",
+			client:          &fakeCourseClient{courses: publishedCourses(250)},
+			wantRecorded:    250,
+			wantUnpublished: true,
+		},
+		{
+			name:         "list changed while paging",
+			client:       &fakeCourseClient{courses: publishedCourses(250), totals: []int{250, 251}},
+			wantRecorded: 250,
+		},
+		{
+			name:         "ownerless courses are skipped",
+			client:       &fakeCourseClient{courses: []domain.PublishedCourse{{CourseID: "course-0"}}},
+			wantRecorded: 0, wantUnpublished: true,
+		},
+		{
+			name:    "course-service unavailable",
+			client:  &fakeCourseClient{err: errors.New("unavailable")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeInstructorRepo{published: map[string]string{}}
+			s := NewInstructorService(nil, repo, tt.client, zap.NewNop())
+
+			err := s.ReconcileCourses(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReconcileCourses() error = %v, want error %v", err, tt.wantErr)
+			}
+			if len(repo.published) != tt.wantRecorded {
+				t.Errorf("recorded %d courses, want %d", len(repo.published), tt.wantRecorded)
+			}
+			// Unpublishing after a partial read would drop live courses
+			if repo.unpublished != tt.wantUnpublished {
+				t.Errorf("unpublished stale = %v, want %v", repo.unpublished, tt.wantUnpublished)
+			}
+		})
+	}
+}
+
+func TestUpdateInstructorProfile(t *testing.T) {
+	link := func(label, url string) domain.ProfileLink { return domain.ProfileLink{Label: label, URL: url} }
+
+	tests := []struct {
+		name     string
+		userID   string
+		headline string
+		links    []domain.ProfileLink
+		wantErr  error
+	}{
+		{name: "valid", userID: "instructor-1", headline: "Teaches Go", links: []domain.ProfileLink{link("Site", "https://example.com")}},
+		{name: "not an instructor", userID: "user-1", wantErr: domain.ErrInstructorNotFound},
+		{name: "headline too long", userID: "instructor-1", headline: strings.Repeat("x", maxHeadlineLength+1), wantErr: domain.ErrInvalidProfileLink},
+		{name: "too many links", userID: "instructor-1", links: make([]domain.ProfileLink, maxProfileLinks+1), wantErr: domain.ErrInvalidProfileLink},
+		{name: "script url", userID: "instructor-1", links: []domain.ProfileLink{link("Site", "javascript:alert(1)")}, wantErr: domain.ErrInvalidProfileLink},
+		{name: "relative url", userID: "instructor-1", links: []domain.ProfileLink{link("Site", "/about")}, wantErr: domain.ErrInvalidProfileLink},
+		{name: "missing label", userID: "instructor-1", links: []domain.ProfileLink{link("", "https://example.com")}, wantErr: domain.ErrInvalidProfileLink},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeUserRepo{users: map[string]*domain.User{
+				"instructor-1": {ID: "instructor-1", Role: domain.RoleInstructor},
+				"user-1":       {ID: "user-1", Role: domain.RoleStudent},
+			}}
+			repo := &fakeInstructorRepo{}
+			s := NewInstructorService(users, repo, nil, zap.NewNop())
+
+			_, err := s.UpdateInstructorProfile(context.Background(), tt.userID, tt.headline, tt.links)
+			if err != tt.wantErr {
+				t.Fatalf("UpdateInstructorProfile() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.updated != (tt.wantErr == nil) {
+				t.Errorf("profile saved = %v, want %v", repo.updated, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestHandleEnrollmentCancelled(t *testing.T) {
+	repo := &fakeInstructorRepo{}
+	s := NewInstructorService(nil, repo, nil, zap.NewNop())
+
+	value := []byte(`{"enrollment_id":"enrollment-1","course_id":"course-1"}`)
+	if err := s.HandleEnrollmentCancelled(context.Background(), nil, value); err != nil {
+		t.Fatalf("HandleEnrollmentCancelled() error = %v", err)
+	}
+	if len(repo.cancelled) != 1 || repo.cancelled[0] != "enrollment-1" {
+		t.Errorf("cancelled = %v, want [enrollment-1]", repo.cancelled)
+	}
+
+	if err := s.HandleEnrollmentCancelled(context.Background(), nil, []byte("{")); err == nil {
+		t.Error("HandleEnrollmentCancelled() accepted a malformed event")
+	}
+}
@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
 	"github.com/dmehra2102/learning-platform/shared/pkg/jwt"
 	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
 	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/password"
 	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -21,29 +26,62 @@ type UserService interface {
 	GetUserByIDs(ctx context.Context, ids []string) ([]*domain.User, error)
 	UpdateUser(ctx context.Context, id string, firstName, lastName, avatarURL, bio *string) (*domain.User, error)
 	DeleteUser(ctx context.Context, id string) error
-	LisUsers(ctx context.Context, page, pageSize int, role *domain.UserRole, status *domain.UserStatus) ([]*domain.User, int, error)
+	LisUsers(ctx context.Context, filter repository.ListFilter) ([]*domain.User, int, string, error)
 	ValidateToken(ctx context.Context, token string) (bool, string, domain.UserRole, error)
 	ChangeUserRole(ctx context.Context, id string, role domain.UserRole) (*domain.User, error)
+	ImpersonateUser(ctx context.Context, adminID, userID, reason string) (*domain.User, string, time.Time, error)
+	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	SuspendUser(ctx context.Context, adminID, userID, reason string, until *time.Time) (*domain.User, error)
+	ReinstateUser(ctx context.Context, actorID, userID, reason string) (*domain.User, error)
+	ReinstateExpired(ctx context.Context, userID string) error
+}
+
+type Config struct {
+	ImpersonationTokenTTL time.Duration
+	PasswordResetTokenTTL time.Duration
+}
+
+// Producers holds one Kafka producer per topic the service publishes to.
+type Producers struct {
+	UserRegistered *kafka.Producer
+	PasswordReset  *kafka.Producer
+	UserSuspended  *kafka.Producer
+	UserReinstated *kafka.Producer
+	ProfileUpdated *kafka.Producer
 }
 
 type userService struct {
-	repo          repository.UserRepository
-	jwtManager    *jwt.Manager
-	kafkaProducer *kafka.Producer
-	logger        *zap.Logger
+	repo           repository.UserRepository
+	resetRepo      repository.PasswordResetRepository
+	jwtManager     *jwt.Manager
+	producers      Producers
+	passwordPolicy *password.Policy
+	revocations    *interceptor.RevocationList
+	logger         *zap.Logger
+	cfg            Config
 }
 
 func NewUserService(
 	repo repository.UserRepository,
+	resetRepo repository.PasswordResetRepository,
 	jwtManager *jwt.Manager,
-	kafkaProducer *kafka.Producer,
+	producers Producers,
+	passwordPolicy *password.Policy,
+	revocations *interceptor.RevocationList,
 	logger *zap.Logger,
+	cfg Config,
 ) UserService {
 	return &userService{
-		repo:          repo,
-		jwtManager:    jwtManager,
-		kafkaProducer: kafkaProducer,
-		logger:        logger,
+		repo:           repo,
+		resetRepo:      resetRepo,
+		jwtManager:     jwtManager,
+		producers:      producers,
+		passwordPolicy: passwordPolicy,
+		revocations:    revocations,
+		logger:         logger,
+		cfg:            cfg,
 	}
 }
 
@@ -56,6 +94,10 @@ func (s *userService) Register(ctx context.Context, email, password, firstName,
 		return nil, "", "", domain.ErrEmailAlreadyExists
 	}
 
+	if err := s.passwordPolicy.Validate(password, email, firstName, lastName); err != nil {
+		return nil, "", "", err
+	}
+
 	// hashing the password to store it in DB
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -97,7 +139,7 @@ func (s *userService) Register(ctx context.Context, email, password, firstName,
 		Timestamp: time.Now(),
 	}
 
-	if err := s.kafkaProducer.PublishMessage(ctx, user.ID, event); err != nil {
+	if err := s.producers.UserRegistered.PublishMessage(ctx, user.ID, event); err != nil {
 		s.logger.Error("failed to publish user registered event", zap.Error(err))
 	}
 
@@ -152,12 +194,27 @@ func (s *userService) UpdateUser(ctx context.Context, id string, firstName, last
 		return nil, err
 	}
 
+	oldFirstName, oldLastName := user.FirstName, user.LastName
 	user.UpdateProfile(firstName, lastName, avatarURL, bio)
 
 	if err := s.repo.Update(ctx, user); err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	// Other services show the name, e.g. on an instructor's courses
+	if user.FirstName != oldFirstName || user.LastName != oldLastName {
+		event := kafka.UserProfileUpdatedEvent{
+			UserID:    user.ID,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Timestamp: user.UpdatedAt,
+		}
+
+		if err := s.producers.ProfileUpdated.PublishMessage(ctx, user.ID, event); err != nil {
+			s.logger.Error("failed to publish user profile updated event", zap.Error(err))
+		}
+	}
+
 	s.logger.Info("user updated successfully", zap.String("user_id", user.ID))
 
 	return user, nil
@@ -173,8 +230,15 @@ func (s *userService) DeleteUser(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *userService) LisUsers(ctx context.Context, page, pageSize int, role *domain.UserRole, status *domain.UserStatus) ([]*domain.User, int, error) {
-	return s.repo.List(ctx, page, pageSize, role, status)
+func (s *userService) LisUsers(ctx context.Context, filter repository.ListFilter) ([]*domain.User, int, string, error) {
+	if filter.Page < 0 {
+		filter.Page = 0
+	}
+	if filter.PageSize < 1 || filter.PageSize > 100 {
+		filter.PageSize = 10
+	}
+
+	return s.repo.List(ctx, filter)
 }
 
 func (s *userService) ValidateToken(ctx context.Context, token string) (bool, string, domain.UserRole, error) {
@@ -183,6 +247,10 @@ func (s *userService) ValidateToken(ctx context.Context, token string) (bool, st
 		return false, "", "", err
 	}
 
+	if claims.IssuedAt != nil && s.revocations.IsRevoked(claims.UserID, claims.IssuedAt.Time) {
+		return false, "", "", jwt.ErrInvalidToken
+	}
+
 	return true, claims.UserID, domain.UserRole(claims.Role), nil
 }
 
@@ -205,3 +273,279 @@ func (s *userService) ChangeUserRole(ctx context.Context, id string, role domain
 
 	return user, nil
 }
+
+func (s *userService) ImpersonateUser(ctx context.Context, adminID, userID, reason string) (*domain.User, string, time.Time, error) {
+	if reason == "" {
+		return nil, "", time.Time{}, domain.ErrReasonRequired
+	}
+	if adminID == userID {
+		return nil, "", time.Time{}, domain.ErrCannotImpersonate
+	}
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+
+	// Admin sessions are never impersonated, so a token can't be used to
+	// pick up another admin's privileges.
+	if user.Role == domain.RoleAdmin || user.Status != domain.StatusActive {
+		return nil, "", time.Time{}, domain.ErrCannotImpersonate
+	}
+
+	expiresAt := time.Now().Add(s.cfg.ImpersonationTokenTTL)
+	accessToken, err := s.jwtManager.GenerateImpersonationToken(user.ID, user.Email, string(user.Role), adminID, s.cfg.ImpersonationTokenTTL)
+	if err != nil {
+		return nil, "", time.Time{}, fmt.Errorf("failed to generate impersonation token: %w", err)
+	}
+
+	s.logger.Info("impersonation token issued",
+		zap.String("actor_id", adminID),
+		zap.String("user_id", user.ID),
+		zap.String("reason", reason),
+		zap.Time("expires_at", expiresAt),
+	)
+
+	return user, accessToken, expiresAt, nil
+}
+
+func (s *userService) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(currentPassword)); err != nil {
+		return domain.ErrInvalidCredentials
+	}
+
+	if currentPassword == newPassword {
+		return fmt.Errorf("%w: must differ from the current password", domain.ErrWeakPassword)
+	}
+
+	if err := s.passwordPolicy.Validate(newPassword, user.Email, user.FirstName, user.LastName); err != nil {
+		return err
+	}
+
+	if err := s.setPassword(ctx, user, newPassword); err != nil {
+		return err
+	}
+
+	s.logger.Info("password changed successfully", zap.String("user_id", user.ID))
+
+	return nil
+}
+
+// RequestPasswordReset issues a single-use reset token and hands it to the
+// notification pipeline. Unknown emails are not reported to the caller so the
+// endpoint can't be used to discover accounts.
+func (s *userService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		if err == domain.ErrUserNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	resetToken := &domain.PasswordResetToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		TokenHash: hashResetToken(token),
+		ExpiresAt: time.Now().Add(s.cfg.PasswordResetTokenTTL),
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.resetRepo.Create(ctx, resetToken); err != nil {
+		return err
+	}
+
+	event := kafka.PasswordResetRequestedEvent{
+		UserID:    user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		Token:     token,
+		ExpiresAt: resetToken.ExpiresAt,
+		Timestamp: time.Now(),
+	}
+
+	if err := s.producers.PasswordReset.PublishMessage(ctx, user.ID, event); err != nil {
+		return fmt.Errorf("failed to publish password reset event: %w", err)
+	}
+
+	s.logger.Info("password reset requested", zap.String("user_id", user.ID))
+
+	return nil
+}
+
+func (s *userService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	resetToken, err := s.resetRepo.GetByTokenHash(ctx, hashResetToken(token))
+	if err != nil {
+		return err
+	}
+
+	if !resetToken.IsUsable() {
+		return domain.ErrInvalidResetToken
+	}
+
+	user, err := s.repo.GetByID(ctx, resetToken.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Validate before burning the token so a rejected password can be retried
+	if err := s.passwordPolicy.Validate(newPassword, user.Email, user.FirstName, user.LastName); err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Whoever knew the old password may still hold a token
+	revokedAt := time.Now()
+	if err := s.resetRepo.Redeem(ctx, resetToken, string(hashedPassword), revokedAt); err != nil {
+		return err
+	}
+	s.revocations.Revoke(user.ID, revokedAt)
+
+	s.logger.Info("password reset successfully", zap.String("user_id", user.ID))
+
+	return nil
+}
+
+func (s *userService) setPassword(ctx context.Context, user *domain.User, newPassword string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Sessions opened with the old password end with it
+	revokedAt := time.Now()
+	if err := s.repo.UpdatePassword(ctx, user.ID, string(hashedPassword), revokedAt); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	s.revocations.Revoke(user.ID, revokedAt)
+
+	return nil
+}
+
+// Reset tokens are stored hashed so a database leak doesn't expose usable
+// tokens.
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *userService) SuspendUser(ctx context.Context, adminID, userID, reason string, until *time.Time) (*domain.User, error) {
+	if adminID == userID {
+		return nil, domain.ErrUnauthorized
+	}
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.Suspend(reason, until); err != nil {
+		return nil, err
+	}
+
+	// Cut off every token issued so far; a reinstated user has to log in again
+	revokedAt := time.Now()
+	if err := s.repo.Suspend(ctx, user, revokedAt); err != nil {
+		return nil, fmt.Errorf("failed to suspend user: %w", err)
+	}
+	s.revocations.Revoke(user.ID, revokedAt)
+
+	event := kafka.UserSuspendedEvent{
+		UserID:          user.ID,
+		Reason:          reason,
+		SuspendedBy:     adminID,
+		SuspendedUntil:  until,
+		TokensRevokedAt: revokedAt,
+		Timestamp:       time.Now(),
+	}
+
+	if err := s.producers.UserSuspended.PublishMessage(ctx, user.ID, event); err != nil {
+		s.logger.Error("failed to publish user suspended event", zap.Error(err))
+	}
+
+	s.logger.Info("user suspended",
+		zap.String("user_id", user.ID),
+		zap.String("suspended_by", adminID),
+		zap.String("reason", reason),
+	)
+
+	return user, nil
+}
+
+func (s *userService) ReinstateUser(ctx context.Context, actorID, userID, reason string) (*domain.User, error) {
+	if reason == "" {
+		return nil, domain.ErrReasonRequired
+	}
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Status != domain.StatusSuspended {
+		return nil, domain.ErrNotSuspended
+	}
+
+	user.Activate()
+
+	if err := s.repo.Reinstate(ctx, user); err != nil {
+		if err == domain.ErrNotSuspended || err == domain.ErrUserNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to reinstate user: %w", err)
+	}
+
+	s.publishReinstated(ctx, user.ID, actorID, reason)
+
+	return user, nil
+}
+
+// systemActorID identifies automatic reinstatements in events and logs.
+const systemActorID = "system"
+
+// ReinstateExpired reactivates a user whose time-bound suspension has ended.
+// It returns domain.ErrNotSuspended if the suspension has since been lifted,
+// extended or made indefinite.
+func (s *userService) ReinstateExpired(ctx context.Context, userID string) error {
+	if err := s.repo.ReinstateExpired(ctx, userID, time.Now()); err != nil {
+		return err
+	}
+
+	s.publishReinstated(ctx, userID, systemActorID, "suspension period ended")
+
+	return nil
+}
+
+func (s *userService) publishReinstated(ctx context.Context, userID, actorID, reason string) {
+	event := kafka.UserReinstatedEvent{
+		UserID:       userID,
+		Reason:       reason,
+		ReinstatedBy: actorID,
+		Timestamp:    time.Now(),
+	}
+
+	if err := s.producers.UserReinstated.PublishMessage(ctx, userID, event); err != nil {
+		s.logger.Error("failed to publish user reinstated event", zap.Error(err))
+	}
+
+	s.logger.Info("user reinstated",
+		zap.String("user_id", userID),
+		zap.String("reinstated_by", actorID),
+		zap.String("reason", reason),
+	)
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dmehra2102/learning-platform/shared/pkg/interceptor"
+	"github.com/dmehra2102/learning-platform/shared/pkg/jwt"
+	"github.com/dmehra2102/learning-platform/shared/pkg/kafka"
+	"github.com/dmehra2102/learning-platform/user-service/internal/domain"
+	"github.com/dmehra2102/learning-platform/user-service/internal/password"
+	"github.com/dmehra2102/learning-platform/user-service/internal/repository"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The fakes embed the repository interfaces so they only need the methods
+// the tests use; anything else panics.
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users     map[string]*domain.User
+	revokedAt map[string]time.Time
+}
+
+func (r *fakeUserRepo) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepo) UpdatePassword(ctx context.Context, id, passwordHash string, revokedAt time.Time) error {
+	r.users[id].PasswordHash = passwordHash
+	r.revokedAt[id] = revokedAt
+	return nil
+}
+
+func (r *fakeUserRepo) Suspend(ctx context.Context, user *domain.User, revokedAt time.Time) error {
+	copied := *user
+	r.users[user.ID] = &copied
+	r.revokedAt[user.ID] = revokedAt
+	return nil
+}
+
+func (r *fakeUserRepo) Reinstate(ctx context.Context, user *domain.User) error {
+	if r.users[user.ID].Status != domain.StatusSuspended {
+		return domain.ErrNotSuspended
+	}
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *fakeUserRepo) ReinstateExpired(ctx context.Context, id string, now time.Time) error {
+	user, ok := r.users[id]
+	if !ok || user.Status != domain.StatusSuspended || user.SuspendedUntil == nil || user.SuspendedUntil.After(now) {
+		return domain.ErrNotSuspended
+	}
+	user.Activate()
+	return nil
+}
+
+type fakeResetRepo struct {
+	repository.PasswordResetRepository
+	users  *fakeUserRepo
+	tokens map[string]*domain.PasswordResetToken
+}
+
+func (r *fakeResetRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	token, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, domain.ErrInvalidResetToken
+	}
+	return token, nil
+}
+
+func (r *fakeResetRepo) Redeem(ctx context.Context, token *domain.PasswordResetToken, passwordHash string, revokedAt time.Time) error {
+	now := time.Now()
+	token.UsedAt = &now
+	return r.users.UpdatePassword(ctx, token.UserID, passwordHash, revokedAt)
+}
+
+const testPassword = "Old-password-1"
+
+func newTestUserService(t *testing.T) (*userService, *fakeUserRepo, *fakeResetRepo, *interceptor.RevocationList) {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := &fakeUserRepo{
+		users: map[string]*domain.User{
+			"user-1":  {ID: "user-1", Email: "ada@example.com", FirstName: "Ada", LastName: "Lovelace", PasswordHash: string(hash), Status: domain.StatusActive, Role: domain.RoleStudent},
+			"admin-1": {ID: "admin-1", Email: "root@example.com", FirstName: "Root", LastName: "Admin", Status: domain.StatusActive, Role: domain.RoleAdmin},
+		},
+		revokedAt: map[string]time.Time{},
+	}
+	resets := &fakeResetRepo{users: users, tokens: map[string]*domain.PasswordResetToken{}}
+	revocations := interceptor.NewRevocationList()
+
+	s := NewUserService(
+		users,
+		resets,
+		jwt.NewManager("test-secret", time.Hour, 24*time.Hour),
+		Producers{
+			UserSuspended:  kafka.NewProducer(nil, "user.suspended", zap.NewNop()),
+			UserReinstated: kafka.NewProducer(nil, "user.reinstated", zap.NewNop()),
+		},
+		password.NewPolicy(password.Config{MinLength: 8}, nil),
+		revocations,
+		zap.NewNop(),
+		Config{},
+	).(*userService)
+
+	return s, users, resets, revocations
+}
+
+func TestChangePassword(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     string
+		newPassword string
+		wantErr     error
+	}{
+		{"changes the password", testPassword, "New-password-2", nil},
+		{"wrong current password", "nope-nope-1", "New-password-2", domain.ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, _, revocations := newTestUserService(t)
+			issuedAt := time.Now().Add(-time.Minute)
+
+			err := s.ChangePassword(context.Background(), "user-1", tt.current, tt.newPassword)
+			if err != tt.wantErr {
+				t.Fatalf("ChangePassword() error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, revoked := users.revokedAt["user-1"]
+			if revoked != (tt.wantErr == nil) {
+				t.Errorf("tokens revoked = %v, want %v", revoked, tt.wantErr == nil)
+			}
+			if got := revocations.IsRevoked("user-1", issuedAt); got != (tt.wantErr == nil) {
+				t.Errorf("earlier token revoked = %v, want %v", got, tt.wantErr == nil)
+			}
+		})
+	}
+
+	t.Run("same as the current password", func(t *testing.T) {
+		s, _, _, _ := newTestUserService(t)
+		if err := s.ChangePassword(context.Background(), "user-1", testPassword, testPassword); err == nil {
+			t.Error("ChangePassword() succeeded, want an error")
+		}
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	const token = "reset-token"
+
+	tests := []struct {
+		name        string
+		expiresIn   time.Duration
+		used        bool
+		newPassword string
+		wantErr     bool
+	}{
+		{name: "resets the password", expiresIn: time.Hour, newPassword: "New-password-2"},
+		{name: "expired token", expiresIn: -time.Minute, newPassword: "New-password-2", wantErr: true},
+		{name: "used token", expiresIn: time.Hour, used: true, newPassword: "New-password-2", wantErr: true},
+		{name: "weak password keeps the token", expiresIn: time.Hour, newPassword: "short", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, resets, revocations := newTestUserService(t)
+			issuedAt := time.Now().Add(-time.Minute)
+
+			resetToken := &domain.PasswordResetToken{ID: "reset-1", UserID: "user-1", TokenHash: hashResetToken(token), ExpiresAt: time.Now().Add(tt.expiresIn)}
+			if tt.used {
+				usedAt := time.Now().Add(-time.Minute)
+				resetToken.UsedAt = &usedAt
+			}
+			resets.tokens[resetToken.TokenHash] = resetToken
+
+			err := s.ResetPassword(context.Background(), token, tt.newPassword)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResetPassword() error = %v, want error %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if _, revoked := users.revokedAt["user-1"]; revoked {
+					t.Error("tokens revoked after a failed reset")
+				}
+				if !tt.used && resetToken.UsedAt != nil {
+					t.Error("reset token used up by a failed reset")
+				}
+				return
+			}
+
+			if err := bcrypt.CompareHashAndPassword([]byte(users.users["user-1"].PasswordHash), []byte(tt.newPassword)); err != nil {
+				t.Error("password not updated")
+			}
+			if !revocations.IsRevoked("user-1", issuedAt) {
+				t.Error("token issued before the reset still accepted")
+			}
+		})
+	}
+}
+
+// cancelledContext makes event publishing fail at once instead of waiting on
+// a broker.
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func TestSuspendUser(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		adminID string
+		userID  string
+		reason  string
+		until   *time.Time
+		wantErr error
+	}{
+		{name: "indefinitely", adminID: "admin-1", userID: "user-1", reason: "spam"},
+		{name: "until a date", adminID: "admin-1", userID: "user-1", reason: "spam", until: &future},
+		{name: "until a past date", adminID: "admin-1", userID: "user-1", reason: "spam", until: &past, wantErr: domain.ErrInvalidSuspension},
+		{name: "without a reason", adminID: "admin-1", userID: "user-1", wantErr: domain.ErrReasonRequired},
+		{name: "themselves", adminID: "admin-1", userID: "admin-1", reason: "oops", wantErr: domain.ErrUnauthorized},
+		{name: "unknown user", adminID: "admin-1", userID: "nobody", reason: "spam", wantErr: domain.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, _, revocations := newTestUserService(t)
+			issuedAt := time.Now().Add(-time.Minute)
+
+			_, err := s.SuspendUser(cancelledContext(), tt.adminID, tt.userID, tt.reason, tt.until)
+			if err != tt.wantErr {
+				t.Fatalf("SuspendUser() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if revocations.IsRevoked(tt.userID, issuedAt) {
+					t.Error("tokens revoked after a failed suspension")
+				}
+				return
+			}
+
+			if users.users["user-1"].Status != domain.StatusSuspended {
+				t.Errorf("status = %s, want %s", users.users["user-1"].Status, domain.StatusSuspended)
+			}
+			if !revocations.IsRevoked("user-1", issuedAt) {
+				t.Error("token issued before the suspension still accepted")
+			}
+		})
+	}
+}
+
+func TestReinstateUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		suspended bool
+		reason    string
+		wantErr   error
+	}{
+		{name: "suspended user", suspended: true, reason: "appeal upheld"},
+		{name: "without a reason", suspended: true, wantErr: domain.ErrReasonRequired},
+		{name: "active user", reason: "appeal upheld", wantErr: domain.ErrNotSuspended},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, _, _ := newTestUserService(t)
+			if tt.suspended {
+				users.users["user-1"].Suspend("spam", nil)
+			}
+
+			user, err := s.ReinstateUser(cancelledContext(), "admin-1", "user-1", tt.reason)
+			if err != tt.wantErr {
+				t.Fatalf("ReinstateUser() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if user.Status != domain.StatusActive || users.users["user-1"].Status != domain.StatusActive {
+				t.Errorf("status = %s, want %s", users.users["user-1"].Status, domain.StatusActive)
+			}
+			if users.users["user-1"].SuspensionReason != "" {
+				t.Errorf("SuspensionReason = %q, want it cleared", users.users["user-1"].SuspensionReason)
+			}
+		})
+	}
+}
+
+func TestReinstateExpired(t *testing.T) {
+	ended := time.Now().Add(-time.Minute)
+	extended := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		until      *time.Time
+		wantErr    error
+		wantStatus domain.UserStatus
+	}{
+		{name: "suspension ended", until: &ended, wantStatus: domain.StatusActive},
+		{name: "suspension extended", until: &extended, wantErr: domain.ErrNotSuspended, wantStatus: domain.StatusSuspended},
+		{name: "made indefinite", wantErr: domain.ErrNotSuspended, wantStatus: domain.StatusSuspended},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, _, _ := newTestUserService(t)
+			users.users["user-1"].Status = domain.StatusSuspended
+			users.users["user-1"].SuspensionReason = "spam"
+			users.users["user-1"].SuspendedUntil = tt.until
+
+			if err := s.ReinstateExpired(cancelledContext(), "user-1"); err != tt.wantErr {
+				t.Fatalf("ReinstateExpired() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := users.users["user-1"].Status; got != tt.wantStatus {
+				t.Errorf("status = %s, want %s", got, tt.wantStatus)
+			}
+		})
+	}
+}